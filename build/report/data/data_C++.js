@@ -0,0 +1 @@
+window.__AST_DATA__={files:[{"linesOfCode":{"linesOfCode":8,"logicalLinesOfCode":2,"nonCommentLinesOfCode":8},"path":"/tmp/cpp3/foo.cpp","pathHash":"e9f12580a97d02ea","programmingLanguage":"C++","stmts":{"analyze":{"abcSize":{"assignments":0,"branches":0,"conditions":2,"magnitude":2},"complexity":{"cognitive":1,"cyclomatic":3},"coupling":{},"maintainability":{"commentWeight":0,"maintainabilityIndex":85.02100561741385,"maintainabilityIndexWithoutComments":85.02100561741385},"risk":{"score":2},"volume":{"cloc":0,"halsteadBugs":0.004641604167868594,"halsteadDifficulty":2.75,"halsteadEffort":65.12406251802891,"halsteadEstimatedLength":5,"halsteadLength":6,"halsteadTime":3.618003473223828,"halsteadVocabulary":4,"halsteadVolume":13.92481250360578,"lloc":2,"loc":8}},"stmtClass":[{"modifiers":{"visibility":"VISIBILITY_PUBLIC"},"name":{"qualified":"Foo","short":"Foo"},"stmts":{"analyze":{"abcSize":{"assignments":0,"branches":0,"conditions":2,"magnitude":2},"classCohesion":{"lcom4":1},"complexity":{"cognitive":1,"cyclomatic":3},"coupling":{},"maintainability":{"commentWeight":0,"maintainabilityIndex":85.02100561741385,"maintainabilityIndexWithoutComments":85.02100561741385},"objectOriented":{"cbo":0,"dit":0,"noc":0,"rfc":2,"wmc":3},"volume":{"cloc":0,"halsteadBugs":0.004641604167868594,"halsteadDifficulty":2.75,"halsteadEffort":65.12406251802891,"halsteadEstimatedLength":5,"halsteadLength":6,"halsteadTime":3.618003473223828,"halsteadVocabulary":4,"halsteadVolume":13.92481250360578,"lloc":2,"loc":6}},"stmtFunction":[{"modifiers":{"visibility":"VISIBILITY_PUBLIC"},"name":{"qualified":"Foo::bar","short":"bar"},"stmts":{"analyze":{"abcSize":{"assignments":0,"branches":0,"conditions":2,"magnitude":2},"complexity":{"cognitive":1,"cyclomatic":2,"maxNesting":1,"npath":"2"}}}},{"modifiers":{"visibility":"VISIBILITY_PUBLIC"},"name":{"qualified":"Foo::baz","short":"baz"},"stmts":{"analyze":{"abcSize":{"assignments":0,"branches":0,"conditions":0,"magnitude":0},"complexity":{"cognitive":0,"cyclomatic":1,"maxNesting":0,"npath":"1"}}}}]}}]}}],risks:{},dictionary:{},fileDeps:{},folderDeps:null,depFileCount:1,nodeToCommunity:{},testQuality:{"globalIsolationScore":0,"isolationLabel":"Coupled","nbTestFiles":0,"nbProdFiles":1,"nbProdClasses":1,"nbTestedClasses":0,"traceabilityPct":0,"isolationHistogram":[0,0,0,0,0],"testFiles":[],"godTests":[],"orphanClasses":[{"className":"Foo","filePath":"/tmp/cpp3/foo.cpp","complexity":3,"efferent":0,"afferent":0,"weight":3}]}};
\ No newline at end of file
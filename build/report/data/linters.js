@@ -0,0 +1 @@
+window.__AST_LINTERS__={d:{"1fae615298f46e46":"max_cognitive","26d79c47395426c3":"max_npath","2dc0eddf17af7c63":"lloc_by_method","34776b37d1b57088":"max_loc_by_method","3e8f2d4a33c82f3c":"max_lloc","498865c7bd1f3959":"unknown","4b1485977448fc6e":"max_nesting_depth","4f3865d58c919874":"max_cbo","5360e83aa98f914f":"no_context_ignored","573b0cb4d97f1092":"afferent_coupling","611da1b5100df680":"max_file_size","68dccd823d791454":"cyclomatic_complexity","7aad19d5a53930df":"max_dit","9068a17b5c1247f2":"maintainability","a5a5766cc302b4aa":"max_abc_size","adc5fcd5c1eb1b26":"max_noc","b3348c8b07449cf6":"max_files_per_package","b4921cfb61d422c8":"slice_prealloc","bb38a0d538878063":"max_rfc","bfc966d5cc50c944":"max_loc","d0a8a89c28c33ab6":"efferent_coupling","e72175d55192416f":"max_wmc","e9f12580a97d02ea":"/tmp/cpp3/foo.cpp","f70a89a5737df1a9":"no_context_missing"},e:[],s:[["573b0cb4d97f1092","498865c7bd1f3959","e9f12580a97d02ea","Afferent coupling OK"],["d0a8a89c28c33ab6","498865c7bd1f3959","e9f12580a97d02ea","Efferent coupling OK"],["9068a17b5c1247f2","498865c7bd1f3959","e9f12580a97d02ea","Maintainability OK"],["bfc966d5cc50c944","498865c7bd1f3959","e9f12580a97d02ea","Max Lines of code OK"],["3e8f2d4a33c82f3c","498865c7bd1f3959","e9f12580a97d02ea","Logical lines of code OK"],["34776b37d1b57088","498865c7bd1f3959","e9f12580a97d02ea","LOC by method OK"],["2dc0eddf17af7c63","498865c7bd1f3959","e9f12580a97d02ea","LLOC by method OK"],["68dccd823d791454","498865c7bd1f3959","e9f12580a97d02ea","Cyclomatic complexity OK"],["1fae615298f46e46","498865c7bd1f3959","e9f12580a97d02ea","Cognitive complexity OK"],["26d79c47395426c3","498865c7bd1f3959","e9f12580a97d02ea","NPath complexity OK"],["a5a5766cc302b4aa","498865c7bd1f3959","e9f12580a97d02ea","ABC size OK"],["e72175d55192416f","498865c7bd1f3959","e9f12580a97d02ea","Weighted methods per class OK"],["7aad19d5a53930df","498865c7bd1f3959","e9f12580a97d02ea","Depth of inheritance tree OK"],["adc5fcd5c1eb1b26","498865c7bd1f3959","e9f12580a97d02ea","Number of children OK"],["4f3865d58c919874","498865c7bd1f3959","e9f12580a97d02ea","Coupling between objects OK"],["bb38a0d538878063","498865c7bd1f3959","e9f12580a97d02ea","Response for a class OK"],["4b1485977448fc6e","498865c7bd1f3959","e9f12580a97d02ea","Max nesting depth 1 ≤ 4"],["611da1b5100df680","498865c7bd1f3959","e9f12580a97d02ea","LOC 8 ≤ 1000"],["b3348c8b07449cf6","498865c7bd1f3959","e9f12580a97d02ea","Package '' has 0 files ≤ 50 in /tmp/cpp3"],["b4921cfb61d422c8","498865c7bd1f3959","e9f12580a97d02ea","No slice preallocation opportunities OK"],["f70a89a5737df1a9","498865c7bd1f3959","e9f12580a97d02ea","No exported I/O-related functions found"],["5360e83aa98f914f","498865c7bd1f3959","e9f12580a97d02ea","No context misuse detected"]],u:[]};
\ No newline at end of file
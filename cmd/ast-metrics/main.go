@@ -13,12 +13,22 @@ import (
 	"github.com/ast-metrics/ast-metrics/internal/configuration"
 	mcpserver "github.com/ast-metrics/ast-metrics/internal/mcp"
 	"github.com/ast-metrics/ast-metrics/internal/engine"
+	"github.com/ast-metrics/ast-metrics/internal/engine/c"
+	"github.com/ast-metrics/ast-metrics/internal/engine/cpp"
 	"github.com/ast-metrics/ast-metrics/internal/engine/csharp"
+	"github.com/ast-metrics/ast-metrics/internal/engine/elixir"
 	"github.com/ast-metrics/ast-metrics/internal/engine/golang"
+	"github.com/ast-metrics/ast-metrics/internal/engine/groovy"
 	"github.com/ast-metrics/ast-metrics/internal/engine/java"
+	"github.com/ast-metrics/ast-metrics/internal/engine/javascript"
+	"github.com/ast-metrics/ast-metrics/internal/engine/kotlin"
+	"github.com/ast-metrics/ast-metrics/internal/engine/lua"
 	"github.com/ast-metrics/ast-metrics/internal/engine/php"
 	"github.com/ast-metrics/ast-metrics/internal/engine/python"
+	"github.com/ast-metrics/ast-metrics/internal/engine/ruby"
 	"github.com/ast-metrics/ast-metrics/internal/engine/rust"
+	"github.com/ast-metrics/ast-metrics/internal/engine/scala"
+	"github.com/ast-metrics/ast-metrics/internal/engine/swift"
 	"github.com/ast-metrics/ast-metrics/internal/engine/typescript"
 	"github.com/ast-metrics/ast-metrics/internal/watcher"
 	"github.com/pterm/pterm"
@@ -85,7 +95,17 @@ func main() {
 	runnerTypeScript := typescript.TypeScriptRunner{}
 	runnerJava := java.JavaRunner{}
 	runnerCSharp := csharp.CSharpRunner{}
-	runners := []engine.Engine{&runnerPhp, &runnerGolang, &runnerPython, &runnerRust, &runnerTypeScript, &runnerJava, &runnerCSharp}
+	runnerJavaScript := javascript.JavaScriptRunner{}
+	runnerKotlin := kotlin.KotlinRunner{}
+	runnerRuby := ruby.RubyRunner{}
+	runnerC := c.CRunner{}
+	runnerCpp := cpp.CppRunner{}
+	runnerScala := scala.ScalaRunner{}
+	runnerGroovy := groovy.GroovyRunner{}
+	runnerSwift := swift.SwiftRunner{}
+	runnerElixir := elixir.ElixirRunner{}
+	runnerLua := lua.LuaRunner{}
+	runners := []engine.Engine{&runnerPhp, &runnerGolang, &runnerPython, &runnerRust, &runnerTypeScript, &runnerJava, &runnerCSharp, &runnerJavaScript, &runnerKotlin, &runnerRuby, &runnerC, &runnerCpp, &runnerScala, &runnerGroovy, &runnerSwift, &runnerElixir, &runnerLua}
 
 	app := &cliV2.App{
 		Name:  "ast-metrics",
@@ -245,6 +265,12 @@ func main() {
 						Usage:    "Compare with another Git branch or commit",
 						Category: "Global options",
 					},
+					// Parse errors
+					&cliV2.BoolFlag{
+						Name:     "fail-on-parse-error",
+						Usage:    "Fail when a file could not be parsed or holds syntax errors",
+						Category: "Global options",
+					},
 					// Profiling (with pprof)
 					&cliV2.BoolFlag{
 						Name:     "profile",
@@ -287,6 +313,56 @@ func main() {
 						Usage:    "Extra file extensions for C# (comma-separated)",
 						Category: "File selection",
 					},
+					&cliV2.StringFlag{
+						Name:     "javascript-extensions",
+						Usage:    "Extra file extensions for JavaScript (comma-separated)",
+						Category: "File selection",
+					},
+					&cliV2.StringFlag{
+						Name:     "kotlin-extensions",
+						Usage:    "Extra file extensions for Kotlin (comma-separated)",
+						Category: "File selection",
+					},
+					&cliV2.StringFlag{
+						Name:     "ruby-extensions",
+						Usage:    "Extra file extensions for Ruby (comma-separated)",
+						Category: "File selection",
+					},
+					&cliV2.StringFlag{
+						Name:     "c-extensions",
+						Usage:    "Extra file extensions for C (comma-separated)",
+						Category: "File selection",
+					},
+					&cliV2.StringFlag{
+						Name:     "cpp-extensions",
+						Usage:    "Extra file extensions for C++ (comma-separated)",
+						Category: "File selection",
+					},
+					&cliV2.StringFlag{
+						Name:     "scala-extensions",
+						Usage:    "Extra file extensions for Scala (comma-separated)",
+						Category: "File selection",
+					},
+					&cliV2.StringFlag{
+						Name:     "groovy-extensions",
+						Usage:    "Extra file extensions for Groovy (comma-separated)",
+						Category: "File selection",
+					},
+					&cliV2.StringFlag{
+						Name:     "swift-extensions",
+						Usage:    "Extra file extensions for Swift (comma-separated)",
+						Category: "File selection",
+					},
+					&cliV2.StringFlag{
+						Name:     "elixir-extensions",
+						Usage:    "Extra file extensions for Elixir (comma-separated)",
+						Category: "File selection",
+					},
+					&cliV2.StringFlag{
+						Name:     "lua-extensions",
+						Usage:    "Extra file extensions for Lua (comma-separated)",
+						Category: "File selection",
+					},
 				},
 				Action: func(cCtx *cliV2.Context) error {
 
@@ -439,6 +515,9 @@ func main() {
 					if cCtx.String("compare-with") != "" {
 						config.CompareWith = cCtx.String("compare-with")
 					}
+					if cCtx.Bool("fail-on-parse-error") {
+						config.FailOnParseError = true
+					}
 
 					// Run command
 					command := command.NewAnalyzeCommand(config, outWriter, runners, isInteractive)
@@ -554,6 +633,8 @@ func main() {
 					&cliV2.StringFlag{Name: "config", Usage: "Load configuration from file", Category: "Configuration"},
 					&cliV2.StringFlag{Name: "report-sarif", Usage: "Write lint violations as SARIF 2.1.0 to the given file", Category: "Report"},
 					&cliV2.StringFlag{Name: "sarif-max-level", Usage: "Cap the level of the SARIF results: error, warning or note", Category: "Report"},
+					&cliV2.StringFlag{Name: "baseline", Usage: "Report only the violations missing from the given baseline file", Category: "Baseline"},
+					&cliV2.StringFlag{Name: "generate-baseline", Usage: "Write the current violations to the given baseline file, instead of reporting them", Category: "Baseline"},
 					&cliV2.StringFlag{Name: "php-extensions", Usage: "Extra file extensions for PHP (comma-separated, e.g. .inc,.module)", Category: "File selection"},
 					&cliV2.StringFlag{Name: "go-extensions", Usage: "Extra file extensions for Go (comma-separated)", Category: "File selection"},
 					&cliV2.StringFlag{Name: "python-extensions", Usage: "Extra file extensions for Python (comma-separated)", Category: "File selection"},
@@ -561,6 +642,16 @@ func main() {
 					&cliV2.StringFlag{Name: "typescript-extensions", Usage: "Extra file extensions for TypeScript (comma-separated)", Category: "File selection"},
 					&cliV2.StringFlag{Name: "java-extensions", Usage: "Extra file extensions for Java (comma-separated)", Category: "File selection"},
 					&cliV2.StringFlag{Name: "csharp-extensions", Usage: "Extra file extensions for C# (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "javascript-extensions", Usage: "Extra file extensions for JavaScript (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "kotlin-extensions", Usage: "Extra file extensions for Kotlin (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "ruby-extensions", Usage: "Extra file extensions for Ruby (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "c-extensions", Usage: "Extra file extensions for C (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "cpp-extensions", Usage: "Extra file extensions for C++ (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "scala-extensions", Usage: "Extra file extensions for Scala (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "groovy-extensions", Usage: "Extra file extensions for Groovy (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "swift-extensions", Usage: "Extra file extensions for Swift (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "elixir-extensions", Usage: "Extra file extensions for Elixir (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "lua-extensions", Usage: "Extra file extensions for Lua (comma-separated)", Category: "File selection"},
 				},
 				Action: func(cCtx *cliV2.Context) error {
 					if cCtx.Bool("verbose") {
@@ -609,10 +700,18 @@ func main() {
 					cmd := command.NewLintCommand(cfg, outWriter, runners)
 					// pass verbose to command
 					cmd.SetVerbose(cCtx.Bool("verbose"))
+					if cCtx.String("baseline") != "" && cCtx.String("generate-baseline") != "" {
+						return fmt.Errorf("--baseline and --generate-baseline cannot be used together")
+					}
+					cmd.SetBaseline(cCtx.String("baseline"))
+					cmd.SetGenerateBaseline(cCtx.String("generate-baseline"))
 					command := cmd
 					if err := command.Execute(); err != nil {
 						return err
 					}
+					if cCtx.String("generate-baseline") != "" {
+						return nil
+					}
 
 					cli.PrintSuccess("No lint violations found.")
 
@@ -633,6 +732,7 @@ func main() {
 					&cliV2.StringFlag{Name: "report-sarif", Usage: "Generate a report in SARIF format (2.1.0)", Category: "Report"},
 					&cliV2.StringFlag{Name: "config", Usage: "Load configuration from file", Category: "Configuration"},
 					&cliV2.StringFlag{Name: "compare-with", Usage: "Compare with another Git branch or commit", Category: "Global options"},
+					&cliV2.BoolFlag{Name: "fail-on-parse-error", Usage: "Fail when a file could not be parsed or holds syntax errors", Category: "Global options"},
 					&cliV2.StringFlag{Name: "php-extensions", Usage: "Extra file extensions for PHP (comma-separated, e.g. .inc,.module)", Category: "File selection"},
 					&cliV2.StringFlag{Name: "go-extensions", Usage: "Extra file extensions for Go (comma-separated)", Category: "File selection"},
 					&cliV2.StringFlag{Name: "python-extensions", Usage: "Extra file extensions for Python (comma-separated)", Category: "File selection"},
@@ -640,6 +740,16 @@ func main() {
 					&cliV2.StringFlag{Name: "typescript-extensions", Usage: "Extra file extensions for TypeScript (comma-separated)", Category: "File selection"},
 					&cliV2.StringFlag{Name: "java-extensions", Usage: "Extra file extensions for Java (comma-separated)", Category: "File selection"},
 					&cliV2.StringFlag{Name: "csharp-extensions", Usage: "Extra file extensions for C# (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "javascript-extensions", Usage: "Extra file extensions for JavaScript (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "kotlin-extensions", Usage: "Extra file extensions for Kotlin (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "ruby-extensions", Usage: "Extra file extensions for Ruby (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "c-extensions", Usage: "Extra file extensions for C (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "cpp-extensions", Usage: "Extra file extensions for C++ (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "scala-extensions", Usage: "Extra file extensions for Scala (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "groovy-extensions", Usage: "Extra file extensions for Groovy (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "swift-extensions", Usage: "Extra file extensions for Swift (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "elixir-extensions", Usage: "Extra file extensions for Elixir (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "lua-extensions", Usage: "Extra file extensions for Lua (comma-separated)", Category: "File selection"},
 				},
 				Action: func(cCtx *cliV2.Context) error {
 					if cCtx.Bool("verbose") {
@@ -718,6 +828,9 @@ func main() {
 					if cCtx.String("compare-with") != "" {
 						cfg.CompareWith = cCtx.String("compare-with")
 					}
+					if cCtx.Bool("fail-on-parse-error") {
+						cfg.FailOnParseError = true
+					}
 					// Run CI command
 					cmd := command.NewCICommand(cfg, outWriter, runners)
 					if err := cmd.Execute(); err != nil {
@@ -748,6 +861,16 @@ func main() {
 					&cliV2.StringFlag{Name: "typescript-extensions", Usage: "Extra file extensions for TypeScript (comma-separated)", Category: "File selection"},
 					&cliV2.StringFlag{Name: "java-extensions", Usage: "Extra file extensions for Java (comma-separated)", Category: "File selection"},
 					&cliV2.StringFlag{Name: "csharp-extensions", Usage: "Extra file extensions for C# (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "javascript-extensions", Usage: "Extra file extensions for JavaScript (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "kotlin-extensions", Usage: "Extra file extensions for Kotlin (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "ruby-extensions", Usage: "Extra file extensions for Ruby (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "c-extensions", Usage: "Extra file extensions for C (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "cpp-extensions", Usage: "Extra file extensions for C++ (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "scala-extensions", Usage: "Extra file extensions for Scala (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "groovy-extensions", Usage: "Extra file extensions for Groovy (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "swift-extensions", Usage: "Extra file extensions for Swift (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "elixir-extensions", Usage: "Extra file extensions for Elixir (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "lua-extensions", Usage: "Extra file extensions for Lua (comma-separated)", Category: "File selection"},
 				},
 				Action: func(cCtx *cliV2.Context) error {
 					if cCtx.Bool("verbose") {
@@ -877,6 +1000,16 @@ func main() {
 					&cliV2.StringFlag{Name: "rust-extensions", Usage: "Extra file extensions for Rust (comma-separated)", Category: "File selection"},
 					&cliV2.StringFlag{Name: "java-extensions", Usage: "Extra file extensions for Java (comma-separated)", Category: "File selection"},
 					&cliV2.StringFlag{Name: "csharp-extensions", Usage: "Extra file extensions for C# (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "javascript-extensions", Usage: "Extra file extensions for JavaScript (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "kotlin-extensions", Usage: "Extra file extensions for Kotlin (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "ruby-extensions", Usage: "Extra file extensions for Ruby (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "c-extensions", Usage: "Extra file extensions for C (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "cpp-extensions", Usage: "Extra file extensions for C++ (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "scala-extensions", Usage: "Extra file extensions for Scala (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "groovy-extensions", Usage: "Extra file extensions for Groovy (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "swift-extensions", Usage: "Extra file extensions for Swift (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "elixir-extensions", Usage: "Extra file extensions for Elixir (comma-separated)", Category: "File selection"},
+					&cliV2.StringFlag{Name: "lua-extensions", Usage: "Extra file extensions for Lua (comma-separated)", Category: "File selection"},
 				},
 				Action: func(cCtx *cliV2.Context) error {
 					// Redirect all logging to stderr (stdout is reserved for JSON-RPC)
@@ -942,6 +1075,11 @@ func mergeExtensionFlags(cCtx *cliV2.Context, config *configuration.Configuratio
 		{"python-extensions", "python"}, {"rust-extensions", "rust"},
 		{"typescript-extensions", "typescript"},
 		{"java-extensions", "java"}, {"csharp-extensions", "csharp"},
+		{"javascript-extensions", "javascript"}, {"kotlin-extensions", "kotlin"},
+		{"ruby-extensions", "ruby"}, {"c-extensions", "c"},
+		{"cpp-extensions", "cpp"}, {"scala-extensions", "scala"},
+		{"groovy-extensions", "groovy"}, {"swift-extensions", "swift"},
+		{"elixir-extensions", "elixir"}, {"lua-extensions", "lua"},
 	} {
 		if v := cCtx.String(pair.flag); v != "" {
 			if config.Extensions == nil {
@@ -25,9 +25,12 @@ type ProjectAggregated struct {
 	// single path is analyzed, since that would duplicate the global view.
 	ByDirectory  map[string]Aggregated
 	ErroredFiles []*pb.File
-	Evaluation   *requirement.EvaluationResult
-	Comparaison  *ProjectComparaison
-	Predictions  []classifier.ClassPrediction
+	// FilesWithParseErrors holds the files that could not be analyzed, and
+	// the files analyzed despite syntax errors, sorted by path.
+	FilesWithParseErrors []*pb.File
+	Evaluation           *requirement.EvaluationResult
+	Comparaison          *ProjectComparaison
+	Predictions          []classifier.ClassPrediction
 }
 
 type AggregateResult struct {
@@ -36,6 +39,15 @@ type AggregateResult struct {
 	Max     float64
 	Avg     float64
 	Counter int
+	// Distribution of the values, computed when the metrics are reduced
+	Median    float64
+	P75       float64
+	P90       float64
+	P95       float64
+	P99       float64
+	StdDev    float64
+	Histogram []HistogramBucket
+	values    []float64
 }
 
 func NewAggregateResult() AggregateResult {
@@ -62,6 +74,7 @@ type Aggregated struct {
 	NbFunctions                             int
 	NbClasses                               int
 	NbClassesWithCode                       int
+	NbAbstractClasses                       int
 	NbMethods                               int
 	Loc                                     AggregateResult
 	Cloc                                    AggregateResult
@@ -74,6 +87,18 @@ type Aggregated struct {
 	CyclomaticComplexity                    AggregateResult
 	CyclomaticComplexityPerMethod           AggregateResult
 	CyclomaticComplexityPerClass            AggregateResult
+	CognitiveComplexityPerMethod            AggregateResult
+	CognitiveComplexityPerClass             AggregateResult
+	WmcPerClass                             AggregateResult
+	DitPerClass                             AggregateResult
+	NocPerClass                             AggregateResult
+	CboPerClass                             AggregateResult
+	RfcPerClass                             AggregateResult
+	PublicMethodsPerClass                   AggregateResult
+	NPathPerMethod                          AggregateResult
+	NestingPerMethod                        AggregateResult
+	AbcSizePerMethod                        AggregateResult
+	AbcSizePerClass                         AggregateResult
 	HalsteadDifficulty                      AggregateResult
 	HalsteadEffort                          AggregateResult
 	HalsteadVolume                          AggregateResult
@@ -99,6 +124,8 @@ type Aggregated struct {
 	ExternalNodes                           map[string]bool // node IDs that are external (not from project source)
 	Community                               *CommunityMetrics
 	TestQuality                             *TestQualityMetrics
+	Duplication                             *DuplicationMetrics
+	DocCoverage                             *DocCoverageMetrics
 	Suggestions                             []Suggestion
 	Architecture                            *ArchitectureMetrics
 }
@@ -114,6 +141,7 @@ type Aggregator struct {
 	files             []*pb.File
 	projectAggregated ProjectAggregated
 	analyzers         []AggregateAnalyzer
+	duplication       *DuplicationAggregator
 	gitSummaries      []ResultOfGitAnalysis
 	ComparedFiles     []*pb.File
 	ComparedBranch    string
@@ -155,6 +183,11 @@ func NewAggregator(files []*pb.File, gitSummaries []ResultOfGitAnalysis) *Aggreg
 	a.WithAggregateAnalyzer(NewCommunityAggregator())
 	// Run test quality analysis
 	a.WithAggregateAnalyzer(NewTestQualityAggregator())
+	// The duplicated blocks of code are searched once, on the whole project
+	// (see executeAggregationOnFiles)
+	a.duplication = NewDuplicationAggregator()
+	// Measure how much of the public API is documented
+	a.WithAggregateAnalyzer(NewDocCoverageAggregator())
 	return a
 }
 
@@ -181,6 +214,18 @@ func newAggregated() Aggregated {
 		CyclomaticComplexity:                    NewAggregateResult(),
 		CyclomaticComplexityPerMethod:           NewAggregateResult(),
 		CyclomaticComplexityPerClass:            NewAggregateResult(),
+		CognitiveComplexityPerMethod:            NewAggregateResult(),
+		CognitiveComplexityPerClass:             NewAggregateResult(),
+		WmcPerClass:                             NewAggregateResult(),
+		DitPerClass:                             NewAggregateResult(),
+		NocPerClass:                             NewAggregateResult(),
+		CboPerClass:                             NewAggregateResult(),
+		RfcPerClass:                             NewAggregateResult(),
+		PublicMethodsPerClass:                   NewAggregateResult(),
+		NPathPerMethod:                          NewAggregateResult(),
+		NestingPerMethod:                        NewAggregateResult(),
+		AbcSizePerMethod:                        NewAggregateResult(),
+		AbcSizePerClass:                         NewAggregateResult(),
 		HalsteadEffort:                          NewAggregateResult(),
 		HalsteadVolume:                          NewAggregateResult(),
 		HalsteadTime:                            NewAggregateResult(),
@@ -205,6 +250,8 @@ func newAggregated() Aggregated {
 		ExternalNodes:                           make(map[string]bool),
 		Community:                               nil,
 		TestQuality:                             nil,
+		Duplication:                             nil,
+		DocCoverage:                             nil,
 		Suggestions:                             make([]Suggestion, 0),
 	}
 }
@@ -276,6 +323,10 @@ func (r *Aggregator) Aggregates() ProjectAggregated {
 
 func (r *Aggregator) executeAggregationOnFiles(files []*pb.File) ProjectAggregated {
 
+	// Metrics that depend on the relations between classes must be known
+	// before the sums are done
+	resolveObjectOrientedMetrics(files)
+
 	projectAggregated := ProjectAggregated{
 		ByFile:                newAggregated(),
 		ByClass:               newAggregated(),
@@ -465,9 +516,26 @@ func (r *Aggregator) executeAggregationOnFiles(files []*pb.File) ProjectAggregat
 	projectAggregated.ByClass = r.mapCoupling(&projectAggregated.ByClass)
 	projectAggregated.ByFile = r.mapCoupling(&projectAggregated.ByFile)
 
+	// Duplication: the clones are searched once, among all the files; each
+	// language and directory keeps the share of its own files
+	if r.duplication != nil {
+		duplication := r.duplication.Detect(projectAggregated.ByFile.ConcernedFiles)
+		projectAggregated.ByFile.Duplication = duplication
+		projectAggregated.ByClass.Duplication = duplication
+		for k, v := range projectAggregated.ByProgrammingLanguage {
+			v.Duplication = duplication.Within(v.ConcernedFiles)
+			projectAggregated.ByProgrammingLanguage[k] = v
+		}
+		for k, v := range projectAggregated.ByDirectory {
+			v.Duplication = duplication.Within(v.ConcernedFiles)
+			projectAggregated.ByDirectory[k] = v
+		}
+	}
+
 	// For all languages (set Combined before running analyzers that rely on it)
 	projectAggregated.Combined = projectAggregated.ByFile
 	projectAggregated.ErroredFiles = projectAggregated.ByFile.ErroredFiles
+	projectAggregated.FilesWithParseErrors = filesWithParseErrors(projectAggregated.ByFile.ConcernedFiles)
 
 	// Risks
 	riskAnalyzer := NewRiskAnalyzer()
@@ -476,12 +544,36 @@ func (r *Aggregator) executeAggregationOnFiles(files []*pb.File) ProjectAggregat
 	return projectAggregated
 }
 
+// filesWithParseErrors returns the files whose parsing failed or reported
+// syntax errors, sorted by path.
+func filesWithParseErrors(files []*pb.File) []*pb.File {
+	result := make([]*pb.File, 0)
+	for _, file := range files {
+		if len(file.Errors) > 0 || len(file.ParseErrors) > 0 {
+			result = append(result, file)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Path < result[j].Path })
+	return result
+}
+
 // Add an analyzer to the aggregator
 // You can add multiple analyzers. See the example of RiskAnalyzer
 func (r *Aggregator) WithAggregateAnalyzer(analyzer AggregateAnalyzer) {
 	r.analyzers = append(r.analyzers, analyzer)
 }
 
+// WithDuplicationThresholds sets how long a block of code must be to be
+// reported as duplicated. Zero keeps the default threshold.
+func (r *Aggregator) WithDuplicationThresholds(minTokens int, minLines int) {
+	if minTokens > 0 {
+		r.duplication.MinTokens = minTokens
+	}
+	if minLines > 0 {
+		r.duplication.MinLines = minLines
+	}
+}
+
 // WithAnalyzedPaths declares the paths given by the user on the command line.
 // They are used to build ProjectAggregated.ByDirectory: one aggregate per
 // analyzed path. When fewer than two paths are given, no per-directory
@@ -623,12 +715,9 @@ func (r *Aggregator) mapSums(file *pb.File, specificAggregation Aggregated) Aggr
 		}
 	}
 
-	result.Loc.Sum += float64(file.LinesOfCode.LinesOfCode)
-	result.Loc.Counter++
-	result.Cloc.Sum += float64(file.LinesOfCode.CommentLinesOfCode)
-	result.Cloc.Counter++
-	result.Lloc.Sum += float64(file.LinesOfCode.LogicalLinesOfCode)
-	result.Lloc.Counter++
+	result.Loc.Add(float64(file.LinesOfCode.LinesOfCode))
+	result.Cloc.Add(float64(file.LinesOfCode.CommentLinesOfCode))
+	result.Lloc.Add(float64(file.LinesOfCode.LogicalLinesOfCode))
 
 	// Functions
 	for _, function := range functions {
@@ -646,8 +735,7 @@ func (r *Aggregator) mapSums(file *pb.File, specificAggregation Aggregated) Aggr
 				// @todo: only for functions and methods of classes (not interfaces)
 				// otherwise, average may be lower than 1
 				ccn := float64(*function.Stmts.Analyze.Complexity.Cyclomatic)
-				result.CyclomaticComplexityPerMethod.Sum += ccn
-				result.CyclomaticComplexityPerMethod.Counter++
+				result.CyclomaticComplexityPerMethod.Add(ccn)
 				if specificAggregation.CyclomaticComplexityPerMethod.Min == 0 || ccn < specificAggregation.CyclomaticComplexityPerMethod.Min {
 					result.CyclomaticComplexityPerMethod.Min = ccn
 				}
@@ -655,8 +743,7 @@ func (r *Aggregator) mapSums(file *pb.File, specificAggregation Aggregated) Aggr
 					result.CyclomaticComplexityPerMethod.Max = ccn
 				}
 
-				result.CyclomaticComplexity.Sum += ccn
-				result.CyclomaticComplexity.Counter++
+				result.CyclomaticComplexity.Add(ccn)
 				if specificAggregation.CyclomaticComplexity.Min == 0 || ccn < specificAggregation.CyclomaticComplexity.Min {
 					result.CyclomaticComplexity.Min = ccn
 				}
@@ -664,13 +751,60 @@ func (r *Aggregator) mapSums(file *pb.File, specificAggregation Aggregated) Aggr
 					result.CyclomaticComplexity.Max = ccn
 				}
 			}
+
+			// Cognitive complexity per method
+			if function.Stmts.Analyze.Complexity.Cognitive != nil {
+				cognitive := float64(*function.Stmts.Analyze.Complexity.Cognitive)
+				result.CognitiveComplexityPerMethod.Add(cognitive)
+				if specificAggregation.CognitiveComplexityPerMethod.Min == 0 || cognitive < specificAggregation.CognitiveComplexityPerMethod.Min {
+					result.CognitiveComplexityPerMethod.Min = cognitive
+				}
+				if specificAggregation.CognitiveComplexityPerMethod.Max == 0 || cognitive > specificAggregation.CognitiveComplexityPerMethod.Max {
+					result.CognitiveComplexityPerMethod.Max = cognitive
+				}
+			}
+
+			// NPath complexity per method
+			if function.Stmts.Analyze.Complexity.Npath != nil {
+				npath := float64(*function.Stmts.Analyze.Complexity.Npath)
+				result.NPathPerMethod.Add(npath)
+				if specificAggregation.NPathPerMethod.Min == 0 || npath < specificAggregation.NPathPerMethod.Min {
+					result.NPathPerMethod.Min = npath
+				}
+				if specificAggregation.NPathPerMethod.Max == 0 || npath > specificAggregation.NPathPerMethod.Max {
+					result.NPathPerMethod.Max = npath
+				}
+			}
+
+			// Max nesting depth per method
+			if function.Stmts.Analyze.Complexity.MaxNesting != nil {
+				nesting := float64(*function.Stmts.Analyze.Complexity.MaxNesting)
+				result.NestingPerMethod.Add(nesting)
+				if specificAggregation.NestingPerMethod.Min == 0 || nesting < specificAggregation.NestingPerMethod.Min {
+					result.NestingPerMethod.Min = nesting
+				}
+				if specificAggregation.NestingPerMethod.Max == 0 || nesting > specificAggregation.NestingPerMethod.Max {
+					result.NestingPerMethod.Max = nesting
+				}
+			}
+		}
+
+		// ABC size per method
+		if function.Stmts.Analyze != nil && function.Stmts.Analyze.AbcSize != nil && function.Stmts.Analyze.AbcSize.Magnitude != nil {
+			abc := *function.Stmts.Analyze.AbcSize.Magnitude
+			result.AbcSizePerMethod.Add(abc)
+			if specificAggregation.AbcSizePerMethod.Min == 0 || abc < specificAggregation.AbcSizePerMethod.Min {
+				result.AbcSizePerMethod.Min = abc
+			}
+			if specificAggregation.AbcSizePerMethod.Max == 0 || abc > specificAggregation.AbcSizePerMethod.Max {
+				result.AbcSizePerMethod.Max = abc
+			}
 		}
 
 		// Average maintainability index per method
 		if function.Stmts.Analyze != nil && function.Stmts.Analyze.Maintainability != nil {
 			if function.Stmts.Analyze.Maintainability.MaintainabilityIndex != nil && !math.IsNaN(float64(*function.Stmts.Analyze.Maintainability.MaintainabilityIndex)) {
-				result.MaintainabilityIndex.Sum += *function.Stmts.Analyze.Maintainability.MaintainabilityIndex
-				result.MaintainabilityIndex.Counter++
+				result.MaintainabilityIndex.Add(*function.Stmts.Analyze.Maintainability.MaintainabilityIndex)
 				if specificAggregation.MaintainabilityIndex.Min == 0 || *function.Stmts.Analyze.Maintainability.MaintainabilityIndex < specificAggregation.MaintainabilityIndex.Min {
 					result.MaintainabilityIndex.Min = *function.Stmts.Analyze.Maintainability.MaintainabilityIndex
 				}
@@ -681,8 +815,7 @@ func (r *Aggregator) mapSums(file *pb.File, specificAggregation Aggregated) Aggr
 
 			// Maintainability index without comments
 			if function.Stmts.Analyze.Maintainability.MaintainabilityIndexWithoutComments != nil && !math.IsNaN(float64(*function.Stmts.Analyze.Maintainability.MaintainabilityIndexWithoutComments)) {
-				result.MaintainabilityIndexWithoutComments.Sum += *function.Stmts.Analyze.Maintainability.MaintainabilityIndexWithoutComments
-				result.MaintainabilityIndexWithoutComments.Counter++
+				result.MaintainabilityIndexWithoutComments.Add(*function.Stmts.Analyze.Maintainability.MaintainabilityIndexWithoutComments)
 				if specificAggregation.MaintainabilityIndexWithoutComments.Min == 0 || *function.Stmts.Analyze.Maintainability.MaintainabilityIndexWithoutComments < specificAggregation.MaintainabilityIndexWithoutComments.Min {
 					result.MaintainabilityIndexWithoutComments.Min = *function.Stmts.Analyze.Maintainability.MaintainabilityIndexWithoutComments
 				}
@@ -693,8 +826,7 @@ func (r *Aggregator) mapSums(file *pb.File, specificAggregation Aggregated) Aggr
 
 			// Comment weight
 			if function.Stmts.Analyze.Maintainability.CommentWeight != nil && !math.IsNaN(float64(*function.Stmts.Analyze.Maintainability.CommentWeight)) {
-				result.MaintainabilityCommentWeight.Sum += *function.Stmts.Analyze.Maintainability.CommentWeight
-				result.MaintainabilityCommentWeight.Counter++
+				result.MaintainabilityCommentWeight.Add(*function.Stmts.Analyze.Maintainability.CommentWeight)
 				if specificAggregation.MaintainabilityCommentWeight.Min == 0 || *function.Stmts.Analyze.Maintainability.CommentWeight < specificAggregation.MaintainabilityCommentWeight.Min {
 					result.MaintainabilityCommentWeight.Min = *function.Stmts.Analyze.Maintainability.CommentWeight
 				}
@@ -705,8 +837,7 @@ func (r *Aggregator) mapSums(file *pb.File, specificAggregation Aggregated) Aggr
 
 			// Maintainability index per method
 			if function.Stmts.Analyze.Maintainability.MaintainabilityIndex != nil && !math.IsNaN(float64(*function.Stmts.Analyze.Maintainability.MaintainabilityIndex)) {
-				result.MaintainabilityPerMethod.Sum += *function.Stmts.Analyze.Maintainability.MaintainabilityIndex
-				result.MaintainabilityPerMethod.Counter++
+				result.MaintainabilityPerMethod.Add(*function.Stmts.Analyze.Maintainability.MaintainabilityIndex)
 				if specificAggregation.MaintainabilityPerMethod.Min == 0 || *function.Stmts.Analyze.Maintainability.MaintainabilityIndex < specificAggregation.MaintainabilityPerMethod.Min {
 					result.MaintainabilityPerMethod.Min = *function.Stmts.Analyze.Maintainability.MaintainabilityIndex
 				}
@@ -717,8 +848,7 @@ func (r *Aggregator) mapSums(file *pb.File, specificAggregation Aggregated) Aggr
 
 			// Maintainability index per method without comments
 			if function.Stmts.Analyze.Maintainability.MaintainabilityIndexWithoutComments != nil && !math.IsNaN(float64(*function.Stmts.Analyze.Maintainability.MaintainabilityIndexWithoutComments)) {
-				result.MaintainabilityPerMethodWithoutComments.Sum += *function.Stmts.Analyze.Maintainability.MaintainabilityIndexWithoutComments
-				result.MaintainabilityPerMethodWithoutComments.Counter++
+				result.MaintainabilityPerMethodWithoutComments.Add(*function.Stmts.Analyze.Maintainability.MaintainabilityIndexWithoutComments)
 				if specificAggregation.MaintainabilityPerMethodWithoutComments.Min == 0 || *function.Stmts.Analyze.Maintainability.MaintainabilityIndexWithoutComments < specificAggregation.MaintainabilityPerMethodWithoutComments.Min {
 					result.MaintainabilityPerMethodWithoutComments.Min = *function.Stmts.Analyze.Maintainability.MaintainabilityIndexWithoutComments
 				}
@@ -729,8 +859,7 @@ func (r *Aggregator) mapSums(file *pb.File, specificAggregation Aggregated) Aggr
 
 			// Comment weight per method
 			if function.Stmts.Analyze.Maintainability.CommentWeight != nil && !math.IsNaN(float64(*function.Stmts.Analyze.Maintainability.CommentWeight)) {
-				result.MaintainabilityCommentWeightPerMethod.Sum += *function.Stmts.Analyze.Maintainability.CommentWeight
-				result.MaintainabilityCommentWeightPerMethod.Counter++
+				result.MaintainabilityCommentWeightPerMethod.Add(*function.Stmts.Analyze.Maintainability.CommentWeight)
 				if specificAggregation.MaintainabilityCommentWeightPerMethod.Min == 0 || *function.Stmts.Analyze.Maintainability.CommentWeight < specificAggregation.MaintainabilityCommentWeightPerMethod.Min {
 					result.MaintainabilityCommentWeightPerMethod.Min = *function.Stmts.Analyze.Maintainability.CommentWeight
 				}
@@ -742,16 +871,13 @@ func (r *Aggregator) mapSums(file *pb.File, specificAggregation Aggregated) Aggr
 		// average lines of code per method
 		if function.Stmts.Analyze != nil && function.Stmts.Analyze.Volume != nil {
 			if function.Stmts.Analyze.Volume.Loc != nil {
-				result.LocPerMethod.Sum += float64(*function.Stmts.Analyze.Volume.Loc)
-				result.LocPerMethod.Counter++
+				result.LocPerMethod.Add(float64(*function.Stmts.Analyze.Volume.Loc))
 			}
 			if function.Stmts.Analyze.Volume.Cloc != nil {
-				result.ClocPerMethod.Sum += float64(*function.Stmts.Analyze.Volume.Cloc)
-				result.ClocPerMethod.Counter++
+				result.ClocPerMethod.Add(float64(*function.Stmts.Analyze.Volume.Cloc))
 			}
 			if function.Stmts.Analyze.Volume.Lloc != nil {
-				result.LlocPerMethod.Sum += float64(*function.Stmts.Analyze.Volume.Lloc)
-				result.LlocPerMethod.Counter++
+				result.LlocPerMethod.Add(float64(*function.Stmts.Analyze.Volume.Lloc))
 			}
 		}
 	}
@@ -770,8 +896,7 @@ func (r *Aggregator) mapSums(file *pb.File, specificAggregation Aggregated) Aggr
 		// Maintainability Index
 		if class.Stmts.Analyze.Maintainability != nil {
 			if class.Stmts.Analyze.Maintainability.MaintainabilityIndex != nil && !math.IsNaN(float64(*class.Stmts.Analyze.Maintainability.MaintainabilityIndex)) {
-				result.MaintainabilityIndex.Sum += *class.Stmts.Analyze.Maintainability.MaintainabilityIndex
-				result.MaintainabilityIndex.Counter++
+				result.MaintainabilityIndex.Add(*class.Stmts.Analyze.Maintainability.MaintainabilityIndex)
 				if specificAggregation.MaintainabilityIndex.Min == 0 || *class.Stmts.Analyze.Maintainability.MaintainabilityIndex < specificAggregation.MaintainabilityIndex.Min {
 					result.MaintainabilityIndex.Min = *class.Stmts.Analyze.Maintainability.MaintainabilityIndex
 				}
@@ -780,8 +905,7 @@ func (r *Aggregator) mapSums(file *pb.File, specificAggregation Aggregated) Aggr
 				}
 			}
 			if class.Stmts.Analyze.Maintainability.MaintainabilityIndexWithoutComments != nil && !math.IsNaN(float64(*class.Stmts.Analyze.Maintainability.MaintainabilityIndexWithoutComments)) {
-				result.MaintainabilityIndexWithoutComments.Sum += *class.Stmts.Analyze.Maintainability.MaintainabilityIndexWithoutComments
-				result.MaintainabilityIndexWithoutComments.Counter++
+				result.MaintainabilityIndexWithoutComments.Add(*class.Stmts.Analyze.Maintainability.MaintainabilityIndexWithoutComments)
 				if specificAggregation.MaintainabilityIndexWithoutComments.Min == 0 || *class.Stmts.Analyze.Maintainability.MaintainabilityIndexWithoutComments < specificAggregation.MaintainabilityIndexWithoutComments.Min {
 					result.MaintainabilityIndexWithoutComments.Min = *class.Stmts.Analyze.Maintainability.MaintainabilityIndexWithoutComments
 				}
@@ -793,10 +917,8 @@ func (r *Aggregator) mapSums(file *pb.File, specificAggregation Aggregated) Aggr
 
 		// Coupling
 		if class.Stmts.Analyze.Coupling != nil {
-			result.EfferentCoupling.Sum += float64(class.Stmts.Analyze.Coupling.Efferent)
-			result.EfferentCoupling.Counter++
-			result.AfferentCoupling.Sum += float64(class.Stmts.Analyze.Coupling.Afferent)
-			result.AfferentCoupling.Counter++
+			result.EfferentCoupling.Add(float64(class.Stmts.Analyze.Coupling.Efferent))
+			result.AfferentCoupling.Add(float64(class.Stmts.Analyze.Coupling.Afferent))
 			// Instability for class
 			if class.Stmts.Analyze.Coupling.Efferent > 0 {
 				class.Stmts.Analyze.Coupling.Instability = float64(class.Stmts.Analyze.Coupling.Efferent) / float64(class.Stmts.Analyze.Coupling.Efferent+class.Stmts.Analyze.Coupling.Afferent)
@@ -806,8 +928,7 @@ func (r *Aggregator) mapSums(file *pb.File, specificAggregation Aggregated) Aggr
 		// cyclomatic complexity per class
 		if class.Stmts.Analyze.Complexity != nil && class.Stmts.Analyze.Complexity.Cyclomatic != nil {
 
-			result.CyclomaticComplexityPerClass.Sum += float64(*class.Stmts.Analyze.Complexity.Cyclomatic)
-			result.CyclomaticComplexityPerClass.Counter++
+			result.CyclomaticComplexityPerClass.Add(float64(*class.Stmts.Analyze.Complexity.Cyclomatic))
 			if specificAggregation.CyclomaticComplexityPerClass.Min == 0 || float64(*class.Stmts.Analyze.Complexity.Cyclomatic) < specificAggregation.CyclomaticComplexityPerClass.Min {
 				result.CyclomaticComplexityPerClass.Min = float64(*class.Stmts.Analyze.Complexity.Cyclomatic)
 			}
@@ -815,8 +936,7 @@ func (r *Aggregator) mapSums(file *pb.File, specificAggregation Aggregated) Aggr
 				result.CyclomaticComplexityPerClass.Max = float64(*class.Stmts.Analyze.Complexity.Cyclomatic)
 			}
 
-			result.CyclomaticComplexity.Sum += float64(*class.Stmts.Analyze.Complexity.Cyclomatic)
-			result.CyclomaticComplexity.Counter++
+			result.CyclomaticComplexity.Add(float64(*class.Stmts.Analyze.Complexity.Cyclomatic))
 			if specificAggregation.CyclomaticComplexity.Min == 0 || float64(*class.Stmts.Analyze.Complexity.Cyclomatic) < specificAggregation.CyclomaticComplexity.Min {
 				result.CyclomaticComplexity.Min = float64(*class.Stmts.Analyze.Complexity.Cyclomatic)
 			}
@@ -825,23 +945,115 @@ func (r *Aggregator) mapSums(file *pb.File, specificAggregation Aggregated) Aggr
 			}
 		}
 
+		// cognitive complexity per class
+		if class.Stmts.Analyze.Complexity != nil && class.Stmts.Analyze.Complexity.Cognitive != nil {
+			cognitive := float64(*class.Stmts.Analyze.Complexity.Cognitive)
+			result.CognitiveComplexityPerClass.Add(cognitive)
+			if specificAggregation.CognitiveComplexityPerClass.Min == 0 || cognitive < specificAggregation.CognitiveComplexityPerClass.Min {
+				result.CognitiveComplexityPerClass.Min = cognitive
+			}
+			if specificAggregation.CognitiveComplexityPerClass.Max == 0 || cognitive > specificAggregation.CognitiveComplexityPerClass.Max {
+				result.CognitiveComplexityPerClass.Max = cognitive
+			}
+		}
+
+		// ABC size per class
+		if class.Stmts.Analyze.AbcSize != nil && class.Stmts.Analyze.AbcSize.Magnitude != nil {
+			abc := *class.Stmts.Analyze.AbcSize.Magnitude
+			result.AbcSizePerClass.Add(abc)
+			if specificAggregation.AbcSizePerClass.Min == 0 || abc < specificAggregation.AbcSizePerClass.Min {
+				result.AbcSizePerClass.Min = abc
+			}
+			if specificAggregation.AbcSizePerClass.Max == 0 || abc > specificAggregation.AbcSizePerClass.Max {
+				result.AbcSizePerClass.Max = abc
+			}
+		}
+
+		// Chidamber & Kemerer suite
+		if oo := class.Stmts.Analyze.ObjectOriented; oo != nil {
+			if oo.Wmc != nil {
+				value := float64(*oo.Wmc)
+				result.WmcPerClass.Add(value)
+				if specificAggregation.WmcPerClass.Min == 0 || value < specificAggregation.WmcPerClass.Min {
+					result.WmcPerClass.Min = value
+				}
+				if specificAggregation.WmcPerClass.Max == 0 || value > specificAggregation.WmcPerClass.Max {
+					result.WmcPerClass.Max = value
+				}
+			}
+			if oo.Dit != nil {
+				value := float64(*oo.Dit)
+				result.DitPerClass.Add(value)
+				if specificAggregation.DitPerClass.Min == 0 || value < specificAggregation.DitPerClass.Min {
+					result.DitPerClass.Min = value
+				}
+				if specificAggregation.DitPerClass.Max == 0 || value > specificAggregation.DitPerClass.Max {
+					result.DitPerClass.Max = value
+				}
+			}
+			if oo.Noc != nil {
+				value := float64(*oo.Noc)
+				result.NocPerClass.Add(value)
+				if specificAggregation.NocPerClass.Min == 0 || value < specificAggregation.NocPerClass.Min {
+					result.NocPerClass.Min = value
+				}
+				if specificAggregation.NocPerClass.Max == 0 || value > specificAggregation.NocPerClass.Max {
+					result.NocPerClass.Max = value
+				}
+			}
+			if oo.Cbo != nil {
+				value := float64(*oo.Cbo)
+				result.CboPerClass.Add(value)
+				if specificAggregation.CboPerClass.Min == 0 || value < specificAggregation.CboPerClass.Min {
+					result.CboPerClass.Min = value
+				}
+				if specificAggregation.CboPerClass.Max == 0 || value > specificAggregation.CboPerClass.Max {
+					result.CboPerClass.Max = value
+				}
+			}
+			if oo.Rfc != nil {
+				value := float64(*oo.Rfc)
+				result.RfcPerClass.Add(value)
+				if specificAggregation.RfcPerClass.Min == 0 || value < specificAggregation.RfcPerClass.Min {
+					result.RfcPerClass.Min = value
+				}
+				if specificAggregation.RfcPerClass.Max == 0 || value > specificAggregation.RfcPerClass.Max {
+					result.RfcPerClass.Max = value
+				}
+			}
+		}
+
+		// Public API of the class
+		if class.GetModifiers().GetIsAbstract() {
+			result.NbAbstractClasses++
+		}
+		publicMethods := 0
+		for _, method := range class.Stmts.StmtFunction {
+			if engine.IsPublic(method) {
+				publicMethods++
+			}
+		}
+		result.PublicMethodsPerClass.Add(float64(publicMethods))
+		if specificAggregation.PublicMethodsPerClass.Min == 0 || float64(publicMethods) < specificAggregation.PublicMethodsPerClass.Min {
+			result.PublicMethodsPerClass.Min = float64(publicMethods)
+		}
+		if specificAggregation.PublicMethodsPerClass.Max == 0 || float64(publicMethods) > specificAggregation.PublicMethodsPerClass.Max {
+			result.PublicMethodsPerClass.Max = float64(publicMethods)
+		}
+
 		// Halstead
 		if class.Stmts.Analyze.Volume != nil {
 			if class.Stmts.Analyze.Volume.HalsteadDifficulty != nil && !math.IsNaN(*class.Stmts.Analyze.Volume.HalsteadDifficulty) {
-				result.HalsteadDifficulty.Sum += *class.Stmts.Analyze.Volume.HalsteadDifficulty
-				result.HalsteadDifficulty.Counter++
+				result.HalsteadDifficulty.Add(*class.Stmts.Analyze.Volume.HalsteadDifficulty)
 			}
 			if class.Stmts.Analyze.Volume.HalsteadEffort != nil && !math.IsNaN(*class.Stmts.Analyze.Volume.HalsteadEffort) {
-				result.HalsteadEffort.Sum += *class.Stmts.Analyze.Volume.HalsteadEffort
-				result.HalsteadEffort.Counter++
+				result.HalsteadEffort.Add(*class.Stmts.Analyze.Volume.HalsteadEffort)
 			}
 			if class.Stmts.Analyze.Volume.HalsteadVolume != nil && !math.IsNaN(*class.Stmts.Analyze.Volume.HalsteadVolume) {
-				result.HalsteadVolume.Sum += *class.Stmts.Analyze.Volume.HalsteadVolume
-				result.HalsteadVolume.Counter++
+				result.HalsteadVolume.Add(*class.Stmts.Analyze.Volume.HalsteadVolume)
 			}
 			if class.Stmts.Analyze.Volume.HalsteadTime != nil && !math.IsNaN(*class.Stmts.Analyze.Volume.HalsteadTime) {
-				result.HalsteadTime.Sum += *class.Stmts.Analyze.Volume.HalsteadTime
-				result.HalsteadTime.Counter++
+				result.HalsteadTime.Add(*class.Stmts.Analyze.Volume.HalsteadTime)
 			}
 		}
 
@@ -850,8 +1062,7 @@ func (r *Aggregator) mapSums(file *pb.File, specificAggregation Aggregated) Aggr
 		if class.Stmts.Analyze.ClassCohesion != nil && class.Stmts.Analyze.ClassCohesion.Lcom4 != nil && *class.Stmts.Analyze.ClassCohesion.Lcom4 > 0 {
 			// want a float64, got a int32
 			lcom4 := float64(*class.Stmts.Analyze.ClassCohesion.Lcom4)
-			result.Lcom4PerClass.Sum += lcom4
-			result.Lcom4PerClass.Counter++
+			result.Lcom4PerClass.Add(lcom4)
 			if specificAggregation.Lcom4PerClass.Min == 0 || lcom4 < specificAggregation.Lcom4PerClass.Min {
 				result.Lcom4PerClass.Min = lcom4
 			}
@@ -907,23 +1118,32 @@ func (r *Aggregator) mergeChunks(aggregated Aggregated, chunk *Aggregated) Aggre
 
 	result.Loc.Sum += chunk.Loc.Sum
 	result.Loc.Counter += chunk.Loc.Counter
+	result.Loc.merge(chunk.Loc)
 	result.Cloc.Sum += chunk.Cloc.Sum
 	result.Cloc.Counter += chunk.Cloc.Counter
+	result.Cloc.merge(chunk.Cloc)
 	result.Lloc.Sum += chunk.Lloc.Sum
 	result.Lloc.Counter += chunk.Lloc.Counter
+	result.Lloc.merge(chunk.Lloc)
 
 	result.MethodsPerClass.Sum += chunk.MethodsPerClass.Sum
 	result.MethodsPerClass.Counter += chunk.MethodsPerClass.Counter
+	result.MethodsPerClass.merge(chunk.MethodsPerClass)
 	result.LocPerClass.Sum += chunk.LocPerClass.Sum
 	result.LocPerClass.Counter += chunk.LocPerClass.Counter
+	result.LocPerClass.merge(chunk.LocPerClass)
 	result.LocPerMethod.Sum += chunk.LocPerMethod.Sum
 	result.LocPerMethod.Counter += chunk.LocPerMethod.Counter
+	result.LocPerMethod.merge(chunk.LocPerMethod)
 	result.LlocPerMethod.Sum += chunk.LlocPerMethod.Sum
 	result.LlocPerMethod.Counter += chunk.LlocPerMethod.Counter
+	result.LlocPerMethod.merge(chunk.LlocPerMethod)
 	result.ClocPerMethod.Sum += chunk.ClocPerMethod.Sum
 	result.ClocPerMethod.Counter += chunk.ClocPerMethod.Counter
+	result.ClocPerMethod.merge(chunk.ClocPerMethod)
 	result.CyclomaticComplexityPerMethod.Sum += chunk.CyclomaticComplexityPerMethod.Sum
 	result.CyclomaticComplexityPerMethod.Counter += chunk.CyclomaticComplexityPerMethod.Counter
+	result.CyclomaticComplexityPerMethod.merge(chunk.CyclomaticComplexityPerMethod)
 	if result.CyclomaticComplexityPerMethod.Min == 0 || (chunk.CyclomaticComplexityPerMethod.Min > 0 && chunk.CyclomaticComplexityPerMethod.Min < result.CyclomaticComplexityPerMethod.Min) {
 		result.CyclomaticComplexityPerMethod.Min = chunk.CyclomaticComplexityPerMethod.Min
 	}
@@ -933,24 +1153,139 @@ func (r *Aggregator) mergeChunks(aggregated Aggregated, chunk *Aggregated) Aggre
 
 	result.CyclomaticComplexityPerClass.Sum += chunk.CyclomaticComplexityPerClass.Sum
 	result.CyclomaticComplexityPerClass.Counter += chunk.CyclomaticComplexityPerClass.Counter
+	result.CyclomaticComplexityPerClass.merge(chunk.CyclomaticComplexityPerClass)
 
 	result.CyclomaticComplexity.Sum += chunk.CyclomaticComplexity.Sum
 	result.CyclomaticComplexity.Counter += chunk.CyclomaticComplexity.Counter
+	result.CyclomaticComplexity.merge(chunk.CyclomaticComplexity)
+
+	result.CognitiveComplexityPerMethod.Sum += chunk.CognitiveComplexityPerMethod.Sum
+	result.CognitiveComplexityPerMethod.Counter += chunk.CognitiveComplexityPerMethod.Counter
+	result.CognitiveComplexityPerMethod.merge(chunk.CognitiveComplexityPerMethod)
+	if result.CognitiveComplexityPerMethod.Min == 0 || (chunk.CognitiveComplexityPerMethod.Min > 0 && chunk.CognitiveComplexityPerMethod.Min < result.CognitiveComplexityPerMethod.Min) {
+		result.CognitiveComplexityPerMethod.Min = chunk.CognitiveComplexityPerMethod.Min
+	}
+	if chunk.CognitiveComplexityPerMethod.Max > result.CognitiveComplexityPerMethod.Max {
+		result.CognitiveComplexityPerMethod.Max = chunk.CognitiveComplexityPerMethod.Max
+	}
+	result.NPathPerMethod.Sum += chunk.NPathPerMethod.Sum
+	result.NPathPerMethod.Counter += chunk.NPathPerMethod.Counter
+	result.NPathPerMethod.merge(chunk.NPathPerMethod)
+	if result.NPathPerMethod.Min == 0 || (chunk.NPathPerMethod.Min > 0 && chunk.NPathPerMethod.Min < result.NPathPerMethod.Min) {
+		result.NPathPerMethod.Min = chunk.NPathPerMethod.Min
+	}
+	if chunk.NPathPerMethod.Max > result.NPathPerMethod.Max {
+		result.NPathPerMethod.Max = chunk.NPathPerMethod.Max
+	}
+	result.NestingPerMethod.Sum += chunk.NestingPerMethod.Sum
+	result.NestingPerMethod.Counter += chunk.NestingPerMethod.Counter
+	result.NestingPerMethod.merge(chunk.NestingPerMethod)
+	if result.NestingPerMethod.Min == 0 || (chunk.NestingPerMethod.Min > 0 && chunk.NestingPerMethod.Min < result.NestingPerMethod.Min) {
+		result.NestingPerMethod.Min = chunk.NestingPerMethod.Min
+	}
+	if chunk.NestingPerMethod.Max > result.NestingPerMethod.Max {
+		result.NestingPerMethod.Max = chunk.NestingPerMethod.Max
+	}
+	result.AbcSizePerMethod.Sum += chunk.AbcSizePerMethod.Sum
+	result.AbcSizePerMethod.Counter += chunk.AbcSizePerMethod.Counter
+	result.AbcSizePerMethod.merge(chunk.AbcSizePerMethod)
+	if result.AbcSizePerMethod.Min == 0 || (chunk.AbcSizePerMethod.Min > 0 && chunk.AbcSizePerMethod.Min < result.AbcSizePerMethod.Min) {
+		result.AbcSizePerMethod.Min = chunk.AbcSizePerMethod.Min
+	}
+	if chunk.AbcSizePerMethod.Max > result.AbcSizePerMethod.Max {
+		result.AbcSizePerMethod.Max = chunk.AbcSizePerMethod.Max
+	}
+	result.AbcSizePerClass.Sum += chunk.AbcSizePerClass.Sum
+	result.AbcSizePerClass.Counter += chunk.AbcSizePerClass.Counter
+	result.AbcSizePerClass.merge(chunk.AbcSizePerClass)
+	if result.AbcSizePerClass.Min == 0 || (chunk.AbcSizePerClass.Min > 0 && chunk.AbcSizePerClass.Min < result.AbcSizePerClass.Min) {
+		result.AbcSizePerClass.Min = chunk.AbcSizePerClass.Min
+	}
+	if chunk.AbcSizePerClass.Max > result.AbcSizePerClass.Max {
+		result.AbcSizePerClass.Max = chunk.AbcSizePerClass.Max
+	}
+	result.CognitiveComplexityPerClass.Sum += chunk.CognitiveComplexityPerClass.Sum
+	result.CognitiveComplexityPerClass.Counter += chunk.CognitiveComplexityPerClass.Counter
+	result.CognitiveComplexityPerClass.merge(chunk.CognitiveComplexityPerClass)
+	if chunk.CognitiveComplexityPerClass.Max > result.CognitiveComplexityPerClass.Max {
+		result.CognitiveComplexityPerClass.Max = chunk.CognitiveComplexityPerClass.Max
+	}
+	result.WmcPerClass.Sum += chunk.WmcPerClass.Sum
+	result.WmcPerClass.Counter += chunk.WmcPerClass.Counter
+	result.WmcPerClass.merge(chunk.WmcPerClass)
+	if result.WmcPerClass.Min == 0 || (chunk.WmcPerClass.Min > 0 && chunk.WmcPerClass.Min < result.WmcPerClass.Min) {
+		result.WmcPerClass.Min = chunk.WmcPerClass.Min
+	}
+	if chunk.WmcPerClass.Max > result.WmcPerClass.Max {
+		result.WmcPerClass.Max = chunk.WmcPerClass.Max
+	}
+	result.DitPerClass.Sum += chunk.DitPerClass.Sum
+	result.DitPerClass.Counter += chunk.DitPerClass.Counter
+	result.DitPerClass.merge(chunk.DitPerClass)
+	if result.DitPerClass.Min == 0 || (chunk.DitPerClass.Min > 0 && chunk.DitPerClass.Min < result.DitPerClass.Min) {
+		result.DitPerClass.Min = chunk.DitPerClass.Min
+	}
+	if chunk.DitPerClass.Max > result.DitPerClass.Max {
+		result.DitPerClass.Max = chunk.DitPerClass.Max
+	}
+	result.NocPerClass.Sum += chunk.NocPerClass.Sum
+	result.NocPerClass.Counter += chunk.NocPerClass.Counter
+	result.NocPerClass.merge(chunk.NocPerClass)
+	if result.NocPerClass.Min == 0 || (chunk.NocPerClass.Min > 0 && chunk.NocPerClass.Min < result.NocPerClass.Min) {
+		result.NocPerClass.Min = chunk.NocPerClass.Min
+	}
+	if chunk.NocPerClass.Max > result.NocPerClass.Max {
+		result.NocPerClass.Max = chunk.NocPerClass.Max
+	}
+	result.CboPerClass.Sum += chunk.CboPerClass.Sum
+	result.CboPerClass.Counter += chunk.CboPerClass.Counter
+	result.CboPerClass.merge(chunk.CboPerClass)
+	if result.CboPerClass.Min == 0 || (chunk.CboPerClass.Min > 0 && chunk.CboPerClass.Min < result.CboPerClass.Min) {
+		result.CboPerClass.Min = chunk.CboPerClass.Min
+	}
+	if chunk.CboPerClass.Max > result.CboPerClass.Max {
+		result.CboPerClass.Max = chunk.CboPerClass.Max
+	}
+	result.RfcPerClass.Sum += chunk.RfcPerClass.Sum
+	result.RfcPerClass.Counter += chunk.RfcPerClass.Counter
+	result.RfcPerClass.merge(chunk.RfcPerClass)
+	if result.RfcPerClass.Min == 0 || (chunk.RfcPerClass.Min > 0 && chunk.RfcPerClass.Min < result.RfcPerClass.Min) {
+		result.RfcPerClass.Min = chunk.RfcPerClass.Min
+	}
+	if chunk.RfcPerClass.Max > result.RfcPerClass.Max {
+		result.RfcPerClass.Max = chunk.RfcPerClass.Max
+	}
+	result.NbAbstractClasses += chunk.NbAbstractClasses
+	result.PublicMethodsPerClass.Sum += chunk.PublicMethodsPerClass.Sum
+	result.PublicMethodsPerClass.Counter += chunk.PublicMethodsPerClass.Counter
+	result.PublicMethodsPerClass.merge(chunk.PublicMethodsPerClass)
+	if result.PublicMethodsPerClass.Min == 0 || (chunk.PublicMethodsPerClass.Min > 0 && chunk.PublicMethodsPerClass.Min < result.PublicMethodsPerClass.Min) {
+		result.PublicMethodsPerClass.Min = chunk.PublicMethodsPerClass.Min
+	}
+	if chunk.PublicMethodsPerClass.Max > result.PublicMethodsPerClass.Max {
+		result.PublicMethodsPerClass.Max = chunk.PublicMethodsPerClass.Max
+	}
 
 	result.HalsteadDifficulty.Sum += chunk.HalsteadDifficulty.Sum
 	result.HalsteadDifficulty.Counter += chunk.HalsteadDifficulty.Counter
+	result.HalsteadDifficulty.merge(chunk.HalsteadDifficulty)
 	result.HalsteadEffort.Sum += chunk.HalsteadEffort.Sum
 	result.HalsteadEffort.Counter += chunk.HalsteadEffort.Counter
+	result.HalsteadEffort.merge(chunk.HalsteadEffort)
 	result.HalsteadVolume.Sum += chunk.HalsteadVolume.Sum
 	result.HalsteadVolume.Counter += chunk.HalsteadVolume.Counter
+	result.HalsteadVolume.merge(chunk.HalsteadVolume)
 	result.HalsteadTime.Sum += chunk.HalsteadTime.Sum
 	result.HalsteadTime.Counter += chunk.HalsteadTime.Counter
+	result.HalsteadTime.merge(chunk.HalsteadTime)
 	result.HalsteadBugs.Sum += chunk.HalsteadBugs.Sum
 	result.HalsteadBugs.Counter += chunk.HalsteadBugs.Counter
+	result.HalsteadBugs.merge(chunk.HalsteadBugs)
 
 	// LCOM
 	result.Lcom4PerClass.Sum += chunk.Lcom4PerClass.Sum
 	result.Lcom4PerClass.Counter += chunk.Lcom4PerClass.Counter
+	result.Lcom4PerClass.merge(chunk.Lcom4PerClass)
 	if result.Lcom4PerClass.Min == 0 || (chunk.Lcom4PerClass.Min > 0 && chunk.Lcom4PerClass.Min < result.Lcom4PerClass.Min) {
 		result.Lcom4PerClass.Min = chunk.Lcom4PerClass.Min
 	}
@@ -960,6 +1295,7 @@ func (r *Aggregator) mergeChunks(aggregated Aggregated, chunk *Aggregated) Aggre
 
 	result.MaintainabilityIndex.Sum += chunk.MaintainabilityIndex.Sum
 	result.MaintainabilityIndex.Counter += chunk.MaintainabilityIndex.Counter
+	result.MaintainabilityIndex.merge(chunk.MaintainabilityIndex)
 	if result.MaintainabilityIndex.Min == 0 || (chunk.MaintainabilityIndex.Min > 0 && chunk.MaintainabilityIndex.Min < result.MaintainabilityIndex.Min) {
 		result.MaintainabilityIndex.Min = chunk.MaintainabilityIndex.Min
 	}
@@ -968,20 +1304,27 @@ func (r *Aggregator) mergeChunks(aggregated Aggregated, chunk *Aggregated) Aggre
 	}
 	result.MaintainabilityIndexWithoutComments.Sum += chunk.MaintainabilityIndexWithoutComments.Sum
 	result.MaintainabilityIndexWithoutComments.Counter += chunk.MaintainabilityIndexWithoutComments.Counter
+	result.MaintainabilityIndexWithoutComments.merge(chunk.MaintainabilityIndexWithoutComments)
 	result.MaintainabilityCommentWeight.Sum += chunk.MaintainabilityCommentWeight.Sum
 	result.MaintainabilityCommentWeight.Counter += chunk.MaintainabilityCommentWeight.Counter
+	result.MaintainabilityCommentWeight.merge(chunk.MaintainabilityCommentWeight)
 
 	result.EfferentCoupling.Sum += chunk.EfferentCoupling.Sum
 	result.EfferentCoupling.Counter += chunk.EfferentCoupling.Counter
+	result.EfferentCoupling.merge(chunk.EfferentCoupling)
 	result.AfferentCoupling.Sum += chunk.AfferentCoupling.Sum
 	result.AfferentCoupling.Counter += chunk.AfferentCoupling.Counter
+	result.AfferentCoupling.merge(chunk.AfferentCoupling)
 
 	result.MaintainabilityPerMethod.Sum += chunk.MaintainabilityPerMethod.Sum
 	result.MaintainabilityPerMethod.Counter += chunk.MaintainabilityPerMethod.Counter
+	result.MaintainabilityPerMethod.merge(chunk.MaintainabilityPerMethod)
 	result.MaintainabilityPerMethodWithoutComments.Sum += chunk.MaintainabilityPerMethodWithoutComments.Sum
 	result.MaintainabilityPerMethodWithoutComments.Counter += chunk.MaintainabilityPerMethodWithoutComments.Counter
+	result.MaintainabilityPerMethodWithoutComments.merge(chunk.MaintainabilityPerMethodWithoutComments)
 	result.MaintainabilityCommentWeightPerMethod.Sum += chunk.MaintainabilityCommentWeightPerMethod.Sum
 	result.MaintainabilityCommentWeightPerMethod.Counter += chunk.MaintainabilityCommentWeightPerMethod.Counter
+	result.MaintainabilityCommentWeightPerMethod.merge(chunk.MaintainabilityCommentWeightPerMethod)
 
 	result.CommitCountForPeriod += chunk.CommitCountForPeriod
 	result.CommittedFilesCountForPeriod += chunk.CommittedFilesCountForPeriod
@@ -1033,6 +1376,42 @@ func (r *Aggregator) reduceMetrics(aggregated Aggregated) Aggregated {
 	if result.CyclomaticComplexity.Counter > 0 {
 		result.CyclomaticComplexity.Avg = result.CyclomaticComplexity.Sum / float64(result.CyclomaticComplexity.Counter)
 	}
+	if result.CognitiveComplexityPerMethod.Counter > 0 {
+		result.CognitiveComplexityPerMethod.Avg = result.CognitiveComplexityPerMethod.Sum / float64(result.CognitiveComplexityPerMethod.Counter)
+	}
+	if result.CognitiveComplexityPerClass.Counter > 0 {
+		result.CognitiveComplexityPerClass.Avg = result.CognitiveComplexityPerClass.Sum / float64(result.CognitiveComplexityPerClass.Counter)
+	}
+	if result.AbcSizePerMethod.Counter > 0 {
+		result.AbcSizePerMethod.Avg = result.AbcSizePerMethod.Sum / float64(result.AbcSizePerMethod.Counter)
+	}
+	if result.AbcSizePerClass.Counter > 0 {
+		result.AbcSizePerClass.Avg = result.AbcSizePerClass.Sum / float64(result.AbcSizePerClass.Counter)
+	}
+	if result.WmcPerClass.Counter > 0 {
+		result.WmcPerClass.Avg = result.WmcPerClass.Sum / float64(result.WmcPerClass.Counter)
+	}
+	if result.DitPerClass.Counter > 0 {
+		result.DitPerClass.Avg = result.DitPerClass.Sum / float64(result.DitPerClass.Counter)
+	}
+	if result.NocPerClass.Counter > 0 {
+		result.NocPerClass.Avg = result.NocPerClass.Sum / float64(result.NocPerClass.Counter)
+	}
+	if result.CboPerClass.Counter > 0 {
+		result.CboPerClass.Avg = result.CboPerClass.Sum / float64(result.CboPerClass.Counter)
+	}
+	if result.RfcPerClass.Counter > 0 {
+		result.RfcPerClass.Avg = result.RfcPerClass.Sum / float64(result.RfcPerClass.Counter)
+	}
+	if result.PublicMethodsPerClass.Counter > 0 {
+		result.PublicMethodsPerClass.Avg = result.PublicMethodsPerClass.Sum / float64(result.PublicMethodsPerClass.Counter)
+	}
+	if result.NPathPerMethod.Counter > 0 {
+		result.NPathPerMethod.Avg = result.NPathPerMethod.Sum / float64(result.NPathPerMethod.Counter)
+	}
+	if result.NestingPerMethod.Counter > 0 {
+		result.NestingPerMethod.Avg = result.NestingPerMethod.Sum / float64(result.NestingPerMethod.Counter)
+	}
 	if result.HalsteadDifficulty.Counter > 0 {
 		result.HalsteadDifficulty.Avg = result.HalsteadDifficulty.Sum / float64(result.HalsteadDifficulty.Counter)
 	}
@@ -1080,6 +1459,11 @@ func (r *Aggregator) reduceMetrics(aggregated Aggregated) Aggregated {
 		result.Instability.Avg = result.EfferentCoupling.Sum / result.AfferentCoupling.Sum
 	}
 
+	// Percentiles and histograms: averages hide the few very complex methods
+	for _, metric := range result.Metrics() {
+		metric.reduceDistribution()
+	}
+
 	// Count commits for the period based on `ResultOfGitAnalysis` data
 	result.ResultOfGitAnalysis = r.gitSummaries
 	if result.ResultOfGitAnalysis != nil {
@@ -1249,8 +1633,7 @@ func (r *Aggregator) mapCoupling(aggregated *Aggregated) Aggregated {
 
 			class.Stmts.Analyze.Coupling.Efferent = int32(len(uniqueClassDependencies))
 			// Increment result (efferent coupling)
-			result.EfferentCoupling.Sum += float64(class.Stmts.Analyze.Coupling.Efferent)
-			result.EfferentCoupling.Counter++
+			result.EfferentCoupling.Add(float64(class.Stmts.Analyze.Coupling.Efferent))
 		}
 	}
 
@@ -1274,8 +1657,7 @@ func (r *Aggregator) mapCoupling(aggregated *Aggregated) Aggregated {
 
 		// instability
 		if class.Stmts.Analyze.Coupling.Afferent > 0 {
-			result.AfferentCoupling.Sum += float64(class.Stmts.Analyze.Coupling.Afferent)
-			result.AfferentCoupling.Counter++
+			result.AfferentCoupling.Add(float64(class.Stmts.Analyze.Coupling.Afferent))
 			if class.Stmts.Analyze.Coupling.Efferent > 0 {
 				class.Stmts.Analyze.Coupling.Instability = float64(class.Stmts.Analyze.Coupling.Efferent) / float64(class.Stmts.Analyze.Coupling.Efferent+class.Stmts.Analyze.Coupling.Afferent)
 			}
@@ -1294,6 +1676,8 @@ func (r *Aggregator) mapCoupling(aggregated *Aggregated) Aggregated {
 	if result.AfferentCoupling.Counter > 0 {
 		result.AfferentCoupling.Avg = result.AfferentCoupling.Sum / float64(result.AfferentCoupling.Counter)
 	}
+	result.EfferentCoupling.reduceDistribution()
+	result.AfferentCoupling.reduceDistribution()
 
 	return result
 }
@@ -449,6 +449,21 @@ func TestFIlesWithErrorAreDetected(t *testing.T) {
 	})
 }
 
+func TestFilesWithParseErrorsAreListed(t *testing.T) {
+	aggregator := Aggregator{}
+	aggregator.files = []*pb.File{
+		{Path: "b.py", Stmts: &pb.Stmts{}, ParseErrors: []*pb.ParseError{{Line: 3, Column: 1, Message: "missing )"}}},
+		{Path: "clean.py", Stmts: &pb.Stmts{}},
+		{Path: "a.go", Errors: []string{"Error1"}},
+	}
+	aggregated := aggregator.Aggregates()
+
+	assert.Equal(t, 1, len(aggregated.ErroredFiles))
+	assert.Equal(t, 2, len(aggregated.FilesWithParseErrors))
+	assert.Equal(t, "a.go", aggregated.FilesWithParseErrors[0].Path)
+	assert.Equal(t, "b.py", aggregated.FilesWithParseErrors[1].Path)
+}
+
 func TestMapSumsDoesNotDuplicateNamespacedPhpSymbols(t *testing.T) {
 	phpSource := `
 <?php
@@ -482,6 +497,32 @@ class Foo
 	assert.Equal(t, 2, aggregated.NbMethods)
 }
 
+func TestMapSumsCountsPublicMethodsAndAbstractClasses(t *testing.T) {
+	phpSource := `
+<?php
+
+abstract class Repository
+{
+    public function find() {}
+    public function save() {}
+    private function reset() {}
+    abstract protected function table();
+}
+`
+
+	file, err := engine.CreateTestFileWithCode(&php.PhpRunner{}, phpSource)
+	assert.Nil(t, err)
+
+	AnalyzeFile(file)
+
+	aggregator := Aggregator{}
+	aggregated := aggregator.mapSums(file, Aggregated{})
+
+	assert.Equal(t, 1, aggregated.NbAbstractClasses)
+	assert.Equal(t, float64(2), aggregated.PublicMethodsPerClass.Sum)
+	assert.Equal(t, 1, aggregated.PublicMethodsPerClass.Counter)
+}
+
 // Every per-method aggregate must survive chunk merging: chunks are computed
 // concurrently per group of files, then merged into the final result.
 func TestMergeChunksKeepsPerMethodAggregates(t *testing.T) {
@@ -71,6 +71,12 @@ func AnalyzeFile(file *pb.File) {
 	cyclomaticVisitor := &Complexity.CyclomaticComplexityVisitor{}
 	root.Accept(cyclomaticVisitor)
 
+	cognitiveVisitor := &Complexity.CognitiveComplexityVisitor{}
+	root.Accept(cognitiveVisitor)
+
+	abcSizeVisitor := &Complexity.AbcSizeVisitor{}
+	root.Accept(abcSizeVisitor)
+
 	locVisitor := &Volume.LocVisitor{}
 	root.Accept(locVisitor)
 
@@ -80,6 +86,9 @@ func AnalyzeFile(file *pb.File) {
 	lcomVisitor := &Component.LackOfCohesionOfMethodsVisitor{Language: file.ProgrammingLanguage}
 	root.Accept(lcomVisitor)
 
+	objectOrientedVisitor := &Component.ObjectOrientedVisitor{}
+	root.Accept(objectOrientedVisitor)
+
 	maintainabilityIndexVisitor := &Component.MaintainabilityIndexVisitor{}
 	root.Accept(maintainabilityIndexVisitor)
 
@@ -95,6 +104,8 @@ func AnalyzeFile(file *pb.File) {
 	// Recompute file cyclomatic complexity using classes plus functions
 	// that are not attached to classes.
 	recomputeFileCyclomatic(file)
+	recomputeFileCognitive(file)
+	recomputeFileAbcSize(file)
 
 	// Recompute Maintainability Index at file level after adjustments
 	mi2 := &Component.MaintainabilityIndexVisitor{}
@@ -132,6 +143,54 @@ func recomputeFileCyclomatic(file *pb.File) {
 	file.Stmts.Analyze.Complexity.Cyclomatic = &fileCyclomatic
 }
 
+// recomputeFileCognitive sums the cognitive complexity of the classes and of
+// the functions that are not attached to classes.
+func recomputeFileCognitive(file *pb.File) {
+	if file == nil || file.Stmts == nil || file.Stmts.Analyze == nil || file.Stmts.Analyze.Complexity == nil {
+		return
+	}
+
+	var fileCognitive int32
+	for _, class := range engine.GetClassesInFile(file) {
+		if class == nil || class.Stmts == nil {
+			continue
+		}
+		fileCognitive += class.Stmts.GetAnalyze().GetComplexity().GetCognitive()
+	}
+	for _, function := range engine.GetFunctionsOutsideClassesInFile(file) {
+		if function == nil || function.Stmts == nil {
+			continue
+		}
+		fileCognitive += function.Stmts.GetAnalyze().GetComplexity().GetCognitive()
+	}
+
+	file.Stmts.Analyze.Complexity.Cognitive = &fileCognitive
+}
+
+// recomputeFileAbcSize sums the ABC vectors of the classes and of the
+// functions that are not attached to classes.
+func recomputeFileAbcSize(file *pb.File) {
+	if file == nil || file.Stmts == nil || file.Stmts.Analyze == nil {
+		return
+	}
+
+	var sizes []*pb.AbcSize
+	for _, class := range engine.GetClassesInFile(file) {
+		if class == nil || class.Stmts == nil {
+			continue
+		}
+		sizes = append(sizes, class.Stmts.GetAnalyze().GetAbcSize())
+	}
+	for _, function := range engine.GetFunctionsOutsideClassesInFile(file) {
+		if function == nil || function.Stmts == nil {
+			continue
+		}
+		sizes = append(sizes, function.Stmts.GetAnalyze().GetAbcSize())
+	}
+
+	file.Stmts.Analyze.AbcSize = engine.SumAbcSize(sizes...)
+}
+
 func consolidateLoc(file *pb.File) {
 	if file != nil {
 		if file.Stmts == nil {
@@ -3,6 +3,7 @@ package analyzer
 import (
 	"testing"
 
+	"github.com/ast-metrics/ast-metrics/internal/engine"
 	pb "github.com/ast-metrics/ast-metrics/pb"
 	"github.com/stretchr/testify/assert"
 )
@@ -161,3 +162,63 @@ func TestRecomputeFileCyclomatic_UsesClassesWhenNoFunctions(t *testing.T) {
 
 	assert.Equal(t, int32(5), *file.Stmts.Analyze.Complexity.Cyclomatic)
 }
+
+func TestAnalyzeFile_SumsCognitiveComplexityOfMethods(t *testing.T) {
+	cognitive := func(v int32) *pb.Stmts {
+		return &pb.Stmts{Analyze: &pb.Analyze{Complexity: &pb.Complexity{Cognitive: &v}}}
+	}
+	class := &pb.StmtClass{
+		Name: &pb.Name{Short: "C", Qualified: "Acme\\C"},
+		Stmts: &pb.Stmts{
+			StmtFunction: []*pb.StmtFunction{
+				{Name: &pb.Name{Short: "A", Qualified: "Acme\\C::A"}, Stmts: cognitive(3)},
+				{Name: &pb.Name{Short: "B", Qualified: "Acme\\C::B"}, Stmts: cognitive(2)},
+			},
+		},
+	}
+	outsideFn := &pb.StmtFunction{Name: &pb.Name{Short: "F", Qualified: "Acme\\F"}, Stmts: cognitive(4)}
+	file := &pb.File{
+		Stmts: &pb.Stmts{
+			StmtClass:    []*pb.StmtClass{class},
+			StmtFunction: []*pb.StmtFunction{outsideFn},
+		},
+	}
+
+	AnalyzeFile(file)
+
+	assert.Equal(t, int32(5), class.Stmts.GetAnalyze().GetComplexity().GetCognitive())
+	assert.Equal(t, int32(9), file.Stmts.GetAnalyze().GetComplexity().GetCognitive())
+}
+
+func TestAnalyzeFile_SumsAbcVectorsOfMethods(t *testing.T) {
+	abc := func(a, b, c int32) *pb.Stmts {
+		return &pb.Stmts{Analyze: &pb.Analyze{AbcSize: engine.NewAbcSize(a, b, c)}}
+	}
+	class := &pb.StmtClass{
+		Name: &pb.Name{Short: "C", Qualified: "Acme\\C"},
+		Stmts: &pb.Stmts{
+			StmtFunction: []*pb.StmtFunction{
+				{Name: &pb.Name{Short: "A", Qualified: "Acme\\C::A"}, Stmts: abc(1, 2, 0)},
+				{Name: &pb.Name{Short: "B", Qualified: "Acme\\C::B"}, Stmts: abc(2, 2, 2)},
+			},
+		},
+	}
+	outsideFn := &pb.StmtFunction{Name: &pb.Name{Short: "F", Qualified: "Acme\\F"}, Stmts: abc(1, 0, 6)}
+	file := &pb.File{
+		Stmts: &pb.Stmts{
+			StmtClass:    []*pb.StmtClass{class},
+			StmtFunction: []*pb.StmtFunction{outsideFn},
+		},
+	}
+
+	AnalyzeFile(file)
+
+	// the size of the class is the magnitude of <3, 4, 2>, not 2.24 + 3.46
+	classAbc := class.Stmts.GetAnalyze().GetAbcSize()
+	assert.Equal(t, int32(3), classAbc.GetAssignments())
+	assert.Equal(t, int32(4), classAbc.GetBranches())
+	assert.Equal(t, int32(2), classAbc.GetConditions())
+	assert.Equal(t, 5.39, classAbc.GetMagnitude())
+	// <4, 4, 8>
+	assert.Equal(t, 9.8, file.Stmts.GetAnalyze().GetAbcSize().GetMagnitude())
+}
@@ -34,6 +34,13 @@ var extractorRowColumns = []string{
 	"nb_unique_operators",      // 27
 	"programming_language",     // 28
 	"cyclomatic_complexity",    // 29
+	"visibility",               // 30
+	"nb_public_methods",        // 31
+	"nb_static_methods",        // 32
+	"is_abstract",              // 33
+	"is_final",                 // 34
+	"annotations_raw",          // 35
+	"nb_annotations",           // 36
 }
 
 var colIndex = func() map[string]int {
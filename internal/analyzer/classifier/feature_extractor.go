@@ -3,6 +3,7 @@ package classifier
 import (
 	"fmt"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/ast-metrics/ast-metrics/internal/engine"
@@ -143,6 +144,17 @@ func (e *FeatureExtractor) ExtractClassMetrics(class *pb.StmtClass, file *pb.Fil
 	// Get namespace
 	namespace := e.getNamespace(file)
 
+	// Visibility and modifiers
+	var methods []*pb.StmtFunction
+	if class.Stmts != nil {
+		methods = class.Stmts.StmtFunction
+	}
+	nbPublicMethods := e.countMethods(methods, engine.IsPublic)
+	nbStaticMethods := e.countMethods(methods, func(m *pb.StmtFunction) bool { return m.GetModifiers().GetIsStatic() })
+
+	// Annotations of the class and of its methods (@Entity, @GetMapping...)
+	annotations := e.getAnnotationsForClass(class)
+
 	return []string{
 		stmtName,
 		"class",
@@ -174,6 +186,13 @@ func (e *FeatureExtractor) ExtractClassMetrics(class *pb.StmtClass, file *pb.Fil
 		fmt.Sprintf("%d", nbUniqueOperators),
 		programmingLanguage,
 		fmt.Sprintf("%d", cyclomaticComplexity),
+		engine.VisibilityName(class.Modifiers),
+		fmt.Sprintf("%d", nbPublicMethods),
+		fmt.Sprintf("%d", nbStaticMethods),
+		flag(class.GetModifiers().GetIsAbstract()),
+		flag(class.GetModifiers().GetIsFinal()),
+		strings.Join(annotations, ";"),
+		fmt.Sprintf("%d", len(class.Annotations)),
 	}
 }
 
@@ -248,8 +267,10 @@ func (e *FeatureExtractor) ExtractFileMetrics(file *pb.File) []string {
 	}
 
 	nbMethods := int32(0)
+	nbPublicMethods := int32(0)
 	if file.Stmts != nil {
 		nbMethods = int32(len(file.Stmts.StmtFunction))
+		nbPublicMethods = e.countMethods(file.Stmts.StmtFunction, engine.IsPublic)
 	}
 
 	nbExtends := int32(0)
@@ -335,6 +356,10 @@ func (e *FeatureExtractor) ExtractFileMetrics(file *pb.File) []string {
 		fmt.Sprintf("%d", nbUniqueOperators),
 		programmingLanguage,
 		fmt.Sprintf("%d", cyclomaticComplexity),
+		"", // Visibility
+		fmt.Sprintf("%d", nbPublicMethods),
+		"0", "0", "0", // Static methods, abstract, final
+		"", "0", // Annotations
 	}
 }
 
@@ -440,6 +465,11 @@ func (e *FeatureExtractor) ExtractInterfaceMetrics(iface *pb.StmtInterface, file
 		"0", "0", "0", "0", // Getters, Setters, Attributes, UniqueOperators
 		file.ProgrammingLanguage,
 		"0", // Cyclomatic complexity
+		"",  // Visibility
+		// the methods of an interface are public, and an interface is abstract
+		fmt.Sprintf("%d", nbMethods),
+		"0", "1", "0", // Static methods, abstract, final
+		"", "0", // Annotations
 	}
 }
 
@@ -558,6 +588,12 @@ func (e *FeatureExtractor) ExtractFunctionMetrics(function *pb.StmtFunction, fil
 		"0", "0", "0", "0", // Getters, Setters, Attributes, UniqueOperators
 		file.ProgrammingLanguage,
 		"0", // Cyclomatic complexity
+		engine.VisibilityName(function.Modifiers),
+		"0", "0", // Public and static methods
+		flag(function.GetModifiers().GetIsAbstract()),
+		flag(function.GetModifiers().GetIsFinal()),
+		strings.Join(e.annotationNames(nil, function.Annotations), ";"),
+		fmt.Sprintf("%d", len(function.Annotations)),
 	}
 }
 
@@ -934,10 +970,54 @@ func (e *FeatureExtractor) countUniqueOperatorsForFile(file *pb.File) int32 {
 	return int32(len(seen))
 }
 
+// getAnnotationsForClass returns the short names of the annotations of a
+// class and of its methods, without duplicates.
+func (e *FeatureExtractor) getAnnotationsForClass(class *pb.StmtClass) []string {
+	names := e.annotationNames(nil, class.Annotations)
+	if class.Stmts != nil {
+		for _, method := range class.Stmts.StmtFunction {
+			names = e.annotationNames(names, method.GetAnnotations())
+		}
+	}
+	return names
+}
+
+// annotationNames appends the short names of annotations to names, skipping
+// the names already there.
+func (e *FeatureExtractor) annotationNames(names []string, annotations []*pb.Annotation) []string {
+	for _, annotation := range annotations {
+		name := annotation.GetName().GetShort()
+		if name != "" && !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// countMethods counts the methods matching keep.
+func (e *FeatureExtractor) countMethods(methods []*pb.StmtFunction, keep func(*pb.StmtFunction) bool) int32 {
+	count := int32(0)
+	for _, method := range methods {
+		if method != nil && keep(method) {
+			count++
+		}
+	}
+	return count
+}
+
+// flag writes a boolean as a numeric feature.
+func flag(b bool) string {
+	if b {
+		return "1"
+	}
+	return "0"
+}
+
 func (e *FeatureExtractor) emptyRow() []string {
 	return []string{
 		"", "", "", "", "", "", "", "0", "0", "0", "0", "0", "0", "0", "0",
 		"0", "0", "0", "0", "0", "0", "0", "0", "0", "0", "0", "0", "0", "", "0",
+		"", "0", "0", "0", "0", "", "0",
 	}
 }
 
@@ -125,6 +125,9 @@ func (p *Predictor) Predict(files []*pb.File, workdir string) ([]ClassPrediction
 // [B] programming_language one-hot (weighted)
 // [C] class_name hashing tf-idf (weighted) size = HashingNFeatures
 // [D] NLP cols hashing tf-idf in model.Features.NlpCols order (weight=1.0 each) size = HashingNFeatures
+//
+// Any column of the extractor row may be listed by the model: annotations_raw
+// is hashed like method_calls_raw, nb_annotations is read as a number.
 func (p *Predictor) rowToFeatures(row []string, model *RandomForestModel, hashBuf []float64) ([]float64, error) {
 	if model == nil {
 		return nil, fmt.Errorf("model is nil")
@@ -298,6 +301,8 @@ func computeDerivedFeature(row []string, name string) (float64, bool) {
 		return safeRatio(rowValue(row, "nb_method_calls"), rowValue(row, "nb_methods")), true
 	case "dep_per_method":
 		return safeRatio(rowValue(row, "nb_external_dependencies"), rowValue(row, "nb_methods")), true
+	case "annotation_per_method":
+		return safeRatio(rowValue(row, "nb_annotations"), rowValue(row, "nb_methods")), true
 	}
 	return 0, false
 }
@@ -0,0 +1,45 @@
+package analyzer
+
+import (
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+)
+
+// AbcSizeVisitor sums the ABC vectors of the methods of each class. The
+// vector of a function is measured on its syntax tree, when the file is
+// parsed; the size of the class is the magnitude of the summed vector, not
+// the sum of the sizes of its methods.
+type AbcSizeVisitor struct {
+}
+
+func (v *AbcSizeVisitor) Visit(stmts *pb.Stmts, parents *pb.Stmts) {
+	if stmts == nil || parents == nil {
+		return
+	}
+
+	for _, class := range parents.StmtClass {
+		if class == nil || class.Stmts != stmts {
+			continue
+		}
+		if stmts.Analyze == nil {
+			stmts.Analyze = &pb.Analyze{}
+		}
+		stmts.Analyze.AbcSize = v.Calculate(stmts.StmtFunction)
+	}
+}
+
+func (v *AbcSizeVisitor) LeaveNode(stmts *pb.Stmts) {
+}
+
+// Calculate returns the sum of the ABC vectors of the functions, and its
+// magnitude.
+func (v *AbcSizeVisitor) Calculate(functions []*pb.StmtFunction) *pb.AbcSize {
+	sizes := make([]*pb.AbcSize, 0, len(functions))
+	for _, fn := range functions {
+		if fn == nil || fn.Stmts == nil {
+			continue
+		}
+		sizes = append(sizes, fn.Stmts.GetAnalyze().GetAbcSize())
+	}
+	return engine.SumAbcSize(sizes...)
+}
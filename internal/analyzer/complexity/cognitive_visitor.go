@@ -0,0 +1,52 @@
+package analyzer
+
+import (
+	pb "github.com/ast-metrics/ast-metrics/pb"
+)
+
+// CognitiveComplexityVisitor sums the cognitive complexity of the methods of
+// each class. The complexity of a function is measured on its syntax tree,
+// when the file is parsed: it depends on how its structures are nested, which
+// the statements do not keep.
+type CognitiveComplexityVisitor struct {
+}
+
+func (v *CognitiveComplexityVisitor) Visit(stmts *pb.Stmts, parents *pb.Stmts) {
+	if stmts == nil || parents == nil {
+		return
+	}
+
+	for _, class := range parents.StmtClass {
+		if class == nil || class.Stmts != stmts {
+			continue
+		}
+		sum := v.Calculate(stmts)
+		if stmts.Analyze == nil {
+			stmts.Analyze = &pb.Analyze{}
+		}
+		if stmts.Analyze.Complexity == nil {
+			stmts.Analyze.Complexity = &pb.Complexity{}
+		}
+		stmts.Analyze.Complexity.Cognitive = &sum
+	}
+}
+
+func (v *CognitiveComplexityVisitor) LeaveNode(stmts *pb.Stmts) {
+}
+
+// Calculate returns the sum of the cognitive complexity of the functions
+// declared in stmts. Nested functions are already counted in the function
+// holding them.
+func (v *CognitiveComplexityVisitor) Calculate(stmts *pb.Stmts) int32 {
+	if stmts == nil {
+		return 0
+	}
+	var sum int32
+	for _, fn := range stmts.StmtFunction {
+		if fn == nil || fn.Stmts == nil {
+			continue
+		}
+		sum += fn.Stmts.GetAnalyze().GetComplexity().GetCognitive()
+	}
+	return sum
+}
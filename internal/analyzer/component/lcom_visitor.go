@@ -22,10 +22,20 @@ var lifecycleMethodsByLanguage = map[string][]string{
 	"PHP":        {"__construct", "__destruct"},
 	"Python":     {"__init__", "__new__", "__del__"},
 	"TypeScript": {"constructor"},
+	"JavaScript": {"constructor"},
+	"Kotlin":     {"constructor"},
+	"Ruby":       {"initialize"},
 	"Java":       {"finalize"},
 	"C#":         {"Finalize"},
 	"Rust":       {"new", "drop"},
 	"Golang":     {},
+	"C++":        {},
+	"C":          {},
+	"Scala":      {"this"},
+	"Groovy":     {},
+	"Swift":      {"init", "deinit"},
+	"Elixir":     {"init"},
+	"Lua":        {"new", "init", "initialize"},
 }
 
 // genericLifecycleMethods is used when the language is unknown. It only contains the
@@ -41,9 +51,11 @@ var genericLifecycleMethods = []string{
 // bears the name of the class. It also covers destructors in these languages, since
 // parsers expose "~Foo" as "Foo".
 var languagesWithConstructorNamedAfterClass = map[string]bool{
-	"Java": true,
-	"C#":   true,
-	"PHP":  true, // PHP 4 style constructors
+	"Java":   true,
+	"C#":     true,
+	"PHP":    true, // PHP 4 style constructors
+	"C++":    true,
+	"Groovy": true,
 }
 
 // qualifiedNameSeparators lists the separators used by the parsers to build a
@@ -220,7 +232,7 @@ func (v *LackOfCohesionOfMethodsVisitor) isLifecycleMethod(class *pb.StmtClass,
 	}
 
 	// A method named after its class is a constructor (or a destructor, parsers
-	// dropping the leading "~") in Java, C# and legacy PHP.
+	// dropping the leading "~") in Java, C#, C++, Groovy and legacy PHP.
 	if isKnownLanguage && !languagesWithConstructorNamedAfterClass[v.Language] {
 		return false
 	}
@@ -229,7 +241,7 @@ func (v *LackOfCohesionOfMethodsVisitor) isLifecycleMethod(class *pb.StmtClass,
 	}
 	className := shortName(class.Name)
 
-	return className != "" && name == className
+	return className != "" && strings.TrimPrefix(name, "~") == className
 }
 
 // isEmptyMethod tells whether the method body holds no statement.
@@ -0,0 +1,75 @@
+package analyzer
+
+import (
+	pb "github.com/ast-metrics/ast-metrics/pb"
+)
+
+// ObjectOrientedVisitor computes the metrics of the Chidamber & Kemerer suite
+// that only depend on the class itself: WMC and RFC. DIT, NOC and CBO need the
+// whole project, and are resolved when the files are aggregated.
+//
+// It must run after the cyclomatic complexity of the methods is computed.
+type ObjectOrientedVisitor struct {
+}
+
+func (v *ObjectOrientedVisitor) Visit(stmts *pb.Stmts, parents *pb.Stmts) {
+	if stmts == nil || parents == nil {
+		return
+	}
+
+	for _, class := range parents.StmtClass {
+		if class == nil || class.Stmts != stmts {
+			continue
+		}
+		if stmts.Analyze == nil {
+			stmts.Analyze = &pb.Analyze{}
+		}
+		if stmts.Analyze.ObjectOriented == nil {
+			stmts.Analyze.ObjectOriented = &pb.ObjectOriented{}
+		}
+		wmc := v.WeightedMethods(stmts)
+		rfc := v.ResponseFor(stmts)
+		stmts.Analyze.ObjectOriented.Wmc = &wmc
+		stmts.Analyze.ObjectOriented.Rfc = &rfc
+	}
+}
+
+func (v *ObjectOrientedVisitor) LeaveNode(stmts *pb.Stmts) {
+}
+
+// WeightedMethods returns the sum of the cyclomatic complexity of the methods
+// of a class. A method without a measured complexity weighs 1.
+func (v *ObjectOrientedVisitor) WeightedMethods(stmts *pb.Stmts) int32 {
+	var wmc int32
+	for _, method := range stmts.StmtFunction {
+		if method == nil {
+			continue
+		}
+		ccn := method.GetStmts().GetAnalyze().GetComplexity().GetCyclomatic()
+		if ccn < 1 {
+			ccn = 1
+		}
+		wmc += ccn
+	}
+	return wmc
+}
+
+// ResponseFor returns the number of methods that can run in response to a
+// message received by the class: its own methods, plus the distinct methods
+// they call, on any receiver. The receivers are not resolved: the methods are
+// told apart by their name.
+func (v *ObjectOrientedVisitor) ResponseFor(stmts *pb.Stmts) int32 {
+	response := make(map[string]bool)
+	for _, method := range stmts.StmtFunction {
+		if method == nil {
+			continue
+		}
+		if name := method.GetName().GetShort(); name != "" {
+			response[name] = true
+		}
+		for _, call := range method.CalledMethods {
+			response[call] = true
+		}
+	}
+	return int32(len(response))
+}
@@ -0,0 +1,58 @@
+package analyzer
+
+import (
+	"testing"
+
+	pb "github.com/ast-metrics/ast-metrics/pb"
+)
+
+func methodWithComplexity(name string, ccn int32, calls ...string) *pb.StmtFunction {
+	return &pb.StmtFunction{
+		Name:          &pb.Name{Short: name},
+		Stmts:         &pb.Stmts{Analyze: &pb.Analyze{Complexity: &pb.Complexity{Cyclomatic: &ccn}}},
+		CalledMethods: calls,
+	}
+}
+
+func TestObjectOrientedVisitor_Visit_NilStmts(t *testing.T) {
+	visitor := &ObjectOrientedVisitor{}
+	visitor.Visit(nil, nil)
+	// Should not panic
+}
+
+func TestObjectOrientedVisitor_Visit_Class(t *testing.T) {
+	classStmts := &pb.Stmts{StmtFunction: []*pb.StmtFunction{
+		methodWithComplexity("save", 4, "validate", "persist"),
+		methodWithComplexity("validate", 2, "persist", "log"),
+		// not measured: weighs 1
+		methodWithComplexity("reset", 0),
+	}}
+	parents := &pb.Stmts{StmtClass: []*pb.StmtClass{{Name: &pb.Name{Short: "Order"}, Stmts: classStmts}}}
+
+	visitor := &ObjectOrientedVisitor{}
+	visitor.Visit(classStmts, parents)
+
+	oo := classStmts.GetAnalyze().GetObjectOriented()
+	if oo == nil {
+		t.Fatal("expected object-oriented metrics")
+	}
+	if oo.GetWmc() != 7 {
+		t.Errorf("expected WMC 7, got %d", oo.GetWmc())
+	}
+	// save, validate, reset + persist, log
+	if oo.GetRfc() != 5 {
+		t.Errorf("expected RFC 5, got %d", oo.GetRfc())
+	}
+}
+
+func TestObjectOrientedVisitor_Visit_IgnoresFunctions(t *testing.T) {
+	fnStmts := &pb.Stmts{}
+	parents := &pb.Stmts{StmtFunction: []*pb.StmtFunction{{Name: &pb.Name{Short: "main"}, Stmts: fnStmts}}}
+
+	visitor := &ObjectOrientedVisitor{}
+	visitor.Visit(fnStmts, parents)
+
+	if fnStmts.GetAnalyze().GetObjectOriented() != nil {
+		t.Error("expected no object-oriented metrics outside a class")
+	}
+}
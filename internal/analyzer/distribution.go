@@ -0,0 +1,146 @@
+package analyzer
+
+import (
+	"math"
+	"sort"
+)
+
+// HistogramBucket counts the values greater than the upper bound of the
+// previous bucket, and lower than or equal to its own.
+type HistogramBucket struct {
+	UpperBound float64
+	Count      int
+	Percent    float64 // share of the values falling in the bucket
+}
+
+// Add records a value of the metric.
+func (a *AggregateResult) Add(value float64) {
+	a.Sum += value
+	a.Counter++
+	a.values = append(a.values, value)
+}
+
+// Values returns a copy of the recorded values, in the order they were added.
+func (a AggregateResult) Values() []float64 {
+	return append([]float64(nil), a.values...)
+}
+
+// merge records the values of another result. The sums and the counters are
+// left to the caller.
+func (a *AggregateResult) merge(other AggregateResult) {
+	a.values = append(a.values, other.values...)
+}
+
+// reduceDistribution computes the percentiles, the standard deviation and the
+// histogram of the recorded values. A result built without values (by hand,
+// or from sums only) keeps them at zero.
+func (a *AggregateResult) reduceDistribution() {
+	a.Median, a.P75, a.P90, a.P95, a.P99, a.StdDev = 0, 0, 0, 0, 0, 0
+	a.Histogram = nil
+	if len(a.values) == 0 {
+		return
+	}
+
+	sorted := append([]float64(nil), a.values...)
+	sort.Float64s(sorted)
+	a.Median = percentile(sorted, 50)
+	a.P75 = percentile(sorted, 75)
+	a.P90 = percentile(sorted, 90)
+	a.P95 = percentile(sorted, 95)
+	a.P99 = percentile(sorted, 99)
+	// the maximum is not tracked by the sums of every metric
+	if highest := sorted[len(sorted)-1]; highest > a.Max {
+		a.Max = highest
+	}
+
+	mean := 0.0
+	for _, v := range sorted {
+		mean += v
+	}
+	mean /= float64(len(sorted))
+	variance := 0.0
+	for _, v := range sorted {
+		variance += (v - mean) * (v - mean)
+	}
+	a.StdDev = math.Sqrt(variance / float64(len(sorted)))
+
+	bounds := HistogramBounds(sorted[len(sorted)-1])
+	a.Histogram = make([]HistogramBucket, len(bounds))
+	next := 0
+	for i, bound := range bounds {
+		a.Histogram[i].UpperBound = bound
+		for next < len(sorted) && sorted[next] <= bound {
+			a.Histogram[i].Count++
+			next++
+		}
+		a.Histogram[i].Percent = float64(a.Histogram[i].Count) * 100 / float64(len(sorted))
+	}
+}
+
+// percentile interpolates linearly between the two closest ranks of sorted
+// values, as spreadsheets do.
+func percentile(sorted []float64, p float64) float64 {
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(rank-float64(lower))
+}
+
+// HistogramBounds returns the upper bounds of the buckets of the histograms,
+// following the 1-2-5 series (0, 1, 2, 5, 10, 20, 50...) up to the first one
+// reaching max. The bounds are the same for every metric, so that histograms
+// of two projects or two branches can be compared.
+func HistogramBounds(max float64) []float64 {
+	bounds := []float64{0}
+	for scale := 1.0; bounds[len(bounds)-1] < max; scale *= 10 {
+		for _, step := range []float64{1, 2, 5} {
+			bounds = append(bounds, step*scale)
+			if step*scale >= max {
+				break
+			}
+		}
+	}
+	return bounds
+}
+
+// Metrics returns the aggregated metrics made of one value per file, class or
+// method, by the name used to refer to them in the configuration and in the
+// exports. The averages computed from sums only (instability) are left out.
+func (a *Aggregated) Metrics() map[string]*AggregateResult {
+	return map[string]*AggregateResult{
+		"loc":                              &a.Loc,
+		"cloc":                             &a.Cloc,
+		"lloc":                             &a.Lloc,
+		"loc_per_method":                   &a.LocPerMethod,
+		"lloc_per_method":                  &a.LlocPerMethod,
+		"cloc_per_method":                  &a.ClocPerMethod,
+		"cyclomatic":                       &a.CyclomaticComplexity,
+		"cyclomatic_per_method":            &a.CyclomaticComplexityPerMethod,
+		"cyclomatic_per_class":             &a.CyclomaticComplexityPerClass,
+		"cognitive_per_method":             &a.CognitiveComplexityPerMethod,
+		"cognitive_per_class":              &a.CognitiveComplexityPerClass,
+		"wmc_per_class":                    &a.WmcPerClass,
+		"dit_per_class":                    &a.DitPerClass,
+		"noc_per_class":                    &a.NocPerClass,
+		"cbo_per_class":                    &a.CboPerClass,
+		"rfc_per_class":                    &a.RfcPerClass,
+		"public_methods_per_class":         &a.PublicMethodsPerClass,
+		"npath_per_method":                 &a.NPathPerMethod,
+		"nesting_per_method":               &a.NestingPerMethod,
+		"abc_size_per_method":              &a.AbcSizePerMethod,
+		"abc_size_per_class":               &a.AbcSizePerClass,
+		"halstead_difficulty":              &a.HalsteadDifficulty,
+		"halstead_effort":                  &a.HalsteadEffort,
+		"halstead_volume":                  &a.HalsteadVolume,
+		"halstead_time":                    &a.HalsteadTime,
+		"lcom4_per_class":                  &a.Lcom4PerClass,
+		"maintainability":                  &a.MaintainabilityIndex,
+		"maintainability_without_comments": &a.MaintainabilityIndexWithoutComments,
+		"comment_weight":                   &a.MaintainabilityCommentWeight,
+		"efferent_coupling":                &a.EfferentCoupling,
+		"afferent_coupling":                &a.AfferentCoupling,
+		"maintainability_per_method":       &a.MaintainabilityPerMethod,
+		"maintainability_per_method_without_comments": &a.MaintainabilityPerMethodWithoutComments,
+		"comment_weight_per_method":                   &a.MaintainabilityCommentWeightPerMethod,
+	}
+}
@@ -0,0 +1,86 @@
+package analyzer
+
+import (
+	"fmt"
+	"testing"
+
+	pb "github.com/ast-metrics/ast-metrics/pb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestReduceDistribution_ShowsTheLongTail(t *testing.T) {
+	result := AggregateResult{}
+	for _, v := range []float64{1, 1, 2, 2, 2, 3, 3, 4, 5, 400} {
+		result.Add(v)
+	}
+
+	result.reduceDistribution()
+
+	assert.Equal(t, float64(423), result.Sum)
+	assert.Equal(t, 10, result.Counter)
+	assert.Equal(t, 2.5, result.Median)
+	assert.Equal(t, 3.75, result.P75)
+	assert.InDelta(t, 44.5, result.P90, 0.001)
+	assert.InDelta(t, 222.25, result.P95, 0.001)
+	assert.InDelta(t, 364.45, result.P99, 0.001)
+	assert.InDelta(t, 119.24, result.StdDev, 0.01)
+
+	bounds := []float64{}
+	counts := []int{}
+	for _, bucket := range result.Histogram {
+		bounds = append(bounds, bucket.UpperBound)
+		counts = append(counts, bucket.Count)
+	}
+	assert.Equal(t, []float64{0, 1, 2, 5, 10, 20, 50, 100, 200, 500}, bounds)
+	assert.Equal(t, []int{0, 2, 3, 4, 0, 0, 0, 0, 0, 1}, counts)
+	assert.Equal(t, float64(30), result.Histogram[2].Percent)
+}
+
+func TestReduceDistribution_WithoutValues(t *testing.T) {
+	result := AggregateResult{Sum: 20, Counter: 5, Avg: 4}
+
+	result.reduceDistribution()
+
+	assert.Equal(t, float64(0), result.P90)
+	assert.Equal(t, float64(0), result.StdDev)
+	assert.Nil(t, result.Histogram)
+}
+
+func TestHistogramBounds(t *testing.T) {
+	assert.Equal(t, []float64{0}, HistogramBounds(0))
+	assert.Equal(t, []float64{0, 1, 2, 5}, HistogramBounds(3))
+	assert.Equal(t, []float64{0, 1, 2, 5, 10}, HistogramBounds(10))
+}
+
+// The values must survive the split of the files into chunks, computed
+// concurrently then merged.
+func TestAggregatesComputesPercentiles(t *testing.T) {
+	var files []*pb.File
+	for i := 1; i <= 100; i++ {
+		files = append(files, &pb.File{
+			Path:                fmt.Sprintf("file%d.go", i),
+			ProgrammingLanguage: "Go",
+			Stmts: &pb.Stmts{
+				StmtFunction: []*pb.StmtFunction{{
+					Name: &pb.Name{Short: "run", Qualified: fmt.Sprintf("run%d", i)},
+					Stmts: &pb.Stmts{Analyze: &pb.Analyze{
+						Complexity: &pb.Complexity{Cyclomatic: proto.Int32(int32(i))},
+					}},
+				}},
+				Analyze: &pb.Analyze{},
+			},
+		})
+	}
+
+	project := NewAggregator(files, nil).Aggregates()
+
+	for _, aggregated := range []Aggregated{project.Combined, project.ByProgrammingLanguage["Go"]} {
+		ccn := aggregated.CyclomaticComplexityPerMethod
+		assert.Equal(t, 100, ccn.Counter)
+		assert.Len(t, ccn.Values(), 100)
+		assert.Equal(t, 50.5, ccn.Median)
+		assert.InDelta(t, 90.1, ccn.P90, 0.001)
+		assert.InDelta(t, 99.01, ccn.P99, 0.001)
+	}
+}
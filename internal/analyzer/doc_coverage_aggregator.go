@@ -0,0 +1,174 @@
+package analyzer
+
+import (
+	"path/filepath"
+	"sort"
+
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+)
+
+// DocCoverageMetrics holds the share of the public classes, interfaces and
+// functions written with a documentation: a doc comment right before them (godoc,
+// Javadoc, PHPDoc, "///"), or a docstring.
+type DocCoverageMetrics struct {
+	PublicSymbols int
+	Documented    int
+	CoveragePct   float64
+	Undocumented  []UndocumentedSymbol   // by file, then by line
+	Files         []FileDocCoverage      // files with public symbols, least documented first
+	Directories   []DirectoryDocCoverage // directories with public symbols, least documented first
+}
+
+// UndocumentedSymbol is a public class, interface or function written without
+// documentation.
+type UndocumentedSymbol struct {
+	Kind      string // "class", "interface" or "function"
+	Name      string
+	FilePath  string
+	ShortPath string
+	Line      int
+}
+
+// FileDocCoverage holds the documentation coverage of the public symbols of a
+// file.
+type FileDocCoverage struct {
+	FilePath      string
+	ShortPath     string
+	PublicSymbols int
+	Documented    int
+	CoveragePct   float64
+}
+
+// DirectoryDocCoverage holds the documentation coverage of the public symbols
+// of the files of a directory (not of its subdirectories): the package, for
+// most languages.
+type DirectoryDocCoverage struct {
+	Path          string
+	ShortPath     string
+	PublicSymbols int
+	Documented    int
+	CoveragePct   float64
+}
+
+// DocCoverageAggregator measures how much of the public API is documented.
+// A method counts when both the method and its class or interface are public:
+// the public method of an internal class is not part of the API. Nested functions and
+// test files are left out.
+type DocCoverageAggregator struct{}
+
+func NewDocCoverageAggregator() *DocCoverageAggregator {
+	return &DocCoverageAggregator{}
+}
+
+func (da *DocCoverageAggregator) Calculate(aggregate *Aggregated) {
+	if aggregate == nil {
+		return
+	}
+	metrics := &DocCoverageMetrics{}
+
+	var files []*pb.File
+	for _, f := range aggregate.ConcernedFiles {
+		if f != nil && f.Stmts != nil && !f.GetIsTest() {
+			files = append(files, f)
+		}
+	}
+	// the order of the chunks of the aggregation is not stable
+	sort.Slice(files, func(i, j int) bool { return files[i].Path < files[j].Path })
+
+	byDirectory := map[string]*DirectoryDocCoverage{}
+	for _, f := range files {
+		fc := FileDocCoverage{FilePath: f.Path, ShortPath: f.ShortPath}
+		var undocumented []UndocumentedSymbol
+		record := func(kind string, name *pb.Name, location *pb.StmtLocationInFile, doc *pb.StmtComment) {
+			fc.PublicSymbols++
+			if doc != nil {
+				fc.Documented++
+				return
+			}
+			symbol := UndocumentedSymbol{Kind: kind, Name: name.GetQualified(), FilePath: f.Path, ShortPath: f.ShortPath, Line: int(location.GetStartLine())}
+			if header := location.GetFile(); header != "" {
+				// declared in the header of a C++ source
+				symbol.FilePath, symbol.ShortPath = header, header
+			}
+			if symbol.Name == "" {
+				symbol.Name = name.GetShort()
+			}
+			undocumented = append(undocumented, symbol)
+		}
+
+		var visitClass func(class *pb.StmtClass)
+		visitClass = func(class *pb.StmtClass) {
+			if !engine.IsPublicClass(class) {
+				return
+			}
+			record("class", class.Name, class.Location, class.DocComment)
+			for _, method := range class.GetStmts().GetStmtFunction() {
+				if engine.IsPublic(method) {
+					record("function", method.Name, method.Location, method.DocComment)
+				}
+			}
+			for _, nested := range class.GetStmts().GetStmtClass() {
+				visitClass(nested)
+			}
+		}
+		for _, class := range f.Stmts.StmtClass {
+			visitClass(class)
+		}
+		// the methods of a Go interface belong to it, the ones of the other
+		// languages are read as functions
+		for _, itf := range f.Stmts.StmtInterface {
+			if !engine.IsPublicInterface(itf) {
+				continue
+			}
+			record("interface", itf.Name, itf.Location, itf.DocComment)
+			for _, method := range itf.GetStmts().GetStmtFunction() {
+				if engine.IsPublic(method) {
+					record("function", method.Name, method.Location, method.DocComment)
+				}
+			}
+		}
+		for _, function := range f.Stmts.StmtFunction {
+			if engine.IsPublic(function) {
+				record("function", function.Name, function.Location, function.DocComment)
+			}
+		}
+		if fc.PublicSymbols == 0 {
+			continue
+		}
+
+		sort.SliceStable(undocumented, func(i, j int) bool { return undocumented[i].Line < undocumented[j].Line })
+		metrics.Undocumented = append(metrics.Undocumented, undocumented...)
+		metrics.PublicSymbols += fc.PublicSymbols
+		metrics.Documented += fc.Documented
+		fc.CoveragePct = percentage(fc.Documented, fc.PublicSymbols)
+		metrics.Files = append(metrics.Files, fc)
+
+		dir := filepath.Dir(f.Path)
+		if byDirectory[dir] == nil {
+			byDirectory[dir] = &DirectoryDocCoverage{Path: dir}
+			if f.ShortPath != "" {
+				byDirectory[dir].ShortPath = filepath.Dir(f.ShortPath)
+			}
+		}
+		byDirectory[dir].PublicSymbols += fc.PublicSymbols
+		byDirectory[dir].Documented += fc.Documented
+	}
+	for _, dd := range byDirectory {
+		dd.CoveragePct = percentage(dd.Documented, dd.PublicSymbols)
+		metrics.Directories = append(metrics.Directories, *dd)
+	}
+	metrics.CoveragePct = percentage(metrics.Documented, metrics.PublicSymbols)
+
+	sort.SliceStable(metrics.Files, func(i, j int) bool {
+		return metrics.Files[i].CoveragePct < metrics.Files[j].CoveragePct
+	})
+	sort.Slice(metrics.Directories, func(i, j int) bool {
+		if metrics.Directories[i].CoveragePct != metrics.Directories[j].CoveragePct {
+			return metrics.Directories[i].CoveragePct < metrics.Directories[j].CoveragePct
+		}
+		return metrics.Directories[i].Path < metrics.Directories[j].Path
+	})
+
+	aggregate.DocCoverage = metrics
+}
@@ -0,0 +1,125 @@
+package analyzer
+
+import (
+	"testing"
+
+	enginePkg "github.com/ast-metrics/ast-metrics/internal/engine"
+	"github.com/ast-metrics/ast-metrics/internal/engine/golang"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	publicSymbol  = &pb.Modifiers{Visibility: pb.Visibility_VISIBILITY_PUBLIC}
+	privateSymbol = &pb.Modifiers{Visibility: pb.Visibility_VISIBILITY_PRIVATE}
+	docComment    = &pb.StmtComment{Text: "/** Documented */"}
+)
+
+func docFunction(name string, line int32, modifiers *pb.Modifiers, doc *pb.StmtComment) *pb.StmtFunction {
+	return &pb.StmtFunction{
+		Name:       &pb.Name{Short: name, Qualified: name},
+		Location:   &pb.StmtLocationInFile{StartLine: line},
+		Modifiers:  modifiers,
+		DocComment: doc,
+		Stmts:      &pb.Stmts{},
+	}
+}
+
+func TestDocCoverageAggregator_CountsPublicSymbols(t *testing.T) {
+	cart := &pb.StmtClass{
+		Name:       &pb.Name{Short: "Cart", Qualified: "shop.Cart"},
+		Location:   &pb.StmtLocationInFile{StartLine: 3},
+		Modifiers:  publicSymbol,
+		DocComment: docComment,
+		Stmts: &pb.Stmts{StmtFunction: []*pb.StmtFunction{
+			docFunction("total", 5, publicSymbol, docComment),
+			docFunction("add", 9, publicSymbol, nil),
+			docFunction("recompute", 12, privateSymbol, nil),
+		}},
+	}
+	// the public methods of an internal class are not part of the API
+	internal := &pb.StmtClass{
+		Name:      &pb.Name{Short: "cache"},
+		Modifiers: &pb.Modifiers{Visibility: pb.Visibility_VISIBILITY_PACKAGE},
+		Stmts:     &pb.Stmts{StmtFunction: []*pb.StmtFunction{docFunction("get", 20, publicSymbol, nil)}},
+	}
+	first := &pb.File{Path: "src/shop/cart.go", ShortPath: "shop/cart.go", Stmts: &pb.Stmts{
+		StmtClass:    []*pb.StmtClass{cart, internal},
+		StmtFunction: []*pb.StmtFunction{docFunction("NewCart", 30, publicSymbol, nil)},
+	}}
+	second := &pb.File{Path: "src/shop/order.go", ShortPath: "shop/order.go", Stmts: &pb.Stmts{
+		StmtFunction: []*pb.StmtFunction{docFunction("Order", 1, publicSymbol, docComment)},
+	}}
+	test := &pb.File{Path: "src/shop/cart_test.go", IsTest: true, Stmts: &pb.Stmts{
+		StmtFunction: []*pb.StmtFunction{docFunction("TestCart", 1, publicSymbol, nil)},
+	}}
+	aggregated := &Aggregated{ConcernedFiles: []*pb.File{second, test, first}}
+
+	NewDocCoverageAggregator().Calculate(aggregated)
+
+	d := aggregated.DocCoverage
+	if !assert.NotNil(t, d) {
+		return
+	}
+	assert.Equal(t, 5, d.PublicSymbols)
+	assert.Equal(t, 3, d.Documented)
+	assert.Equal(t, float64(60), d.CoveragePct)
+
+	if assert.Len(t, d.Undocumented, 2) {
+		assert.Equal(t, UndocumentedSymbol{Kind: "function", Name: "add", FilePath: "src/shop/cart.go", ShortPath: "shop/cart.go", Line: 9}, d.Undocumented[0])
+		assert.Equal(t, "NewCart", d.Undocumented[1].Name)
+	}
+	if assert.Len(t, d.Files, 2) {
+		assert.Equal(t, "src/shop/cart.go", d.Files[0].FilePath, "least documented first")
+		assert.Equal(t, float64(50), d.Files[0].CoveragePct)
+		assert.Equal(t, float64(100), d.Files[1].CoveragePct)
+	}
+	if assert.Len(t, d.Directories, 1) {
+		assert.Equal(t, DirectoryDocCoverage{Path: "src/shop", ShortPath: "shop", PublicSymbols: 5, Documented: 3, CoveragePct: 60}, d.Directories[0])
+	}
+}
+
+func TestDocCoverageAggregator_WithoutPublicSymbols(t *testing.T) {
+	aggregated := &Aggregated{ConcernedFiles: []*pb.File{{Path: "main.go", Stmts: &pb.Stmts{
+		StmtFunction: []*pb.StmtFunction{docFunction("main", 1, privateSymbol, nil)},
+	}}}}
+
+	NewDocCoverageAggregator().Calculate(aggregated)
+
+	assert.Equal(t, 0, aggregated.DocCoverage.PublicSymbols)
+	assert.Empty(t, aggregated.DocCoverage.Files)
+}
+
+// The interfaces are part of the API, and so are the methods of a Go
+// interface, that belong to it.
+func TestDocCoverageAggregator_CountsInterfaces(t *testing.T) {
+	file, err := enginePkg.CreateTestFileWithCode(&golang.GolangRunner{}, `package store
+
+// Store keeps the carts.
+type Store interface {
+	// Save saves a cart.
+	Save(id int) error
+	Load(id int) error
+	io.Reader
+}
+
+type cache interface {
+	Get(id int) error
+}
+`)
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	aggregated := &Aggregated{ConcernedFiles: []*pb.File{file}}
+
+	NewDocCoverageAggregator().Calculate(aggregated)
+
+	d := aggregated.DocCoverage
+	assert.Equal(t, 3, d.PublicSymbols)
+	assert.Equal(t, 2, d.Documented)
+	if assert.Len(t, d.Undocumented, 1) {
+		assert.Equal(t, "function", d.Undocumented[0].Kind)
+		assert.Equal(t, `store\Store.Load`, d.Undocumented[0].Name)
+		assert.Equal(t, 7, d.Undocumented[0].Line)
+	}
+}
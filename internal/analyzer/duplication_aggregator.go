@@ -0,0 +1,312 @@
+package analyzer
+
+import (
+	"path/filepath"
+	"slices"
+	"sort"
+
+	pb "github.com/ast-metrics/ast-metrics/pb"
+)
+
+const (
+	// DefaultDuplicationMinTokens is the number of tokens a block must share
+	// with another one to be reported as duplicated.
+	DefaultDuplicationMinTokens = 100
+	// DefaultDuplicationMinLines is the number of lines a duplicated block
+	// must span to be reported.
+	DefaultDuplicationMinLines = 10
+)
+
+// DuplicationMetrics holds the duplicated blocks of code found among the
+// concerned files, and the share of the lines of code they cover.
+type DuplicationMetrics struct {
+	MinTokens          int
+	MinLines           int
+	Lines              int // lines holding code
+	DuplicatedLines    int // lines of code belonging to a duplicated block
+	DuplicatedLinesPct float64
+	Clones             []CodeClone            // longest first
+	Files              []FileDuplication      // files with duplicated lines, most duplicated first
+	Directories        []DirectoryDuplication // directories with duplicated lines, most duplicated first
+	compared           []FileDuplication      // every file compared, by path
+}
+
+// CodeClone is a block of code found twice. Names and values may differ
+// between the two copies.
+type CodeClone struct {
+	Tokens int
+	First  CloneLocation
+	Second CloneLocation
+}
+
+// CloneLocation is the place of one copy of a duplicated block.
+type CloneLocation struct {
+	FilePath  string
+	ShortPath string
+	StartLine int
+	EndLine   int
+}
+
+// Lines returns the number of lines of the copy.
+func (l CloneLocation) Lines() int {
+	return l.EndLine - l.StartLine + 1
+}
+
+// FileDuplication holds the duplicated lines of a file.
+type FileDuplication struct {
+	FilePath           string
+	ShortPath          string
+	Lines              int
+	DuplicatedLines    int
+	DuplicatedLinesPct float64
+}
+
+// DirectoryDuplication holds the duplicated lines of the files of a
+// directory (not of its subdirectories).
+type DirectoryDuplication struct {
+	Path               string
+	ShortPath          string
+	Lines              int
+	DuplicatedLines    int
+	DuplicatedLinesPct float64
+}
+
+// DuplicationAggregator finds the blocks of code copied from one place to
+// another, in the same file or across files, whatever their language. Test
+// files are left out: tests repeat their setup on purpose.
+type DuplicationAggregator struct {
+	MinTokens int
+	MinLines  int
+}
+
+func NewDuplicationAggregator() *DuplicationAggregator {
+	return &DuplicationAggregator{MinTokens: DefaultDuplicationMinTokens, MinLines: DefaultDuplicationMinLines}
+}
+
+// tokenPosition is the place of a token in the streams of the files.
+type tokenPosition struct {
+	file  int
+	token int
+}
+
+// cloneBase is the multiplier of the rolling hash of a window of tokens.
+const cloneBase = 1000003
+
+// Calculate finds the duplicated blocks among the concerned files of the
+// aggregate.
+func (da *DuplicationAggregator) Calculate(aggregate *Aggregated) {
+	if aggregate == nil {
+		return
+	}
+	aggregate.Duplication = da.Detect(aggregate.ConcernedFiles)
+}
+
+// Detect compares every window of MinTokens tokens with the windows already
+// seen. A matching window is extended as long as both copies go on alike,
+// then the detection resumes after the copy, so a long duplicated block is
+// reported once.
+func (da *DuplicationAggregator) Detect(concerned []*pb.File) *DuplicationMetrics {
+	minTokens, minLines := da.MinTokens, da.MinLines
+	if minTokens <= 0 {
+		minTokens = DefaultDuplicationMinTokens
+	}
+	if minLines <= 0 {
+		minLines = DefaultDuplicationMinLines
+	}
+	metrics := &DuplicationMetrics{MinTokens: minTokens, MinLines: minLines}
+
+	var files []*pb.File
+	for _, f := range concerned {
+		if f != nil && !f.GetIsTest() && len(f.GetTokens().GetKinds()) > 0 {
+			files = append(files, f)
+		}
+	}
+	// the order of the chunks of the aggregation is not stable
+	sort.Slice(files, func(i, j int) bool { return files[i].Path < files[j].Path })
+
+	// the power of the base dropping the first token out of the window
+	var highest uint64 = 1
+	for i := 1; i < minTokens; i++ {
+		highest *= cloneBase
+	}
+
+	duplicated := make([]map[int32]bool, len(files))
+	seen := map[uint64][]tokenPosition{}
+	for fi, f := range files {
+		kinds := f.Tokens.Kinds
+		var hash uint64
+		resumeAt := 0
+		for i := 0; i+minTokens <= len(kinds); i++ {
+			if i == 0 {
+				for _, kind := range kinds[:minTokens] {
+					hash = hash*cloneBase + uint64(kind)
+				}
+			} else {
+				hash = (hash-uint64(kinds[i-1])*highest)*cloneBase + uint64(kinds[i+minTokens-1])
+			}
+			if i >= resumeAt {
+				if clone, ok := matchClone(files, seen[hash], fi, i, minTokens); ok && clone.First.Lines() >= minLines && clone.Second.Lines() >= minLines {
+					metrics.Clones = append(metrics.Clones, clone.CodeClone)
+					markLines(duplicated, files, clone.first, clone.Tokens)
+					markLines(duplicated, files, clone.second, clone.Tokens)
+					resumeAt = i + clone.Tokens
+				}
+			}
+			seen[hash] = append(seen[hash], tokenPosition{file: fi, token: i})
+		}
+	}
+	sort.SliceStable(metrics.Clones, func(i, j int) bool { return metrics.Clones[i].Tokens > metrics.Clones[j].Tokens })
+
+	for fi, f := range files {
+		lines := map[int32]bool{}
+		for _, line := range f.Tokens.Lines {
+			lines[line] = true
+		}
+		metrics.compared = append(metrics.compared, FileDuplication{FilePath: f.Path, ShortPath: f.ShortPath, Lines: len(lines), DuplicatedLines: len(duplicated[fi])})
+	}
+	metrics.summarize()
+	return metrics
+}
+
+// Within returns the share of the duplication held by some of the files (the
+// files of a language, of a directory): their lines, and the clones having a
+// copy among them. The clones are not searched again, so a block copied from
+// a file outside of them still counts.
+func (d *DuplicationMetrics) Within(files []*pb.File) *DuplicationMetrics {
+	if d == nil {
+		return nil
+	}
+	paths := make(map[string]bool, len(files))
+	for _, f := range files {
+		if f != nil {
+			paths[f.Path] = true
+		}
+	}
+	share := &DuplicationMetrics{MinTokens: d.MinTokens, MinLines: d.MinLines}
+	for _, clone := range d.Clones {
+		if paths[clone.First.FilePath] || paths[clone.Second.FilePath] {
+			share.Clones = append(share.Clones, clone)
+		}
+	}
+	for _, fd := range d.compared {
+		if paths[fd.FilePath] {
+			share.compared = append(share.compared, fd)
+		}
+	}
+	share.summarize()
+	return share
+}
+
+// summarize sums up the duplicated lines of the compared files, by file, by
+// directory and in total.
+func (d *DuplicationMetrics) summarize() {
+	byDirectory := map[string]*DirectoryDuplication{}
+	for _, fd := range d.compared {
+		d.Lines += fd.Lines
+		d.DuplicatedLines += fd.DuplicatedLines
+
+		dir := filepath.Dir(fd.FilePath)
+		if byDirectory[dir] == nil {
+			byDirectory[dir] = &DirectoryDuplication{Path: dir}
+			if fd.ShortPath != "" {
+				byDirectory[dir].ShortPath = filepath.Dir(fd.ShortPath)
+			}
+		}
+		byDirectory[dir].Lines += fd.Lines
+		byDirectory[dir].DuplicatedLines += fd.DuplicatedLines
+
+		if fd.DuplicatedLines > 0 {
+			fd.DuplicatedLinesPct = percentage(fd.DuplicatedLines, fd.Lines)
+			d.Files = append(d.Files, fd)
+		}
+	}
+	for _, dd := range byDirectory {
+		if dd.DuplicatedLines > 0 {
+			dd.DuplicatedLinesPct = percentage(dd.DuplicatedLines, dd.Lines)
+			d.Directories = append(d.Directories, *dd)
+		}
+	}
+	d.DuplicatedLinesPct = percentage(d.DuplicatedLines, d.Lines)
+
+	sort.Slice(d.Files, func(i, j int) bool {
+		if d.Files[i].DuplicatedLinesPct != d.Files[j].DuplicatedLinesPct {
+			return d.Files[i].DuplicatedLinesPct > d.Files[j].DuplicatedLinesPct
+		}
+		return d.Files[i].FilePath < d.Files[j].FilePath
+	})
+	sort.Slice(d.Directories, func(i, j int) bool {
+		if d.Directories[i].DuplicatedLinesPct != d.Directories[j].DuplicatedLinesPct {
+			return d.Directories[i].DuplicatedLinesPct > d.Directories[j].DuplicatedLinesPct
+		}
+		return d.Directories[i].Path < d.Directories[j].Path
+	})
+}
+
+// foundClone is a clone along with the positions of its copies.
+type foundClone struct {
+	CodeClone
+	first  tokenPosition
+	second tokenPosition
+}
+
+// matchClone looks for an earlier copy of the window starting at the given token,
+// among the windows sharing its hash, and extends it as far as both copies
+// go on alike. Two copies in the same file never overlap.
+func matchClone(files []*pb.File, candidates []tokenPosition, fi int, i int, minTokens int) (foundClone, bool) {
+	kinds := files[fi].Tokens.Kinds
+	for _, candidate := range candidates {
+		other := files[candidate.file].Tokens.Kinds
+		j := candidate.token
+		if candidate.file == fi && j+minTokens > i {
+			continue
+		}
+		if !slices.Equal(kinds[i:i+minTokens], other[j:j+minTokens]) {
+			// a collision of the hash
+			continue
+		}
+		length := minTokens
+		for i+length < len(kinds) && j+length < len(other) && kinds[i+length] == other[j+length] {
+			if candidate.file == fi && j+length >= i {
+				break
+			}
+			length++
+		}
+		second := tokenPosition{file: fi, token: i}
+		return foundClone{
+			CodeClone: CodeClone{
+				Tokens: length,
+				First:  cloneLocation(files, candidate, length),
+				Second: cloneLocation(files, second, length),
+			},
+			first:  candidate,
+			second: second,
+		}, true
+	}
+	return foundClone{}, false
+}
+
+func cloneLocation(files []*pb.File, start tokenPosition, length int) CloneLocation {
+	f := files[start.file]
+	return CloneLocation{
+		FilePath:  f.Path,
+		ShortPath: f.ShortPath,
+		StartLine: int(f.Tokens.Lines[start.token]),
+		EndLine:   int(f.Tokens.Lines[start.token+length-1]),
+	}
+}
+
+func markLines(duplicated []map[int32]bool, files []*pb.File, start tokenPosition, length int) {
+	if duplicated[start.file] == nil {
+		duplicated[start.file] = map[int32]bool{}
+	}
+	for _, line := range files[start.file].Tokens.Lines[start.token : start.token+length] {
+		duplicated[start.file][line] = true
+	}
+}
+
+func percentage(part int, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(part) * 100 / float64(total)
+}
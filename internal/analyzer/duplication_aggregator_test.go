@@ -0,0 +1,115 @@
+package analyzer
+
+import (
+	"testing"
+
+	pb "github.com/ast-metrics/ast-metrics/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+// tokenFile builds a file whose tokens are the given kinds, two tokens per
+// line.
+func tokenFile(path string, kinds ...uint32) *pb.File {
+	stream := &pb.TokenStream{}
+	for i, kind := range kinds {
+		stream.Kinds = append(stream.Kinds, kind)
+		stream.Lines = append(stream.Lines, int32(i/2)+1)
+	}
+	return &pb.File{Path: path, ShortPath: path, Tokens: stream}
+}
+
+// sequence returns count kinds starting at from.
+func sequence(from uint32, count int) []uint32 {
+	var kinds []uint32
+	for i := 0; i < count; i++ {
+		kinds = append(kinds, from+uint32(i))
+	}
+	return kinds
+}
+
+func TestDuplicationAggregator_FindsBlocksCopiedAcrossFiles(t *testing.T) {
+	block := sequence(100, 20) // 10 lines
+	first := tokenFile("src/a.go", append(append(sequence(1, 10), block...), sequence(200, 10)...)...)
+	second := tokenFile("lib/b.py", append(sequence(300, 4), block...)...)
+	aggregated := &Aggregated{ConcernedFiles: []*pb.File{second, first}}
+
+	da := &DuplicationAggregator{MinTokens: 10, MinLines: 3}
+	da.Calculate(aggregated)
+
+	d := aggregated.Duplication
+	if assert.NotNil(t, d) && assert.Len(t, d.Clones, 1) {
+		clone := d.Clones[0]
+		assert.Equal(t, 20, clone.Tokens)
+		assert.Equal(t, CloneLocation{FilePath: "lib/b.py", ShortPath: "lib/b.py", StartLine: 3, EndLine: 12}, clone.First)
+		assert.Equal(t, CloneLocation{FilePath: "src/a.go", ShortPath: "src/a.go", StartLine: 6, EndLine: 15}, clone.Second)
+	}
+	assert.Equal(t, 32, d.Lines)
+	assert.Equal(t, 20, d.DuplicatedLines)
+	assert.InDelta(t, 62.5, d.DuplicatedLinesPct, 0.01)
+
+	if assert.Len(t, d.Files, 2) {
+		assert.Equal(t, "lib/b.py", d.Files[0].FilePath)
+		assert.InDelta(t, 83.3, d.Files[0].DuplicatedLinesPct, 0.1)
+		assert.InDelta(t, 50, d.Files[1].DuplicatedLinesPct, 0.01)
+	}
+	if assert.Len(t, d.Directories, 2) {
+		assert.Equal(t, "lib", d.Directories[0].Path)
+	}
+}
+
+func TestDuplicationAggregator_CopiesInTheSameFileDoNotOverlap(t *testing.T) {
+	block := sequence(100, 12)
+	file := tokenFile("a.go", append(append(append([]uint32{}, block...), 7, 7), block...)...)
+	aggregated := &Aggregated{ConcernedFiles: []*pb.File{file}}
+
+	(&DuplicationAggregator{MinTokens: 10, MinLines: 3}).Calculate(aggregated)
+
+	if assert.Len(t, aggregated.Duplication.Clones, 1) {
+		clone := aggregated.Duplication.Clones[0]
+		assert.Equal(t, 12, clone.Tokens)
+		assert.Equal(t, 1, clone.First.StartLine)
+		assert.Equal(t, 8, clone.Second.StartLine)
+	}
+}
+
+func TestDuplicationAggregator_IgnoresShortBlocks(t *testing.T) {
+	block := sequence(100, 12) // 6 lines
+	aggregated := &Aggregated{ConcernedFiles: []*pb.File{
+		tokenFile("a.go", block...),
+		tokenFile("b.go", block...),
+	}}
+
+	(&DuplicationAggregator{MinTokens: 10, MinLines: 7}).Calculate(aggregated)
+	assert.Empty(t, aggregated.Duplication.Clones)
+	assert.Equal(t, float64(0), aggregated.Duplication.DuplicatedLinesPct)
+
+	(&DuplicationAggregator{MinTokens: 20, MinLines: 1}).Calculate(aggregated)
+	assert.Empty(t, aggregated.Duplication.Clones)
+}
+
+func TestDuplicationMetrics_WithinKeepsTheShareOfTheFiles(t *testing.T) {
+	block := sequence(100, 20)
+	first := tokenFile("src/a.go", append(append(sequence(1, 10), block...), sequence(200, 10)...)...)
+	second := tokenFile("lib/b.py", append(sequence(300, 4), block...)...)
+	alone := tokenFile("lib/c.py", sequence(400, 10)...)
+
+	d := (&DuplicationAggregator{MinTokens: 10, MinLines: 3}).Detect([]*pb.File{first, second, alone})
+	share := d.Within([]*pb.File{second, alone})
+
+	// the block copied from src/a.go counts, without searching again
+	if assert.Len(t, share.Clones, 1) {
+		assert.Equal(t, "lib/b.py", share.Clones[0].First.FilePath)
+	}
+	assert.Equal(t, 17, share.Lines)
+	assert.Equal(t, 10, share.DuplicatedLines)
+	if assert.Len(t, share.Files, 1) {
+		assert.Equal(t, "lib/b.py", share.Files[0].FilePath)
+	}
+	if assert.Len(t, share.Directories, 1) {
+		assert.Equal(t, "lib", share.Directories[0].Path)
+		assert.InDelta(t, 58.8, share.Directories[0].DuplicatedLinesPct, 0.1)
+	}
+
+	assert.Empty(t, d.Within([]*pb.File{alone}).Clones)
+	assert.Nil(t, (*DuplicationMetrics)(nil).Within([]*pb.File{alone}))
+}
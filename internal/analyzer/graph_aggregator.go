@@ -64,7 +64,8 @@ func (ga *GraphAggregator) Calculate(aggregate *Aggregated) {
 		if file == nil || file.Stmts == nil {
 			continue
 		}
-		// Gather dependencies at file level
+		// Gather dependencies at file level: imports, parents, and the
+		// interfaces a class implements (resolved for Go structs)
 		deps := engine.GetDependenciesInFile(file)
 		for _, dep := range deps {
 			if dep == nil {
@@ -0,0 +1,33 @@
+package analyzer
+
+import (
+	"testing"
+
+	pb "github.com/ast-metrics/ast-metrics/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraphAggregatorLinksImplementedInterfaces(t *testing.T) {
+	// a Go struct implements an interface of another package without naming
+	// it: the parser resolves it into the implements of the struct
+	file := &pb.File{
+		Path: "adapters/sql.go",
+		Stmts: &pb.Stmts{
+			StmtClass: []*pb.StmtClass{
+				{
+					Name:       &pb.Name{Short: "SQLStore", Qualified: "adapters\\SQLStore"},
+					Implements: []*pb.Name{{Short: "Repository", Qualified: "ports\\Repository"}},
+				},
+			},
+		},
+	}
+	aggregated := &Aggregated{ConcernedFiles: []*pb.File{file}}
+
+	NewGraphAggregator().Calculate(aggregated)
+
+	node := aggregated.Graph.Nodes["adapters\\SQLStore"]
+	if assert.NotNil(t, node) {
+		assert.Contains(t, node.Edges, "ports\\Repository")
+	}
+	assert.True(t, aggregated.ExternalNodes["ports\\Repository"], "the interface only receives edges")
+}
@@ -22,4 +22,21 @@ type RequirementError struct {
 	// Line is the 1-based line in the concerned file where the violation
 	// occurs. Zero means the rule is file-level (no specific line).
 	Line int
+	// File is the file concerned by a violation of a project-level rule, or
+	// the file analyzed with the checked one where the violation lies (C++
+	// header). Empty otherwise, and for violations of the whole project.
+	File string
+	// EndLine is the last line of a violation spanning several lines (a
+	// duplicated block). Zero when it holds on Line only.
+	EndLine int
+	// Related is another place involved in the violation: the other copy of
+	// a duplicated block.
+	Related *Location
+}
+
+// Location is a range of lines of a file.
+type Location struct {
+	File      string
+	StartLine int
+	EndLine   int
 }
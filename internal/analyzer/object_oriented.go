@@ -0,0 +1,199 @@
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+)
+
+// resolveObjectOrientedMetrics computes the metrics of the Chidamber & Kemerer
+// suite that need the whole project: the depth of inheritance tree, the number
+// of children and the coupling between objects. WMC and RFC are computed per
+// file, by the ObjectOrientedVisitor.
+//
+// Parents are resolved by qualified name, then by short name. When several
+// classes share the short name, the one of the same language, then of the same
+// namespace, wins. A parent declared outside the project (a class of the standard
+// library, for example) counts as one level of inheritance.
+//
+// A class is coupled to its parents, to the imports it makes use of, and to
+// the classes of the project it mentions (field and parameter types,
+// instantiations), whichever side of the relation it is on.
+// Test files are ignored, as for the afferent coupling.
+func resolveObjectOrientedMetrics(files []*pb.File) {
+	var classes []*pb.StmtClass
+	byQualified := make(map[string]*pb.StmtClass)
+	byShort := make(map[string][]*pb.StmtClass)
+	languages := make(map[*pb.StmtClass]string)
+	fileOf := make(map[*pb.StmtClass]*pb.File)
+
+	for _, file := range files {
+		if file == nil || file.Stmts == nil || file.GetIsTest() {
+			continue
+		}
+		for _, class := range engine.GetClassesInFile(file) {
+			if class == nil || class.Name == nil || class.Stmts == nil {
+				continue
+			}
+			classes = append(classes, class)
+			languages[class] = file.ProgrammingLanguage
+			fileOf[class] = file
+			if class.Name.Qualified != "" {
+				byQualified[class.Name.Qualified] = class
+			}
+			if class.Name.Short != "" {
+				byShort[class.Name.Short] = append(byShort[class.Name.Short], class)
+			}
+		}
+	}
+
+	resolve := func(name *pb.Name, from *pb.StmtClass) *pb.StmtClass {
+		if name == nil {
+			return nil
+		}
+		if class, ok := byQualified[name.Qualified]; ok {
+			return class
+		}
+		candidates := byShort[name.Short]
+		for _, closer := range []func(*pb.StmtClass) bool{
+			func(c *pb.StmtClass) bool { return languages[c] == languages[from] },
+			func(c *pb.StmtClass) bool { return namespaceOf(c) == namespaceOf(from) },
+		} {
+			if len(candidates) <= 1 {
+				break
+			}
+			var kept []*pb.StmtClass
+			for _, c := range candidates {
+				if closer(c) {
+					kept = append(kept, c)
+				}
+			}
+			candidates = kept
+		}
+		if len(candidates) == 1 {
+			return candidates[0]
+		}
+		return nil
+	}
+
+	// Inheritance: children and depth
+	children := make(map[*pb.StmtClass]int32)
+	for _, class := range classes {
+		for _, parent := range class.Extends {
+			if p := resolve(parent, class); p != nil && p != class {
+				children[p]++
+			}
+		}
+	}
+
+	depths := make(map[*pb.StmtClass]int32)
+	var depthOf func(class *pb.StmtClass, visiting map[*pb.StmtClass]bool) int32
+	depthOf = func(class *pb.StmtClass, visiting map[*pb.StmtClass]bool) int32 {
+		if d, ok := depths[class]; ok {
+			return d
+		}
+		if visiting[class] {
+			// cyclic inheritance: the code does not compile, stop here
+			return 0
+		}
+		visiting[class] = true
+		var depth int32
+		for _, parent := range class.Extends {
+			parentDepth := int32(0)
+			if p := resolve(parent, class); p != nil {
+				parentDepth = depthOf(p, visiting)
+			}
+			if parentDepth+1 > depth {
+				depth = parentDepth + 1
+			}
+		}
+		delete(visiting, class)
+		depths[class] = depth
+		return depth
+	}
+
+	// Coupling between objects: classes used by the class, and classes using it
+	coupled := make(map[*pb.StmtClass]map[string]bool)
+	couple := func(class *pb.StmtClass, name string) {
+		if class == nil || name == "" || name == classKey(class) {
+			return
+		}
+		if coupled[class] == nil {
+			coupled[class] = make(map[string]bool)
+		}
+		coupled[class][name] = true
+	}
+	for _, class := range classes {
+		uses := func(name *pb.Name) {
+			target := resolve(name, class)
+			if target == class {
+				return
+			}
+			key := name.Qualified
+			if target != nil {
+				key = classKey(target)
+			}
+			couple(class, key)
+			couple(target, classKey(class))
+		}
+		// the imports of the file the class makes use of (Java, Kotlin), the
+		// dependencies written in the class itself (PHP) and its parents
+		dependencies := engine.GetImportsUsedByClass(fileOf[class], class)
+		dependencies = append(dependencies, class.Stmts.StmtExternalDependencies...)
+		imported := make(map[string]bool)
+		for _, dependency := range dependencies {
+			if dependency == nil || dependency.ClassName == "" {
+				continue
+			}
+			name := engine.DependencyName(dependency)
+			imported[name.Short] = true
+			uses(name)
+		}
+		for _, parents := range [][]*pb.Name{class.Extends, class.Implements} {
+			for _, parent := range parents {
+				if parent != nil && !imported[parent.Short] {
+					uses(&pb.Name{Qualified: classKey(&pb.StmtClass{Name: parent}), Short: parent.Short})
+				}
+			}
+		}
+		// the other types it mentions count when they are classes of the
+		// project: the classes of the same package need no import
+		for _, reference := range class.References {
+			if imported[reference] {
+				continue
+			}
+			if target := resolve(&pb.Name{Short: reference}, class); target != nil {
+				uses(&pb.Name{Qualified: classKey(target), Short: reference})
+			}
+		}
+	}
+
+	for _, class := range classes {
+		if class.Stmts.Analyze == nil {
+			class.Stmts.Analyze = &pb.Analyze{}
+		}
+		if class.Stmts.Analyze.ObjectOriented == nil {
+			class.Stmts.Analyze.ObjectOriented = &pb.ObjectOriented{}
+		}
+		dit := depthOf(class, make(map[*pb.StmtClass]bool))
+		noc := children[class]
+		cbo := int32(len(coupled[class]))
+		class.Stmts.Analyze.ObjectOriented.Dit = &dit
+		class.Stmts.Analyze.ObjectOriented.Noc = &noc
+		class.Stmts.Analyze.ObjectOriented.Cbo = &cbo
+	}
+}
+
+func classKey(class *pb.StmtClass) string {
+	if class.Name.Qualified != "" {
+		return class.Name.Qualified
+	}
+	return class.Name.Short
+}
+
+// namespaceOf returns the qualified name of a class without its short name,
+// whatever the separator of the language.
+func namespaceOf(class *pb.StmtClass) string {
+	return strings.TrimSuffix(class.Name.Qualified, class.Name.Short)
+}
@@ -0,0 +1,192 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	"github.com/ast-metrics/ast-metrics/internal/engine/java"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+)
+
+func ooClass(qualified, short string, extends ...string) *pb.StmtClass {
+	class := &pb.StmtClass{
+		Name:  &pb.Name{Qualified: qualified, Short: short},
+		Stmts: &pb.Stmts{},
+	}
+	for _, parent := range extends {
+		class.Extends = append(class.Extends, &pb.Name{Qualified: parent, Short: parent})
+	}
+	return class
+}
+
+func ooMetrics(t *testing.T, class *pb.StmtClass) *pb.ObjectOriented {
+	t.Helper()
+	oo := class.GetStmts().GetAnalyze().GetObjectOriented()
+	if oo == nil {
+		t.Fatalf("no object-oriented metrics for %s", class.Name.Qualified)
+	}
+	return oo
+}
+
+func TestResolveObjectOrientedMetrics_Inheritance(t *testing.T) {
+	base := ooClass("App\\Base", "Base")
+	// resolved by short name
+	service := ooClass("App\\Service", "Service", "Base")
+	mailer := ooClass("App\\Mailer", "Mailer", "App\\Service")
+	repository := ooClass("App\\Repository", "Repository", "App\\Base")
+	// declared outside the project
+	failure := ooClass("App\\Failure", "Failure", "Exception")
+
+	files := []*pb.File{
+		{Path: "Base.php", Stmts: &pb.Stmts{StmtClass: []*pb.StmtClass{base, service}}},
+		{Path: "Mailer.php", Stmts: &pb.Stmts{StmtClass: []*pb.StmtClass{mailer, repository, failure}}},
+	}
+	resolveObjectOrientedMetrics(files)
+
+	cases := []struct {
+		class    *pb.StmtClass
+		dit, noc int32
+	}{
+		{base, 0, 2},
+		{service, 1, 1},
+		{mailer, 2, 0},
+		{repository, 1, 0},
+		{failure, 1, 0},
+	}
+	for _, tc := range cases {
+		oo := ooMetrics(t, tc.class)
+		if oo.GetDit() != tc.dit {
+			t.Errorf("%s: expected DIT %d, got %d", tc.class.Name.Qualified, tc.dit, oo.GetDit())
+		}
+		if oo.GetNoc() != tc.noc {
+			t.Errorf("%s: expected NOC %d, got %d", tc.class.Name.Qualified, tc.noc, oo.GetNoc())
+		}
+	}
+}
+
+func TestResolveObjectOrientedMetrics_CyclicInheritance(t *testing.T) {
+	a := ooClass("A", "A", "B")
+	b := ooClass("B", "B", "A")
+	resolveObjectOrientedMetrics([]*pb.File{{Stmts: &pb.Stmts{StmtClass: []*pb.StmtClass{a, b}}}})
+
+	// Should not loop forever
+	if ooMetrics(t, a).GetDit() == 0 && ooMetrics(t, b).GetDit() == 0 {
+		t.Error("expected a depth for classes in a cycle")
+	}
+}
+
+func TestResolveObjectOrientedMetrics_Coupling(t *testing.T) {
+	order := ooClass("App\\Order", "Order")
+	order.Stmts.StmtExternalDependencies = []*pb.StmtExternalDependency{
+		{ClassName: "Customer", Namespace: "App\\Customer"},
+		{ClassName: "Customer", Namespace: "App\\Customer"},
+		{ClassName: "DateTime"},
+		// itself
+		{ClassName: "Order", Namespace: "App\\Order"},
+	}
+	customer := ooClass("App\\Customer", "Customer")
+	invoice := ooClass("App\\Invoice", "Invoice")
+	invoice.Stmts.StmtExternalDependencies = []*pb.StmtExternalDependency{
+		{ClassName: "Order", Namespace: "App\\Order"},
+	}
+	// test files are ignored
+	orderTest := ooClass("App\\OrderTest", "OrderTest")
+	orderTest.Stmts.StmtExternalDependencies = []*pb.StmtExternalDependency{
+		{ClassName: "Order", Namespace: "App\\Order"},
+	}
+
+	resolveObjectOrientedMetrics([]*pb.File{
+		{Stmts: &pb.Stmts{StmtClass: []*pb.StmtClass{order, customer, invoice}}},
+		{IsTest: true, Stmts: &pb.Stmts{StmtClass: []*pb.StmtClass{orderTest}}},
+	})
+
+	// Customer, DateTime (used) + Invoice (using it)
+	if cbo := ooMetrics(t, order).GetCbo(); cbo != 3 {
+		t.Errorf("expected CBO 3 for Order, got %d", cbo)
+	}
+	if cbo := ooMetrics(t, customer).GetCbo(); cbo != 1 {
+		t.Errorf("expected CBO 1 for Customer, got %d", cbo)
+	}
+	if cbo := ooMetrics(t, invoice).GetCbo(); cbo != 1 {
+		t.Errorf("expected CBO 1 for Invoice, got %d", cbo)
+	}
+	if orderTest.Stmts.Analyze != nil {
+		t.Error("expected test classes to be skipped")
+	}
+}
+
+func TestResolveObjectOrientedMetrics_CouplingFromJavaImports(t *testing.T) {
+	sources := []string{`package com.acme.web;
+
+import com.acme.data.UserRepository;
+import java.util.List;
+import java.util.Map;
+import java.util.Set;
+
+public class Users extends Base implements Api {
+    private UserRepository repo;
+    private List<String> names;
+    private Map<String, Integer> counts;
+
+    public String find(Criteria c) {
+        Helper h = new Helper();
+        return repo.find(c).name();
+    }
+}`, `package com.acme.web;
+
+class Helper {
+}`, `package com.acme.data;
+
+public class UserRepository {
+}`}
+	var files []*pb.File
+	for _, source := range sources {
+		file, err := engine.CreateTestFileWithCode(&java.JavaRunner{}, source)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files = append(files, file)
+	}
+	resolveObjectOrientedMetrics(files)
+
+	users := engine.GetClassesInFile(files[0])[0]
+	helper := engine.GetClassesInFile(files[1])[0]
+	repository := engine.GetClassesInFile(files[2])[0]
+
+	// UserRepository, List, Map (imported and used), Base, Api (parents) and
+	// Helper (same package). Set is imported but not used, Criteria and String
+	// are neither imported nor declared in the project.
+	if cbo := ooMetrics(t, users).GetCbo(); cbo != 6 {
+		t.Errorf("expected CBO 6 for Users, got %d", cbo)
+	}
+	if cbo := ooMetrics(t, helper).GetCbo(); cbo != 1 {
+		t.Errorf("expected CBO 1 for Helper, got %d", cbo)
+	}
+	if cbo := ooMetrics(t, repository).GetCbo(); cbo != 1 {
+		t.Errorf("expected CBO 1 for UserRepository, got %d", cbo)
+	}
+}
+
+func TestResolveObjectOrientedMetrics_SameShortName(t *testing.T) {
+	javaBase := ooClass("app.Base", "Base")
+	javaChild := ooClass("app.Child", "Child", "Base")
+	otherBase := ooClass("lib.Base", "Base")
+	pythonBase := ooClass("models.Base", "Base")
+	pythonChild := ooClass("models.Child", "Child", "Base")
+
+	resolveObjectOrientedMetrics([]*pb.File{
+		{ProgrammingLanguage: "Java", Stmts: &pb.Stmts{StmtClass: []*pb.StmtClass{javaBase, javaChild, otherBase}}},
+		{ProgrammingLanguage: "Python", Stmts: &pb.Stmts{StmtClass: []*pb.StmtClass{pythonBase, pythonChild}}},
+	})
+
+	// each Child extends the Base of its language and of its namespace
+	if noc := ooMetrics(t, javaBase).GetNoc(); noc != 1 {
+		t.Errorf("expected NOC 1 for app.Base, got %d", noc)
+	}
+	if noc := ooMetrics(t, pythonBase).GetNoc(); noc != 1 {
+		t.Errorf("expected NOC 1 for models.Base, got %d", noc)
+	}
+	if noc := ooMetrics(t, otherBase).GetNoc(); noc != 0 {
+		t.Errorf("expected NOC 0 for lib.Base, got %d", noc)
+	}
+}
@@ -0,0 +1,200 @@
+package requirement
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ast-metrics/ast-metrics/internal/analyzer/ruleset"
+	"github.com/ast-metrics/ast-metrics/internal/configuration"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+)
+
+const (
+	// rules reporting the overrides and severities that cannot be applied
+	invalidOverrideRule = "override_rules"
+	invalidSeverityRule = "severity_rules"
+)
+
+// override is an override of the configuration, ready to be matched against
+// the paths of the files.
+type override struct {
+	index    int
+	paths    []*regexp.Regexp
+	rules    *configuration.ConfigurationRequirementsRules
+	severity map[string]Severity
+}
+
+func (o *override) concerns(path string) bool {
+	for _, re := range o.paths {
+		if re.MatchString(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// overrides holds the overrides and the severities of the requirements. The
+// overrides are applied in their order: the last one matching a file wins.
+type overrides struct {
+	all      []*override
+	severity map[string]Severity
+}
+
+// compileOverrides reads the overrides and the severities of the
+// requirements. The ones that cannot be read are left out, and returned as
+// violations.
+func compileOverrides(requirements configuration.ConfigurationRequirements) (*overrides, []RuleOutcome) {
+	var invalid []RuleOutcome
+	report := func(rule string, err error) {
+		invalid = append(invalid, RuleOutcome{
+			Severity: SeverityHigh,
+			Rule:     rule,
+			Message:  fmt.Sprintf("Invalid configuration: %v", err),
+		})
+	}
+
+	compiled := &overrides{}
+	var err error
+	if compiled.severity, err = compileSeverities(requirements.Severity); err != nil {
+		report(invalidSeverityRule, err)
+	}
+	for i, cfg := range requirements.Overrides {
+		o := &override{index: i, rules: cfg.Rules}
+		if len(cfg.Paths) == 0 {
+			report(invalidOverrideRule, fmt.Errorf("override #%d has no paths", i+1))
+			continue
+		}
+		for _, glob := range cfg.Paths {
+			re, err := configuration.CompileGlob(glob)
+			if err != nil {
+				report(invalidOverrideRule, fmt.Errorf("override #%d: invalid path %q: %v", i+1, glob, err))
+				continue
+			}
+			o.paths = append(o.paths, re)
+		}
+		if o.severity, err = compileSeverities(cfg.Severity); err != nil {
+			report(invalidSeverityRule, fmt.Errorf("override #%d: %v", i+1, err))
+		}
+		// the custom and query rules of an override are checked with the
+		// files it concerns, and reported here when they cannot be
+		for _, err := range validateRules(cfg.Rules) {
+			report(invalidOverrideRule, fmt.Errorf("override #%d: %v", i+1, err))
+		}
+		compiled.all = append(compiled.all, o)
+	}
+	return compiled, invalid
+}
+
+// validateRules returns the errors of the custom and query rules declared in
+// rules, that cannot be checked.
+func validateRules(rules *configuration.ConfigurationRequirementsRules) []error {
+	if rules == nil {
+		return nil
+	}
+	var errs []error
+	for _, cfg := range rules.Custom {
+		if _, err := ruleset.NewCustomRule(cfg); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for _, cfg := range rules.Queries {
+		if _, err := ruleset.NewQueryRule(cfg); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// compileSeverities reads a map of rules to severities. The valid entries are
+// kept when others are not.
+func compileSeverities(severities map[string]string) (map[string]Severity, error) {
+	compiled := map[string]Severity{}
+	var errs []string
+	for rule, name := range severities {
+		severity, err := ruleset.ParseSeverity(name)
+		if err == nil && strings.TrimSpace(name) == "" {
+			err = fmt.Errorf("no severity (expected low, medium or high)")
+		}
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("severity of %s: %v", rule, err))
+			continue
+		}
+		compiled[rule] = severity
+	}
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return compiled, fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return compiled, nil
+}
+
+// requirementsGroup is a set of files checked with the same rules.
+type requirementsGroup struct {
+	requirements *configuration.ConfigurationRequirements
+	files        []*pb.File
+}
+
+// groups splits the files by the overrides of rules matching them, and
+// returns the requirements each group is checked with. The files matching no
+// override are checked with the requirements as they are.
+func (o *overrides) groups(requirements *configuration.ConfigurationRequirements, files []*pb.File) []requirementsGroup {
+	if len(o.all) == 0 {
+		return []requirementsGroup{{requirements: requirements, files: files}}
+	}
+	var groups []requirementsGroup
+	byKey := map[string]int{}
+	for _, file := range files {
+		var matching []*override
+		var key strings.Builder
+		for _, ov := range o.all {
+			if ov.rules != nil && ov.concerns(file.Path) {
+				matching = append(matching, ov)
+				fmt.Fprintf(&key, "%d,", ov.index)
+			}
+		}
+		i, ok := byKey[key.String()]
+		if !ok {
+			overridden := *requirements
+			for _, ov := range matching {
+				overridden.Rules = overridden.Rules.Override(ov.rules)
+			}
+			i = len(groups)
+			byKey[key.String()] = i
+			groups = append(groups, requirementsGroup{requirements: &overridden})
+		}
+		groups[i].files = append(groups[i].files, file)
+	}
+	return groups
+}
+
+// severityOf returns the severity of a violation of the rule in the file:
+// the one remapped by the last override matching the file, or by the
+// requirements, or else the severity given by the rule.
+func (o *overrides) severityOf(rule string, path string, severity Severity) Severity {
+	for i := len(o.all) - 1; i >= 0; i-- {
+		if path == "" || !o.all[i].concerns(path) {
+			continue
+		}
+		if remapped, ok := lookupSeverity(o.all[i].severity, rule); ok {
+			return remapped
+		}
+	}
+	if remapped, ok := lookupSeverity(o.severity, rule); ok {
+		return remapped
+	}
+	return severity
+}
+
+func lookupSeverity(severities map[string]Severity, rule string) (Severity, bool) {
+	if severity, ok := severities[rule]; ok {
+		return severity, true
+	}
+	for name, severity := range severities {
+		if isRule(name, rule) {
+			return severity, true
+		}
+	}
+	return "", false
+}
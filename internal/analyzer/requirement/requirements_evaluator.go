@@ -7,12 +7,14 @@ import (
 	"github.com/ast-metrics/ast-metrics/internal/analyzer/issue"
 	"github.com/ast-metrics/ast-metrics/internal/analyzer/ruleset"
 	"github.com/ast-metrics/ast-metrics/internal/configuration"
+	"github.com/ast-metrics/ast-metrics/internal/engine"
 	pb "github.com/ast-metrics/ast-metrics/pb"
 )
 
-// Expose Severity and RequirementError in this package via alias to avoid import cycles
+// Expose Severity, RequirementError and Location in this package via alias to avoid import cycles
 type Severity = issue.Severity
 type RequirementError = issue.RequirementError
+type Location = issue.Location
 
 const (
 	SeverityUnknown Severity = issue.SeverityUnknown
@@ -31,6 +33,13 @@ type RuleOutcome struct {
 	// Line is the 1-based line in File where the violation occurs.
 	// Zero means the violation is file-level (no specific line).
 	Line int
+	// Cell is the 1-based notebook cell where the violation occurs, for
+	// notebooks only. Line is then the line in the cell.
+	Cell int
+	// EndLine is the last line of a violation spanning several lines, and
+	// Related another place involved in it (see RequirementError).
+	EndLine int
+	Related *Location
 }
 
 type RequirementsEvaluator struct {
@@ -42,7 +51,9 @@ type EvaluationResult struct {
 	ProjectAggregated ProjectAggregated
 	Errors            []RuleOutcome
 	Successes         []RuleOutcome
-	Succeeded         bool
+	// Suppressions lists the suppression comments that silenced violations.
+	Suppressions []Suppression
+	Succeeded    bool
 }
 
 // method to get number of errors by severity
@@ -126,10 +137,66 @@ func (r *RequirementsEvaluator) Evaluate(files []*pb.File, projectAggregated Pro
 		Errors:            []RuleOutcome{},
 	}
 
-	// Delegate to registry-based rulesets
+	// Suppression comments written in the code
+	suppressed, invalidSuppressions := collectSuppressions(files, r.Requirements.Suppressions.ReasonRequired())
+	// Thresholds and severities overridden for some paths
+	overridden, invalidOverrides := compileOverrides(r.Requirements)
+
+	// File-level rules, with the thresholds of the overrides of each file
+	for _, group := range overridden.groups(&r.Requirements, files) {
+		r.checkFiles(group.requirements, group.files, suppressed, &evaluation)
+	}
+
+	// Project-level rules
 	reg := ruleset.Registry(&r.Requirements)
 	for _, rlset := range reg.EnabledRulesets() {
-		// File-level rules
+		if provider, ok := rlset.(ruleset.ProjectRuleProvider); ok {
+			for _, rule := range provider.EnabledProjectRules() {
+				rule := rule // capture
+				rule.CheckProject(
+					projectAggregated.ProjectCtx,
+					func(err RequirementError) {
+						if suppressed.suppress(err.File, rule.Name(), err.Line) {
+							return
+						}
+						outcome := RuleOutcome{Severity: err.Severity, Rule: rule.Name(), Message: err.Message, File: err.File, Line: err.Line, EndLine: err.EndLine, Related: err.Related}
+						if file := fileByPath(files, err.File); file != nil && len(file.Cells) > 0 && err.Line > 0 {
+							outcome.Cell, outcome.Line = engine.NotebookPosition(file, err.Line)
+						}
+						evaluation.Errors = append(evaluation.Errors, outcome)
+					},
+					func(ok string) {
+						sev, msg := parseSeverityFromMessage(ok)
+						evaluation.Successes = append(evaluation.Successes, RuleOutcome{Severity: sev, Rule: rule.Name(), Message: msg})
+					},
+				)
+			}
+		}
+	}
+
+	// Suppressions that cannot be applied, or that silence nothing anymore
+	var staleSuppressions []RuleOutcome
+	evaluation.Suppressions, staleSuppressions = suppressed.split()
+	evaluation.Errors = append(evaluation.Errors, invalidSuppressions...)
+	evaluation.Errors = append(evaluation.Errors, staleSuppressions...)
+	evaluation.Errors = append(evaluation.Errors, invalidOverrides...)
+
+	// Severities remapped by the configuration
+	for i, outcome := range evaluation.Errors {
+		evaluation.Errors[i].Severity = overridden.severityOf(outcome.Rule, outcome.File, outcome.Severity)
+	}
+
+	if len(evaluation.Errors) > 0 {
+		evaluation.Succeeded = false
+	}
+
+	return evaluation
+}
+
+// checkFiles checks the file-level rules of the requirements on the files.
+func (r *RequirementsEvaluator) checkFiles(requirements *configuration.ConfigurationRequirements, files []*pb.File, suppressed suppressions, evaluation *EvaluationResult) {
+	reg := ruleset.Registry(requirements)
+	for _, rlset := range reg.EnabledRulesets() {
 		for _, rule := range rlset.Enabled() {
 			for _, file := range files {
 
@@ -147,8 +214,20 @@ func (r *RequirementsEvaluator) Evaluate(files []*pb.File, projectAggregated Pro
 				rule.CheckFile(
 					file,
 					func(err RequirementError) {
+						// a violation may lie in a file analyzed with this one (C++ header)
+						path := file.Path
+						if err.File != "" {
+							path = err.File
+						}
+						if suppressed.suppress(path, rule.Name(), err.Line) {
+							return
+						}
 						// Severity provided by rule; message should be clean already
-						evaluation.Errors = append(evaluation.Errors, RuleOutcome{Severity: err.Severity, Rule: rule.Name(), Message: err.Message, File: file.Path, Line: err.Line})
+						outcome := RuleOutcome{Severity: err.Severity, Rule: rule.Name(), Message: err.Message, File: path, Line: err.Line}
+						if len(file.Cells) > 0 && err.Line > 0 && path == file.Path {
+							outcome.Cell, outcome.Line = engine.NotebookPosition(file, err.Line)
+						}
+						evaluation.Errors = append(evaluation.Errors, outcome)
 					},
 					func(ok string) {
 						sev, msg := parseSeverityFromMessage(ok)
@@ -157,28 +236,19 @@ func (r *RequirementsEvaluator) Evaluate(files []*pb.File, projectAggregated Pro
 				)
 			}
 		}
-
-		// Project-level rules
-		if provider, ok := rlset.(ruleset.ProjectRuleProvider); ok {
-			for _, rule := range provider.EnabledProjectRules() {
-				rule := rule // capture
-				rule.CheckProject(
-					projectAggregated.ProjectCtx,
-					func(err RequirementError) {
-						evaluation.Errors = append(evaluation.Errors, RuleOutcome{Severity: err.Severity, Rule: rule.Name(), Message: err.Message})
-					},
-					func(ok string) {
-						sev, msg := parseSeverityFromMessage(ok)
-						evaluation.Successes = append(evaluation.Successes, RuleOutcome{Severity: sev, Rule: rule.Name(), Message: msg})
-					},
-				)
-			}
-		}
 	}
+}
 
-	if len(evaluation.Errors) > 0 {
-		evaluation.Succeeded = false
+// fileByPath returns the analyzed file of a path, nil when the path is empty
+// or not analyzed.
+func fileByPath(files []*pb.File, path string) *pb.File {
+	if path == "" {
+		return nil
 	}
-
-	return evaluation
+	for _, file := range files {
+		if file.Path == path {
+			return file
+		}
+	}
+	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/ast-metrics/ast-metrics/internal/analyzer/ruleset"
 	"github.com/ast-metrics/ast-metrics/internal/configuration"
 	pb "github.com/ast-metrics/ast-metrics/pb"
 	"github.com/stretchr/testify/assert"
@@ -104,3 +105,300 @@ requirements:
 
 	assert.Equal(t, 0, len(evaluation.Errors))
 }
+
+func TestEvaluationLocatesNotebookViolationsInCells(t *testing.T) {
+	files := []*pb.File{
+		{
+			Path: "analysis.ipynb",
+			Cells: []*pb.NotebookCell{
+				{Index: 1, StartLine: 1, EndLine: 2},
+				{Index: 3, StartLine: 4, EndLine: 12},
+			},
+			Stmts: &pb.Stmts{
+				StmtFunction: []*pb.StmtFunction{
+					{
+						Name:        &pb.Name{Short: "train"},
+						Location:    &pb.StmtLocationInFile{StartLine: 6, EndLine: 12},
+						LinesOfCode: &pb.LinesOfCode{LinesOfCode: 7},
+					},
+				},
+			},
+		},
+	}
+
+	configInYaml := `
+requirements:
+  rules:
+    volume:
+      max_loc_by_method: 5
+`
+	loader := configuration.NewConfigurationLoader()
+	config, err := loader.Import(configInYaml)
+	assert.Nil(t, err)
+
+	evaluation := NewRequirementsEvaluator(*config.Requirements).Evaluate(files, ProjectAggregated{})
+
+	assert.Equal(t, 1, len(evaluation.Errors))
+	assert.Equal(t, 3, evaluation.Errors[0].Cell)
+	assert.Equal(t, 3, evaluation.Errors[0].Line)
+}
+
+func TestEvaluationLocatesDuplicatedBlocks(t *testing.T) {
+	files := []*pb.File{{Path: "a.go"}, {Path: "b.go"}}
+	configInYaml := `
+requirements:
+  rules:
+    duplication:
+      max_duplication: 5
+`
+	loader := configuration.NewConfigurationLoader()
+	config, err := loader.Import(configInYaml)
+	assert.Nil(t, err)
+
+	ctx := ruleset.ProjectContext{
+		DuplicatedLinesPct: 12.5,
+		Clones: []ruleset.CloneInfo{{
+			Tokens: 80,
+			First:  ruleset.CloneLocationInfo{FilePath: "a.go", StartLine: 10, EndLine: 20},
+			Second: ruleset.CloneLocationInfo{FilePath: "b.go", StartLine: 3, EndLine: 13},
+		}},
+	}
+	evaluation := NewRequirementsEvaluator(*config.Requirements).Evaluate(files, ProjectAggregated{ProjectCtx: ctx})
+
+	assert.Equal(t, 3, len(evaluation.Errors))
+	assert.Equal(t, "Code duplication too high: got 12.5% of duplicated lines (max: 5%)", evaluation.Errors[0].Message)
+	assert.Equal(t, "", evaluation.Errors[0].File)
+	assert.Equal(t, "a.go", evaluation.Errors[1].File)
+	assert.Equal(t, 10, evaluation.Errors[1].Line)
+	assert.Equal(t, "Duplicated block of 11 lines (80 tokens), also found in b.go (lines 3-13)", evaluation.Errors[1].Message)
+	assert.Equal(t, "b.go", evaluation.Errors[2].File)
+	assert.Equal(t, 3, evaluation.Errors[2].Line)
+}
+
+func TestEvaluationChecksPercentiles(t *testing.T) {
+	configInYaml := `
+requirements:
+  rules:
+    distribution:
+      max:
+        - metric: cyclomatic_per_method
+          statistic: p90
+          value: 8
+      min:
+        - metric: maintainability_per_method
+          statistic: median
+          value: 70
+`
+	loader := configuration.NewConfigurationLoader()
+	config, err := loader.Import(configInYaml)
+	assert.Nil(t, err)
+
+	ctx := ruleset.ProjectContext{Distributions: map[string]ruleset.DistributionInfo{
+		"cyclomatic_per_method":      {Count: 120, Avg: 3.1, P90: 9.5},
+		"maintainability_per_method": {Count: 120, Median: 82},
+	}}
+	evaluation := NewRequirementsEvaluator(*config.Requirements).Evaluate(nil, ProjectAggregated{ProjectCtx: ctx})
+
+	assert.False(t, evaluation.Succeeded)
+	assert.Equal(t, 1, len(evaluation.Errors))
+	assert.Equal(t, "max_distribution", evaluation.Errors[0].Rule)
+	assert.Equal(t, "p90 of cyclomatic_per_method too high: got 9.50 (max: 8)", evaluation.Errors[0].Message)
+	assert.Equal(t, 1, len(evaluation.Successes))
+	assert.Equal(t, "min_distribution", evaluation.Successes[0].Rule)
+}
+
+func TestEvaluationLocatesUndocumentedSymbols(t *testing.T) {
+	files := []*pb.File{{Path: "cart.go"}}
+	configInYaml := `
+requirements:
+  rules:
+    documentation:
+      min_doc_coverage: 80
+`
+	loader := configuration.NewConfigurationLoader()
+	config, err := loader.Import(configInYaml)
+	assert.Nil(t, err)
+
+	ctx := ruleset.ProjectContext{
+		PublicSymbols:  3,
+		DocCoveragePct: 66.7,
+		UndocumentedSymbols: []ruleset.UndocumentedSymbolInfo{
+			{Kind: "function", Name: "Cart.Add", FilePath: "cart.go", Line: 14},
+		},
+	}
+	evaluation := NewRequirementsEvaluator(*config.Requirements).Evaluate(files, ProjectAggregated{ProjectCtx: ctx})
+
+	assert.False(t, evaluation.Succeeded)
+	assert.Equal(t, 2, len(evaluation.Errors))
+	assert.Equal(t, "min_doc_coverage", evaluation.Errors[0].Rule)
+	assert.Equal(t, "Documentation coverage too low: got 66.7% of the public symbols documented (min: 80%)", evaluation.Errors[0].Message)
+	assert.Equal(t, "cart.go", evaluation.Errors[1].File)
+	assert.Equal(t, 14, evaluation.Errors[1].Line)
+}
+
+func TestEvaluationChecksCustomRules(t *testing.T) {
+	cyclomatic := int32(14)
+	files := []*pb.File{{Path: "src/cart.go", Stmts: &pb.Stmts{StmtFunction: []*pb.StmtFunction{{
+		Name:        &pb.Name{Short: "Checkout"},
+		Location:    &pb.StmtLocationInFile{StartLine: 21},
+		LinesOfCode: &pb.LinesOfCode{LinesOfCode: 95},
+		Stmts:       &pb.Stmts{Analyze: &pb.Analyze{Complexity: &pb.Complexity{Cyclomatic: &cyclomatic}}},
+	}}}}}
+	configInYaml := `
+requirements:
+  rules:
+    custom:
+      - name: complex_and_long
+        scope: function
+        expression: cyclomatic > 10 && loc > 80 && !is_test
+        severity: high
+        message: "{name}() is complex and long ({cyclomatic}, {loc} lines)"
+      - name: typo
+        scope: function
+        expression: cyclomatik > 10
+`
+	loader := configuration.NewConfigurationLoader()
+	config, err := loader.Import(configInYaml)
+	assert.Nil(t, err)
+
+	evaluation := NewRequirementsEvaluator(*config.Requirements).Evaluate(files, ProjectAggregated{})
+
+	assert.False(t, evaluation.Succeeded)
+	if assert.Equal(t, 2, len(evaluation.Errors)) {
+		assert.Equal(t, "complex_and_long", evaluation.Errors[0].Rule)
+		assert.Equal(t, "Checkout() is complex and long (14, 95 lines)", evaluation.Errors[0].Message)
+		assert.Equal(t, "src/cart.go", evaluation.Errors[0].File)
+		assert.Equal(t, 21, evaluation.Errors[0].Line)
+		assert.Equal(t, "custom_rules", evaluation.Errors[1].Rule)
+		assert.Contains(t, evaluation.Errors[1].Message, `unknown metric "cyclomatik"`)
+	}
+}
+
+func TestEvaluationAppliesSuppressions(t *testing.T) {
+	cyclomatic := int32(14)
+	function := func(name string, line int32) *pb.StmtFunction {
+		return &pb.StmtFunction{
+			Name:     &pb.Name{Short: name},
+			Location: &pb.StmtLocationInFile{StartLine: line},
+			Stmts:    &pb.Stmts{Analyze: &pb.Analyze{Complexity: &pb.Complexity{Cyclomatic: &cyclomatic}}},
+		}
+	}
+	files := []*pb.File{{
+		Path: "src/parser.go",
+		Stmts: &pb.Stmts{StmtFunction: []*pb.StmtFunction{
+			function("Parse", 11),
+			function("Lex", 31),
+		}},
+		Directives: []*pb.StmtComment{
+			directive("// ast-metrics-ignore-next-line max_cyclomatic: legacy parser, see ADR-12", 10),
+			directive("// ast-metrics-ignore-next-line max_npath: nothing to silence", 20),
+		},
+	}}
+	configInYaml := `
+requirements:
+  rules:
+    custom:
+      - name: max_cyclomatic
+        scope: function
+        expression: cyclomatic > 10
+`
+	loader := configuration.NewConfigurationLoader()
+	config, err := loader.Import(configInYaml)
+	assert.Nil(t, err)
+
+	evaluation := NewRequirementsEvaluator(*config.Requirements).Evaluate(files, ProjectAggregated{})
+
+	if assert.Equal(t, 2, len(evaluation.Errors)) {
+		assert.Equal(t, "max_cyclomatic", evaluation.Errors[0].Rule)
+		assert.Equal(t, 31, evaluation.Errors[0].Line)
+		assert.Equal(t, "stale_suppression", evaluation.Errors[1].Rule)
+		assert.Equal(t, 20, evaluation.Errors[1].Line)
+		assert.Equal(t, SeverityLow, evaluation.Errors[1].Severity)
+	}
+	if assert.Equal(t, 1, len(evaluation.Suppressions)) {
+		assert.Equal(t, 10, evaluation.Suppressions[0].Line)
+		assert.Equal(t, "legacy parser, see ADR-12", evaluation.Suppressions[0].Reason)
+		assert.Equal(t, 1, evaluation.Suppressions[0].Used)
+	}
+}
+
+func TestEvaluationAppliesOverridesAndSeverities(t *testing.T) {
+	file := func(path string, cyclomatic int32) *pb.File {
+		return &pb.File{Path: path, Stmts: &pb.Stmts{Analyze: &pb.Analyze{Complexity: &pb.Complexity{Cyclomatic: &cyclomatic}}}}
+	}
+	files := []*pb.File{
+		file("src/service.go", 14),
+		file("src/legacy/parser.go", 25),
+		file("src/clients/billing.go", 14),
+	}
+	configInYaml := `
+requirements:
+  rules:
+    complexity:
+      max_cyclomatic: 10
+  severity:
+    max_cyclomatic: high
+    max_loc: critical
+  overrides:
+    - paths: ["legacy/**"]
+      rules:
+        complexity:
+          max_cyclomatic: 30
+    - paths: ["src/clients"]
+      severity:
+        cyclomatic_complexity: low
+`
+	loader := configuration.NewConfigurationLoader()
+	config, err := loader.Import(configInYaml)
+	assert.Nil(t, err)
+
+	evaluation := NewRequirementsEvaluator(*config.Requirements).Evaluate(files, ProjectAggregated{})
+
+	if assert.Equal(t, 3, len(evaluation.Errors)) {
+		assert.Equal(t, "src/service.go", evaluation.Errors[0].File)
+		assert.Equal(t, SeverityHigh, evaluation.Errors[0].Severity)
+		assert.Equal(t, "src/clients/billing.go", evaluation.Errors[1].File)
+		assert.Equal(t, SeverityLow, evaluation.Errors[1].Severity)
+		assert.Equal(t, "severity_rules", evaluation.Errors[2].Rule)
+		assert.Contains(t, evaluation.Errors[2].Message, `severity of max_loc: unknown severity "critical"`)
+	}
+}
+
+// The custom and query rules of an override are validated like the ones of
+// the requirements.
+func TestEvaluationReportsTheInvalidRulesOfOverrides(t *testing.T) {
+	cyclomatic := int32(14)
+	files := []*pb.File{{Path: "src/legacy/parser.go", Stmts: &pb.Stmts{Analyze: &pb.Analyze{Complexity: &pb.Complexity{Cyclomatic: &cyclomatic}}}}}
+	configInYaml := `
+requirements:
+  rules:
+    complexity:
+      max_cyclomatic: 30
+  overrides:
+    - paths: ["legacy/**"]
+      rules:
+        custom:
+          - name: typo
+            scope: file
+            expression: lok > 80
+        queries:
+          - name: broken
+            language: php
+            query: (function_call @match
+`
+	loader := configuration.NewConfigurationLoader()
+	config, err := loader.Import(configInYaml)
+	assert.Nil(t, err)
+
+	evaluation := NewRequirementsEvaluator(*config.Requirements).Evaluate(files, ProjectAggregated{})
+
+	if assert.Equal(t, 2, len(evaluation.Errors)) {
+		for _, outcome := range evaluation.Errors {
+			assert.Equal(t, "override_rules", outcome.Rule)
+			assert.Equal(t, SeverityHigh, outcome.Severity)
+			assert.Contains(t, outcome.Message, "Invalid configuration: override #1: ")
+		}
+		assert.Contains(t, evaluation.Errors[0].Message, "typo")
+		assert.Contains(t, evaluation.Errors[1].Message, "broken")
+	}
+}
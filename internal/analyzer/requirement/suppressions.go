@@ -0,0 +1,226 @@
+package requirement
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+)
+
+const (
+	ignoreDirective         = "ast-metrics-ignore-"
+	ignoreNextLineDirective = "ast-metrics-ignore-next-line"
+	ignoreFileDirective     = "ast-metrics-ignore-file"
+
+	// rules reporting the suppressions that are not applied
+	invalidSuppressionRule = "invalid_suppression"
+	staleSuppressionRule   = "stale_suppression"
+)
+
+// ruleAliases maps the name of a rule in the configuration to the name it
+// reports its violations with, when they differ: suppressions and severities
+// may use either.
+var ruleAliases = map[string]string{
+	"max_cyclomatic":            "cyclomatic_complexity",
+	"max_afferent_coupling":     "afferent_coupling",
+	"max_efferent_coupling":     "efferent_coupling",
+	"min_maintainability":       "maintainability",
+	"max_logical_loc":           "max_lloc",
+	"max_logical_loc_by_method": "lloc_by_method",
+	"max_nesting":               "max_nesting_depth",
+	"context_missing":           "no_context_missing",
+	"context_ignored":           "no_context_ignored",
+}
+
+// isRule reports whether a name, as written in the configuration or in a
+// comment, designates the rule.
+func isRule(name string, rule string) bool {
+	return name == rule || ruleAliases[name] == rule
+}
+
+// Suppression is a comment silencing the violations of the next line, or of
+// the whole file, with the reason they are accepted:
+//
+//	// ast-metrics-ignore-next-line max_cognitive: legacy parser, see ADR-12
+//	// ast-metrics-ignore-file: generated code
+//
+// The rules are separated by commas or spaces; a suppression naming no rule
+// silences all of them.
+type Suppression struct {
+	File   string
+	Line   int // line of the comment
+	Target int // line whose violations are suppressed; 0 for the whole file
+	// From is the line after the comment. It comes before Target when
+	// decorators, attributes or comments lie between the comment and the
+	// declaration: their lines are suppressed too.
+	From int
+	// Cell is the 1-based notebook cell of the comment, for notebooks only.
+	// Line is then the line in the cell.
+	Cell   int
+	Rules  []string
+	Reason string
+	// Used counts the violations the suppression silenced.
+	Used int
+}
+
+// RulesLabel returns the rules the suppression silences, as the lint output
+// prints them.
+func (s *Suppression) RulesLabel() string {
+	if len(s.Rules) == 0 {
+		return "all rules"
+	}
+	return strings.Join(s.Rules, ", ")
+}
+
+// suppresses reports whether the suppression silences a violation of the rule
+// on the line (0 for a violation of the whole file).
+func (s *Suppression) suppresses(rule string, line int) bool {
+	if s.Target != 0 && (line < s.From || line > s.Target) {
+		return false
+	}
+	if len(s.Rules) == 0 {
+		return true
+	}
+	for _, name := range s.Rules {
+		if isRule(name, rule) {
+			return true
+		}
+	}
+	return false
+}
+
+// suppressions holds the suppressions of the analyzed files, by path.
+type suppressions map[string][]*Suppression
+
+// collectSuppressions reads the suppressions of the directives of the files.
+// The ones that cannot be applied, an unknown directive or a missing reason
+// when one is required, are returned as violations.
+func collectSuppressions(files []*pb.File, requireReason bool) (suppressions, []RuleOutcome) {
+	found := suppressions{}
+	var invalid []RuleOutcome
+	for _, file := range files {
+		for _, comment := range file.GetDirectives() {
+			// a directive may lie in a file analyzed with this one (C++ header)
+			path := file.Path
+			if comment.GetFile() != "" {
+				path = comment.GetFile()
+			}
+			nextLine := int(comment.GetLocation().GetEndLine()) + 1
+			target := max(int(comment.GetTargetLine()), nextLine)
+			for i, text := range strings.Split(comment.GetText(), "\n") {
+				start := strings.Index(text, ignoreDirective)
+				if start < 0 || !isCommentMarker(text[:start]) {
+					// the directive is quoted in a sentence
+					continue
+				}
+				s, err := parseSuppression(text[start:], nextLine, target, requireReason)
+				s.File = path
+				s.Line = int(comment.GetLocation().GetStartLine()) + i
+				if len(file.Cells) > 0 && path == file.Path {
+					s.Cell, s.Line = engine.NotebookPosition(file, s.Line)
+				}
+				if err != nil {
+					invalid = append(invalid, RuleOutcome{
+						Severity: SeverityMedium,
+						Rule:     invalidSuppressionRule,
+						Message:  fmt.Sprintf("Suppression not applied: %v", err),
+						File:     path,
+						Line:     s.Line,
+						Cell:     s.Cell,
+					})
+					continue
+				}
+				found[path] = append(found[path], s)
+			}
+		}
+	}
+	return found, invalid
+}
+
+// isCommentMarker reports whether the text opening a line of a comment is
+// only its marker ("//", "#", " * "...).
+func isCommentMarker(text string) bool {
+	for _, r := range text {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// parseSuppression reads a directive, from its name to the end of the line.
+// nextLine is the line following the comment, target the line of the code it
+// stands for.
+func parseSuppression(text string, nextLine int, target int, requireReason bool) (*Suppression, error) {
+	s := &Suppression{}
+	name := text
+	if end := strings.IndexAny(text, " \t:*"); end >= 0 {
+		name = text[:end]
+	}
+	switch name {
+	case ignoreNextLineDirective:
+		s.From, s.Target = nextLine, target
+	case ignoreFileDirective:
+	default:
+		return s, fmt.Errorf("unknown directive %s (expected %s or %s)", name, ignoreNextLineDirective, ignoreFileDirective)
+	}
+
+	rest := strings.TrimSpace(text[len(name):])
+	// the end of a block comment written on the same line
+	rest = strings.TrimSpace(strings.TrimSuffix(rest, "*/"))
+	rules, reason, _ := strings.Cut(rest, ":")
+	s.Reason = strings.TrimSpace(reason)
+	s.Rules = strings.FieldsFunc(rules, func(r rune) bool { return r == ',' || unicode.IsSpace(r) })
+	if requireReason && s.Reason == "" {
+		return s, fmt.Errorf("%s gives no reason (expected the reason after a colon: \"%s max_cognitive: legacy parser\")", name, name)
+	}
+	return s, nil
+}
+
+// suppress reports whether a violation of the rule on the line of the file
+// is silenced, and counts it for the suppression silencing it.
+func (all suppressions) suppress(path string, rule string, line int) bool {
+	if path == "" {
+		return false
+	}
+	for _, s := range all[path] {
+		if s.suppresses(rule, line) {
+			s.Used++
+			return true
+		}
+	}
+	return false
+}
+
+// split returns the suppressions that silenced a violation, and the stale
+// ones as violations: they silence nothing anymore and should be removed.
+func (all suppressions) split() ([]Suppression, []RuleOutcome) {
+	paths := make([]string, 0, len(all))
+	for path := range all {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	used := []Suppression{}
+	var stale []RuleOutcome
+	for _, path := range paths {
+		for _, s := range all[path] {
+			if s.Used > 0 {
+				used = append(used, *s)
+				continue
+			}
+			stale = append(stale, RuleOutcome{
+				Severity: SeverityLow,
+				Rule:     staleSuppressionRule,
+				Message:  fmt.Sprintf("Suppression of %s matches no violation: it can be removed", s.RulesLabel()),
+				File:     s.File,
+				Line:     s.Line,
+				Cell:     s.Cell,
+			})
+		}
+	}
+	return used, stale
+}
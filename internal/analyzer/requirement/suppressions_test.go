@@ -0,0 +1,90 @@
+package requirement
+
+import (
+	"testing"
+
+	pb "github.com/ast-metrics/ast-metrics/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func directive(text string, line int32) *pb.StmtComment {
+	return &pb.StmtComment{Text: text, Location: &pb.StmtLocationInFile{StartLine: line, EndLine: line}}
+}
+
+func TestCollectSuppressions(t *testing.T) {
+	files := []*pb.File{{
+		Path: "src/parser.go",
+		Directives: []*pb.StmtComment{
+			directive("// ast-metrics-ignore-next-line max_cyclomatic, max_npath: legacy parser, see ADR-12", 10),
+			directive("/* ast-metrics-ignore-file: generated code */", 1),
+			directive("// ast-metrics-ignore-next-line max_cyclomatic", 30),
+			directive("// ast-metrics-ignore-nextline max_cyclomatic: typo", 40),
+			directive("// see the ast-metrics-ignore-file directive", 50),
+		},
+	}}
+
+	found, invalid := collectSuppressions(files, true)
+
+	assert.Equal(t, []*Suppression{
+		{File: "src/parser.go", Line: 10, Target: 11, From: 11, Rules: []string{"max_cyclomatic", "max_npath"}, Reason: "legacy parser, see ADR-12"},
+		{File: "src/parser.go", Line: 1, Target: 0, Rules: []string{}, Reason: "generated code"},
+	}, found["src/parser.go"])
+	if assert.Equal(t, 2, len(invalid)) {
+		assert.Equal(t, "invalid_suppression", invalid[0].Rule)
+		assert.Equal(t, 30, invalid[0].Line)
+		assert.Contains(t, invalid[0].Message, "ast-metrics-ignore-next-line gives no reason")
+		assert.Equal(t, 40, invalid[1].Line)
+		assert.Contains(t, invalid[1].Message, "unknown directive ast-metrics-ignore-nextline")
+	}
+
+	// without a mandatory reason, the suppression is applied
+	found, invalid = collectSuppressions(files, false)
+	assert.Equal(t, 3, len(found["src/parser.go"]))
+	assert.Equal(t, 1, len(invalid))
+}
+
+func TestCollectSuppressions_OfAHeader(t *testing.T) {
+	header := directive("// ast-metrics-ignore-next-line no_c_cast: checked by the caller", 4)
+	header.File = "src/foo.h"
+	files := []*pb.File{{Path: "src/foo.cpp", Directives: []*pb.StmtComment{header}}}
+
+	found, invalid := collectSuppressions(files, true)
+
+	assert.Empty(t, invalid)
+	assert.Empty(t, found["src/foo.cpp"])
+	assert.Equal(t, []*Suppression{
+		{File: "src/foo.h", Line: 4, Target: 5, From: 5, Rules: []string{"no_c_cast"}, Reason: "checked by the caller"},
+	}, found["src/foo.h"])
+	assert.True(t, found.suppress("src/foo.h", "no_c_cast", 5), "the violations of the header are silenced")
+	assert.False(t, found.suppress("src/foo.cpp", "no_c_cast", 5))
+}
+
+// A suppression written above a decorator silences the declaration below it,
+// whichever of their lines the violation is reported on.
+func TestCollectSuppressions_AboveADecorator(t *testing.T) {
+	comment := directive("# ast-metrics-ignore-next-line max_cyclomatic: legacy", 2)
+	comment.TargetLine = 4
+	files := []*pb.File{{Path: "src/parser.py", Directives: []*pb.StmtComment{comment}}}
+
+	found, invalid := collectSuppressions(files, true)
+
+	assert.Empty(t, invalid)
+	assert.Equal(t, []*Suppression{
+		{File: "src/parser.py", Line: 2, Target: 4, From: 3, Rules: []string{"max_cyclomatic"}, Reason: "legacy"},
+	}, found["src/parser.py"])
+	assert.True(t, found.suppress("src/parser.py", "max_cyclomatic", 4), "the line of the def")
+	assert.True(t, found.suppress("src/parser.py", "max_cyclomatic", 3), "the line of the decorator")
+	assert.False(t, found.suppress("src/parser.py", "max_cyclomatic", 5))
+}
+
+func TestSuppressionSuppresses(t *testing.T) {
+	nextLine := &Suppression{Target: 11, From: 11, Rules: []string{"max_cyclomatic"}}
+	assert.True(t, nextLine.suppresses("cyclomatic_complexity", 11), "configuration name of the rule")
+	assert.True(t, nextLine.suppresses("max_cyclomatic", 11))
+	assert.False(t, nextLine.suppresses("cyclomatic_complexity", 12))
+	assert.False(t, nextLine.suppresses("max_npath", 11))
+
+	wholeFile := &Suppression{Target: 0}
+	assert.True(t, wholeFile.suppresses("max_npath", 12))
+	assert.True(t, wholeFile.suppresses("max_loc", 0))
+}
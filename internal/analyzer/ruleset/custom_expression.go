@@ -0,0 +1,268 @@
+package ruleset
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// valueKind is the type of a value in a custom expression.
+type valueKind int
+
+const (
+	numberValue valueKind = iota
+	boolValue
+	stringValue
+)
+
+func (k valueKind) String() string {
+	switch k {
+	case boolValue:
+		return "boolean"
+	case stringValue:
+		return "string"
+	}
+	return "number"
+}
+
+// customVariable is a metric, or a property, that a custom expression can
+// read: "cyclomatic", "is_test", "name"...
+type customVariable struct {
+	kind  valueKind
+	value func(customTarget) any // float64, bool or string, according to kind
+}
+
+// customExpression is a boolean expression over the metrics of a file, a
+// class or a function, written with the syntax of Go:
+//
+//	cyclomatic > 10 && loc > 80 && !is_test
+//
+// Numbers, booleans and strings can be compared, and numbers computed with
+// + - * / %. matches(name, "^Test") tells whether a string matches a
+// regular expression. The expression is checked once, when it is parsed:
+// an unknown metric or a comparison of a number with a string is an error.
+type customExpression struct {
+	source    string
+	root      ast.Expr
+	variables map[string]customVariable
+	regexps   map[ast.Expr]*regexp.Regexp // compiled patterns of the calls to matches()
+}
+
+func parseCustomExpression(source string, variables map[string]customVariable) (*customExpression, error) {
+	if strings.TrimSpace(source) == "" {
+		return nil, fmt.Errorf("the expression is empty")
+	}
+	root, err := parser.ParseExpr(source)
+	if err != nil {
+		return nil, fmt.Errorf("invalid expression %q: %v", source, err)
+	}
+	e := &customExpression{source: source, root: root, variables: variables, regexps: map[ast.Expr]*regexp.Regexp{}}
+	kind, err := e.check(root)
+	if err != nil {
+		return nil, fmt.Errorf("invalid expression %q: %v", source, err)
+	}
+	if kind != boolValue {
+		return nil, fmt.Errorf("invalid expression %q: it gives a %s, not a boolean", source, kind)
+	}
+	return e, nil
+}
+
+// Matches evaluates the expression on a file, a class or a function.
+func (e *customExpression) Matches(target customTarget) bool {
+	return e.eval(e.root, target).(bool)
+}
+
+// check returns the kind of the value of an expression, or an error when the
+// expression cannot be evaluated.
+func (e *customExpression) check(n ast.Expr) (valueKind, error) {
+	switch n := n.(type) {
+	case *ast.ParenExpr:
+		return e.check(n.X)
+	case *ast.BasicLit:
+		switch n.Kind {
+		case token.INT, token.FLOAT:
+			return numberValue, nil
+		case token.STRING:
+			return stringValue, nil
+		}
+		return 0, fmt.Errorf("unsupported literal %s", n.Value)
+	case *ast.Ident:
+		if n.Name == "true" || n.Name == "false" {
+			return boolValue, nil
+		}
+		if v, ok := e.variables[n.Name]; ok {
+			return v.kind, nil
+		}
+		return 0, fmt.Errorf("unknown metric %q (expected one of: %s)", n.Name, strings.Join(e.variableNames(), ", "))
+	case *ast.UnaryExpr:
+		kind, err := e.check(n.X)
+		if err != nil {
+			return 0, err
+		}
+		switch {
+		case n.Op == token.NOT && kind == boolValue:
+			return boolValue, nil
+		case n.Op == token.SUB && kind == numberValue:
+			return numberValue, nil
+		}
+		return 0, fmt.Errorf("operator %s does not apply to a %s", n.Op, kind)
+	case *ast.BinaryExpr:
+		return e.checkBinary(n)
+	case *ast.CallExpr:
+		return e.checkCall(n)
+	}
+	return 0, fmt.Errorf("unsupported syntax")
+}
+
+func (e *customExpression) checkBinary(n *ast.BinaryExpr) (valueKind, error) {
+	left, err := e.check(n.X)
+	if err != nil {
+		return 0, err
+	}
+	right, err := e.check(n.Y)
+	if err != nil {
+		return 0, err
+	}
+	if left != right {
+		return 0, fmt.Errorf("cannot compare or combine a %s with a %s", left, right)
+	}
+	switch n.Op {
+	case token.LAND, token.LOR:
+		if left == boolValue {
+			return boolValue, nil
+		}
+	case token.EQL, token.NEQ:
+		return boolValue, nil
+	case token.LSS, token.LEQ, token.GTR, token.GEQ:
+		if left != boolValue {
+			return boolValue, nil
+		}
+	case token.ADD, token.SUB, token.MUL, token.QUO, token.REM:
+		if left == numberValue {
+			return numberValue, nil
+		}
+	default:
+		return 0, fmt.Errorf("unsupported operator %s", n.Op)
+	}
+	return 0, fmt.Errorf("operator %s does not apply to a %s", n.Op, left)
+}
+
+func (e *customExpression) checkCall(n *ast.CallExpr) (valueKind, error) {
+	name, ok := n.Fun.(*ast.Ident)
+	if !ok || name.Name != "matches" {
+		return 0, fmt.Errorf("unknown function (expected matches)")
+	}
+	if len(n.Args) != 2 {
+		return 0, fmt.Errorf("matches expects a string and a pattern")
+	}
+	if kind, err := e.check(n.Args[0]); err != nil {
+		return 0, err
+	} else if kind != stringValue {
+		return 0, fmt.Errorf("matches expects a string, not a %s", kind)
+	}
+	lit, ok := n.Args[1].(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return 0, fmt.Errorf("the pattern of matches must be a string")
+	}
+	pattern, _ := strconv.Unquote(lit.Value)
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return 0, fmt.Errorf("invalid pattern %q: %v", pattern, err)
+	}
+	e.regexps[n] = re
+	return boolValue, nil
+}
+
+// eval computes the value of an expression that passed check.
+func (e *customExpression) eval(n ast.Expr, target customTarget) any {
+	switch n := n.(type) {
+	case *ast.ParenExpr:
+		return e.eval(n.X, target)
+	case *ast.BasicLit:
+		if n.Kind == token.STRING {
+			s, _ := strconv.Unquote(n.Value)
+			return s
+		}
+		f, _ := strconv.ParseFloat(n.Value, 64)
+		return f
+	case *ast.Ident:
+		if n.Name == "true" || n.Name == "false" {
+			return n.Name == "true"
+		}
+		return e.variables[n.Name].value(target)
+	case *ast.UnaryExpr:
+		if n.Op == token.NOT {
+			return !e.eval(n.X, target).(bool)
+		}
+		return -e.eval(n.X, target).(float64)
+	case *ast.CallExpr:
+		return e.regexps[n].MatchString(e.eval(n.Args[0], target).(string))
+	case *ast.BinaryExpr:
+		return e.evalBinary(n, target)
+	}
+	return nil
+}
+
+func (e *customExpression) evalBinary(n *ast.BinaryExpr, target customTarget) any {
+	switch n.Op {
+	case token.LAND:
+		return e.eval(n.X, target).(bool) && e.eval(n.Y, target).(bool)
+	case token.LOR:
+		return e.eval(n.X, target).(bool) || e.eval(n.Y, target).(bool)
+	}
+
+	left, right := e.eval(n.X, target), e.eval(n.Y, target)
+	switch n.Op {
+	case token.EQL:
+		return left == right
+	case token.NEQ:
+		return left != right
+	}
+	if l, ok := left.(string); ok {
+		r := right.(string)
+		switch n.Op {
+		case token.LSS:
+			return l < r
+		case token.LEQ:
+			return l <= r
+		case token.GTR:
+			return l > r
+		}
+		return l >= r
+	}
+
+	l, r := left.(float64), right.(float64)
+	switch n.Op {
+	case token.LSS:
+		return l < r
+	case token.LEQ:
+		return l <= r
+	case token.GTR:
+		return l > r
+	case token.GEQ:
+		return l >= r
+	case token.ADD:
+		return l + r
+	case token.SUB:
+		return l - r
+	case token.MUL:
+		return l * r
+	case token.QUO:
+		return l / r
+	}
+	return float64(int64(l) % max(int64(r), 1))
+}
+
+func (e *customExpression) variableNames() []string {
+	names := make([]string, 0, len(e.variables))
+	for name := range e.variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,83 @@
+package ruleset
+
+import (
+	"testing"
+
+	pb "github.com/ast-metrics/ast-metrics/pb"
+)
+
+func TestCustomExpression_Matches(t *testing.T) {
+	cyclomatic := int32(12)
+	target := customTarget{
+		file: &pb.File{Path: "src/shop/cart.go", ProgrammingLanguage: "Go"},
+		function: &pb.StmtFunction{
+			Name:        &pb.Name{Short: "TestCheckout"},
+			LinesOfCode: &pb.LinesOfCode{LinesOfCode: 90},
+			Parameters:  []*pb.StmtParameter{{}, {}},
+			Stmts:       &pb.Stmts{Analyze: &pb.Analyze{Complexity: &pb.Complexity{Cyclomatic: &cyclomatic}}},
+		},
+	}
+	variables, err := customVariables("function")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		expression string
+		expected   bool
+	}{
+		{"cyclomatic > 10 && loc > 80 && !is_test", true},
+		{"cyclomatic > 10 && loc > 100", false},
+		{"cyclomatic >= 12 || false", true},
+		{"cyclomatic * 2 - parameters == 22", true},
+		{"(loc / cyclomatic) <= 7.5", true},
+		{"-cyclomatic < 0 && cognitive == 0", true},
+		{`language == "Go" && path != "main.go"`, true},
+		{`matches(name, "^Test") && matches(path, "/shop/")`, true},
+		{`name < "A"`, false},
+	}
+	for _, test := range tests {
+		expression, err := parseCustomExpression(test.expression, variables)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.expression, err)
+			continue
+		}
+		if got := expression.Matches(target); got != test.expected {
+			t.Errorf("%s: expected %v, got %v", test.expression, test.expected, got)
+		}
+	}
+}
+
+func TestCustomExpression_Errors(t *testing.T) {
+	variables, err := customVariables("class")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		expression string
+		expected   string
+	}{
+		{"", "the expression is empty"},
+		{"cyclomatic >", `invalid expression "cyclomatic >": 1:13: expected operand, found 'EOF'`},
+		{"cyclomatic + 1", `invalid expression "cyclomatic + 1": it gives a number, not a boolean`},
+		{"npath > 10", `invalid expression "npath > 10": unknown metric "npath" (expected one of: abc_size, afferent, cbo, cloc, cognitive, cyclomatic, dit, efferent, instability, is_abstract, is_public, is_test, language, lcom4, lloc, loc, maintainability, methods, name, noc, path, public_methods, rfc, wmc)`},
+		{`name > 10`, `invalid expression "name > 10": cannot compare or combine a string with a number`},
+		{"is_test && loc", `invalid expression "is_test && loc": cannot compare or combine a boolean with a number`},
+		{"!loc", `invalid expression "!loc": operator ! does not apply to a number`},
+		{"is_test < true", `invalid expression "is_test < true": operator < does not apply to a boolean`},
+		{"methods & 1 == 1", `invalid expression "methods & 1 == 1": unsupported operator &`},
+		{`matches(name, "(")`, "invalid expression \"matches(name, \\\"(\\\")\": invalid pattern \"(\": error parsing regexp: missing closing ): `(`"},
+		{"len(name) > 3", `invalid expression "len(name) > 3": unknown function (expected matches)`},
+	}
+	for _, test := range tests {
+		_, err := parseCustomExpression(test.expression, variables)
+		if err == nil {
+			t.Errorf("%s: expected an error", test.expression)
+			continue
+		}
+		if err.Error() != test.expected {
+			t.Errorf("%s: expected %q, got %q", test.expression, test.expected, err.Error())
+		}
+	}
+}
@@ -11,3 +11,9 @@ func lineOf(loc *pb.StmtLocationInFile) int {
 	}
 	return int(loc.GetStartLine())
 }
+
+// fileOf returns the path of the file holding a location, when it is not the
+// checked file (a class declared in a C++ header), or "".
+func fileOf(loc *pb.StmtLocationInFile) string {
+	return loc.GetFile()
+}
@@ -11,6 +11,16 @@ type ProjectContext struct {
 	GlobalIsolationScore float64
 	GodTests             []GodTestInfo
 	OrphanClasses        []OrphanClassInfo
+	DuplicatedLinesPct   float64
+	Clones               []CloneInfo
+	// Distributions holds the statistics of the aggregated metrics, by metric
+	// name (cyclomatic_per_method, loc...)
+	Distributions map[string]DistributionInfo
+	// PublicSymbols is the number of public classes and functions, and
+	// DocCoveragePct the share of them written with a documentation
+	PublicSymbols       int
+	DocCoveragePct      float64
+	UndocumentedSymbols []UndocumentedSymbolInfo
 }
 
 // GodTestInfo describes a test file with excessive fan-out.
@@ -26,6 +36,68 @@ type OrphanClassInfo struct {
 	Weight    float64
 }
 
+// CloneInfo describes a block of code found twice.
+type CloneInfo struct {
+	Tokens int
+	First  CloneLocationInfo
+	Second CloneLocationInfo
+}
+
+// CloneLocationInfo is the place of one copy of a duplicated block.
+type CloneLocationInfo struct {
+	FilePath  string
+	StartLine int
+	EndLine   int
+}
+
+// UndocumentedSymbolInfo describes a public class or function written without
+// documentation.
+type UndocumentedSymbolInfo struct {
+	Kind     string // "class" or "function"
+	Name     string
+	FilePath string
+	Line     int
+}
+
+// DistributionInfo describes how the values of an aggregated metric spread.
+type DistributionInfo struct {
+	Count  int
+	Avg    float64
+	Min    float64
+	Max    float64
+	Median float64
+	P75    float64
+	P90    float64
+	P95    float64
+	P99    float64
+	StdDev float64
+}
+
+// Statistic returns a statistic by the name used in the configuration.
+func (d DistributionInfo) Statistic(name string) (float64, bool) {
+	switch name {
+	case "avg":
+		return d.Avg, true
+	case "min":
+		return d.Min, true
+	case "max":
+		return d.Max, true
+	case "median", "p50":
+		return d.Median, true
+	case "p75":
+		return d.P75, true
+	case "p90":
+		return d.P90, true
+	case "p95":
+		return d.P95, true
+	case "p99":
+		return d.P99, true
+	case "stddev":
+		return d.StdDev, true
+	}
+	return 0, false
+}
+
 // ProjectRule checks project-level aggregated metrics (as opposed to per-file Rule).
 type ProjectRule interface {
 	Name() string
@@ -17,8 +17,14 @@ func (r *RegistryImpl) AllRulesets() []Ruleset {
 		&architectureRuleset{cfg: r.cfg},
 		&volumeRuleset{cfg: r.cfg},
 		&complexityRuleset{cfg: r.cfg},
+		&objectOrientedRuleset{cfg: r.cfg},
 		&golangRuleset{cfg: r.cfg},
 		&testingRuleset{cfg: r.cfg},
+		&duplicationRuleset{cfg: r.cfg},
+		&distributionRuleset{cfg: r.cfg},
+		&documentationRuleset{cfg: r.cfg},
+		&customRuleset{cfg: r.cfg},
+		&queriesRuleset{cfg: r.cfg},
 	}
 }
 
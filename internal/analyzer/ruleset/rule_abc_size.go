@@ -0,0 +1,54 @@
+package ruleset
+
+import (
+	"fmt"
+
+	"github.com/ast-metrics/ast-metrics/internal/analyzer/issue"
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+)
+
+type abcSizeRule struct {
+	max *int
+}
+
+func NewAbcSizeRule(max *int) Rule {
+	return &abcSizeRule{max: max}
+}
+
+func (r *abcSizeRule) Name() string {
+	return "max_abc_size"
+}
+
+func (r *abcSizeRule) Description() string {
+	return "Checks the ABC size (assignments, branches, conditions) of functions"
+}
+
+func (r *abcSizeRule) CheckFile(file *pb.File, addError func(issue.RequirementError), addSuccess func(string)) {
+	if r.max == nil || file.Stmts == nil {
+		return
+	}
+
+	ok := true
+	for _, f := range engine.GetFunctionsInFile(file) {
+		if f == nil || f.Stmts == nil || f.Stmts.Analyze == nil || f.Stmts.Analyze.AbcSize == nil || f.Stmts.Analyze.AbcSize.Magnitude == nil {
+			continue
+		}
+		abc := f.Stmts.Analyze.AbcSize
+		if *abc.Magnitude > float64(*r.max) {
+			addError(issue.RequirementError{
+				Severity: issue.SeverityMedium,
+				Code:     r.Name(),
+				Message: fmt.Sprintf("ABC size too high in method %s(): got %.2f <%d, %d, %d> (max: %d)",
+					f.GetName().GetShort(), *abc.Magnitude, abc.GetAssignments(), abc.GetBranches(), abc.GetConditions(), *r.max),
+				Line: lineOf(f.GetLocation()),
+				File: fileOf(f.GetLocation()),
+			})
+			ok = false
+		}
+	}
+
+	if ok {
+		addSuccess("ABC size OK")
+	}
+}
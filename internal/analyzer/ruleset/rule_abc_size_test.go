@@ -0,0 +1,63 @@
+package ruleset
+
+import (
+	"testing"
+
+	"github.com/ast-metrics/ast-metrics/internal/analyzer/issue"
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+)
+
+func abcFunction(name string, line int32, a, b, c int32) *pb.StmtFunction {
+	return &pb.StmtFunction{
+		Name:     &pb.Name{Short: name, Qualified: name},
+		Location: &pb.StmtLocationInFile{StartLine: line},
+		Stmts: &pb.Stmts{
+			Analyze: &pb.Analyze{AbcSize: engine.NewAbcSize(a, b, c)},
+		},
+	}
+}
+
+func TestAbcSizeRule_CheckFile_Violation(t *testing.T) {
+	max := 17
+	rule := NewAbcSizeRule(&max)
+	file := &pb.File{Stmts: &pb.Stmts{StmtFunction: []*pb.StmtFunction{
+		abcFunction("checkout", 12, 9, 14, 6),
+		abcFunction("total", 40, 2, 3, 1),
+	}}}
+
+	errors := []issue.RequirementError{}
+	successes := []string{}
+	rule.CheckFile(file,
+		func(e issue.RequirementError) { errors = append(errors, e) },
+		func(s string) { successes = append(successes, s) })
+
+	if len(errors) != 1 {
+		t.Fatalf("expected 1 error, got %d", len(errors))
+	}
+	if errors[0].Code != "max_abc_size" || errors[0].Line != 12 {
+		t.Errorf("unexpected error: %+v", errors[0])
+	}
+	if errors[0].Message != "ABC size too high in method checkout(): got 17.69 <9, 14, 6> (max: 17)" {
+		t.Errorf("unexpected message: %s", errors[0].Message)
+	}
+	if len(successes) != 0 {
+		t.Errorf("expected no success, got %v", successes)
+	}
+}
+
+func TestAbcSizeRule_CheckFile_OK(t *testing.T) {
+	max := 17
+	rule := NewAbcSizeRule(&max)
+	file := &pb.File{Stmts: &pb.Stmts{StmtFunction: []*pb.StmtFunction{abcFunction("total", 40, 2, 3, 1)}}}
+
+	errors := []issue.RequirementError{}
+	successes := []string{}
+	rule.CheckFile(file,
+		func(e issue.RequirementError) { errors = append(errors, e) },
+		func(s string) { successes = append(successes, s) })
+
+	if len(errors) != 0 || len(successes) != 1 {
+		t.Errorf("expected a success, got %d errors and %d successes", len(errors), len(successes))
+	}
+}
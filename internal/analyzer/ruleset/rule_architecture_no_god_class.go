@@ -52,6 +52,7 @@ func (r *noGodClassRule) CheckFile(file *pb.File, addError func(issue.Requiremen
 					Message:  fmt.Sprintf("God class detected: %d LOC, %d methods, LCOM4 %d", loc, methodCount, lcom),
 					Code:     r.Name(),
 					Line:     lineOf(class.GetLocation()),
+					File:     fileOf(class.GetLocation()),
 				})
 				return
 			}
@@ -0,0 +1,53 @@
+package ruleset
+
+import (
+	"fmt"
+
+	"github.com/ast-metrics/ast-metrics/internal/analyzer/issue"
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+)
+
+type cognitiveRule struct {
+	max *int
+}
+
+func NewCognitiveRule(max *int) Rule {
+	return &cognitiveRule{max: max}
+}
+
+func (r *cognitiveRule) Name() string {
+	return "max_cognitive"
+}
+
+func (r *cognitiveRule) Description() string {
+	return "Checks the cognitive complexity of functions"
+}
+
+func (r *cognitiveRule) CheckFile(file *pb.File, addError func(issue.RequirementError), addSuccess func(string)) {
+	if r.max == nil || file.Stmts == nil {
+		return
+	}
+
+	ok := true
+	for _, f := range engine.GetFunctionsInFile(file) {
+		if f == nil || f.Stmts == nil || f.Stmts.Analyze == nil || f.Stmts.Analyze.Complexity == nil || f.Stmts.Analyze.Complexity.Cognitive == nil {
+			continue
+		}
+		value := int(*f.Stmts.Analyze.Complexity.Cognitive)
+		if value > *r.max {
+			addError(issue.RequirementError{
+				Severity: issue.SeverityMedium,
+				Code:     r.Name(),
+				Message:  fmt.Sprintf("Cognitive complexity too high in method %s(): got %d (max: %d)", f.GetName().GetShort(), value, *r.max),
+				Line:     lineOf(f.GetLocation()),
+				File:     fileOf(f.GetLocation()),
+			})
+			ok = false
+		}
+	}
+
+	if ok {
+		addSuccess("Cognitive complexity OK")
+	}
+}
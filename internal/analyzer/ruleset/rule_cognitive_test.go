@@ -0,0 +1,94 @@
+package ruleset
+
+import (
+	"testing"
+
+	"github.com/ast-metrics/ast-metrics/internal/analyzer/issue"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+)
+
+func cognitiveFunction(name string, line int32, value int32) *pb.StmtFunction {
+	return &pb.StmtFunction{
+		Name:     &pb.Name{Short: name, Qualified: name},
+		Location: &pb.StmtLocationInFile{StartLine: line},
+		Stmts: &pb.Stmts{
+			Analyze: &pb.Analyze{Complexity: &pb.Complexity{Cognitive: &value}},
+		},
+	}
+}
+
+func TestCognitiveRule_CheckFile_NilMax(t *testing.T) {
+	rule := NewCognitiveRule(nil)
+	file := &pb.File{Stmts: &pb.Stmts{StmtFunction: []*pb.StmtFunction{cognitiveFunction("run", 3, 40)}}}
+
+	errors := []issue.RequirementError{}
+	successes := []string{}
+	rule.CheckFile(file,
+		func(e issue.RequirementError) { errors = append(errors, e) },
+		func(s string) { successes = append(successes, s) })
+
+	if len(errors) != 0 || len(successes) != 0 {
+		t.Errorf("expected nothing with nil max, got %d errors and %d successes", len(errors), len(successes))
+	}
+}
+
+func TestCognitiveRule_CheckFile_ViolationInMethod(t *testing.T) {
+	max := 15
+	rule := NewCognitiveRule(&max)
+	file := &pb.File{
+		Stmts: &pb.Stmts{
+			StmtClass: []*pb.StmtClass{
+				{
+					Name: &pb.Name{Short: "Parser", Qualified: "Parser"},
+					Stmts: &pb.Stmts{
+						StmtFunction: []*pb.StmtFunction{
+							cognitiveFunction("parse", 12, 22),
+							cognitiveFunction("reset", 40, 1),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	errors := []issue.RequirementError{}
+	successes := []string{}
+	rule.CheckFile(file,
+		func(e issue.RequirementError) { errors = append(errors, e) },
+		func(s string) { successes = append(successes, s) })
+
+	if len(errors) != 1 {
+		t.Fatalf("expected 1 error, got %d", len(errors))
+	}
+	if errors[0].Code != "max_cognitive" {
+		t.Errorf("expected 'max_cognitive' code, got %s", errors[0].Code)
+	}
+	if errors[0].Message != "Cognitive complexity too high in method parse(): got 22 (max: 15)" {
+		t.Errorf("unexpected error message: %s", errors[0].Message)
+	}
+	if errors[0].Line != 12 {
+		t.Errorf("expected the error on line 12, got %d", errors[0].Line)
+	}
+	if len(successes) != 0 {
+		t.Errorf("expected no success, got %v", successes)
+	}
+}
+
+func TestCognitiveRule_CheckFile_Success(t *testing.T) {
+	max := 15
+	rule := NewCognitiveRule(&max)
+	file := &pb.File{Stmts: &pb.Stmts{StmtFunction: []*pb.StmtFunction{cognitiveFunction("run", 3, 15)}}}
+
+	errors := []issue.RequirementError{}
+	successes := []string{}
+	rule.CheckFile(file,
+		func(e issue.RequirementError) { errors = append(errors, e) },
+		func(s string) { successes = append(successes, s) })
+
+	if len(errors) != 0 {
+		t.Errorf("expected no errors, got %d", len(errors))
+	}
+	if len(successes) != 1 || successes[0] != "Cognitive complexity OK" {
+		t.Errorf("unexpected successes: %v", successes)
+	}
+}
@@ -3,6 +3,7 @@ package ruleset
 import (
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/ast-metrics/ast-metrics/internal/analyzer/issue"
 	"github.com/ast-metrics/ast-metrics/internal/configuration"
@@ -39,9 +40,9 @@ func (c *couplingRule) CheckFile(file *pb.File, addError func(issue.RequirementE
 
 	// External dependencies carry no line of their own; anchor the violation
 	// to the offending class when the file exposes one.
-	line := 0
+	line, path := 0, ""
 	if classes := engine.GetClassesInFile(file); len(classes) > 0 {
-		line = lineOf(classes[0].GetLocation())
+		line, path = lineOf(classes[0].GetLocation()), fileOf(classes[0].GetLocation())
 	}
 
 	hasError := false
@@ -58,6 +59,7 @@ func (c *couplingRule) CheckFile(file *pb.File, addError func(issue.RequirementE
 					Code:     c.Name(),
 					Message:  fmt.Sprintf("Forbidden coupling between %s and %s", file.Path, dependency.ClassName),
 					Line:     line,
+					File:     path,
 				})
 				hasError = true
 				break
@@ -65,7 +67,70 @@ func (c *couplingRule) CheckFile(file *pb.File, addError func(issue.RequirementE
 		}
 	}
 
+	if c.checkAnnotated(file, addError) {
+		hasError = true
+	}
+
 	if !hasError {
 		addSuccess("Coupling OK")
 	}
 }
+
+// checkAnnotated checks the dependencies of the annotated classes of a file,
+// and reports whether one of them is forbidden.
+func (c *couplingRule) checkAnnotated(file *pb.File, addError func(issue.RequirementError)) bool {
+	hasError := false
+	for _, annotated := range c.cfg.Annotated {
+		// the annotation is matched as a whole: "Controller" is not "RestControllerAdvice"
+		annotationRegex := regexp.MustCompile("(?i)^(?:" + strings.TrimPrefix(annotated.Annotation, "@") + ")$")
+		toRegex := regexp.MustCompile("(?i)" + annotated.To)
+		for _, class := range engine.GetClassesInFile(file) {
+			annotation := findAnnotation(class.GetAnnotations(), annotationRegex)
+			if annotation == nil {
+				continue
+			}
+			name := class.GetName().GetQualified()
+			if name == "" {
+				name = class.GetName().GetShort()
+			}
+			for _, dependency := range annotatedDependencies(file, class) {
+				if toRegex.MatchString(dependency.ClassName) || toRegex.MatchString(engine.DependencyName(dependency).Qualified) {
+					addError(issue.RequirementError{
+						Severity: issue.SeverityUnknown,
+						Code:     c.Name(),
+						Message:  fmt.Sprintf("Forbidden coupling between %s (annotated %s) and %s", name, annotation.GetName().GetShort(), dependency.ClassName),
+						Line:     lineOf(class.GetLocation()),
+						File:     fileOf(class.GetLocation()),
+					})
+					hasError = true
+					break
+				}
+			}
+		}
+	}
+	return hasError
+}
+
+// annotatedDependencies returns what an annotated class depends on: its own
+// dependencies, the imports of the file it makes use of (Java and Kotlin
+// imports are written for the whole file), and the types it mentions (a field
+// typed with a class of the same package needs no import).
+func annotatedDependencies(file *pb.File, class *pb.StmtClass) []*pb.StmtExternalDependency {
+	dependencies := engine.GetDependenciesInClass(class)
+	dependencies = append(dependencies, engine.GetImportsUsedByClass(file, class)...)
+	for _, reference := range class.GetReferences() {
+		dependencies = append(dependencies, &pb.StmtExternalDependency{ClassName: reference})
+	}
+	return dependencies
+}
+
+// findAnnotation returns the first annotation whose short or qualified name
+// matches the expression.
+func findAnnotation(annotations []*pb.Annotation, expr *regexp.Regexp) *pb.Annotation {
+	for _, annotation := range annotations {
+		if expr.MatchString(annotation.GetName().GetShort()) || expr.MatchString(annotation.GetName().GetQualified()) {
+			return annotation
+		}
+	}
+	return nil
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/ast-metrics/ast-metrics/internal/analyzer/issue"
 	"github.com/ast-metrics/ast-metrics/internal/configuration"
+	enginePkg "github.com/ast-metrics/ast-metrics/internal/engine"
+	javarunner "github.com/ast-metrics/ast-metrics/internal/engine/java"
 	pb "github.com/ast-metrics/ast-metrics/pb"
 )
 
@@ -305,3 +307,163 @@ func TestCouplingRule_CheckFile_DepsAtNamespaceLevel(t *testing.T) {
 		t.Fatalf("expected 1 error for namespace-level dependency, got %d", len(errors))
 	}
 }
+
+func TestCouplingRule_CheckFile_AnnotatedClass(t *testing.T) {
+	cfg := &configuration.ConfigurationCouplingRule{
+		Annotated: []configuration.ConfigurationAnnotatedCoupling{
+			{Annotation: "@Controller", To: "Repository"},
+		},
+	}
+	rule := NewCouplingRule(cfg)
+
+	// Only the annotated class is checked, whatever the path of the file
+	file := &pb.File{
+		Path: "/src/web/Users.java",
+		Stmts: &pb.Stmts{
+			StmtClass: []*pb.StmtClass{
+				{
+					Name:        &pb.Name{Short: "Users", Qualified: "web.Users"},
+					Annotations: []*pb.Annotation{{Name: &pb.Name{Short: "Controller", Qualified: "org.springframework.stereotype.Controller"}}},
+					Location:    &pb.StmtLocationInFile{StartLine: 12},
+					Stmts: &pb.Stmts{
+						StmtFunction: []*pb.StmtFunction{
+							{
+								Name:      &pb.Name{Short: "list"},
+								Externals: []*pb.Name{{Short: "UserRepository", Qualified: "data.UserRepository"}},
+							},
+						},
+					},
+				},
+				{
+					Name: &pb.Name{Short: "Jobs", Qualified: "web.Jobs"},
+					Stmts: &pb.Stmts{
+						StmtExternalDependencies: []*pb.StmtExternalDependency{
+							{ClassName: "JobRepository"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	errors := []issue.RequirementError{}
+	successes := []string{}
+
+	rule.CheckFile(file,
+		func(e issue.RequirementError) { errors = append(errors, e) },
+		func(s string) { successes = append(successes, s) })
+
+	if len(errors) != 1 {
+		t.Fatalf("expected 1 error, got %d", len(errors))
+	}
+	if errors[0].Line != 12 {
+		t.Errorf("expected the error on the annotated class (line 12), got %d", errors[0].Line)
+	}
+	if errors[0].Message != "Forbidden coupling between web.Users (annotated Controller) and UserRepository" {
+		t.Errorf("unexpected message: %s", errors[0].Message)
+	}
+	if len(successes) != 0 {
+		t.Errorf("expected no success, got %d", len(successes))
+	}
+}
+
+func TestCouplingRule_CheckFile_AnnotatedClassWithoutForbiddenDependency(t *testing.T) {
+	cfg := &configuration.ConfigurationCouplingRule{
+		Annotated: []configuration.ConfigurationAnnotatedCoupling{
+			{Annotation: "Controller", To: "Repository"},
+		},
+	}
+	rule := NewCouplingRule(cfg)
+
+	file := &pb.File{
+		Path: "/src/web/Users.java",
+		Stmts: &pb.Stmts{
+			StmtClass: []*pb.StmtClass{
+				{
+					Name:        &pb.Name{Short: "Users"},
+					Annotations: []*pb.Annotation{{Name: &pb.Name{Short: "Controller", Qualified: "Controller"}}},
+					Stmts: &pb.Stmts{
+						StmtExternalDependencies: []*pb.StmtExternalDependency{
+							{ClassName: "UserService"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	errors := []issue.RequirementError{}
+	successes := []string{}
+
+	rule.CheckFile(file,
+		func(e issue.RequirementError) { errors = append(errors, e) },
+		func(s string) { successes = append(successes, s) })
+
+	if len(errors) != 0 {
+		t.Fatalf("expected no error, got %d", len(errors))
+	}
+	if len(successes) != 1 {
+		t.Errorf("expected 1 success, got %d", len(successes))
+	}
+}
+
+func TestCouplingRule_CheckFile_AnnotatedJavaClass(t *testing.T) {
+	cfg := &configuration.ConfigurationCouplingRule{
+		Annotated: []configuration.ConfigurationAnnotatedCoupling{
+			{Annotation: "Controller", To: "com.acme.data"},
+		},
+	}
+	rule := NewCouplingRule(cfg)
+
+	cases := []struct {
+		name   string
+		code   string
+		errors int
+	}{
+		// the import is written for the file, the class uses it for a field
+		{"field typed with an import", `package com.acme.web;
+
+import com.acme.data.UserRepository;
+
+@Controller
+public class Users {
+    private UserRepository repo;
+}`, 1},
+		// "Controller" is not "RestControllerAdvice"
+		{"other annotation", `package com.acme.web;
+
+import com.acme.data.UserRepository;
+
+@RestControllerAdvice
+public class Users {
+    private UserRepository repo;
+}`, 0},
+		// imported, but used by another class of the file only
+		{"import used elsewhere", `package com.acme.web;
+
+import com.acme.data.UserRepository;
+
+@Controller
+public class Users {
+}
+
+class Jobs {
+    private UserRepository repo;
+}`, 0},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			file, err := enginePkg.CreateTestFileWithCode(&javarunner.JavaRunner{}, tc.code)
+			if err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+			errors := []issue.RequirementError{}
+			rule.CheckFile(file,
+				func(e issue.RequirementError) { errors = append(errors, e) },
+				func(s string) {})
+			if len(errors) != tc.errors {
+				t.Fatalf("expected %d errors, got %d", tc.errors, len(errors))
+			}
+		})
+	}
+}
@@ -0,0 +1,295 @@
+package ruleset
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ast-metrics/ast-metrics/internal/analyzer/issue"
+	"github.com/ast-metrics/ast-metrics/internal/configuration"
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+)
+
+// customTarget is the file, class or function a custom expression is
+// evaluated on. Only the file is set for the "file" scope.
+type customTarget struct {
+	file     *pb.File
+	class    *pb.StmtClass
+	function *pb.StmtFunction
+}
+
+func (t customTarget) name() string {
+	var name *pb.Name
+	switch {
+	case t.function != nil:
+		name = t.function.GetName()
+	case t.class != nil:
+		name = t.class.GetName()
+	default:
+		if t.file.GetShortPath() != "" {
+			return t.file.GetShortPath()
+		}
+		return t.file.GetPath()
+	}
+	if name.GetQualified() != "" && t.class != nil {
+		return name.GetQualified()
+	}
+	return name.GetShort()
+}
+
+func (t customTarget) stmts() *pb.Stmts {
+	switch {
+	case t.function != nil:
+		return t.function.GetStmts()
+	case t.class != nil:
+		return t.class.GetStmts()
+	}
+	return t.file.GetStmts()
+}
+
+func (t customTarget) linesOfCode() *pb.LinesOfCode {
+	switch {
+	case t.function != nil:
+		return t.function.GetLinesOfCode()
+	case t.class != nil:
+		return t.class.GetLinesOfCode()
+	}
+	return t.file.GetLinesOfCode()
+}
+
+func (t customTarget) location() *pb.StmtLocationInFile {
+	switch {
+	case t.function != nil:
+		return t.function.GetLocation()
+	case t.class != nil:
+		return t.class.GetLocation()
+	}
+	return nil
+}
+
+func numberVariable(value func(customTarget) float64) customVariable {
+	return customVariable{kind: numberValue, value: func(t customTarget) any { return value(t) }}
+}
+
+func boolVariable(value func(customTarget) bool) customVariable {
+	return customVariable{kind: boolValue, value: func(t customTarget) any { return value(t) }}
+}
+
+func stringVariable(value func(customTarget) string) customVariable {
+	return customVariable{kind: stringValue, value: func(t customTarget) any { return value(t) }}
+}
+
+// customVariables returns the metrics a custom expression can read for a
+// scope. A metric that was not computed (the maintainability of a file in a
+// language without classes, for example) reads as 0.
+func customVariables(scope string) (map[string]customVariable, error) {
+	variables := map[string]customVariable{
+		"name":     stringVariable(customTarget.name),
+		"path":     stringVariable(func(t customTarget) string { return t.file.GetPath() }),
+		"language": stringVariable(func(t customTarget) string { return t.file.GetProgrammingLanguage() }),
+		"is_test":  boolVariable(func(t customTarget) bool { return t.file.GetIsTest() }),
+
+		"loc":  numberVariable(func(t customTarget) float64 { return float64(t.linesOfCode().GetLinesOfCode()) }),
+		"lloc": numberVariable(func(t customTarget) float64 { return float64(t.linesOfCode().GetLogicalLinesOfCode()) }),
+		"cloc": numberVariable(func(t customTarget) float64 { return float64(t.linesOfCode().GetCommentLinesOfCode()) }),
+		"cyclomatic": numberVariable(func(t customTarget) float64 {
+			return float64(t.stmts().GetAnalyze().GetComplexity().GetCyclomatic())
+		}),
+		"cognitive": numberVariable(func(t customTarget) float64 {
+			return float64(t.stmts().GetAnalyze().GetComplexity().GetCognitive())
+		}),
+		"abc_size": numberVariable(func(t customTarget) float64 {
+			return t.stmts().GetAnalyze().GetAbcSize().GetMagnitude()
+		}),
+		"maintainability": numberVariable(func(t customTarget) float64 {
+			return t.stmts().GetAnalyze().GetMaintainability().GetMaintainabilityIndex()
+		}),
+	}
+
+	switch scope {
+	case "file":
+		variables["classes"] = numberVariable(func(t customTarget) float64 { return float64(len(engine.GetClassesInFile(t.file))) })
+		variables["functions"] = numberVariable(func(t customTarget) float64 { return float64(len(engine.GetFunctionsInFile(t.file))) })
+	case "class":
+		variables["methods"] = numberVariable(func(t customTarget) float64 { return float64(len(t.stmts().GetStmtFunction())) })
+		variables["public_methods"] = numberVariable(func(t customTarget) float64 {
+			count := 0
+			for _, method := range t.stmts().GetStmtFunction() {
+				if engine.IsPublic(method) {
+					count++
+				}
+			}
+			return float64(count)
+		})
+		oo := func(t customTarget) *pb.ObjectOriented { return t.stmts().GetAnalyze().GetObjectOriented() }
+		variables["wmc"] = numberVariable(func(t customTarget) float64 { return float64(oo(t).GetWmc()) })
+		variables["dit"] = numberVariable(func(t customTarget) float64 { return float64(oo(t).GetDit()) })
+		variables["noc"] = numberVariable(func(t customTarget) float64 { return float64(oo(t).GetNoc()) })
+		variables["cbo"] = numberVariable(func(t customTarget) float64 { return float64(oo(t).GetCbo()) })
+		variables["rfc"] = numberVariable(func(t customTarget) float64 { return float64(oo(t).GetRfc()) })
+		variables["lcom4"] = numberVariable(func(t customTarget) float64 {
+			return float64(t.stmts().GetAnalyze().GetClassCohesion().GetLcom4())
+		})
+		coupling := func(t customTarget) *pb.Coupling { return t.stmts().GetAnalyze().GetCoupling() }
+		variables["afferent"] = numberVariable(func(t customTarget) float64 { return float64(coupling(t).GetAfferent()) })
+		variables["efferent"] = numberVariable(func(t customTarget) float64 { return float64(coupling(t).GetEfferent()) })
+		variables["instability"] = numberVariable(func(t customTarget) float64 { return coupling(t).GetInstability() })
+		variables["is_public"] = boolVariable(func(t customTarget) bool { return engine.IsPublicClass(t.class) })
+		variables["is_abstract"] = boolVariable(func(t customTarget) bool { return t.class.GetModifiers().GetIsAbstract() })
+	case "function":
+		variables["npath"] = numberVariable(func(t customTarget) float64 {
+			return float64(t.stmts().GetAnalyze().GetComplexity().GetNpath())
+		})
+		variables["nesting"] = numberVariable(func(t customTarget) float64 {
+			return float64(t.stmts().GetAnalyze().GetComplexity().GetMaxNesting())
+		})
+		variables["parameters"] = numberVariable(func(t customTarget) float64 { return float64(len(t.function.GetParameters())) })
+		variables["is_public"] = boolVariable(func(t customTarget) bool { return engine.IsPublic(t.function) })
+		variables["is_static"] = boolVariable(func(t customTarget) bool { return t.function.GetModifiers().GetIsStatic() })
+		variables["is_abstract"] = boolVariable(func(t customTarget) bool { return t.function.GetModifiers().GetIsAbstract() })
+	default:
+		return nil, fmt.Errorf("unknown scope %q (expected file, class or function)", scope)
+	}
+	return variables, nil
+}
+
+// customPlaceholder matches the "{metric}" of a message template.
+var customPlaceholder = regexp.MustCompile(`\{(\w+)\}`)
+
+type customRule struct {
+	cfg        configuration.ConfigurationCustomRule
+	expression *customExpression
+	paths      []*regexp.Regexp
+	severity   issue.Severity
+}
+
+// NewCustomRule builds a rule declared in the configuration. It fails when
+// the declaration is incomplete or its expression is invalid, so that the
+// mistake is reported instead of silently checking nothing.
+func NewCustomRule(cfg configuration.ConfigurationCustomRule) (Rule, error) {
+	if strings.TrimSpace(cfg.Name) == "" {
+		return nil, fmt.Errorf("a custom rule has no name")
+	}
+	variables, err := customVariables(cfg.Scope)
+	if err != nil {
+		return nil, fmt.Errorf("custom rule %s: %v", cfg.Name, err)
+	}
+	expression, err := parseCustomExpression(cfg.Expression, variables)
+	if err != nil {
+		return nil, fmt.Errorf("custom rule %s: %v", cfg.Name, err)
+	}
+
+	r := &customRule{cfg: cfg, expression: expression}
+	if r.severity, err = ParseSeverity(cfg.Severity); err != nil {
+		return nil, fmt.Errorf("custom rule %s: %v", cfg.Name, err)
+	}
+	for _, path := range cfg.Paths {
+		re, err := configuration.CompileGlob(path)
+		if err != nil {
+			return nil, fmt.Errorf("custom rule %s: invalid path %q: %v", cfg.Name, path, err)
+		}
+		r.paths = append(r.paths, re)
+	}
+	return r, nil
+}
+
+func (r *customRule) Name() string {
+	return r.cfg.Name
+}
+
+func (r *customRule) Description() string {
+	return fmt.Sprintf("Reports each %s where %s", r.cfg.Scope, r.cfg.Expression)
+}
+
+func (r *customRule) CheckFile(file *pb.File, addError func(issue.RequirementError), addSuccess func(string)) {
+	if file.Stmts == nil || !r.concerns(file) {
+		return
+	}
+
+	var targets []customTarget
+	switch r.cfg.Scope {
+	case "file":
+		targets = append(targets, customTarget{file: file})
+	case "class":
+		for _, class := range engine.GetClassesInFile(file) {
+			targets = append(targets, customTarget{file: file, class: class})
+		}
+	case "function":
+		for _, function := range engine.GetFunctionsInFile(file) {
+			targets = append(targets, customTarget{file: file, function: function})
+		}
+	}
+
+	ok := true
+	for _, target := range targets {
+		if !r.expression.Matches(target) {
+			continue
+		}
+		addError(issue.RequirementError{
+			Severity: r.severity,
+			Code:     r.Name(),
+			Message:  r.message(target),
+			Line:     lineOf(target.location()),
+			File:     fileOf(target.location()),
+		})
+		ok = false
+	}
+
+	if ok {
+		addSuccess(fmt.Sprintf("Custom rule %s OK", r.Name()))
+	}
+}
+
+// ParseSeverity reads the severity of a rule declared in the configuration;
+// medium when it is not set.
+func ParseSeverity(severity string) (issue.Severity, error) {
+	switch strings.ToLower(severity) {
+	case "", string(issue.SeverityMedium):
+		return issue.SeverityMedium, nil
+	case string(issue.SeverityLow):
+		return issue.SeverityLow, nil
+	case string(issue.SeverityHigh):
+		return issue.SeverityHigh, nil
+	}
+	return "", fmt.Errorf("unknown severity %q (expected low, medium or high)", severity)
+}
+
+// concerns reports whether the file is one of the paths the rule is
+// restricted to.
+func (r *customRule) concerns(file *pb.File) bool {
+	if len(r.paths) == 0 {
+		return true
+	}
+	for _, re := range r.paths {
+		if re.MatchString(file.Path) {
+			return true
+		}
+	}
+	return false
+}
+
+// message fills the template of the rule with the metrics of the target: in
+// "{name} is too complex ({cyclomatic})", {name} and {cyclomatic} are
+// replaced by their value. Unknown placeholders are left as they are.
+func (r *customRule) message(target customTarget) string {
+	if r.cfg.Message == "" {
+		return fmt.Sprintf("%s %s matches %s", strings.ToUpper(r.cfg.Scope[:1])+r.cfg.Scope[1:], target.name(), r.cfg.Expression)
+	}
+	return customPlaceholder.ReplaceAllStringFunc(r.cfg.Message, func(placeholder string) string {
+		variable, ok := r.expression.variables[placeholder[1:len(placeholder)-1]]
+		if !ok {
+			return placeholder
+		}
+		switch value := variable.value(target).(type) {
+		case float64:
+			return strconv.FormatFloat(math.Round(value*100)/100, 'f', -1, 64)
+		case bool:
+			return strconv.FormatBool(value)
+		default:
+			return fmt.Sprint(value)
+		}
+	})
+}
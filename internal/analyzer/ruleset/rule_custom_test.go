@@ -0,0 +1,161 @@
+package ruleset
+
+import (
+	"testing"
+
+	"github.com/ast-metrics/ast-metrics/internal/analyzer/issue"
+	"github.com/ast-metrics/ast-metrics/internal/configuration"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+)
+
+func customRuleFile(path string, isTest bool) *pb.File {
+	wmc := int32(42)
+	return &pb.File{Path: path, IsTest: isTest, Stmts: &pb.Stmts{StmtClass: []*pb.StmtClass{
+		{
+			Name:     &pb.Name{Short: "Cart", Qualified: "shop.Cart"},
+			Location: &pb.StmtLocationInFile{StartLine: 7},
+			Stmts:    &pb.Stmts{Analyze: &pb.Analyze{ObjectOriented: &pb.ObjectOriented{Wmc: &wmc}}},
+		},
+		{
+			Name:     &pb.Name{Short: "Item", Qualified: "shop.Item"},
+			Location: &pb.StmtLocationInFile{StartLine: 60},
+			Stmts:    &pb.Stmts{},
+		},
+	}}}
+}
+
+func checkCustomRule(t *testing.T, cfg configuration.ConfigurationCustomRule, file *pb.File) ([]issue.RequirementError, []string) {
+	t.Helper()
+	rule, err := NewCustomRule(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	errors := []issue.RequirementError{}
+	successes := []string{}
+	rule.CheckFile(file,
+		func(e issue.RequirementError) { errors = append(errors, e) },
+		func(s string) { successes = append(successes, s) })
+	return errors, successes
+}
+
+func TestCustomRule_CheckFile_Violation(t *testing.T) {
+	cfg := configuration.ConfigurationCustomRule{Name: "heavy_class", Scope: "class", Expression: "wmc > 30 && !is_test"}
+
+	errors, successes := checkCustomRule(t, cfg, customRuleFile("src/cart.php", false))
+
+	if len(errors) != 1 {
+		t.Fatalf("expected 1 error, got %d", len(errors))
+	}
+	expected := issue.RequirementError{
+		Severity: issue.SeverityMedium,
+		Code:     "heavy_class",
+		Message:  "Class shop.Cart matches wmc > 30 && !is_test",
+		Line:     7,
+	}
+	if errors[0] != expected {
+		t.Errorf("unexpected error: %+v", errors[0])
+	}
+	if len(successes) != 0 {
+		t.Errorf("expected no success, got %v", successes)
+	}
+}
+
+func TestCustomRule_CheckFile_OK(t *testing.T) {
+	cfg := configuration.ConfigurationCustomRule{Name: "heavy_class", Scope: "class", Expression: "wmc > 30 && !is_test"}
+
+	errors, successes := checkCustomRule(t, cfg, customRuleFile("tests/cart.php", true))
+
+	if len(errors) != 0 || len(successes) != 1 {
+		t.Fatalf("expected only a success, got %v and %v", errors, successes)
+	}
+	if successes[0] != "Custom rule heavy_class OK" {
+		t.Errorf("unexpected success: %s", successes[0])
+	}
+}
+
+func TestCustomRule_CheckFile_MessageAndSeverity(t *testing.T) {
+	cfg := configuration.ConfigurationCustomRule{
+		Name:       "small_classes",
+		Scope:      "class",
+		Expression: "wmc < 50",
+		Severity:   "low",
+		Message:    "{name}: WMC of {wmc} ({unknown})",
+	}
+
+	errors, _ := checkCustomRule(t, cfg, customRuleFile("src/cart.php", false))
+
+	if len(errors) != 2 {
+		t.Fatalf("expected 2 errors, got %d", len(errors))
+	}
+	if errors[0].Message != "shop.Cart: WMC of 42 ({unknown})" || errors[1].Message != "shop.Item: WMC of 0 ({unknown})" {
+		t.Errorf("unexpected messages: %q, %q", errors[0].Message, errors[1].Message)
+	}
+	if errors[0].Severity != issue.SeverityLow {
+		t.Errorf("expected a low severity, got %s", errors[0].Severity)
+	}
+}
+
+func TestCustomRule_CheckFile_Paths(t *testing.T) {
+	cfg := configuration.ConfigurationCustomRule{Name: "no_class", Scope: "file", Expression: "classes > 0", Paths: []string{"src/domain/**"}}
+
+	errors, successes := checkCustomRule(t, cfg, customRuleFile("src/cart.php", false))
+	if len(errors) != 0 || len(successes) != 0 {
+		t.Errorf("expected the file to be skipped, got %v and %v", errors, successes)
+	}
+
+	errors, _ = checkCustomRule(t, cfg, customRuleFile("src/domain/cart.php", false))
+	if len(errors) != 1 || errors[0].Message != "File src/domain/cart.php matches classes > 0" || errors[0].Line != 0 {
+		t.Errorf("unexpected errors: %+v", errors)
+	}
+
+	// the paths of "ast-metrics lint src" are absolute
+	errors, _ = checkCustomRule(t, cfg, customRuleFile("/tmp/proj/src/domain/cart.php", false))
+	if len(errors) != 1 {
+		t.Errorf("expected an absolute path to match, got %+v", errors)
+	}
+	errors, successes = checkCustomRule(t, cfg, customRuleFile("/tmp/proj/src/cart.php", false))
+	if len(errors) != 0 || len(successes) != 0 {
+		t.Errorf("expected the file to be skipped, got %v and %v", errors, successes)
+	}
+}
+
+func TestNewCustomRule_InvalidDeclarations(t *testing.T) {
+	tests := []struct {
+		cfg      configuration.ConfigurationCustomRule
+		expected string
+	}{
+		{configuration.ConfigurationCustomRule{Scope: "file", Expression: "loc > 1"}, "a custom rule has no name"},
+		{configuration.ConfigurationCustomRule{Name: "r", Scope: "method", Expression: "loc > 1"}, `custom rule r: unknown scope "method" (expected file, class or function)`},
+		{configuration.ConfigurationCustomRule{Name: "r", Scope: "file", Expression: "loc > 1", Severity: "critical"}, `custom rule r: unknown severity "critical" (expected low, medium or high)`},
+		{configuration.ConfigurationCustomRule{Name: "r", Scope: "file", Expression: "loc > 1", Paths: []string{" "}}, `custom rule r: invalid path " ": empty path`},
+	}
+	for _, test := range tests {
+		_, err := NewCustomRule(test.cfg)
+		if err == nil || err.Error() != test.expected {
+			t.Errorf("expected %q, got %v", test.expected, err)
+		}
+	}
+}
+
+func TestCustomRuleset_ReportsInvalidRules(t *testing.T) {
+	cfg := &configuration.ConfigurationRequirements{Rules: &configuration.ConfigurationRequirementsRules{
+		Custom: []configuration.ConfigurationCustomRule{
+			{Name: "long_functions", Scope: "function", Expression: "loc > 80"},
+			{Name: "typo", Scope: "function", Expression: "lok > 80"},
+		},
+	}}
+	rs := &customRuleset{cfg: cfg}
+
+	if !rs.IsEnabled() || len(rs.Enabled()) != 1 || rs.Enabled()[0].Name() != "long_functions" {
+		t.Fatalf("expected the valid rule to be enabled")
+	}
+	projectRules := rs.EnabledProjectRules()
+	if len(projectRules) != 1 {
+		t.Fatalf("expected the invalid rule to be reported, got %d project rules", len(projectRules))
+	}
+	errors := []issue.RequirementError{}
+	projectRules[0].CheckProject(ProjectContext{}, func(e issue.RequirementError) { errors = append(errors, e) }, func(string) {})
+	if len(errors) != 1 || errors[0].Code != "custom_rules" || errors[0].Severity != issue.SeverityHigh {
+		t.Errorf("unexpected errors: %+v", errors)
+	}
+}
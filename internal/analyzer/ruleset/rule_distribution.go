@@ -0,0 +1,86 @@
+package ruleset
+
+import (
+	"fmt"
+
+	"github.com/ast-metrics/ast-metrics/internal/analyzer/issue"
+	"github.com/ast-metrics/ast-metrics/internal/configuration"
+)
+
+type distributionRule struct {
+	thresholds []configuration.ConfigurationDistributionThreshold
+	isMax      bool
+}
+
+// NewMaxDistributionRule checks that statistics of the metrics stay below
+// their threshold. Ex: the p90 of the cyclomatic complexity per method.
+func NewMaxDistributionRule(thresholds []configuration.ConfigurationDistributionThreshold) ProjectRule {
+	return &distributionRule{thresholds: thresholds, isMax: true}
+}
+
+// NewMinDistributionRule checks that statistics of the metrics stay above
+// their threshold. Ex: the median of the maintainability per method.
+func NewMinDistributionRule(thresholds []configuration.ConfigurationDistributionThreshold) ProjectRule {
+	return &distributionRule{thresholds: thresholds}
+}
+
+func (r *distributionRule) Name() string {
+	if r.isMax {
+		return "max_distribution"
+	}
+	return "min_distribution"
+}
+
+func (r *distributionRule) Description() string {
+	if r.isMax {
+		return "Checks that a statistic of a metric (p90, median...) stays below a maximum threshold"
+	}
+	return "Checks that a statistic of a metric (p90, median...) stays above a minimum threshold"
+}
+
+// CheckProject compares each configured statistic with its threshold. A
+// metric without any value (no class in the project, for example) is not
+// checked.
+func (r *distributionRule) CheckProject(ctx ProjectContext, addError func(issue.RequirementError), addSuccess func(string)) {
+	for _, threshold := range r.thresholds {
+		distribution, ok := ctx.Distributions[threshold.Metric]
+		if !ok {
+			addError(issue.RequirementError{
+				Severity: issue.SeverityHigh,
+				Message:  fmt.Sprintf("Unknown metric %q in the distribution rules", threshold.Metric),
+				Code:     r.Name(),
+			})
+			continue
+		}
+		value, ok := distribution.Statistic(threshold.Statistic)
+		if !ok {
+			addError(issue.RequirementError{
+				Severity: issue.SeverityHigh,
+				Message:  fmt.Sprintf("Unknown statistic %q in the distribution rules (expected avg, min, max, median, p75, p90, p95, p99 or stddev)", threshold.Statistic),
+				Code:     r.Name(),
+			})
+			continue
+		}
+		if distribution.Count == 0 {
+			continue
+		}
+
+		if r.isMax && value > threshold.Value {
+			addError(issue.RequirementError{
+				Severity: issue.SeverityHigh,
+				Message:  fmt.Sprintf("%s of %s too high: got %.2f (max: %g)", threshold.Statistic, threshold.Metric, value, threshold.Value),
+				Code:     r.Name(),
+			})
+			continue
+		}
+		if !r.isMax && value < threshold.Value {
+			addError(issue.RequirementError{
+				Severity: issue.SeverityHigh,
+				Message:  fmt.Sprintf("%s of %s too low: got %.2f (min: %g)", threshold.Statistic, threshold.Metric, value, threshold.Value),
+				Code:     r.Name(),
+			})
+			continue
+		}
+		addSuccess(fmt.Sprintf("%s of %s OK", threshold.Statistic, threshold.Metric))
+	}
+}
@@ -0,0 +1,87 @@
+package ruleset
+
+import (
+	"testing"
+
+	"github.com/ast-metrics/ast-metrics/internal/analyzer/issue"
+	"github.com/ast-metrics/ast-metrics/internal/configuration"
+)
+
+func TestDistributionRule_NoThreshold(t *testing.T) {
+	called := false
+	NewMaxDistributionRule(nil).CheckProject(ProjectContext{}, func(e issue.RequirementError) {
+		called = true
+	}, func(s string) {
+		called = true
+	})
+
+	if called {
+		t.Error("expected no outcome without threshold")
+	}
+}
+
+func TestDistributionRule_ComparesTheStatistic(t *testing.T) {
+	ctx := ProjectContext{Distributions: map[string]DistributionInfo{
+		"cyclomatic_per_method": {Count: 50, Avg: 2.4, P90: 6, P99: 31},
+		"wmc_per_class":         {},
+	}}
+	thresholds := []configuration.ConfigurationDistributionThreshold{
+		{Metric: "cyclomatic_per_method", Statistic: "p90", Value: 8},
+		{Metric: "cyclomatic_per_method", Statistic: "p99", Value: 20},
+		// no class in the project: nothing to check
+		{Metric: "wmc_per_class", Statistic: "p90", Value: 1},
+	}
+	var errors []issue.RequirementError
+	var successes []string
+
+	NewMaxDistributionRule(thresholds).CheckProject(ctx, func(e issue.RequirementError) {
+		errors = append(errors, e)
+	}, func(s string) {
+		successes = append(successes, s)
+	})
+
+	if len(errors) != 1 || errors[0].Message != "p99 of cyclomatic_per_method too high: got 31.00 (max: 20)" {
+		t.Errorf("expected the p99 to be reported, got %+v", errors)
+	}
+	if len(successes) != 1 || successes[0] != "p90 of cyclomatic_per_method OK" {
+		t.Errorf("expected the p90 to pass, got %+v", successes)
+	}
+}
+
+func TestDistributionRule_Minimum(t *testing.T) {
+	ctx := ProjectContext{Distributions: map[string]DistributionInfo{
+		"maintainability_per_method": {Count: 10, Median: 64},
+	}}
+	var errors []issue.RequirementError
+
+	NewMinDistributionRule([]configuration.ConfigurationDistributionThreshold{
+		{Metric: "maintainability_per_method", Statistic: "median", Value: 70},
+	}).CheckProject(ctx, func(e issue.RequirementError) {
+		errors = append(errors, e)
+	}, func(s string) {})
+
+	if len(errors) != 1 || errors[0].Code != "min_distribution" || errors[0].Message != "median of maintainability_per_method too low: got 64.00 (min: 70)" {
+		t.Errorf("expected the median to be reported, got %+v", errors)
+	}
+}
+
+func TestDistributionRule_UnknownNames(t *testing.T) {
+	ctx := ProjectContext{Distributions: map[string]DistributionInfo{
+		"cyclomatic_per_method": {Count: 10},
+	}}
+	var errors []issue.RequirementError
+
+	NewMaxDistributionRule([]configuration.ConfigurationDistributionThreshold{
+		{Metric: "complexity", Statistic: "p90", Value: 8},
+		{Metric: "cyclomatic_per_method", Statistic: "p80", Value: 8},
+	}).CheckProject(ctx, func(e issue.RequirementError) {
+		errors = append(errors, e)
+	}, func(s string) {})
+
+	if len(errors) != 2 {
+		t.Fatalf("expected both typos to be reported, got %+v", errors)
+	}
+	if errors[0].Message != `Unknown metric "complexity" in the distribution rules` {
+		t.Errorf("unexpected message %q", errors[0].Message)
+	}
+}
@@ -0,0 +1,51 @@
+package ruleset
+
+import (
+	"fmt"
+
+	"github.com/ast-metrics/ast-metrics/internal/analyzer/issue"
+)
+
+type minDocCoverageRule struct {
+	min *int
+}
+
+func NewMinDocCoverageRule(min *int) ProjectRule {
+	return &minDocCoverageRule{min: min}
+}
+
+func (r *minDocCoverageRule) Name() string {
+	return "min_doc_coverage"
+}
+
+func (r *minDocCoverageRule) Description() string {
+	return "Checks that the percentage of documented public classes and functions stays above a minimum threshold"
+}
+
+// CheckProject reports the documentation coverage of the public symbols, then
+// each undocumented symbol, so that they can be documented one by one.
+func (r *minDocCoverageRule) CheckProject(ctx ProjectContext, addError func(issue.RequirementError), addSuccess func(string)) {
+	if r.min == nil {
+		return
+	}
+
+	if ctx.PublicSymbols == 0 || ctx.DocCoveragePct >= float64(*r.min) {
+		addSuccess("Documentation coverage OK")
+		return
+	}
+
+	addError(issue.RequirementError{
+		Severity: issue.SeverityHigh,
+		Message:  fmt.Sprintf("Documentation coverage too low: got %.1f%% of the public symbols documented (min: %d%%)", ctx.DocCoveragePct, *r.min),
+		Code:     r.Name(),
+	})
+	for _, symbol := range ctx.UndocumentedSymbols {
+		addError(issue.RequirementError{
+			Severity: issue.SeverityLow,
+			Message:  fmt.Sprintf("Public %s %s is not documented", symbol.Kind, symbol.Name),
+			Code:     r.Name(),
+			File:     symbol.FilePath,
+			Line:     symbol.Line,
+		})
+	}
+}
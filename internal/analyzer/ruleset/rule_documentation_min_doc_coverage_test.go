@@ -0,0 +1,75 @@
+package ruleset
+
+import (
+	"testing"
+
+	"github.com/ast-metrics/ast-metrics/internal/analyzer/issue"
+)
+
+func TestMinDocCoverageRule_NilThreshold(t *testing.T) {
+	rule := NewMinDocCoverageRule(nil)
+	called := false
+
+	rule.CheckProject(ProjectContext{PublicSymbols: 10, DocCoveragePct: 10}, func(e issue.RequirementError) {
+		called = true
+	}, func(s string) {
+		called = true
+	})
+
+	if called {
+		t.Error("expected no outcome with nil threshold")
+	}
+}
+
+func TestMinDocCoverageRule_Violation(t *testing.T) {
+	min := 80
+	rule := NewMinDocCoverageRule(&min)
+	var errors []issue.RequirementError
+
+	ctx := ProjectContext{
+		PublicSymbols:  4,
+		DocCoveragePct: 50,
+		UndocumentedSymbols: []UndocumentedSymbolInfo{
+			{Kind: "class", Name: "shop.Cart", FilePath: "src/cart.go", Line: 3},
+			{Kind: "function", Name: "shop.Cart.Add", FilePath: "src/cart.go", Line: 12},
+		},
+	}
+	rule.CheckProject(ctx, func(e issue.RequirementError) {
+		errors = append(errors, e)
+	}, func(s string) {})
+
+	if len(errors) != 3 {
+		t.Fatalf("expected the coverage and both symbols to be reported, got %d errors", len(errors))
+	}
+	if errors[0].Severity != issue.SeverityHigh || errors[0].File != "" {
+		t.Errorf("expected a high project-level error, got %+v", errors[0])
+	}
+	if errors[0].Message != "Documentation coverage too low: got 50.0% of the public symbols documented (min: 80%)" {
+		t.Errorf("unexpected message: %s", errors[0].Message)
+	}
+	if errors[2].Message != "Public function shop.Cart.Add is not documented" || errors[2].File != "src/cart.go" || errors[2].Line != 12 {
+		t.Errorf("expected the undocumented function to be located, got %+v", errors[2])
+	}
+}
+
+func TestMinDocCoverageRule_Success(t *testing.T) {
+	min := 80
+	rule := NewMinDocCoverageRule(&min)
+
+	for _, ctx := range []ProjectContext{
+		{PublicSymbols: 10, DocCoveragePct: 80},
+		// nothing public: nothing to document
+		{},
+	} {
+		var successes []string
+		rule.CheckProject(ctx, func(e issue.RequirementError) {
+			t.Errorf("unexpected error: %s", e.Message)
+		}, func(s string) {
+			successes = append(successes, s)
+		})
+
+		if len(successes) != 1 {
+			t.Fatalf("expected 1 success, got %d", len(successes))
+		}
+	}
+}
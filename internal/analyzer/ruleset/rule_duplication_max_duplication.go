@@ -0,0 +1,57 @@
+package ruleset
+
+import (
+	"fmt"
+
+	"github.com/ast-metrics/ast-metrics/internal/analyzer/issue"
+)
+
+type maxDuplicationRule struct {
+	max *int
+}
+
+func NewMaxDuplicationRule(max *int) ProjectRule {
+	return &maxDuplicationRule{max: max}
+}
+
+func (r *maxDuplicationRule) Name() string {
+	return "max_duplication"
+}
+
+func (r *maxDuplicationRule) Description() string {
+	return "Checks that the percentage of duplicated lines of code stays below a maximum threshold"
+}
+
+// CheckProject reports the percentage of duplicated lines, then each copy of
+// each duplicated block, so that they can be found in the code.
+func (r *maxDuplicationRule) CheckProject(ctx ProjectContext, addError func(issue.RequirementError), addSuccess func(string)) {
+	if r.max == nil {
+		return
+	}
+
+	if ctx.DuplicatedLinesPct <= float64(*r.max) {
+		addSuccess("Code duplication OK")
+		return
+	}
+
+	addError(issue.RequirementError{
+		Severity: issue.SeverityHigh,
+		Message:  fmt.Sprintf("Code duplication too high: got %.1f%% of duplicated lines (max: %d%%)", ctx.DuplicatedLinesPct, *r.max),
+		Code:     r.Name(),
+	})
+	for _, clone := range ctx.Clones {
+		for _, copies := range [][2]CloneLocationInfo{{clone.First, clone.Second}, {clone.Second, clone.First}} {
+			here, other := copies[0], copies[1]
+			addError(issue.RequirementError{
+				Severity: issue.SeverityLow,
+				Message: fmt.Sprintf("Duplicated block of %d lines (%d tokens), also found in %s (lines %d-%d)",
+					here.EndLine-here.StartLine+1, clone.Tokens, other.FilePath, other.StartLine, other.EndLine),
+				Code:    r.Name(),
+				File:    here.FilePath,
+				Line:    here.StartLine,
+				EndLine: here.EndLine,
+				Related: &issue.Location{File: other.FilePath, StartLine: other.StartLine, EndLine: other.EndLine},
+			})
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package ruleset
+
+import (
+	"testing"
+
+	"github.com/ast-metrics/ast-metrics/internal/analyzer/issue"
+)
+
+func TestMaxDuplicationRule_NilThreshold(t *testing.T) {
+	rule := NewMaxDuplicationRule(nil)
+	called := false
+
+	rule.CheckProject(ProjectContext{DuplicatedLinesPct: 30}, func(e issue.RequirementError) {
+		called = true
+	}, func(s string) {
+		called = true
+	})
+
+	if called {
+		t.Error("expected no outcome with nil threshold")
+	}
+}
+
+func TestMaxDuplicationRule_Violation(t *testing.T) {
+	max := 3
+	rule := NewMaxDuplicationRule(&max)
+	var errors []issue.RequirementError
+
+	ctx := ProjectContext{
+		DuplicatedLinesPct: 4.2,
+		Clones: []CloneInfo{{
+			Tokens: 60,
+			First:  CloneLocationInfo{FilePath: "src/a.php", StartLine: 4, EndLine: 12},
+			Second: CloneLocationInfo{FilePath: "src/b.php", StartLine: 40, EndLine: 48},
+		}},
+	}
+	rule.CheckProject(ctx, func(e issue.RequirementError) {
+		errors = append(errors, e)
+	}, func(s string) {})
+
+	if len(errors) != 3 {
+		t.Fatalf("expected the percentage and both copies to be reported, got %d errors", len(errors))
+	}
+	if errors[0].Severity != issue.SeverityHigh || errors[0].File != "" {
+		t.Errorf("expected a high project-level error, got %+v", errors[0])
+	}
+	if errors[1].File != "src/a.php" || errors[1].Line != 4 || errors[2].File != "src/b.php" || errors[2].Line != 40 {
+		t.Errorf("expected each copy to be located, got %+v and %+v", errors[1], errors[2])
+	}
+	if errors[1].EndLine != 12 || *errors[1].Related != (issue.Location{File: "src/b.php", StartLine: 40, EndLine: 48}) {
+		t.Errorf("expected the copy to span its lines, with the other copy, got %+v", errors[1])
+	}
+}
+
+func TestMaxDuplicationRule_Success(t *testing.T) {
+	max := 5
+	rule := NewMaxDuplicationRule(&max)
+	var successes []string
+
+	rule.CheckProject(ProjectContext{DuplicatedLinesPct: 5}, func(e issue.RequirementError) {
+		t.Errorf("unexpected error: %s", e.Message)
+	}, func(s string) {
+		successes = append(successes, s)
+	})
+
+	if len(successes) != 1 {
+		t.Fatalf("expected 1 success, got %d", len(successes))
+	}
+}
@@ -0,0 +1,41 @@
+package ruleset
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ast-metrics/ast-metrics/internal/analyzer/issue"
+)
+
+// invalidRulesRule reports the rules declared in the configuration that
+// cannot be checked: an unknown metric, a query the grammar cannot read, a
+// missing name...
+type invalidRulesRule struct {
+	kind string // "custom", "query"
+	errs []error
+}
+
+func newInvalidRulesRule(kind string, errs []error) ProjectRule {
+	return &invalidRulesRule{kind: kind, errs: errs}
+}
+
+func (r *invalidRulesRule) Name() string {
+	return r.kind + "_rules"
+}
+
+func (r *invalidRulesRule) Description() string {
+	return fmt.Sprintf("Checks that the %s rules of the configuration are valid", r.kind)
+}
+
+func (r *invalidRulesRule) CheckProject(ctx ProjectContext, addError func(issue.RequirementError), addSuccess func(string)) {
+	for _, err := range r.errs {
+		addError(issue.RequirementError{
+			Severity: issue.SeverityHigh,
+			Code:     r.Name(),
+			Message:  fmt.Sprintf("Invalid configuration: %v", err),
+		})
+	}
+	if len(r.errs) == 0 {
+		addSuccess(strings.ToUpper(r.kind[:1]) + r.kind[1:] + " rules OK")
+	}
+}
@@ -41,6 +41,7 @@ func (r *maintainabilityRule) CheckFile(file *pb.File, addError func(issue.Requi
 				Message:  fmt.Sprintf("Maintainability too low: got %d (min: %d)", value, *r.min),
 				Code:     r.Name(),
 				Line:     lineOf(class.GetLocation()),
+				File:     fileOf(class.GetLocation()),
 			})
 			return
 		}
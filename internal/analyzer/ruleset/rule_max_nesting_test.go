@@ -3,9 +3,9 @@ package ruleset
 import (
 	"testing"
 
+	"github.com/ast-metrics/ast-metrics/internal/analyzer/issue"
 	enginePkg "github.com/ast-metrics/ast-metrics/internal/engine"
 	golangrunner "github.com/ast-metrics/ast-metrics/internal/engine/golang"
-	pb "github.com/ast-metrics/ast-metrics/pb"
 )
 
 const nestedGo = `package main
@@ -22,8 +22,8 @@ func f(x int) int {
 }
 `
 
-// Ensure the max depth calculator detects at least 3 nested levels on a simple Go snippet.
-func TestRuleMaxNesting_DepthAtLeastThree(t *testing.T) {
+// The depth is read from the value stored on each function by the parser.
+func TestRuleMaxNesting_ReadsTheDepthOfFunctions(t *testing.T) {
 	r := &golangrunner.GolangRunner{}
 	file, err := enginePkg.CreateTestFileWithCode(r, nestedGo)
 	if err != nil {
@@ -32,32 +32,29 @@ func TestRuleMaxNesting_DepthAtLeastThree(t *testing.T) {
 	if file == nil || file.Stmts == nil {
 		t.Fatalf("nil file or stmts")
 	}
-	// Try to locate nested structures in the parsed tree to understand structure
-	var fn *pb.StmtFunction
-	for _, ns := range file.Stmts.StmtNamespace {
-		for _, f := range ns.Stmts.StmtFunction {
-			if f.Name != nil && f.Name.Short == "f" {
-				fn = f
-				break
-			}
-		}
+
+	depth, fn := maxNestingInFile(file)
+	if depth != 3 {
+		t.Fatalf("expected nesting depth 3, got %d", depth)
+	}
+	if fn.GetName().GetShort() != "f" {
+		t.Errorf("expected the depth to be found in f(), got %s()", fn.GetName().GetShort())
 	}
-	if fn == nil || fn.Stmts == nil {
-		t.Fatalf("function f not found or has no stmts")
+
+	var errors []issue.RequirementError
+	rule := &ruleMaxNesting{max: 2}
+	rule.CheckFile(file, func(e issue.RequirementError) { errors = append(errors, e) }, func(string) {})
+	if len(errors) != 1 {
+		t.Fatalf("expected 1 error, got %d", len(errors))
 	}
-	// Log some structure info to diagnose
-	ifCount := len(fn.Stmts.StmtDecisionIf)
-	loopCount := len(fn.Stmts.StmtLoop)
-	switchCount := len(fn.Stmts.StmtDecisionSwitch)
-	t.Logf("fn-level counts: if=%d, loop=%d, switch=%d", ifCount, loopCount, switchCount)
-	if ifCount > 0 {
-		inner := fn.Stmts.StmtDecisionIf[0].GetStmts()
-		if inner != nil {
-			t.Logf("inner if stmts: loops=%d ifs=%d", len(inner.StmtLoop), len(inner.StmtDecisionIf))
-		}
+	if errors[0].Message != "Nesting depth 3 > 2 in f()" || errors[0].Line != 3 {
+		t.Errorf("unexpected error: %+v", errors[0])
 	}
-	depth := maxDepthStmts(file.Stmts, 0)
-	if depth != 3 {
-		t.Fatalf("expected nesting depth >= 3, got %d", depth)
+
+	successes := 0
+	rule = &ruleMaxNesting{max: 3}
+	rule.CheckFile(file, func(issue.RequirementError) { t.Error("unexpected error") }, func(string) { successes++ })
+	if successes != 1 {
+		t.Errorf("expected 1 success, got %d", successes)
 	}
 }
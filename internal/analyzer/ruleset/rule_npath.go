@@ -0,0 +1,53 @@
+package ruleset
+
+import (
+	"fmt"
+
+	"github.com/ast-metrics/ast-metrics/internal/analyzer/issue"
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+)
+
+type npathRule struct {
+	max *int
+}
+
+func NewNPathRule(max *int) Rule {
+	return &npathRule{max: max}
+}
+
+func (r *npathRule) Name() string {
+	return "max_npath"
+}
+
+func (r *npathRule) Description() string {
+	return "Checks the NPath complexity (number of execution paths) of functions"
+}
+
+func (r *npathRule) CheckFile(file *pb.File, addError func(issue.RequirementError), addSuccess func(string)) {
+	if r.max == nil || file.Stmts == nil {
+		return
+	}
+
+	ok := true
+	for _, f := range engine.GetFunctionsInFile(file) {
+		if f == nil || f.Stmts == nil || f.Stmts.Analyze == nil || f.Stmts.Analyze.Complexity == nil || f.Stmts.Analyze.Complexity.Npath == nil {
+			continue
+		}
+		value := *f.Stmts.Analyze.Complexity.Npath
+		if value > int64(*r.max) {
+			addError(issue.RequirementError{
+				Severity: issue.SeverityMedium,
+				Code:     r.Name(),
+				Message:  fmt.Sprintf("NPath complexity too high in method %s(): got %d (max: %d)", f.GetName().GetShort(), value, *r.max),
+				Line:     lineOf(f.GetLocation()),
+				File:     fileOf(f.GetLocation()),
+			})
+			ok = false
+		}
+	}
+
+	if ok {
+		addSuccess("NPath complexity OK")
+	}
+}
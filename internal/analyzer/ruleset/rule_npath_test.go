@@ -0,0 +1,86 @@
+package ruleset
+
+import (
+	"testing"
+
+	"github.com/ast-metrics/ast-metrics/internal/analyzer/issue"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+)
+
+func npathFunction(name string, line int32, value int64) *pb.StmtFunction {
+	return &pb.StmtFunction{
+		Name:     &pb.Name{Short: name, Qualified: name},
+		Location: &pb.StmtLocationInFile{StartLine: line},
+		Stmts: &pb.Stmts{
+			Analyze: &pb.Analyze{Complexity: &pb.Complexity{Npath: &value}},
+		},
+	}
+}
+
+func TestNPathRule_CheckFile_Violation(t *testing.T) {
+	max := 200
+	rule := NewNPathRule(&max)
+	file := &pb.File{Stmts: &pb.Stmts{StmtFunction: []*pb.StmtFunction{
+		npathFunction("dispatch", 7, 1024),
+		npathFunction("close", 30, 2),
+	}}}
+
+	errors := []issue.RequirementError{}
+	successes := []string{}
+	rule.CheckFile(file,
+		func(e issue.RequirementError) { errors = append(errors, e) },
+		func(s string) { successes = append(successes, s) })
+
+	if len(errors) != 1 {
+		t.Fatalf("expected 1 error, got %d", len(errors))
+	}
+	if errors[0].Code != "max_npath" || errors[0].Line != 7 {
+		t.Errorf("unexpected error: %+v", errors[0])
+	}
+	if errors[0].Message != "NPath complexity too high in method dispatch(): got 1024 (max: 200)" {
+		t.Errorf("unexpected message: %s", errors[0].Message)
+	}
+	if len(successes) != 0 {
+		t.Errorf("expected no success, got %v", successes)
+	}
+}
+
+func TestNPathRule_CheckFile_OK(t *testing.T) {
+	max := 200
+	rule := NewNPathRule(&max)
+	file := &pb.File{Stmts: &pb.Stmts{StmtFunction: []*pb.StmtFunction{npathFunction("close", 30, 200)}}}
+
+	errors := []issue.RequirementError{}
+	successes := []string{}
+	rule.CheckFile(file,
+		func(e issue.RequirementError) { errors = append(errors, e) },
+		func(s string) { successes = append(successes, s) })
+
+	if len(errors) != 0 || len(successes) != 1 {
+		t.Errorf("expected a success, got %d errors and %d successes", len(errors), len(successes))
+	}
+}
+
+func TestNPathRule_CheckFile_ReportsAFunctionOfAHeaderInTheHeader(t *testing.T) {
+	max := 200
+	rule := NewNPathRule(&max)
+	inHeader := npathFunction("dispatch", 7, 1024)
+	inHeader.Location.File = "src/foo.h"
+	file := &pb.File{Path: "src/foo.cpp", Stmts: &pb.Stmts{StmtFunction: []*pb.StmtFunction{
+		inHeader,
+		npathFunction("close", 30, 1024),
+	}}}
+
+	errors := []issue.RequirementError{}
+	rule.CheckFile(file, func(e issue.RequirementError) { errors = append(errors, e) }, func(string) {})
+
+	if len(errors) != 2 {
+		t.Fatalf("expected 2 errors, got %d", len(errors))
+	}
+	if errors[0].File != "src/foo.h" || errors[0].Line != 7 {
+		t.Errorf("expected the violation in the header, got %+v", errors[0])
+	}
+	if errors[1].File != "" || errors[1].Line != 30 {
+		t.Errorf("expected the violation in the checked file, got %+v", errors[1])
+	}
+}
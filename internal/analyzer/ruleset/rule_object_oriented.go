@@ -0,0 +1,106 @@
+package ruleset
+
+import (
+	"fmt"
+
+	"github.com/ast-metrics/ast-metrics/internal/analyzer/issue"
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+)
+
+// classMetricRule checks a metric of the Chidamber & Kemerer suite against a
+// maximum, class by class.
+type classMetricRule struct {
+	name        string
+	description string
+	label       string
+	max         *int
+	value       func(*pb.ObjectOriented) *int32
+}
+
+func NewMaxWmcRule(max *int) Rule {
+	return &classMetricRule{
+		name:        "max_wmc",
+		description: "Limits the weighted methods per class (sum of the cyclomatic complexity of its methods)",
+		label:       "Weighted methods per class",
+		max:         max,
+		value:       func(o *pb.ObjectOriented) *int32 { return o.Wmc },
+	}
+}
+
+func NewMaxDitRule(max *int) Rule {
+	return &classMetricRule{
+		name:        "max_dit",
+		description: "Limits the depth of the inheritance tree of classes",
+		label:       "Depth of inheritance tree",
+		max:         max,
+		value:       func(o *pb.ObjectOriented) *int32 { return o.Dit },
+	}
+}
+
+func NewMaxNocRule(max *int) Rule {
+	return &classMetricRule{
+		name:        "max_noc",
+		description: "Limits the number of classes directly extending a class",
+		label:       "Number of children",
+		max:         max,
+		value:       func(o *pb.ObjectOriented) *int32 { return o.Noc },
+	}
+}
+
+func NewMaxCboRule(max *int) Rule {
+	return &classMetricRule{
+		name:        "max_cbo",
+		description: "Limits the number of classes a class is coupled to",
+		label:       "Coupling between objects",
+		max:         max,
+		value:       func(o *pb.ObjectOriented) *int32 { return o.Cbo },
+	}
+}
+
+func NewMaxRfcRule(max *int) Rule {
+	return &classMetricRule{
+		name:        "max_rfc",
+		description: "Limits the number of methods that can run in response to a call to a class",
+		label:       "Response for a class",
+		max:         max,
+		value:       func(o *pb.ObjectOriented) *int32 { return o.Rfc },
+	}
+}
+
+func (r *classMetricRule) Name() string {
+	return r.name
+}
+
+func (r *classMetricRule) Description() string {
+	return r.description
+}
+
+func (r *classMetricRule) CheckFile(file *pb.File, addError func(issue.RequirementError), addSuccess func(string)) {
+	if r.max == nil || file.Stmts == nil {
+		return
+	}
+
+	ok := true
+	for _, class := range engine.GetClassesInFile(file) {
+		oo := class.GetStmts().GetAnalyze().GetObjectOriented()
+		if oo == nil || r.value(oo) == nil {
+			continue
+		}
+		value := int(*r.value(oo))
+		if value > *r.max {
+			addError(issue.RequirementError{
+				Severity: issue.SeverityMedium,
+				Code:     r.Name(),
+				Message:  fmt.Sprintf("%s too high in class %s: got %d (max: %d)", r.label, class.GetName().GetQualified(), value, *r.max),
+				Line:     lineOf(class.GetLocation()),
+				File:     fileOf(class.GetLocation()),
+			})
+			ok = false
+		}
+	}
+
+	if ok {
+		addSuccess(r.label + " OK")
+	}
+}
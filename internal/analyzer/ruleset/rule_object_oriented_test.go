@@ -0,0 +1,88 @@
+package ruleset
+
+import (
+	"testing"
+
+	"github.com/ast-metrics/ast-metrics/internal/analyzer/issue"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+)
+
+func objectOrientedClass(name string, line int32, oo *pb.ObjectOriented) *pb.StmtClass {
+	return &pb.StmtClass{
+		Name:     &pb.Name{Short: name, Qualified: "App\\" + name},
+		Location: &pb.StmtLocationInFile{StartLine: line},
+		Stmts:    &pb.Stmts{Analyze: &pb.Analyze{ObjectOriented: oo}},
+	}
+}
+
+func TestMaxWmcRule_CheckFile_Violation(t *testing.T) {
+	max := 50
+	wmc, small := int32(64), int32(3)
+	rule := NewMaxWmcRule(&max)
+	file := &pb.File{Stmts: &pb.Stmts{StmtClass: []*pb.StmtClass{
+		objectOrientedClass("Invoice", 5, &pb.ObjectOriented{Wmc: &wmc}),
+		objectOrientedClass("Money", 80, &pb.ObjectOriented{Wmc: &small}),
+	}}}
+
+	errors := []issue.RequirementError{}
+	successes := []string{}
+	rule.CheckFile(file,
+		func(e issue.RequirementError) { errors = append(errors, e) },
+		func(s string) { successes = append(successes, s) })
+
+	if len(errors) != 1 {
+		t.Fatalf("expected 1 error, got %d", len(errors))
+	}
+	if errors[0].Code != "max_wmc" || errors[0].Line != 5 {
+		t.Errorf("unexpected error: %+v", errors[0])
+	}
+	if errors[0].Message != "Weighted methods per class too high in class App\\Invoice: got 64 (max: 50)" {
+		t.Errorf("unexpected message: %s", errors[0].Message)
+	}
+	if len(successes) != 0 {
+		t.Errorf("expected no success, got %v", successes)
+	}
+}
+
+func TestMaxDitRule_CheckFile_OK(t *testing.T) {
+	max := 5
+	dit := int32(5)
+	rule := NewMaxDitRule(&max)
+	file := &pb.File{Stmts: &pb.Stmts{StmtClass: []*pb.StmtClass{
+		objectOrientedClass("Controller", 3, &pb.ObjectOriented{Dit: &dit}),
+		// not measured: ignored
+		objectOrientedClass("Legacy", 40, nil),
+	}}}
+
+	errors := []issue.RequirementError{}
+	successes := []string{}
+	rule.CheckFile(file,
+		func(e issue.RequirementError) { errors = append(errors, e) },
+		func(s string) { successes = append(successes, s) })
+
+	if len(errors) != 0 || len(successes) != 1 {
+		t.Fatalf("expected a success, got %d errors and %d successes", len(errors), len(successes))
+	}
+	if successes[0] != "Depth of inheritance tree OK" {
+		t.Errorf("unexpected success: %s", successes[0])
+	}
+}
+
+func TestObjectOrientedRules_Names(t *testing.T) {
+	max := 1
+	expected := map[string]Rule{
+		"max_wmc": NewMaxWmcRule(&max),
+		"max_dit": NewMaxDitRule(&max),
+		"max_noc": NewMaxNocRule(&max),
+		"max_cbo": NewMaxCboRule(&max),
+		"max_rfc": NewMaxRfcRule(&max),
+	}
+	for name, rule := range expected {
+		if rule.Name() != name {
+			t.Errorf("expected %s, got %s", name, rule.Name())
+		}
+		if rule.Description() == "" {
+			t.Errorf("missing description for %s", name)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package ruleset
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ast-metrics/ast-metrics/internal/analyzer/issue"
+	"github.com/ast-metrics/ast-metrics/internal/configuration"
+	"github.com/ast-metrics/ast-metrics/internal/engine/treesitter"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+)
+
+type queryRule struct {
+	query    *treesitter.Query
+	severity issue.Severity
+}
+
+// NewQueryRule builds a rule declared in the configuration as a tree-sitter
+// query. The query runs while the files are parsed; the rule reports what it
+// matched. It fails when the declaration is incomplete, or the query cannot
+// be read by the grammar of its language.
+func NewQueryRule(cfg configuration.ConfigurationQueryRule) (Rule, error) {
+	if strings.TrimSpace(cfg.Name) == "" {
+		return nil, fmt.Errorf("a query rule has no name")
+	}
+	severity, err := ParseSeverity(cfg.Severity)
+	if err != nil {
+		return nil, fmt.Errorf("query rule %s: %v", cfg.Name, err)
+	}
+	query, err := treesitter.CompileQuery(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("query rule %s: %v", cfg.Name, err)
+	}
+	return &queryRule{query: query, severity: severity}, nil
+}
+
+func (r *queryRule) Name() string {
+	return r.query.Rule.Name
+}
+
+func (r *queryRule) Description() string {
+	return fmt.Sprintf("Reports the %s code matching a tree-sitter query", r.query.Rule.Language)
+}
+
+func (r *queryRule) CheckFile(file *pb.File, addError func(issue.RequirementError), addSuccess func(string)) {
+	// the matches were filtered by path when parsing: those of a C++ header
+	// are kept even when the source file is not among the paths of the query
+	ok := true
+	for _, match := range file.GetQueryMatches() {
+		if match.GetRule() != r.Name() {
+			continue
+		}
+		addError(issue.RequirementError{
+			Severity: r.severity,
+			Code:     r.Name(),
+			Message:  match.GetMessage(),
+			Line:     int(match.GetLine()),
+			File:     match.GetFile(),
+		})
+		ok = false
+	}
+
+	if ok && r.query.Concerns(file) {
+		addSuccess(fmt.Sprintf("Query rule %s OK", r.Name()))
+	}
+}
@@ -0,0 +1,113 @@
+package ruleset
+
+import (
+	"testing"
+
+	"github.com/ast-metrics/ast-metrics/internal/analyzer/issue"
+	"github.com/ast-metrics/ast-metrics/internal/configuration"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+)
+
+var noEval = configuration.ConfigurationQueryRule{
+	Name:     "no_eval",
+	Language: "php",
+	Query:    `(function_call_expression function: (name) @fn (#eq? @fn "eval"))`,
+	Severity: "high",
+}
+
+func checkQueryRule(t *testing.T, cfg configuration.ConfigurationQueryRule, file *pb.File) ([]issue.RequirementError, []string) {
+	t.Helper()
+	rule, err := NewQueryRule(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	errors := []issue.RequirementError{}
+	successes := []string{}
+	rule.CheckFile(file,
+		func(e issue.RequirementError) { errors = append(errors, e) },
+		func(s string) { successes = append(successes, s) })
+	return errors, successes
+}
+
+func TestQueryRule_CheckFile_ReportsTheMatchesOfTheRule(t *testing.T) {
+	file := &pb.File{Path: "src/run.php", ProgrammingLanguage: "PHP", QueryMatches: []*pb.QueryMatch{
+		{Rule: "no_eval", Line: 12, Column: 5, Message: "Forbidden code: eval"},
+		{Rule: "another_rule", Line: 14, Column: 1, Message: "Forbidden code: exit"},
+	}}
+
+	errors, successes := checkQueryRule(t, noEval, file)
+
+	expected := []issue.RequirementError{{Severity: issue.SeverityHigh, Code: "no_eval", Message: "Forbidden code: eval", Line: 12}}
+	if len(errors) != 1 || errors[0] != expected[0] {
+		t.Errorf("unexpected errors: %+v", errors)
+	}
+	if len(successes) != 0 {
+		t.Errorf("expected no success, got %v", successes)
+	}
+}
+
+func TestQueryRule_CheckFile_ReportsTheMatchesOfAHeaderInTheHeader(t *testing.T) {
+	cfg := configuration.ConfigurationQueryRule{Name: "no_c_cast", Language: "cpp", Query: `(cast_expression) @match`}
+	file := &pb.File{Path: "src/foo.cpp", ProgrammingLanguage: "C++", QueryMatches: []*pb.QueryMatch{
+		{Rule: "no_c_cast", Line: 5, Column: 24, Message: "C-style cast", File: "src/foo.h"},
+	}}
+
+	errors, _ := checkQueryRule(t, cfg, file)
+
+	if len(errors) != 1 || errors[0].File != "src/foo.h" || errors[0].Line != 5 {
+		t.Errorf("expected the violation in the header, got %+v", errors)
+	}
+}
+
+func TestQueryRule_CheckFile_OK(t *testing.T) {
+	errors, successes := checkQueryRule(t, noEval, &pb.File{Path: "src/run.php", ProgrammingLanguage: "PHP"})
+
+	if len(errors) != 0 || len(successes) != 1 || successes[0] != "Query rule no_eval OK" {
+		t.Errorf("expected only a success, got %v and %v", errors, successes)
+	}
+}
+
+func TestQueryRule_CheckFile_SkipsOtherLanguagesAndPaths(t *testing.T) {
+	cfg := noEval
+	cfg.Exclude = []string{"vendor/**"}
+
+	for _, file := range []*pb.File{
+		{Path: "src/run.py", ProgrammingLanguage: "Python"},
+		{Path: "vendor/lib.php", ProgrammingLanguage: "PHP"},
+	} {
+		errors, successes := checkQueryRule(t, cfg, file)
+		if len(errors) != 0 || len(successes) != 0 {
+			t.Errorf("%s: expected the file to be skipped, got %v and %v", file.Path, errors, successes)
+		}
+	}
+}
+
+func TestQueriesRuleset_ReportsInvalidRules(t *testing.T) {
+	cfg := &configuration.ConfigurationRequirements{Rules: &configuration.ConfigurationRequirementsRules{
+		Queries: []configuration.ConfigurationQueryRule{
+			noEval,
+			{Name: "typo", Language: "php", Query: "(function_call @match"},
+			{Language: "php", Query: "(name) @match"},
+		},
+	}}
+	rs := &queriesRuleset{cfg: cfg}
+
+	if !rs.IsEnabled() || len(rs.Enabled()) != 1 || rs.Enabled()[0].Name() != "no_eval" {
+		t.Fatalf("expected the valid rule to be enabled")
+	}
+	projectRules := rs.EnabledProjectRules()
+	if len(projectRules) != 1 || projectRules[0].Name() != "query_rules" {
+		t.Fatalf("expected the invalid rules to be reported, got %d project rules", len(projectRules))
+	}
+	errors := []issue.RequirementError{}
+	projectRules[0].CheckProject(ProjectContext{}, func(e issue.RequirementError) { errors = append(errors, e) }, func(string) {})
+	if len(errors) != 2 {
+		t.Fatalf("expected 2 errors, got %+v", errors)
+	}
+	if errors[0].Message != "Invalid configuration: query rule typo: invalid query: invalid node type 'function_call' at line 1 column 0" {
+		t.Errorf("unexpected message: %s", errors[0].Message)
+	}
+	if errors[1].Message != "Invalid configuration: a query rule has no name" {
+		t.Errorf("unexpected message: %s", errors[1].Message)
+	}
+}
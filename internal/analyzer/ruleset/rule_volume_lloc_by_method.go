@@ -38,6 +38,7 @@ func (r *llocByMethodRule) CheckFile(file *pb.File, addError func(issue.Requirem
 				Message:  fmt.Sprintf("LLOC too high in method %s(): got %d (max: %d)", f.Name.Short, value, *r.max),
 				Code:     r.Name(),
 				Line:     lineOf(f.GetLocation()),
+				File:     fileOf(f.GetLocation()),
 			})
 			ok = false
 			continue
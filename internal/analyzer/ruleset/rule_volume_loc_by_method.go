@@ -38,6 +38,7 @@ func (r *locByMethodRule) CheckFile(file *pb.File, addError func(issue.Requireme
 				Message:  fmt.Sprintf("LOC too high in method %s(): got %d (max: %d)", f.Name.Short, value, *r.max),
 				Code:     r.Name(),
 				Line:     lineOf(f.GetLocation()),
+				File:     fileOf(f.GetLocation()),
 			})
 			ok = false
 			continue
@@ -40,6 +40,7 @@ func (r *maxMethodsPerClassRule) CheckFile(file *pb.File, addError func(issue.Re
 					Message:  fmt.Sprintf("Class has %d methods, maximum allowed is %d", methodCount, r.threshold),
 					Code:     r.Name(),
 					Line:     lineOf(class.GetLocation()),
+					File:     fileOf(class.GetLocation()),
 				})
 				return
 			}
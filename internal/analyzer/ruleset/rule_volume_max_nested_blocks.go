@@ -3,6 +3,7 @@ package ruleset
 import (
 	"fmt"
 	"github.com/ast-metrics/ast-metrics/internal/analyzer/issue"
+	"github.com/ast-metrics/ast-metrics/internal/engine"
 	pb "github.com/ast-metrics/ast-metrics/pb"
 )
 
@@ -26,19 +27,26 @@ func (r *maxNestedBlocksRule) Description() string {
 }
 
 func (r *maxNestedBlocksRule) CheckFile(file *pb.File, addError func(issue.RequirementError), addSuccess func(string)) {
-	if r.threshold == 0 || file.Stmts == nil || file.Stmts.Analyze == nil || file.Stmts.Analyze.Complexity == nil {
+	if r.threshold == 0 || file.Stmts == nil {
 		return
 	}
 
-	// Use cyclomatic complexity as approximation for nesting depth
-	if file.Stmts.Analyze.Complexity.Cyclomatic != nil && int(*file.Stmts.Analyze.Complexity.Cyclomatic) > r.threshold {
-		addError(issue.RequirementError{
-			Severity: issue.SeverityMedium,
-			Message:  fmt.Sprintf("File has complexity of %d, maximum nesting allowed is %d", int(*file.Stmts.Analyze.Complexity.Cyclomatic), r.threshold),
-			Code:     r.Name(),
-		})
-		return
+	ok := true
+	for _, f := range engine.GetFunctionsInFile(file) {
+		depth := int(f.GetStmts().GetAnalyze().GetComplexity().GetMaxNesting())
+		if depth > r.threshold {
+			addError(issue.RequirementError{
+				Severity: issue.SeverityMedium,
+				Message:  fmt.Sprintf("Blocks nested %d levels deep in method %s(), maximum allowed is %d", depth, f.GetName().GetShort(), r.threshold),
+				Code:     r.Name(),
+				Line:     lineOf(f.GetLocation()),
+				File:     fileOf(f.GetLocation()),
+			})
+			ok = false
+		}
 	}
 
-	addSuccess(fmt.Sprintf("Max nested blocks OK in file %s", file.Path))
+	if ok {
+		addSuccess(fmt.Sprintf("Max nested blocks OK in file %s", file.Path))
+	}
 }
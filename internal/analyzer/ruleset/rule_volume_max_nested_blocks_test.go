@@ -10,14 +10,21 @@ func TestMaxNestedBlocksRule(t *testing.T) {
 	threshold := 5
 	rule := NewMaxNestedBlocksRule(&threshold)
 
-	// Test file with high complexity (proxy for nesting)
-	cyclomatic := int32(8)
+	// Test file with a deeply nested function
+	nesting := int32(8)
 	file := &pb.File{
 		Path: "test.go",
 		Stmts: &pb.Stmts{
-			Analyze: &pb.Analyze{
-				Complexity: &pb.Complexity{
-					Cyclomatic: &cyclomatic,
+			StmtFunction: []*pb.StmtFunction{
+				{
+					Name: &pb.Name{Short: "deep"},
+					Stmts: &pb.Stmts{
+						Analyze: &pb.Analyze{
+							Complexity: &pb.Complexity{
+								MaxNesting: &nesting,
+							},
+						},
+					},
 				},
 			},
 		},
@@ -40,7 +47,7 @@ func TestMaxNestedBlocksRule(t *testing.T) {
 	}
 
 	// Test file within threshold
-	cyclomatic = 4
+	nesting = 4
 	errorCalled = false
 	successCalled = false
 	
@@ -37,6 +37,7 @@ func (r *maxParametersPerMethodRule) CheckFile(file *pb.File, addError func(issu
 				Message:  fmt.Sprintf("Method has %d parameters, maximum allowed is %d", len(function.Parameters), r.threshold),
 				Code:     r.Name(),
 				Line:     lineOf(function.GetLocation()),
+				File:     fileOf(function.GetLocation()),
 			})
 			return
 		}
@@ -53,6 +54,7 @@ func (r *maxParametersPerMethodRule) CheckFile(file *pb.File, addError func(issu
 							Message:  fmt.Sprintf("Method has %d parameters, maximum allowed is %d", len(method.Parameters), r.threshold),
 							Code:     r.Name(),
 							Line:     lineOf(method.GetLocation()),
+							File:     fileOf(method.GetLocation()),
 						})
 						return
 					}
@@ -2,8 +2,8 @@ package ruleset
 
 import (
 	"fmt"
-	"strings"
 	"github.com/ast-metrics/ast-metrics/internal/analyzer/issue"
+	"github.com/ast-metrics/ast-metrics/internal/engine"
 	pb "github.com/ast-metrics/ast-metrics/pb"
 )
 
@@ -35,12 +35,8 @@ func (r *maxPublicMethodsRule) CheckFile(file *pb.File, addError func(issue.Requ
 		if class.Stmts != nil && class.Stmts.StmtFunction != nil {
 			publicCount := 0
 			for _, method := range class.Stmts.StmtFunction {
-				if method.Name != nil && method.Name.Short != "" {
-					// Simple heuristic: method is public if it starts with uppercase (Go) or doesn't start with _ (other languages)
-					name := method.Name.Short
-					if len(name) > 0 && (name[0] >= 'A' && name[0] <= 'Z' || !strings.HasPrefix(name, "_")) {
-						publicCount++
-					}
+				if engine.IsPublic(method) {
+					publicCount++
 				}
 			}
 			if publicCount > r.threshold {
@@ -49,6 +45,7 @@ func (r *maxPublicMethodsRule) CheckFile(file *pb.File, addError func(issue.Requ
 					Message:  fmt.Sprintf("Class has %d public methods, maximum allowed is %d", publicCount, r.threshold),
 					Code:     r.Name(),
 					Line:     lineOf(class.GetLocation()),
+					File:     fileOf(class.GetLocation()),
 				})
 				return
 			}
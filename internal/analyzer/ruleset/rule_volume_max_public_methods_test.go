@@ -70,3 +70,45 @@ func TestMaxPublicMethodsRule(t *testing.T) {
 		t.Error("Expected success to be called for class within threshold")
 	}
 }
+
+func TestMaxPublicMethodsRuleUsesVisibility(t *testing.T) {
+	threshold := 2
+	rule := NewMaxPublicMethodsRule(&threshold)
+
+	public := &pb.Modifiers{Visibility: pb.Visibility_VISIBILITY_PUBLIC}
+	private := &pb.Modifiers{Visibility: pb.Visibility_VISIBILITY_PRIVATE}
+	file := &pb.File{
+		Path: "Test.java",
+		Stmts: &pb.Stmts{
+			StmtClass: []*pb.StmtClass{
+				{
+					Name: &pb.Name{Short: "TestClass"},
+					Stmts: &pb.Stmts{
+						StmtFunction: []*pb.StmtFunction{
+							{Name: &pb.Name{Short: "save"}, Modifiers: public},
+							{Name: &pb.Name{Short: "load"}, Modifiers: public},
+							{Name: &pb.Name{Short: "validate"}, Modifiers: private},
+							{Name: &pb.Name{Short: "normalize"}, Modifiers: private},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	errorCalled := false
+	rule.CheckFile(file, func(err issue.RequirementError) {
+		errorCalled = true
+	}, func(msg string) {})
+	if errorCalled {
+		t.Error("Expected private methods not to be counted")
+	}
+
+	file.Stmts.StmtClass[0].Stmts.StmtFunction[2].Modifiers = public
+	rule.CheckFile(file, func(err issue.RequirementError) {
+		errorCalled = true
+	}, func(msg string) {})
+	if !errorCalled {
+		t.Error("Expected error for class with 3 public methods")
+	}
+}
@@ -25,6 +25,15 @@ func (c *complexityRuleset) Enabled() []Rule {
 	if c.cfg.Rules.Complexity != nil && c.cfg.Rules.Complexity.Cyclomatic != nil {
 		rules = append(rules, NewCyclomaticRule(c.cfg.Rules.Complexity.Cyclomatic))
 	}
+	if c.cfg.Rules.Complexity != nil && c.cfg.Rules.Complexity.Cognitive != nil {
+		rules = append(rules, NewCognitiveRule(c.cfg.Rules.Complexity.Cognitive))
+	}
+	if c.cfg.Rules.Complexity != nil && c.cfg.Rules.Complexity.NPath != nil {
+		rules = append(rules, NewNPathRule(c.cfg.Rules.Complexity.NPath))
+	}
+	if c.cfg.Rules.Complexity != nil && c.cfg.Rules.Complexity.AbcSize != nil {
+		rules = append(rules, NewAbcSizeRule(c.cfg.Rules.Complexity.AbcSize))
+	}
 	// Legacy support: requirements.rules.cyclomatic_complexity: { max: X }
 	if c.cfg.Rules.CyclomaticLegacy != nil && c.cfg.Rules.CyclomaticLegacy.Max > 0 {
 		m := c.cfg.Rules.CyclomaticLegacy.Max
@@ -33,12 +42,18 @@ func (c *complexityRuleset) Enabled() []Rule {
 	return rules
 }
 func (c *complexityRuleset) All() []Rule {
-	var cyclo *int
+	var cyclo, cognitive, npath, abc *int
 	if c != nil && c.cfg != nil && c.cfg.Rules != nil && c.cfg.Rules.Complexity != nil {
 		cyclo = c.cfg.Rules.Complexity.Cyclomatic
+		cognitive = c.cfg.Rules.Complexity.Cognitive
+		npath = c.cfg.Rules.Complexity.NPath
+		abc = c.cfg.Rules.Complexity.AbcSize
 	}
 	return []Rule{
 		NewCyclomaticRule(cyclo),
+		NewCognitiveRule(cognitive),
+		NewNPathRule(npath),
+		NewAbcSizeRule(abc),
 	}
 }
 
@@ -77,8 +77,8 @@ func TestComplexityRuleset_All_ReturnsAllPossibleRules(t *testing.T) {
 	ruleset := &complexityRuleset{}
 	all := ruleset.All()
 	
-	if len(all) != 1 {
-		t.Fatalf("expected 1 total rule, got %d", len(all))
+	if len(all) != 4 {
+		t.Fatalf("expected 4 total rules, got %d", len(all))
 	}
 
 	ruleNames := make(map[string]bool)
@@ -86,7 +86,7 @@ func TestComplexityRuleset_All_ReturnsAllPossibleRules(t *testing.T) {
 		ruleNames[rule.Name()] = true
 	}
 
-	expectedRules := []string{"cyclomatic_complexity"}
+	expectedRules := []string{"cyclomatic_complexity", "max_cognitive", "max_npath", "max_abc_size"}
 	for _, name := range expectedRules {
 		if !ruleNames[name] {
 			t.Errorf("missing expected rule: %s", name)
@@ -0,0 +1,68 @@
+package ruleset
+
+import (
+	"github.com/ast-metrics/ast-metrics/internal/configuration"
+)
+
+type customRuleset struct {
+	cfg *configuration.ConfigurationRequirements
+}
+
+func (c *customRuleset) Category() string {
+	return "custom"
+}
+
+func (c *customRuleset) Description() string {
+	return "Rules declared in the configuration, as expressions over the metrics of files, classes or functions"
+}
+
+// All returns the custom rules of the configuration; there is no custom rule
+// without a configuration.
+func (c *customRuleset) All() []Rule {
+	return c.Enabled()
+}
+
+// Enabled returns the valid custom rules of the configuration. The invalid
+// ones are reported by the project rule "custom_rules".
+func (c *customRuleset) Enabled() []Rule {
+	var rules []Rule
+	for _, cfg := range c.declarations() {
+		if rule, err := NewCustomRule(cfg); err == nil {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+func (c *customRuleset) IsEnabled() bool {
+	return len(c.declarations()) > 0
+}
+
+// AllProjectRules returns all project-level rules regardless of configuration.
+func (c *customRuleset) AllProjectRules() []ProjectRule {
+	return []ProjectRule{
+		newInvalidRulesRule("custom", nil),
+	}
+}
+
+// EnabledProjectRules reports the custom rules that cannot be checked, when
+// some are declared.
+func (c *customRuleset) EnabledProjectRules() []ProjectRule {
+	var errs []error
+	for _, cfg := range c.declarations() {
+		if _, err := NewCustomRule(cfg); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return []ProjectRule{newInvalidRulesRule("custom", errs)}
+}
+
+func (c *customRuleset) declarations() []configuration.ConfigurationCustomRule {
+	if c == nil || c.cfg == nil || c.cfg.Rules == nil {
+		return nil
+	}
+	return c.cfg.Rules.Custom
+}
@@ -0,0 +1,56 @@
+package ruleset
+
+import (
+	"github.com/ast-metrics/ast-metrics/internal/configuration"
+)
+
+type distributionRuleset struct {
+	cfg *configuration.ConfigurationRequirements
+}
+
+func (d *distributionRuleset) Category() string {
+	return "distribution"
+}
+
+func (d *distributionRuleset) Description() string {
+	return "Distribution of the metrics (percentiles, median, standard deviation)"
+}
+
+// All returns an empty slice — distribution rules are project-level, not file-level.
+func (d *distributionRuleset) All() []Rule {
+	return []Rule{}
+}
+
+// Enabled returns an empty slice — distribution rules are project-level, not file-level.
+func (d *distributionRuleset) Enabled() []Rule {
+	return []Rule{}
+}
+
+func (d *distributionRuleset) IsEnabled() bool {
+	return len(d.EnabledProjectRules()) > 0
+}
+
+// AllProjectRules returns all project-level rules regardless of configuration.
+func (d *distributionRuleset) AllProjectRules() []ProjectRule {
+	return []ProjectRule{
+		NewMaxDistributionRule(nil),
+		NewMinDistributionRule(nil),
+	}
+}
+
+// EnabledProjectRules returns project-level rules that are configured.
+func (d *distributionRuleset) EnabledProjectRules() []ProjectRule {
+	var rules []ProjectRule
+	if d == nil || d.cfg == nil || d.cfg.Rules == nil || d.cfg.Rules.Distribution == nil {
+		return rules
+	}
+
+	if len(d.cfg.Rules.Distribution.Max) > 0 {
+		rules = append(rules, NewMaxDistributionRule(d.cfg.Rules.Distribution.Max))
+	}
+	if len(d.cfg.Rules.Distribution.Min) > 0 {
+		rules = append(rules, NewMinDistributionRule(d.cfg.Rules.Distribution.Min))
+	}
+
+	return rules
+}
@@ -0,0 +1,52 @@
+package ruleset
+
+import (
+	"github.com/ast-metrics/ast-metrics/internal/configuration"
+)
+
+type documentationRuleset struct {
+	cfg *configuration.ConfigurationRequirements
+}
+
+func (d *documentationRuleset) Category() string {
+	return "documentation"
+}
+
+func (d *documentationRuleset) Description() string {
+	return "Documentation of the public API (doc comments, docstrings)"
+}
+
+// All returns an empty slice — documentation rules are project-level, not file-level.
+func (d *documentationRuleset) All() []Rule {
+	return []Rule{}
+}
+
+// Enabled returns an empty slice — documentation rules are project-level, not file-level.
+func (d *documentationRuleset) Enabled() []Rule {
+	return []Rule{}
+}
+
+func (d *documentationRuleset) IsEnabled() bool {
+	return len(d.EnabledProjectRules()) > 0
+}
+
+// AllProjectRules returns all project-level rules regardless of configuration.
+func (d *documentationRuleset) AllProjectRules() []ProjectRule {
+	return []ProjectRule{
+		NewMinDocCoverageRule(nil),
+	}
+}
+
+// EnabledProjectRules returns project-level rules that are configured.
+func (d *documentationRuleset) EnabledProjectRules() []ProjectRule {
+	var rules []ProjectRule
+	if d == nil || d.cfg == nil || d.cfg.Rules == nil || d.cfg.Rules.Documentation == nil {
+		return rules
+	}
+
+	if d.cfg.Rules.Documentation.MinDocCoverage != nil {
+		rules = append(rules, NewMinDocCoverageRule(d.cfg.Rules.Documentation.MinDocCoverage))
+	}
+
+	return rules
+}
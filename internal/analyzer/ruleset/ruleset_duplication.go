@@ -0,0 +1,52 @@
+package ruleset
+
+import (
+	"github.com/ast-metrics/ast-metrics/internal/configuration"
+)
+
+type duplicationRuleset struct {
+	cfg *configuration.ConfigurationRequirements
+}
+
+func (d *duplicationRuleset) Category() string {
+	return "duplication"
+}
+
+func (d *duplicationRuleset) Description() string {
+	return "Duplicated code (blocks copied within or across files)"
+}
+
+// All returns an empty slice — duplication rules are project-level, not file-level.
+func (d *duplicationRuleset) All() []Rule {
+	return []Rule{}
+}
+
+// Enabled returns an empty slice — duplication rules are project-level, not file-level.
+func (d *duplicationRuleset) Enabled() []Rule {
+	return []Rule{}
+}
+
+func (d *duplicationRuleset) IsEnabled() bool {
+	return len(d.EnabledProjectRules()) > 0
+}
+
+// AllProjectRules returns all project-level rules regardless of configuration.
+func (d *duplicationRuleset) AllProjectRules() []ProjectRule {
+	return []ProjectRule{
+		NewMaxDuplicationRule(nil),
+	}
+}
+
+// EnabledProjectRules returns project-level rules that are configured.
+func (d *duplicationRuleset) EnabledProjectRules() []ProjectRule {
+	var rules []ProjectRule
+	if d == nil || d.cfg == nil || d.cfg.Rules == nil || d.cfg.Rules.Duplication == nil {
+		return rules
+	}
+
+	if d.cfg.Rules.Duplication.MaxDuplication != nil {
+		rules = append(rules, NewMaxDuplicationRule(d.cfg.Rules.Duplication.MaxDuplication))
+	}
+
+	return rules
+}
@@ -0,0 +1,64 @@
+package ruleset
+
+import (
+	"github.com/ast-metrics/ast-metrics/internal/configuration"
+)
+
+// Object-oriented programming ruleset
+
+type objectOrientedRuleset struct {
+	cfg *configuration.ConfigurationRequirements
+}
+
+func (o *objectOrientedRuleset) Category() string {
+	return "object-oriented-programming"
+}
+func (o *objectOrientedRuleset) Description() string {
+	return "Design of classes (e.g., Chidamber & Kemerer metrics)"
+}
+func (o *objectOrientedRuleset) Enabled() []Rule {
+	rules := []Rule{}
+	if o == nil || o.cfg == nil || o.cfg.Rules == nil || o.cfg.Rules.ObjectOrientedProgramming == nil {
+		return rules
+	}
+	oop := o.cfg.Rules.ObjectOrientedProgramming
+	if oop.Maintainability != nil {
+		rules = append(rules, NewMaintainabilityRule(oop.Maintainability))
+	}
+	if oop.MaxWmc != nil {
+		rules = append(rules, NewMaxWmcRule(oop.MaxWmc))
+	}
+	if oop.MaxDit != nil {
+		rules = append(rules, NewMaxDitRule(oop.MaxDit))
+	}
+	if oop.MaxNoc != nil {
+		rules = append(rules, NewMaxNocRule(oop.MaxNoc))
+	}
+	if oop.MaxCbo != nil {
+		rules = append(rules, NewMaxCboRule(oop.MaxCbo))
+	}
+	if oop.MaxRfc != nil {
+		rules = append(rules, NewMaxRfcRule(oop.MaxRfc))
+	}
+	return rules
+}
+
+func (o *objectOrientedRuleset) All() []Rule {
+	oop := &configuration.ConfigurationOOPRules{}
+	if o != nil && o.cfg != nil && o.cfg.Rules != nil && o.cfg.Rules.ObjectOrientedProgramming != nil {
+		oop = o.cfg.Rules.ObjectOrientedProgramming
+	}
+	return []Rule{
+		NewMaintainabilityRule(oop.Maintainability),
+		NewMaxWmcRule(oop.MaxWmc),
+		NewMaxDitRule(oop.MaxDit),
+		NewMaxNocRule(oop.MaxNoc),
+		NewMaxCboRule(oop.MaxCbo),
+		NewMaxRfcRule(oop.MaxRfc),
+	}
+}
+
+func (o *objectOrientedRuleset) IsEnabled() bool {
+	enabled := o.Enabled()
+	return len(enabled) > 0
+}
@@ -0,0 +1,63 @@
+package ruleset
+
+import (
+	"testing"
+
+	"github.com/ast-metrics/ast-metrics/internal/configuration"
+)
+
+func TestObjectOrientedRuleset_Category(t *testing.T) {
+	ruleset := &objectOrientedRuleset{}
+	if ruleset.Category() != "object-oriented-programming" {
+		t.Errorf("expected 'object-oriented-programming', got %s", ruleset.Category())
+	}
+}
+
+func TestObjectOrientedRuleset_IsEnabled_EmptyConfig(t *testing.T) {
+	ruleset := &objectOrientedRuleset{cfg: &configuration.ConfigurationRequirements{}}
+	if ruleset.IsEnabled() {
+		t.Error("expected ruleset to be disabled with empty config")
+	}
+}
+
+func TestObjectOrientedRuleset_Enabled_ReturnsConfiguredRules(t *testing.T) {
+	maxDit, maxCbo := 5, 14
+	cfg := &configuration.ConfigurationRequirements{
+		Rules: &configuration.ConfigurationRequirementsRules{
+			ObjectOrientedProgramming: &configuration.ConfigurationOOPRules{
+				MaxDit: &maxDit,
+				MaxCbo: &maxCbo,
+			},
+		},
+	}
+	ruleset := &objectOrientedRuleset{cfg: cfg}
+
+	enabled := ruleset.Enabled()
+	if len(enabled) != 2 {
+		t.Fatalf("expected 2 enabled rules, got %d", len(enabled))
+	}
+	if enabled[0].Name() != "max_dit" || enabled[1].Name() != "max_cbo" {
+		t.Errorf("unexpected rules: %s, %s", enabled[0].Name(), enabled[1].Name())
+	}
+}
+
+func TestObjectOrientedRuleset_All_ReturnsAllPossibleRules(t *testing.T) {
+	ruleset := &objectOrientedRuleset{}
+	all := ruleset.All()
+
+	if len(all) != 6 {
+		t.Fatalf("expected 6 total rules, got %d", len(all))
+	}
+
+	ruleNames := make(map[string]bool)
+	for _, rule := range all {
+		ruleNames[rule.Name()] = true
+	}
+
+	expectedRules := []string{"maintainability", "max_wmc", "max_dit", "max_noc", "max_cbo", "max_rfc"}
+	for _, name := range expectedRules {
+		if !ruleNames[name] {
+			t.Errorf("missing expected rule: %s", name)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package ruleset
+
+import (
+	"github.com/ast-metrics/ast-metrics/internal/configuration"
+)
+
+type queriesRuleset struct {
+	cfg *configuration.ConfigurationRequirements
+}
+
+func (q *queriesRuleset) Category() string {
+	return "queries"
+}
+
+func (q *queriesRuleset) Description() string {
+	return "Rules declared in the configuration as tree-sitter queries, for the structure of the code"
+}
+
+// All returns the query rules of the configuration; there is no query rule
+// without a configuration.
+func (q *queriesRuleset) All() []Rule {
+	return q.Enabled()
+}
+
+// Enabled returns the valid query rules of the configuration. The invalid
+// ones are reported by the project rule "query_rules".
+func (q *queriesRuleset) Enabled() []Rule {
+	var rules []Rule
+	for _, cfg := range q.declarations() {
+		if rule, err := NewQueryRule(cfg); err == nil {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+func (q *queriesRuleset) IsEnabled() bool {
+	return len(q.declarations()) > 0
+}
+
+// AllProjectRules returns all project-level rules regardless of configuration.
+func (q *queriesRuleset) AllProjectRules() []ProjectRule {
+	return []ProjectRule{
+		newInvalidRulesRule("query", nil),
+	}
+}
+
+// EnabledProjectRules reports the query rules that cannot be checked, when
+// some are declared.
+func (q *queriesRuleset) EnabledProjectRules() []ProjectRule {
+	var errs []error
+	for _, cfg := range q.declarations() {
+		if _, err := NewQueryRule(cfg); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return []ProjectRule{newInvalidRulesRule("query", errs)}
+}
+
+func (q *queriesRuleset) declarations() []configuration.ConfigurationQueryRule {
+	if q == nil || q.cfg == nil || q.cfg.Rules == nil {
+		return nil
+	}
+	return q.cfg.Rules.Queries
+}
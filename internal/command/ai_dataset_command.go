@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/ast-metrics/ast-metrics/internal/analyzer"
@@ -244,6 +245,13 @@ func (c *AIDatasetCommand) generateCSV(files []*pb.File) error {
 		"nb_unique_operators",
 		"programming_language",
 		"cyclomatic_complexity",
+		"visibility",
+		"nb_public_methods",
+		"nb_static_methods",
+		"is_abstract",
+		"is_final",
+		"annotations_raw",
+		"nb_annotations",
 	}
 	if err := writer.Write(header); err != nil {
 		return err
@@ -498,6 +506,20 @@ func (c *AIDatasetCommand) extractClassMetrics(class *pb.StmtClass, file *pb.Fil
 	// Get file path (relative)
 	filePath := c.getRelativeFilePath(file)
 
+	// Visibility and modifiers
+	var methods []*pb.StmtFunction
+	if class.Stmts != nil {
+		methods = class.Stmts.StmtFunction
+	}
+	nbPublicMethods := c.countMethods(methods, engine.IsPublic)
+	nbStaticMethods := c.countMethods(methods, func(m *pb.StmtFunction) bool { return m.GetModifiers().GetIsStatic() })
+
+	// Annotations of the class and of its methods (@Entity, @GetMapping...)
+	annotations := c.annotationNames(nil, class.Annotations)
+	for _, method := range methods {
+		annotations = c.annotationNames(annotations, method.GetAnnotations())
+	}
+
 	return []string{
 		stmtName,
 		"class",
@@ -529,6 +551,13 @@ func (c *AIDatasetCommand) extractClassMetrics(class *pb.StmtClass, file *pb.Fil
 		fmt.Sprintf("%d", nbUniqueOperators),
 		programmingLanguage,
 		fmt.Sprintf("%d", cyclomaticComplexity),
+		engine.VisibilityName(class.Modifiers),
+		fmt.Sprintf("%d", nbPublicMethods),
+		fmt.Sprintf("%d", nbStaticMethods),
+		flag(class.GetModifiers().GetIsAbstract()),
+		flag(class.GetModifiers().GetIsFinal()),
+		strings.Join(annotations, ";"),
+		fmt.Sprintf("%d", len(class.Annotations)),
 	}
 }
 
@@ -1096,8 +1125,10 @@ func (c *AIDatasetCommand) extractFileMetrics(file *pb.File, namespace string) [
 	}
 
 	nbMethods := int32(0)
+	nbPublicMethods := int32(0)
 	if file.Stmts != nil {
 		nbMethods = int32(len(file.Stmts.StmtFunction))
+		nbPublicMethods = c.countMethods(file.Stmts.StmtFunction, engine.IsPublic)
 	}
 
 	nbExtends := int32(0)
@@ -1180,6 +1211,10 @@ func (c *AIDatasetCommand) extractFileMetrics(file *pb.File, namespace string) [
 		fmt.Sprintf("%d", nbUniqueOperators),
 		programmingLanguage,
 		fmt.Sprintf("%d", cyclomaticComplexity),
+		"", // Visibility
+		fmt.Sprintf("%d", nbPublicMethods),
+		"0", "0", "0", // Static methods, abstract, final
+		"", "0", // Annotations
 	}
 }
 
@@ -1288,7 +1323,39 @@ func (c *AIDatasetCommand) emptyRow() []string {
 	return []string{
 		"", "", "", "", "", "", "", "0", "0", "0", "0", "0", "0", "0", "0",
 		"0", "0", "0", "0", "0", "0", "0", "0", "0", "0", "0", "0", "0", "", "0",
+		"", "0", "0", "0", "0", "", "0",
+	}
+}
+
+// countMethods counts the methods matching keep.
+func (c *AIDatasetCommand) countMethods(methods []*pb.StmtFunction, keep func(*pb.StmtFunction) bool) int32 {
+	count := int32(0)
+	for _, method := range methods {
+		if method != nil && keep(method) {
+			count++
+		}
+	}
+	return count
+}
+
+// annotationNames appends the short names of annotations to names, skipping
+// the names already there.
+func (c *AIDatasetCommand) annotationNames(names []string, annotations []*pb.Annotation) []string {
+	for _, annotation := range annotations {
+		name := annotation.GetName().GetShort()
+		if name != "" && !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// flag writes a boolean as a numeric feature.
+func flag(b bool) string {
+	if b {
+		return "1"
 	}
+	return "0"
 }
 
 // dumpASTWithLimits calls DumpAST with file limits and concurrency control
@@ -0,0 +1,68 @@
+package command
+
+import (
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	"github.com/ast-metrics/ast-metrics/internal/engine/php"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+// readDataset reads the rows of a dataset by column name.
+func readDataset(t *testing.T, path string) []map[string]string {
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("open dataset: %v", err)
+	}
+	defer f.Close()
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		t.Fatalf("read dataset: %v", err)
+	}
+	var rows []map[string]string
+	for _, record := range records[1:] {
+		row := map[string]string{}
+		for i, column := range records[0] {
+			row[column] = record[i]
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func TestAIDatasetCommand_GenerateCSV_WritesTheModifiersAndAnnotations(t *testing.T) {
+	file, err := engine.CreateTestFileWithCode(&php.PhpRunner{}, `<?php
+#[Entity]
+abstract class Cart {
+    public static function create() {}
+    #[Route("/total")]
+    public function total() {}
+    private function recompute() {}
+}
+`)
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	output := filepath.Join(t.TempDir(), "dataset.csv")
+	c := NewAIDatasetCommand(t.TempDir(), output, false, 0, 1)
+
+	if err := c.generateCSV([]*pb.File{file}); err != nil {
+		t.Fatalf("generateCSV: %v", err)
+	}
+
+	rows := readDataset(t, output)
+	if assert.Len(t, rows, 1) {
+		assert.Equal(t, "class", rows[0]["stmt_type"])
+		assert.Equal(t, "public", rows[0]["visibility"])
+		assert.Equal(t, "2", rows[0]["nb_public_methods"])
+		assert.Equal(t, "1", rows[0]["nb_static_methods"])
+		assert.Equal(t, "1", rows[0]["is_abstract"])
+		assert.Equal(t, "0", rows[0]["is_final"])
+		assert.Equal(t, "Entity;Route", rows[0]["annotations_raw"])
+		assert.Equal(t, "1", rows[0]["nb_annotations"])
+	}
+}
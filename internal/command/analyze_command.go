@@ -21,6 +21,10 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+// ErrParseErrors is returned by the analysis when files could not be parsed,
+// and the configuration asks to fail on parse errors.
+var ErrParseErrors = errors.New("parse errors")
+
 type AnalyzeCommand struct {
 	Configuration   *configuration.Configuration
 	outWriter       *bufio.Writer
@@ -130,6 +134,7 @@ func (v *AnalyzeCommand) Execute() error {
 	aggregator.WithAggregateAnalyzer(Activity.NewBusFactor())
 	// Per-directory views of the HTML report: one scope per analyzed path
 	aggregator.WithAnalyzedPaths(v.Configuration.SourcesToAnalyzePath)
+	aggregator.WithDuplicationThresholds(v.Configuration.Duplication.MinTokens, v.Configuration.Duplication.MinLines)
 	if v.Configuration.CompareWith != "" {
 		aggregator.WithComparaison(allResultsCloned, v.Configuration.CompareWith)
 	}
@@ -189,11 +194,19 @@ func (v *AnalyzeCommand) Execute() error {
 	// Details errors
 	if len(projectAggregated.ErroredFiles) > 0 {
 		cli.PrintWarning(fmt.Sprintf("%d files could not be analyzed. Use the --verbose option to get details", len(projectAggregated.ErroredFiles)))
-		if log.GetLevel() == log.DebugLevel {
-			for _, file := range projectAggregated.ErroredFiles {
-				cli.PrintError("File " + file.Path)
-				for _, err := range file.Errors {
-					cli.PrintError("    " + err)
+	}
+	if partial := len(projectAggregated.FilesWithParseErrors) - len(projectAggregated.ErroredFiles); partial > 0 {
+		cli.PrintWarning(fmt.Sprintf("%d files were analyzed despite syntax errors. Use the --verbose option to get details", partial))
+	}
+	if log.GetLevel() == log.DebugLevel {
+		for _, file := range projectAggregated.FilesWithParseErrors {
+			cli.PrintError("File " + file.Path)
+			for _, err := range file.Errors {
+				cli.PrintError("    " + err)
+			}
+			if len(file.Errors) == 0 {
+				for _, e := range file.ParseErrors {
+					cli.PrintError(fmt.Sprintf("    line %d, column %d: %s", e.Line, e.Column, e.Message))
 				}
 			}
 		}
@@ -242,11 +255,53 @@ func (v *AnalyzeCommand) Execute() error {
 		endScreen.Render()
 	}
 
+	if v.Configuration.FailOnParseError && len(projectAggregated.FilesWithParseErrors) > 0 {
+		return fmt.Errorf("%w in %d files", ErrParseErrors, len(projectAggregated.FilesWithParseErrors))
+	}
+
 	return nil
 }
 
 func buildProjectContext(pa analyzer.ProjectAggregated) ruleset.ProjectContext {
 	ctx := ruleset.ProjectContext{}
+	if d := pa.Combined.Duplication; d != nil {
+		ctx.DuplicatedLinesPct = d.DuplicatedLinesPct
+		for _, clone := range d.Clones {
+			ctx.Clones = append(ctx.Clones, ruleset.CloneInfo{
+				Tokens: clone.Tokens,
+				First:  ruleset.CloneLocationInfo{FilePath: clone.First.FilePath, StartLine: clone.First.StartLine, EndLine: clone.First.EndLine},
+				Second: ruleset.CloneLocationInfo{FilePath: clone.Second.FilePath, StartLine: clone.Second.StartLine, EndLine: clone.Second.EndLine},
+			})
+		}
+	}
+	if dc := pa.Combined.DocCoverage; dc != nil {
+		ctx.PublicSymbols = dc.PublicSymbols
+		ctx.DocCoveragePct = dc.CoveragePct
+		for _, symbol := range dc.Undocumented {
+			ctx.UndocumentedSymbols = append(ctx.UndocumentedSymbols, ruleset.UndocumentedSymbolInfo{
+				Kind:     symbol.Kind,
+				Name:     symbol.Name,
+				FilePath: symbol.FilePath,
+				Line:     symbol.Line,
+			})
+		}
+	}
+	combined := pa.Combined
+	ctx.Distributions = make(map[string]ruleset.DistributionInfo)
+	for name, metric := range combined.Metrics() {
+		ctx.Distributions[name] = ruleset.DistributionInfo{
+			Count:  metric.Counter,
+			Avg:    metric.Avg,
+			Min:    metric.Min,
+			Max:    metric.Max,
+			Median: metric.Median,
+			P75:    metric.P75,
+			P90:    metric.P90,
+			P95:    metric.P95,
+			P99:    metric.P99,
+			StdDev: metric.StdDev,
+		}
+	}
 	tq := pa.Combined.TestQuality
 	if tq == nil {
 		return ctx
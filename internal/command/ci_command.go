@@ -2,6 +2,7 @@ package command
 
 import (
 	"bufio"
+	"errors"
 
 	"github.com/ast-metrics/ast-metrics/internal/configuration"
 	"github.com/ast-metrics/ast-metrics/internal/engine"
@@ -35,8 +36,15 @@ func (c *CICommand) Execute() error {
 
 	// 2) Run full analysis with reports (non-interactive)
 	analyzeCmd := NewAnalyzeCommand(c.Configuration, c.outWriter, c.runners, false)
-	_ = analyzeCmd.Execute()
+	analyzeErr := analyzeCmd.Execute()
 
-	// 3) Return lint error (if any) so CI can fail
-	return lintErr
+	// 3) Return lint error (if any) so CI can fail, then parse errors when
+	// the configuration asks to fail on them
+	if lintErr != nil {
+		return lintErr
+	}
+	if errors.Is(analyzeErr, ErrParseErrors) {
+		return analyzeErr
+	}
+	return nil
 }
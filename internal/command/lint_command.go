@@ -13,6 +13,7 @@ import (
 	"github.com/ast-metrics/ast-metrics/internal/configuration"
 	"github.com/ast-metrics/ast-metrics/internal/engine"
 	"github.com/ast-metrics/ast-metrics/internal/report"
+	"github.com/ast-metrics/ast-metrics/internal/review"
 	pb "github.com/ast-metrics/ast-metrics/pb"
 	"github.com/pterm/pterm"
 	"golang.org/x/term"
@@ -24,6 +25,10 @@ type LintCommand struct {
 	outWriter     *bufio.Writer
 	runners       []engine.Engine
 	verbose       bool
+	// baseline is the file of the violations to hide, generateBaseline the
+	// file to write them to instead of reporting them.
+	baseline         string
+	generateBaseline string
 }
 
 // lintTestHook is a test hook to force an error during LintCommand execution.
@@ -32,6 +37,13 @@ var lintTestHook func() error
 
 func (c *LintCommand) SetVerbose(v bool) { c.verbose = v }
 
+// SetBaseline hides the violations listed in the baseline file.
+func (c *LintCommand) SetBaseline(path string) { c.baseline = path }
+
+// SetGenerateBaseline writes the violations found to a baseline file, rather
+// than failing on them.
+func (c *LintCommand) SetGenerateBaseline(path string) { c.generateBaseline = path }
+
 func NewLintCommand(configuration *configuration.Configuration, outWriter *bufio.Writer, runners []engine.Engine) *LintCommand {
 	return &LintCommand{
 		Configuration: configuration,
@@ -94,6 +106,7 @@ func (c *LintCommand) Execute() error {
 
 	// Aggregate to get project-level metrics (TestQuality etc.)
 	aggregator := analyzer.NewAggregator(allResults, nil)
+	aggregator.WithDuplicationThresholds(c.Configuration.Duplication.MinTokens, c.Configuration.Duplication.MinLines)
 	projectAggregated := aggregator.Aggregates()
 
 	if spinner != nil {
@@ -109,7 +122,31 @@ func (c *LintCommand) Execute() error {
 	projectCtx := buildProjectContext(projectAggregated)
 	evaluation := reqEval.Evaluate(allResults, requirement.ProjectAggregated{ProjectCtx: projectCtx})
 
-	// If SARIF path provided, write SARIF report from violations
+	// Baseline: paths are kept relative to the working directory
+	root, _ := os.Getwd()
+	if c.generateBaseline != "" {
+		baseline := review.NewBaseline(evaluation.Errors, root)
+		if err := baseline.Save(c.generateBaseline); err != nil {
+			return err
+		}
+		cli.PrintSuccess(fmt.Sprintf("Baseline generated: %s (%d violation(s))", c.generateBaseline, len(baseline.Entries)))
+		return nil
+	}
+	var fixed []review.BaselineEntry
+	hidden := 0
+	if c.baseline != "" {
+		baseline, err := review.LoadBaseline(c.baseline)
+		if err != nil {
+			return err
+		}
+		all := len(evaluation.Errors)
+		evaluation.Errors, fixed = baseline.Filter(evaluation.Errors, root)
+		hidden = all - len(evaluation.Errors)
+	}
+
+	// If SARIF path provided, write SARIF report from the violations left after
+	// the baseline and the suppressions, as printed below: the duplicated blocks
+	// come with the violations of max_duplication
 	if c.Configuration.Reports.Sarif != "" {
 		_, err := report.GenerateSarifFromOutcomes(c.Configuration.Reports.Sarif, evaluation.Errors, c.Configuration.Reports.SarifMaxLevel)
 		if err != nil {
@@ -201,6 +238,9 @@ func (c *LintCommand) Execute() error {
 			greyStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#888888"))
 
 			ruleStyled := greyStyle.Render(" #" + m.Rule + "")
+			if m.Cell > 0 {
+				ruleStyled = greyStyle.Render(fmt.Sprintf(" (cell %d, line %d)", m.Cell, m.Line)) + ruleStyled
+			}
 			content := "  • " + badge + stripPathPrefix(m.Message, f) + ruleStyled
 			fmt.Println(content)
 
@@ -240,6 +280,11 @@ func (c *LintCommand) Execute() error {
 		fmt.Println()
 	}
 
+	printSuppressions(evaluation.Suppressions)
+	if c.baseline != "" {
+		printBaselineFixes(c.baseline, hidden, fixed)
+	}
+
 	// Summary and exit code
 	if total == 0 {
 		return nil
@@ -249,6 +294,51 @@ func (c *LintCommand) Execute() error {
 	return fmt.Errorf("%d lint issue(s) found (%d high, %d medium, %d low)", total, totalHigh, totalMedium, totalLow)
 }
 
+// printSuppressions lists the suppression comments that silenced violations,
+// with their reason: what is accepted stays visible.
+func printSuppressions(suppressions []requirement.Suppression) {
+	if len(suppressions) == 0 {
+		return
+	}
+	underline := lipgloss.NewStyle().Underline(true).Bold(true)
+	greyStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#888888"))
+	fmt.Println(underline.Render(fmt.Sprintf("Suppressions (%d)", len(suppressions))))
+	for _, s := range suppressions {
+		position := fmt.Sprintf("%s:%d", s.File, s.Line)
+		if s.Cell > 0 {
+			position = fmt.Sprintf("%s (cell %d, line %d)", s.File, s.Cell, s.Line)
+		}
+		scope := "next line"
+		if s.Target == 0 {
+			scope = "whole file"
+		}
+		reason := s.Reason
+		if reason == "" {
+			reason = "no reason given"
+		}
+		fmt.Println("  • " + position + " — " + s.RulesLabel() + " (" + scope + "): " + reason +
+			greyStyle.Render(fmt.Sprintf(" %d violation(s) silenced", s.Used)))
+	}
+	fmt.Println()
+}
+
+// printBaselineFixes tells how many violations the baseline hid, and lists
+// its entries that no violation matches anymore, for the file to be shrunk.
+func printBaselineFixes(path string, hidden int, fixed []review.BaselineEntry) {
+	cli.PrintInfo(fmt.Sprintf("%d known violation(s) hidden by the baseline %s", hidden, path))
+	if len(fixed) == 0 {
+		return
+	}
+	underline := lipgloss.NewStyle().Underline(true).Bold(true)
+	greyStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#888888"))
+	fmt.Println(underline.Render(fmt.Sprintf("Fixed since the baseline (%d)", len(fixed))))
+	for _, entry := range fixed {
+		fmt.Println("  • " + entry.File + " — " + entry.Message + greyStyle.Render(" #"+entry.Rule))
+	}
+	fmt.Println("  These entries can be removed from " + path + ", or the baseline generated again.")
+	fmt.Println()
+}
+
 // extractPath tries to match a File.Path from analysis results inside the message string
 func extractPath(msg string, files []*pb.File) string {
 	for _, f := range files {
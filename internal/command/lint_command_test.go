@@ -2,7 +2,10 @@ package command
 
 import (
 	"bufio"
+	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/ast-metrics/ast-metrics/internal/configuration"
@@ -71,3 +74,87 @@ func TestExtractPathAndStrip(t *testing.T) {
 		t.Fatalf("stripPathPrefix did not strip anything")
 	}
 }
+
+func TestLintCommand_Execute_HidesTheViolationsOfTheBaseline(t *testing.T) {
+	work := storage.Default()
+	work.Purge()
+	work.Ensure()
+
+	cfg := configuration.NewConfiguration()
+	cfg.Storage = work
+	cfg.Requirements = configuration.NewConfigurationRequirements()
+	intVal := func(i int) *int { return &i }
+	cfg.Requirements.Rules.Volume.Loc = intVal(1)
+
+	dir := t.TempDir()
+	source := filepath.Join(dir, "lint_baseline.php")
+	if err := os.WriteFile(source, []byte("<?php\nfunction foo() {\n\techo 1;\n}\n"), 0644); err != nil {
+		t.Fatalf("failed to write source: %v", err)
+	}
+	cfg.SourcesToAnalyzePath = []string{source}
+	baseline := filepath.Join(dir, "baseline.json")
+	outWriter := bufio.NewWriter(os.Stdout)
+
+	generate := NewLintCommand(cfg, outWriter, []engine.Engine{&php.PhpRunner{}})
+	generate.SetGenerateBaseline(baseline)
+	if err := generate.Execute(); err != nil {
+		t.Fatalf("expected the baseline to be generated, got %v", err)
+	}
+
+	lint := NewLintCommand(cfg, outWriter, []engine.Engine{&php.PhpRunner{}})
+	lint.SetBaseline(baseline)
+	if err := lint.Execute(); err != nil {
+		t.Fatalf("expected the known violations to be hidden, got %v", err)
+	}
+}
+
+// The SARIF report of lint holds what the terminal shows: a duplicated block
+// hidden by the baseline does not come back as a result of its own.
+func TestLintCommand_Execute_SarifLeavesOutTheViolationsOfTheBaseline(t *testing.T) {
+	work := storage.Default()
+	work.Purge()
+	work.Ensure()
+
+	cfg := configuration.NewConfiguration()
+	cfg.Storage = work
+	cfg.Requirements = configuration.NewConfigurationRequirements()
+	intVal := func(i int) *int { return &i }
+	cfg.Requirements.Rules.Duplication = &configuration.ConfigurationDuplicationRules{MaxDuplication: intVal(1)}
+	cfg.Duplication = configuration.ConfigurationDuplication{MinTokens: 10, MinLines: 3}
+
+	dir := t.TempDir()
+	code := "<?php\nfunction %s($a) {\n\t$b = $a * 2;\n\tif ($b > 10) {\n\t\treturn $b - 1;\n\t}\n\treturn $b + 1;\n}\n"
+	for _, name := range []string{"first", "second"} {
+		source := filepath.Join(dir, name+".php")
+		if err := os.WriteFile(source, []byte(fmt.Sprintf(code, name)), 0644); err != nil {
+			t.Fatalf("failed to write source: %v", err)
+		}
+	}
+	cfg.SourcesToAnalyzePath = []string{dir}
+	baseline := filepath.Join(dir, "baseline.json")
+	outWriter := bufio.NewWriter(os.Stdout)
+
+	generate := NewLintCommand(cfg, outWriter, []engine.Engine{&php.PhpRunner{}})
+	generate.SetGenerateBaseline(baseline)
+	if err := generate.Execute(); err != nil {
+		t.Fatalf("expected the baseline to be generated, got %v", err)
+	}
+	content, err := os.ReadFile(baseline)
+	if err != nil || !strings.Contains(string(content), "duplication") {
+		t.Fatalf("expected the duplication in the baseline, got %s (%v)", content, err)
+	}
+
+	cfg.Reports.Sarif = filepath.Join(dir, "lint.sarif")
+	lint := NewLintCommand(cfg, outWriter, []engine.Engine{&php.PhpRunner{}})
+	lint.SetBaseline(baseline)
+	if err := lint.Execute(); err != nil {
+		t.Fatalf("expected the known violations to be hidden, got %v", err)
+	}
+	sarif, err := os.ReadFile(cfg.Reports.Sarif)
+	if err != nil {
+		t.Fatalf("expected a SARIF report, got %v", err)
+	}
+	if strings.Contains(string(sarif), "duplicat") {
+		t.Errorf("expected no duplicated block in the SARIF report, got %s", sarif)
+	}
+}
@@ -194,6 +194,7 @@ func (c *ReviewCommand) evaluateRequirements(files []*pb.File) []requirement.Rul
 		return nil
 	}
 	aggregator := analyzer.NewAggregator(files, nil)
+	aggregator.WithDuplicationThresholds(c.Configuration.Duplication.MinTokens, c.Configuration.Duplication.MinLines)
 	projectAggregated := aggregator.Aggregates()
 	evaluator := requirement.NewRequirementsEvaluator(*c.Configuration.Requirements)
 	evaluation := evaluator.Evaluate(files, requirement.ProjectAggregated{ProjectCtx: buildProjectContext(projectAggregated)})
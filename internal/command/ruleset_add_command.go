@@ -85,6 +85,21 @@ func (c *RulesetAddCommand) Execute() error {
 				cfg.Requirements.Rules.Testing = &configuration.ConfigurationTestingRules{}
 			}
 		},
+		"duplication": func() {
+			if cfg.Requirements.Rules.Duplication == nil {
+				cfg.Requirements.Rules.Duplication = &configuration.ConfigurationDuplicationRules{}
+			}
+		},
+		"distribution": func() {
+			if cfg.Requirements.Rules.Distribution == nil {
+				cfg.Requirements.Rules.Distribution = &configuration.ConfigurationDistributionRules{}
+			}
+		},
+		"documentation": func() {
+			if cfg.Requirements.Rules.Documentation == nil {
+				cfg.Requirements.Rules.Documentation = &configuration.ConfigurationDocumentationRules{}
+			}
+		},
 	}
 	if f, ok := ensureCategory[c.Name]; ok {
 		f()
@@ -132,10 +147,34 @@ func (c *RulesetAddCommand) Execute() error {
 		if cfg.Requirements.Rules.Complexity.Cyclomatic == nil {
 			cfg.Requirements.Rules.Complexity.Cyclomatic = intVal(10)
 		}
+		if cfg.Requirements.Rules.Complexity.Cognitive == nil {
+			cfg.Requirements.Rules.Complexity.Cognitive = intVal(15)
+		}
+		if cfg.Requirements.Rules.Complexity.NPath == nil {
+			cfg.Requirements.Rules.Complexity.NPath = intVal(200)
+		}
+		if cfg.Requirements.Rules.Complexity.AbcSize == nil {
+			cfg.Requirements.Rules.Complexity.AbcSize = intVal(17)
+		}
 	case "object-oriented-programming":
 		if cfg.Requirements.Rules.ObjectOrientedProgramming.Maintainability == nil {
 			cfg.Requirements.Rules.ObjectOrientedProgramming.Maintainability = intVal(70)
 		}
+		if cfg.Requirements.Rules.ObjectOrientedProgramming.MaxWmc == nil {
+			cfg.Requirements.Rules.ObjectOrientedProgramming.MaxWmc = intVal(50)
+		}
+		if cfg.Requirements.Rules.ObjectOrientedProgramming.MaxDit == nil {
+			cfg.Requirements.Rules.ObjectOrientedProgramming.MaxDit = intVal(5)
+		}
+		if cfg.Requirements.Rules.ObjectOrientedProgramming.MaxNoc == nil {
+			cfg.Requirements.Rules.ObjectOrientedProgramming.MaxNoc = intVal(10)
+		}
+		if cfg.Requirements.Rules.ObjectOrientedProgramming.MaxCbo == nil {
+			cfg.Requirements.Rules.ObjectOrientedProgramming.MaxCbo = intVal(14)
+		}
+		if cfg.Requirements.Rules.ObjectOrientedProgramming.MaxRfc == nil {
+			cfg.Requirements.Rules.ObjectOrientedProgramming.MaxRfc = intVal(50)
+		}
 	case "golang":
 		if cfg.Requirements.Rules.Golang == nil {
 			cfg.Requirements.Rules.Golang = &configuration.ConfigurationGolangRuleset{}
@@ -165,6 +204,46 @@ func (c *RulesetAddCommand) Execute() error {
 			f := 20.0
 			cfg.Requirements.Rules.Testing.MaxOrphanWeight = &f
 		}
+	case "duplication":
+		if cfg.Requirements.Rules.Duplication.MaxDuplication == nil {
+			cfg.Requirements.Rules.Duplication.MaxDuplication = intVal(5)
+		}
+	case "distribution":
+		if len(cfg.Requirements.Rules.Distribution.Max) == 0 {
+			cfg.Requirements.Rules.Distribution.Max = []configuration.ConfigurationDistributionThreshold{
+				{Metric: "cyclomatic_per_method", Statistic: "p90", Value: 8},
+				{Metric: "loc_per_method", Statistic: "p90", Value: 40},
+			}
+		}
+	case "documentation":
+		if cfg.Requirements.Rules.Documentation.MinDocCoverage == nil {
+			cfg.Requirements.Rules.Documentation.MinDocCoverage = intVal(80)
+		}
+	case "custom":
+		if len(cfg.Requirements.Rules.Custom) == 0 {
+			cfg.Requirements.Rules.Custom = []configuration.ConfigurationCustomRule{
+				{
+					Name:       "complex_and_long_functions",
+					Scope:      "function",
+					Expression: "cyclomatic > 10 && loc > 80 && !is_test",
+					Severity:   "medium",
+					Message:    "{name}() is complex ({cyclomatic}) and long ({loc} lines)",
+				},
+			}
+		}
+	case "queries":
+		if len(cfg.Requirements.Rules.Queries) == 0 {
+			cfg.Requirements.Rules.Queries = []configuration.ConfigurationQueryRule{
+				{
+					Name:     "no_console_log",
+					Language: "javascript",
+					Query:    `((call_expression function: (member_expression object: (identifier) @object property: (property_identifier) @method)) @match (#eq? @object "console") (#eq? @method "log"))`,
+					Paths:    []string{"src/"},
+					Severity: "low",
+					Message:  "Remove the call to console.{method}",
+				},
+			}
+		}
 	}
 
 	// Save back to file
@@ -3,6 +3,7 @@ package configuration
 import (
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 
 	storage "github.com/ast-metrics/ast-metrics/internal/storage"
@@ -30,9 +31,15 @@ type Configuration struct {
 	// if not empty, compare the current analysis with the one in this branch / commit
 	CompareWith string `yaml:"comparewith,omitempty"`
 
+	// if true, the analysis fails when a file could not be parsed or holds syntax errors
+	FailOnParseError bool `yaml:"fail_on_parse_error,omitempty"`
+
 	// Extra file extensions per language (e.g. {"php": [".inc", ".module"]})
 	Extensions map[string][]string `yaml:"extensions,omitempty"`
 
+	// Thresholds of the detection of duplicated code
+	Duplication ConfigurationDuplication `yaml:"duplication,omitempty"`
+
 	// Location of cache files
 	Storage *storage.Workdir `yaml:"-"`
 
@@ -62,9 +69,43 @@ func (c *ConfigurationReport) HasReports() bool {
 	return c.Html != "" || c.Markdown != "" || c.Json != "" || c.OpenMetrics != "" || c.Sarif != ""
 }
 
+// ConfigurationDuplication sets how long a block of code must be to be
+// reported as duplicated. Zero keeps the default threshold.
+type ConfigurationDuplication struct {
+	MinTokens int `yaml:"min_tokens,omitempty"`
+	MinLines  int `yaml:"min_lines,omitempty"`
+}
+
 type ConfigurationRequirements struct {
-	Rules   *ConfigurationRequirementsRules `yaml:"rules"`
-	Exclude []string                        `yaml:"exclude,omitempty"`
+	Rules        *ConfigurationRequirementsRules `yaml:"rules"`
+	Exclude      []string                        `yaml:"exclude,omitempty"`
+	Suppressions *ConfigurationSuppressions      `yaml:"suppressions,omitempty"`
+	// Severity remaps the severity of the violations of a rule (max_loc: low).
+	Severity map[string]string `yaml:"severity,omitempty"`
+	// Overrides replace the thresholds of the rules for some paths.
+	Overrides []ConfigurationRequirementsOverride `yaml:"overrides,omitempty"`
+}
+
+// ConfigurationRequirementsOverride replaces the thresholds of some rules, or
+// the severity of their violations, for the files matching one of its paths:
+// a legacy module, generated clients...
+type ConfigurationRequirementsOverride struct {
+	Paths    []string                        `yaml:"paths"` // globs: legacy/**, **/*.pb.go
+	Rules    *ConfigurationRequirementsRules `yaml:"rules,omitempty"`
+	Severity map[string]string               `yaml:"severity,omitempty"`
+}
+
+// ConfigurationSuppressions sets how the comments suppressing a violation
+// ("ast-metrics-ignore-next-line") are accepted.
+type ConfigurationSuppressions struct {
+	// RequireReason rejects the suppressions that do not say why they are
+	// there. True when not set.
+	RequireReason *bool `yaml:"require_reason,omitempty"`
+}
+
+// ReasonRequired reports whether a suppression must give its reason.
+func (c *ConfigurationSuppressions) ReasonRequired() bool {
+	return c == nil || c.RequireReason == nil || *c.RequireReason
 }
 
 type ConfigurationCouplingRule struct {
@@ -72,6 +113,14 @@ type ConfigurationCouplingRule struct {
 		From string `yaml:"from"`
 		To   string `yaml:"to"`
 	} `yaml:"forbidden,omitempty"`
+	Annotated []ConfigurationAnnotatedCoupling `yaml:"annotated,omitempty"`
+}
+
+// ConfigurationAnnotatedCoupling forbids the classes carrying an annotation
+// (or an attribute, or a decorator) to depend on some classes.
+type ConfigurationAnnotatedCoupling struct {
+	Annotation string `yaml:"annotation"`
+	To         string `yaml:"to"`
 }
 
 type ConfigurationArchitectureRules struct {
@@ -98,25 +147,78 @@ type ConfigurationVolumeRules struct {
 
 type ConfigurationComplexityRules struct {
 	Cyclomatic *int `yaml:"max_cyclomatic,omitempty"`
+	Cognitive  *int `yaml:"max_cognitive,omitempty"`
+	NPath      *int `yaml:"max_npath,omitempty"`
+	AbcSize    *int `yaml:"max_abc_size,omitempty"`
 }
 
 type ConfigurationOOPRules struct {
 	Maintainability *int `yaml:"min_maintainability,omitempty"`
+	MaxWmc          *int `yaml:"max_wmc,omitempty"`
+	MaxDit          *int `yaml:"max_dit,omitempty"`
+	MaxNoc          *int `yaml:"max_noc,omitempty"`
+	MaxCbo          *int `yaml:"max_cbo,omitempty"`
+	MaxRfc          *int `yaml:"max_rfc,omitempty"`
 }
 
 type ConfigurationRequirementsRules struct {
 	// New nested rulesets
-	Architecture              *ConfigurationArchitectureRules `yaml:"architecture,omitempty"`
-	Volume                    *ConfigurationVolumeRules       `yaml:"volume,omitempty"`
-	Complexity                *ConfigurationComplexityRules   `yaml:"complexity,omitempty"`
-	ObjectOrientedProgramming *ConfigurationOOPRules          `yaml:"object-oriented-programming,omitempty"`
-	Golang                    *ConfigurationGolangRuleset     `yaml:"golang,omitempty"`
-	Testing                   *ConfigurationTestingRules      `yaml:"testing,omitempty"`
+	Architecture              *ConfigurationArchitectureRules  `yaml:"architecture,omitempty"`
+	Volume                    *ConfigurationVolumeRules        `yaml:"volume,omitempty"`
+	Complexity                *ConfigurationComplexityRules    `yaml:"complexity,omitempty"`
+	ObjectOrientedProgramming *ConfigurationOOPRules           `yaml:"object-oriented-programming,omitempty"`
+	Golang                    *ConfigurationGolangRuleset      `yaml:"golang,omitempty"`
+	Testing                   *ConfigurationTestingRules       `yaml:"testing,omitempty"`
+	Duplication               *ConfigurationDuplicationRules   `yaml:"duplication,omitempty"`
+	Distribution              *ConfigurationDistributionRules  `yaml:"distribution,omitempty"`
+	Documentation             *ConfigurationDocumentationRules `yaml:"documentation,omitempty"`
+
+	// Rules written as expressions over the metrics, with no Go code
+	Custom []ConfigurationCustomRule `yaml:"custom,omitempty"`
+	// Rules written as tree-sitter queries, for the structure of the code
+	Queries []ConfigurationQueryRule `yaml:"queries,omitempty"`
 
 	// Legacy flat rules support for backward compatibility
 	CyclomaticLegacy *ConfigurationDefaultRule `yaml:"cyclomatic_complexity,omitempty"`
 }
 
+// Override returns the rules with those of the override laid over them: a
+// threshold set by the override replaces the one of the rules, the others
+// are kept. Lists (custom rules, distribution thresholds...) are replaced as
+// a whole. Neither of them is modified.
+func (c *ConfigurationRequirementsRules) Override(override *ConfigurationRequirementsRules) *ConfigurationRequirementsRules {
+	if override == nil {
+		return c
+	}
+	if c == nil {
+		return override
+	}
+	return overlay(reflect.ValueOf(c), reflect.ValueOf(override)).Interface().(*ConfigurationRequirementsRules)
+}
+
+// overlay returns the value set over base: the value itself, or for a
+// structure, a copy of base with the fields set over it.
+func overlay(base reflect.Value, over reflect.Value) reflect.Value {
+	switch {
+	case over.IsZero():
+		return base
+	case base.IsZero():
+		return over
+	case over.Kind() == reflect.Pointer && over.Elem().Kind() == reflect.Struct:
+		merged := reflect.New(over.Elem().Type())
+		merged.Elem().Set(overlay(base.Elem(), over.Elem()))
+		return merged
+	case over.Kind() == reflect.Struct:
+		merged := reflect.New(over.Type()).Elem()
+		merged.Set(base)
+		for i := 0; i < over.NumField(); i++ {
+			merged.Field(i).Set(overlay(base.Field(i), over.Field(i)))
+		}
+		return merged
+	}
+	return over
+}
+
 type ConfigurationTestingRules struct {
 	MinTraceability   *int     `yaml:"min_traceability,omitempty"`
 	MinIsolationScore *int     `yaml:"min_isolation_score,omitempty"`
@@ -124,6 +226,57 @@ type ConfigurationTestingRules struct {
 	MaxOrphanWeight   *float64 `yaml:"max_orphan_weight,omitempty"`
 }
 
+type ConfigurationDuplicationRules struct {
+	// Maximum percentage of the lines of code belonging to a duplicated block
+	MaxDuplication *int `yaml:"max_duplication,omitempty"`
+}
+
+type ConfigurationDocumentationRules struct {
+	// Minimum percentage of the public classes and functions written with a
+	// documentation (doc comment, docstring)
+	MinDocCoverage *int `yaml:"min_doc_coverage,omitempty"`
+}
+
+// ConfigurationDistributionRules bounds a statistic (p90, median...) of the
+// aggregated metrics of the project. Ex: the p90 of the cyclomatic complexity
+// per method must stay below 8.
+type ConfigurationDistributionRules struct {
+	Max []ConfigurationDistributionThreshold `yaml:"max,omitempty"`
+	Min []ConfigurationDistributionThreshold `yaml:"min,omitempty"`
+}
+
+type ConfigurationDistributionThreshold struct {
+	Metric    string  `yaml:"metric"`    // cyclomatic_per_method, loc_per_method, maintainability_per_method...
+	Statistic string  `yaml:"statistic"` // avg, min, max, median, p75, p90, p95, p99 or stddev
+	Value     float64 `yaml:"value"`
+}
+
+// ConfigurationCustomRule is a rule declared in the configuration: each file,
+// class or function (the scope) matching the expression is reported. Ex: the
+// functions where "cyclomatic > 10 && loc > 80 && !is_test".
+type ConfigurationCustomRule struct {
+	Name       string   `yaml:"name"`
+	Scope      string   `yaml:"scope"`              // file, class or function
+	Expression string   `yaml:"expression"`         // ex: cyclomatic > 10 && loc > 80 && !is_test
+	Paths      []string `yaml:"paths,omitempty"`    // globs (src/domain/**); when set, only the matching files are checked
+	Severity   string   `yaml:"severity,omitempty"` // low, medium (default) or high
+	Message    string   `yaml:"message,omitempty"`  // ex: "{name} is too complex ({cyclomatic})"
+}
+
+// ConfigurationQueryRule is a rule declared in the configuration as a
+// tree-sitter query: each piece of code of the language matching the query
+// is reported. The node reported is the one captured as @match, or else the
+// first capture. Ex: the calls to fmt.Println outside of cmd/.
+type ConfigurationQueryRule struct {
+	Name     string   `yaml:"name"`
+	Language string   `yaml:"language"`           // go, php, python, javascript... as in the extensions
+	Query    string   `yaml:"query"`              // ex: ((call_expression function: (_) @fn) @match (#eq? @fn "fmt.Println"))
+	Paths    []string `yaml:"paths,omitempty"`    // globs (src/**); when set, only the matching files are checked
+	Exclude  []string `yaml:"exclude,omitempty"`  // globs of the files left out (cmd/**)
+	Severity string   `yaml:"severity,omitempty"` // low, medium (default) or high
+	Message  string   `yaml:"message,omitempty"`  // ex: "Use the logger rather than {fn}"
+}
+
 // ConfigurationGolangRuleset toggles for Golang-specific best-practice rules (per-rule)
 // If a field is set to true, the corresponding rule is enabled. Omitting or false disables it.
 type ConfigurationGolangRuleset struct {
@@ -206,13 +359,26 @@ func (c *Configuration) SetExcludePatterns(patterns []string) {
 	(*c).ExcludePatterns = patterns
 }
 
-var defaultExtensions = map[string]string{
-	"php": ".php", "go": ".go", "python": ".py", "rust": ".rs", "typescript": ".ts",
-	"java": ".java", "csharp": ".cs",
+var defaultExtensions = map[string][]string{
+	"php": {".php"}, "go": {".go"}, "python": {".py", ".ipynb"}, "rust": {".rs"}, "typescript": {".ts"},
+	"java": {".java"}, "csharp": {".cs"},
+	"javascript": {".js", ".jsx", ".mjs", ".cjs"},
+	"kotlin":     {".kt", ".kts"},
+	"ruby":       {".rb", ".rake"},
+	"c":          {".c", ".h"},
+	"cpp":        {".cpp", ".cc", ".cxx", ".hpp", ".hh", ".hxx"},
+	"scala":      {".scala", ".sc"},
+	"groovy":     {".groovy", ".gvy", ".gradle"},
+	"swift":      {".swift"},
+	"elixir":     {".ex", ".exs"},
+	"lua":        {".lua"},
+	// single-file components, parsed by the TypeScript or JavaScript engine
+	"vue":    {".vue"},
+	"svelte": {".svelte"},
 }
 
 func (c *Configuration) GetExtensionsForLanguage(lang string) []string {
-	base := []string{defaultExtensions[lang]}
+	base := append([]string{}, defaultExtensions[lang]...)
 	if c.Extensions != nil {
 		for _, ext := range c.Extensions[lang] {
 			if !strings.HasPrefix(ext, ".") {
@@ -109,6 +109,11 @@ exclude:
 # extensions:
 #   php: [".inc", ".module", ".install", ".theme"]
 
+# Size from which a block of code found twice is reported as duplicated
+# duplication:
+#   min_tokens: 100
+#   min_lines: 10
+
 # Reports to generate
 reports:
   html: ./build/report
@@ -119,6 +124,22 @@ requirements:
   # Files matching these patterns are excluded from requirement checks
   # exclude:
   #   - /tests/
+  # A violation can be accepted in the code, with its reason:
+  #   // ast-metrics-ignore-next-line max_cognitive: legacy parser, see ADR-12
+  # suppressions:
+  #   require_reason: true
+  # Severity of the violations of some rules (low, medium or high)
+  # severity:
+  #   max_cognitive: high
+  # Thresholds of some rules for some paths (globs); the last match wins
+  # overrides:
+  #   - paths: ["legacy/**"]
+  #     rules:
+  #       complexity:
+  #         max_cyclomatic: 30
+  #   - paths: ["clients/**"]
+  #     severity:
+  #       max_loc: low
   rules:
     architecture:
       # Coupling between components
@@ -128,6 +149,11 @@ requirements:
           # Regular expressions are used
           - from: "Model"
             to: "Controller"
+        # annotated:
+        #   # Fails if a class annotated @Controller (or #[Controller],
+        #   # [Controller]) depends on a Repository
+        #   - annotation: "Controller"
+        #     to: "Repository"
       # max_afferent_coupling: 10
       # max_efferent_coupling: 10
       # min_maintainability: 70
@@ -142,6 +168,63 @@ requirements:
     complexity:
       # Maximum cyclomatic complexity
       max_cyclomatic: 10
+      # Maximum cognitive complexity by method
+      # max_cognitive: 15
+      # Maximum number of execution paths by method (NPath complexity)
+      # max_npath: 200
+      # Maximum ABC size by method (assignments, branches and conditions)
+      # max_abc_size: 17
+
+    # Design of classes (Chidamber & Kemerer metrics)
+    # object-oriented-programming:
+    #   max_wmc: 50
+    #   max_dit: 5
+    #   max_noc: 10
+    #   max_cbo: 14
+    #   max_rfc: 50
+
+    # Maximum percentage of duplicated lines of code
+    # duplication:
+    #   max_duplication: 5
+
+    # Bounds on the distribution of a metric: averages hide the long tail
+    # (statistics: avg, min, max, median, p75, p90, p95, p99, stddev)
+    # distribution:
+    #   max:
+    #     - metric: cyclomatic_per_method
+    #       statistic: p90
+    #       value: 8
+    #   min:
+    #     - metric: maintainability_per_method
+    #       statistic: median
+    #       value: 70
+
+    # Minimum percentage of documented public classes and functions
+    # documentation:
+    #   min_doc_coverage: 80
+
+    # Rules of your own, as expressions over the metrics of each file, class or
+    # function. Ex: the long and complex functions outside of tests
+    # custom:
+    #   - name: complex_and_long_functions
+    #     scope: function # file, class or function
+    #     expression: cyclomatic > 10 && loc > 80 && !is_test
+    #     paths: ["src/**"]
+    #     severity: high
+    #     message: "{name}() is complex ({cyclomatic}) and long ({loc} lines)"
+
+    # Rules of your own on the structure of the code, as tree-sitter queries.
+    # The node captured as @match is reported. Ex: fmt.Println outside of cmd/
+    # queries:
+    #   - name: no_println
+    #     language: go
+    #     query: |
+    #       ((call_expression
+    #          function: (selector_expression operand: (identifier) @pkg field: (field_identifier) @fn)) @match
+    #        (#eq? @pkg "fmt") (#match? @fn "^Print"))
+    #     exclude: ["cmd/**"]
+    #     severity: low
+    #     message: "Use the logger rather than fmt.{fn}"
 `)
 
 	if err != nil {
@@ -39,6 +39,14 @@ func TestGetExtensionsForLanguage(t *testing.T) {
 		}
 	})
 
+	t.Run("returns every default extension of a language", func(t *testing.T) {
+		config := NewConfiguration()
+		exts := config.GetExtensionsForLanguage("javascript")
+		if len(exts) != 4 || exts[0] != ".js" || exts[1] != ".jsx" || exts[2] != ".mjs" || exts[3] != ".cjs" {
+			t.Errorf("Expected [.js .jsx .mjs .cjs], got %v", exts)
+		}
+	})
+
 	t.Run("merges extra extensions with default", func(t *testing.T) {
 		config := NewConfiguration()
 		config.Extensions = map[string][]string{
@@ -88,3 +96,36 @@ func TestConfigurationAcceptsExcludePatterns(t *testing.T) {
 		t.Errorf("ExcludePatterns = %s; want %s", configuration.ExcludePatterns[0], "/foo")
 	}
 }
+
+func TestRulesOverride(t *testing.T) {
+	intVal := func(i int) *int { return &i }
+	rules := &ConfigurationRequirementsRules{
+		Complexity: &ConfigurationComplexityRules{Cyclomatic: intVal(10), Cognitive: intVal(15)},
+		Volume:     &ConfigurationVolumeRules{Loc: intVal(500)},
+		Custom:     []ConfigurationCustomRule{{Name: "a"}, {Name: "b"}},
+	}
+	override := &ConfigurationRequirementsRules{
+		Complexity:    &ConfigurationComplexityRules{Cyclomatic: intVal(30)},
+		Documentation: &ConfigurationDocumentationRules{MinDocCoverage: intVal(50)},
+		Custom:        []ConfigurationCustomRule{{Name: "c"}},
+	}
+
+	merged := rules.Override(override)
+
+	if *merged.Complexity.Cyclomatic != 30 || *merged.Complexity.Cognitive != 15 {
+		t.Errorf("complexity = %d, %d; want 30, 15", *merged.Complexity.Cyclomatic, *merged.Complexity.Cognitive)
+	}
+	if *merged.Volume.Loc != 500 || *merged.Documentation.MinDocCoverage != 50 {
+		t.Errorf("rules set on one side only should be kept")
+	}
+	if len(merged.Custom) != 1 || merged.Custom[0].Name != "c" {
+		t.Errorf("Custom = %v; want the rules of the override", merged.Custom)
+	}
+	// neither side is modified
+	if *rules.Complexity.Cyclomatic != 10 || rules.Documentation != nil || override.Complexity.Cognitive != nil {
+		t.Errorf("Override modified the rules it merges")
+	}
+	if rules.Override(nil) != rules {
+		t.Errorf("no override should give the rules as they are")
+	}
+}
@@ -0,0 +1,45 @@
+package configuration
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// CompileGlob turns a glob of paths into a regular expression. "**" matches
+// any number of directories, "*" and "?" any characters but "/". A glob
+// that does not start with "/" matches from any directory, and a directory
+// matches the files it holds: "legacy" and "legacy/**" are the same.
+//
+// The paths of the configuration are globs, matched against the paths of the
+// analyzed files, whether they are relative or absolute.
+func CompileGlob(glob string) (*regexp.Regexp, error) {
+	if strings.TrimSpace(glob) == "" {
+		return nil, fmt.Errorf("empty path")
+	}
+	var b strings.Builder
+	if strings.HasPrefix(glob, "/") {
+		b.WriteString("^")
+	} else {
+		b.WriteString("(^|/)")
+	}
+	pattern := strings.TrimSuffix(strings.TrimSuffix(glob, "/**"), "/")
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case strings.HasPrefix(pattern[i:], "**/"):
+			b.WriteString("([^/]*/)*")
+			i += 2
+		case strings.HasPrefix(pattern[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case c == '*':
+			b.WriteString("[^/]*")
+		case c == '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteString("(/|$)")
+	return regexp.Compile(b.String())
+}
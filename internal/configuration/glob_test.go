@@ -0,0 +1,34 @@
+package configuration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompileGlob(t *testing.T) {
+	cases := []struct {
+		glob    string
+		matches []string
+		misses  []string
+	}{
+		{"legacy/**", []string{"legacy/a.go", "src/legacy/deep/a.go", "/abs/legacy/a.go"}, []string{"legacy.go", "src/legacy_v2/a.go"}},
+		{"legacy", []string{"legacy/a.go", "src/legacy"}, []string{"src/legacyx/a.go"}},
+		{"**/*.pb.go", []string{"a.pb.go", "api/v1/a.pb.go"}, []string{"api/v1/a.go", "a.pb.go.txt"}},
+		{"clients/*/gen.go", []string{"clients/billing/gen.go"}, []string{"clients/billing/v1/gen.go"}},
+		{"/src/main.?s", []string{"/src/main.js", "/src/main.ts"}, []string{"app/src/main.js", "/src/main.tsx"}},
+	}
+	for _, tc := range cases {
+		re, err := CompileGlob(tc.glob)
+		assert.NoError(t, err)
+		for _, path := range tc.matches {
+			assert.True(t, re.MatchString(path), "%s should match %s", tc.glob, path)
+		}
+		for _, path := range tc.misses {
+			assert.False(t, re.MatchString(path), "%s should not match %s", tc.glob, path)
+		}
+	}
+
+	_, err := CompileGlob(" ")
+	assert.Error(t, err)
+}
@@ -0,0 +1,33 @@
+package engine
+
+import (
+	"math"
+
+	pb "github.com/ast-metrics/ast-metrics/pb"
+)
+
+// NewAbcSize returns the ABC vector made of the counts of assignments,
+// branches and conditions, with its magnitude sqrt(A² + B² + C²) rounded to
+// two decimals.
+func NewAbcSize(assignments, branches, conditions int32) *pb.AbcSize {
+	a, b, c := float64(assignments), float64(branches), float64(conditions)
+	magnitude := math.Round(math.Sqrt(a*a+b*b+c*c)*100) / 100
+	return &pb.AbcSize{
+		Assignments: &assignments,
+		Branches:    &branches,
+		Conditions:  &conditions,
+		Magnitude:   &magnitude,
+	}
+}
+
+// SumAbcSize adds up ABC vectors: the size of a class or of a file is the
+// magnitude of the sum, not the sum of the magnitudes.
+func SumAbcSize(sizes ...*pb.AbcSize) *pb.AbcSize {
+	var assignments, branches, conditions int32
+	for _, abc := range sizes {
+		assignments += abc.GetAssignments()
+		branches += abc.GetBranches()
+		conditions += abc.GetConditions()
+	}
+	return NewAbcSize(assignments, branches, conditions)
+}
@@ -60,8 +60,20 @@ func DumpFiles(
 				opts.BeforeParse(path)
 			}
 
-			if file, err := parse(path); err == nil && file != nil {
-				if opts.AfterParse != nil {
+			file, err := parse(path)
+			if err != nil {
+				// the file is kept, with its error: it is reported as a file
+				// that could not be analyzed instead of vanishing
+				if file == nil {
+					file = &pb.File{Path: path, ProgrammingLanguage: opts.Label}
+				}
+				if file.Stmts == nil {
+					file.Stmts = FactoryStmts()
+				}
+				file.Errors = append(file.Errors, err.Error())
+			}
+			if file != nil {
+				if err == nil && opts.AfterParse != nil {
 					opts.AfterParse(file)
 				}
 				mu.Lock()
@@ -0,0 +1,123 @@
+package c
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/ast-metrics/ast-metrics/internal/configuration"
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	"github.com/ast-metrics/ast-metrics/internal/engine/cpp"
+	Treesitter "github.com/ast-metrics/ast-metrics/internal/engine/treesitter"
+	"github.com/ast-metrics/ast-metrics/internal/file"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+
+	"github.com/pterm/pterm"
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+type CRunner struct {
+	progressbar   *pterm.SpinnerPrinter
+	Configuration *configuration.Configuration
+	foundFiles    file.FileList
+}
+
+func (r CRunner) Name() string                                     { return "C" }
+func (r CRunner) IsRequired() bool                                 { return len(r.getFileList().Files) > 0 }
+func (r *CRunner) Ensure() error                                   { return nil }
+func (r *CRunner) SetProgressbar(p *pterm.SpinnerPrinter)          { r.progressbar = p }
+func (r *CRunner) SetConfiguration(c *configuration.Configuration) { r.Configuration = c }
+
+func (r CRunner) DumpAST() []*pb.File {
+	return engine.DumpFiles(
+		r.getFileList().Files,
+		r.progressbar,
+		func(path string) (*pb.File, error) { return r.Parse(path) },
+		engine.DumpOptions{Label: r.Name()},
+	)
+}
+
+func (r CRunner) Finish() error {
+	if r.progressbar != nil {
+		r.progressbar.Stop()
+	}
+	return nil
+}
+
+func (r CRunner) Parse(path string) (*pb.File, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return &pb.File{Path: path, ProgrammingLanguage: "C"}, err
+	}
+
+	parser := sitter.NewParser()
+	adapter := NewTreeSitterAdapter(src)
+	parser.SetLanguage(adapter.Language())
+
+	tree := parser.Parse(nil, src)
+	root := tree.RootNode()
+	adapter.SetRootNode(root)
+
+	v := Treesitter.NewVisitor(adapter, path, src)
+	v.SetQueries(Treesitter.QueriesFor(r.Configuration, "c", path))
+	v.Visit(root)
+
+	file := v.Result()
+	file.ProgrammingLanguage = "C"
+
+	// Detect if file is a test file
+	file.IsTest = r.isTestFile(path, src)
+
+	return file, nil
+}
+
+func (r *CRunner) getFileList() file.FileList {
+	if r.foundFiles.Files != nil {
+		return r.foundFiles
+	}
+	finder := file.Finder{Configuration: *r.Configuration}
+	if r.Configuration.FileDiscovery != nil {
+		if fd, ok := r.Configuration.FileDiscovery.(*file.FileDiscovery); ok {
+			finder.Discovery = fd
+		}
+	}
+	extensions := r.Configuration.GetExtensionsForLanguage("c")
+	var lists []file.FileList
+	for _, ext := range extensions {
+		lists = append(lists, finder.Search(ext))
+	}
+	merged := file.MergeFileLists(lists...)
+
+	// a header paired with a C++ source file is analyzed by the C++ engine
+	files := make([]string, 0, len(merged.Files))
+	for _, path := range merged.Files {
+		if !cpp.HasPairedSource(path) {
+			files = append(files, path)
+		}
+	}
+	merged.Files = files
+
+	r.foundFiles = merged
+	return r.foundFiles
+}
+
+var cTestMarkers = regexp.MustCompile(`#include\s*[<"](check\.h|cmocka\.h|unity\.h|CUnit/|criterion/)`)
+
+// isTestFile determines if a C file is a test file based on:
+// 1. Filename patterns (foo_test.c, test_foo.c)
+// 2. Conventional test directories (test/, tests/)
+// 3. Test framework includes (Check, cmocka, Unity, CUnit, Criterion)
+func (r CRunner) isTestFile(path string, src []byte) bool {
+	base := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	if strings.HasSuffix(base, "_test") || strings.HasPrefix(base, "test_") {
+		return true
+	}
+
+	normalized := "/" + filepath.ToSlash(filepath.Dir(path)) + "/"
+	if strings.Contains(normalized, "/test/") || strings.Contains(normalized, "/tests/") {
+		return true
+	}
+
+	return cTestMarkers.Match(src)
+}
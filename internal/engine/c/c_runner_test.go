@@ -0,0 +1,178 @@
+package c
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ast-metrics/ast-metrics/internal/configuration"
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func parseC(t *testing.T, src string) *pb.File {
+	t.Helper()
+	result, err := engine.CreateTestFileWithCode(&CRunner{}, src)
+	assert.Nil(t, err, "Expected no error, got %v", err)
+	assert.NotNil(t, result)
+	return result
+}
+
+func findFunction(file *pb.File, name string) *pb.StmtFunction {
+	for _, fn := range engine.GetFunctionsInFile(file) {
+		if fn.Name != nil && fn.Name.Short == name {
+			return fn
+		}
+	}
+	return nil
+}
+
+func writeFile(t *testing.T, path string, code string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("failed to create dir: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(code), 0644); err != nil {
+		t.Fatalf("failed to create file: %v", err)
+	}
+}
+
+func TestCStructsAndFunctions(t *testing.T) {
+	src := `#include <stdio.h>
+#include "list.h"
+
+struct node {
+    int value;
+    struct node *next;
+    int (*compare)(int, int);
+};
+
+typedef struct {
+    int r, g;
+} color_t;
+
+struct node n;
+
+static int *find(struct node *head, const char *name, ...) {
+    return &head->value;
+}
+`
+	result := parseC(t, src)
+	assert.Equal(t, "C", result.ProgrammingLanguage)
+
+	classes := engine.GetClassesInFile(result)
+	assert.Equal(t, 2, len(classes), "struct definitions only")
+	assert.Equal(t, "node", classes[0].Name.Short)
+	operands := []string{}
+	for _, op := range classes[0].Operands {
+		operands = append(operands, op.Name)
+	}
+	assert.ElementsMatch(t, []string{"value", "next"}, operands)
+	assert.Equal(t, "color_t", classes[1].Name.Short)
+
+	find := findFunction(result, "find")
+	assert.NotNil(t, find)
+	assert.Equal(t, 2, len(find.Parameters))
+	assert.Equal(t, "head", find.Parameters[0].Name)
+	assert.Equal(t, "name", find.Parameters[1].Name)
+
+	deps := map[string]bool{}
+	for _, dep := range engine.GetDependenciesInFile(result) {
+		deps[dep.Namespace] = true
+	}
+	assert.True(t, deps["stdio.h"])
+	assert.True(t, deps["list.h"])
+}
+
+func TestCDecisions(t *testing.T) {
+	src := `
+int decide(int a) {
+    if (a > 0) {
+        a++;
+    } else if (a == 0) {
+        a--;
+    } else {
+        a = 1;
+    }
+    for (int i = 0; i < a; i++) {}
+    while (a > 0) { a--; }
+    do { a++; } while (a < 3);
+    switch (a) {
+        case 1: return 1;
+        default: return 0;
+    }
+}
+`
+	result := parseC(t, src)
+	fn := findFunction(result, "decide")
+	assert.NotNil(t, fn)
+	assert.Equal(t, 2, len(fn.Stmts.StmtDecisionIf))
+	assert.Equal(t, 1, len(fn.Stmts.StmtDecisionElse))
+	assert.Equal(t, 3, len(fn.Stmts.StmtLoop))
+	assert.Equal(t, 1, len(fn.Stmts.StmtDecisionSwitch))
+	assert.Equal(t, 2, len(fn.Stmts.StmtDecisionCase))
+}
+
+func TestCRunner_SkipsHeadersPairedWithCpp(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "list.c"), "int size(void) { return 0; }\n")
+	writeFile(t, filepath.Join(dir, "list.h"), "int size(void);\n")
+	writeFile(t, filepath.Join(dir, "widget.cpp"), "#include \"widget.h\"\n")
+	writeFile(t, filepath.Join(dir, "widget.h"), "class Widget {};\n")
+
+	runner := CRunner{}
+	runner.SetConfiguration(configuration.NewConfiguration())
+	runner.Configuration.SetSourcesToAnalyzePath([]string{dir})
+
+	files := []string{}
+	for _, path := range runner.getFileList().Files {
+		files = append(files, filepath.Base(path))
+	}
+	assert.ElementsMatch(t, []string{"list.c", "list.h"}, files)
+}
+
+func TestCRunner_IsTest(t *testing.T) {
+	cases := []struct {
+		name     string
+		code     string
+		expected bool
+	}{
+		{"list_test.c", "int main(void) {}", true},
+		{"test_list.c", "int main(void) {}", true},
+		{"tests/list.c", "int main(void) {}", true},
+		{"suite.c", "#include <check.h>\n", true},
+		{"src/list.c", "int size(void) { return 0; }", false},
+		{"src/contest.c", "int main(void) {}", false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tc.name)
+			writeFile(t, path, tc.code)
+			file, err := (&CRunner{}).Parse(path)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expected, file.IsTest)
+		})
+	}
+}
+
+func TestCRunner_Parse_NonExistentFile(t *testing.T) {
+	file, err := (&CRunner{}).Parse("/nonexistent/file.c")
+	assert.NotNil(t, err)
+	assert.NotNil(t, file)
+	assert.Equal(t, "C", file.ProgrammingLanguage)
+}
+
+func TestCModifiers(t *testing.T) {
+	src := `
+static int helper(void) { return 0; }
+int compute(void) { return helper(); }
+`
+	result := parseC(t, src)
+	methods := map[string]*pb.Modifiers{}
+	for _, fn := range engine.GetFunctionsInFile(result) {
+		methods[fn.Name.Short] = fn.Modifiers
+	}
+	assert.Equal(t, pb.Visibility_VISIBILITY_PRIVATE, methods["helper"].Visibility, "a static function is private to its file")
+	assert.Equal(t, pb.Visibility_VISIBILITY_PUBLIC, methods["compute"].Visibility)
+}
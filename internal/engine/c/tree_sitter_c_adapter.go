@@ -0,0 +1,401 @@
+package c
+
+import (
+	"strings"
+
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	Treesitter "github.com/ast-metrics/ast-metrics/internal/engine/treesitter"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+	sitter "github.com/smacker/go-tree-sitter"
+	tsC "github.com/smacker/go-tree-sitter/c"
+)
+
+type TreeSitterAdapter struct {
+	src []byte
+	// root caches the tree shared by the runner, to avoid re-parsing
+	root *sitter.Node
+}
+
+func NewTreeSitterAdapter(src []byte) *TreeSitterAdapter   { return &TreeSitterAdapter{src: src} }
+func (a *TreeSitterAdapter) SetSource(src []byte)          { a.src = src; a.root = nil }
+func (a *TreeSitterAdapter) SetRootNode(root *sitter.Node) { a.root = root }
+func (a *TreeSitterAdapter) Language() *sitter.Language    { return tsC.GetLanguage() }
+
+// ensureRoot returns the tree shared by the runner, parsing the source when
+// the adapter is used on its own (tests).
+func (a *TreeSitterAdapter) ensureRoot(src []byte) (*sitter.Node, []byte) {
+	source := a.src
+	if source == nil {
+		source = src
+	}
+	if a.root != nil {
+		return a.root, source
+	}
+	if source == nil {
+		return nil, nil
+	}
+	parser := sitter.NewParser()
+	parser.SetLanguage(a.Language())
+	a.root = parser.Parse(nil, source).RootNode()
+	return a.root, source
+}
+
+func (a *TreeSitterAdapter) IsModule(n *sitter.Node) bool { return n.Type() == "translation_unit" }
+
+// IsClass maps the structs of C to classes: they hold the data the
+// functions of a file work on. Unions and enums are left out.
+func (a *TreeSitterAdapter) IsClass(n *sitter.Node) bool {
+	if n.Type() != "struct_specifier" {
+		return false
+	}
+	// only a definition is a class, not "struct point p;". An anonymous
+	// struct is a class only when a typedef names it.
+	return n.ChildByFieldName("body") != nil && a.NodeName(n) != ""
+}
+
+func (a *TreeSitterAdapter) IsFunction(n *sitter.Node) bool {
+	// prototypes are declarations, not functions
+	return n.Type() == "function_definition"
+}
+
+func (a *TreeSitterAdapter) NodeName(n *sitter.Node) string {
+	if a.src == nil || n == nil {
+		return ""
+	}
+	if n.Type() == "function_definition" {
+		// static int *add(int a): the name is below the pointer declarators
+		d := n.ChildByFieldName("declarator")
+		for d != nil && d.Type() != "function_declarator" {
+			d = d.ChildByFieldName("declarator")
+		}
+		if d == nil {
+			return ""
+		}
+		return text(a.src, d.ChildByFieldName("declarator"))
+	}
+	if name := n.ChildByFieldName("name"); name != nil {
+		return text(a.src, name)
+	}
+	// typedef struct { ... } point_t;
+	if p := n.Parent(); p != nil && p.Type() == "type_definition" {
+		if d := p.ChildByFieldName("declarator"); d != nil && d.Type() == "type_identifier" {
+			return text(a.src, d)
+		}
+	}
+	return ""
+}
+
+func (a *TreeSitterAdapter) NodeBody(n *sitter.Node) *sitter.Node {
+	if n == nil {
+		return nil
+	}
+	return n.ChildByFieldName("body")
+}
+
+func (a *TreeSitterAdapter) NodeParams(n *sitter.Node) *sitter.Node {
+	if n == nil {
+		return nil
+	}
+	d := n.ChildByFieldName("declarator")
+	for d != nil && d.Type() != "function_declarator" {
+		d = d.ChildByFieldName("declarator")
+	}
+	if d == nil {
+		return nil
+	}
+	return d.ChildByFieldName("parameters")
+}
+
+func (a *TreeSitterAdapter) EachParamIdent(params *sitter.Node, yield func(string)) {
+	if params == nil || a.src == nil {
+		return
+	}
+	for i := 0; i < int(params.NamedChildCount()); i++ {
+		p := params.NamedChild(i)
+		switch p.Type() {
+		case "parameter_declaration", "optional_parameter_declaration", "variadic_parameter_declaration":
+			// int a, const T& b = T(), int* p, Args... args: the identifier is
+			// below the pointer and reference declarators; unnamed
+			// parameters are skipped
+			d := p.ChildByFieldName("declarator")
+			for d != nil && d.Type() != "identifier" {
+				next := d.ChildByFieldName("declarator")
+				if next == nil {
+					next = firstNamedChildOfType(d, "identifier")
+				}
+				d = next
+			}
+			if d != nil {
+				yield(text(a.src, d))
+			}
+		}
+	}
+}
+
+// ModuleNameFromPath returns an empty namespace: C has a single, global one.
+func (a *TreeSitterAdapter) ModuleNameFromPath(path string) string { return "" }
+
+func (a *TreeSitterAdapter) AttachQualified(parentClass string, fn string) string {
+	if parentClass == "" {
+		return fn
+	}
+	return parentClass + "." + fn
+}
+
+func (a *TreeSitterAdapter) EachChildBody(body *sitter.Node, yield func(*sitter.Node)) {
+	if body == nil {
+		return
+	}
+	for i := 0; i < int(body.ChildCount()); i++ {
+		yield(body.Child(i))
+	}
+}
+
+func (a *TreeSitterAdapter) Decision(n *sitter.Node) (Treesitter.DecisionKind, *sitter.Node) {
+	switch n.Type() {
+	case "if_statement":
+		return Treesitter.DecIf, n.ChildByFieldName("consequence")
+
+	case "else_clause":
+		// else if: re-visit the nested if so that deeper branches keep being
+		// counted
+		if nested := firstNamedChildOfType(n, "if_statement"); nested != nil {
+			return Treesitter.DecElif, nested
+		}
+		return Treesitter.DecElse, n
+
+	case "switch_statement":
+		return Treesitter.DecSwitch, n.ChildByFieldName("body")
+
+	case "case_statement":
+		// "default:" shares the node of a case
+		return Treesitter.DecCase, n
+
+	case "for_statement", "while_statement", "do_statement":
+		return Treesitter.DecLoop, n.ChildByFieldName("body")
+	}
+	// conditional expressions intentionally left out, consistent with the
+	// other engines.
+	return Treesitter.DecNone, nil
+}
+
+// Imports reports the #include directives. The module is the included path,
+// without its quotes or angle brackets.
+func (a *TreeSitterAdapter) Imports(n *sitter.Node) []Treesitter.ImportItem {
+	if n == nil || n.Type() != "preproc_include" {
+		return nil
+	}
+	path := n.ChildByFieldName("path")
+	if path == nil {
+		return nil
+	}
+	module := strings.Trim(text(a.src, path), "\"<>")
+	if module == "" {
+		return nil
+	}
+	return []Treesitter.ImportItem{{Module: module, Name: ""}}
+}
+
+// Modifiers reports the visibility of a function: a static function is
+// private to its file. Structs are public.
+func (a *TreeSitterAdapter) Modifiers(n *sitter.Node) *pb.Modifiers {
+	for i := 0; i < int(n.NamedChildCount()); i++ {
+		if ch := n.NamedChild(i); ch.Type() == "storage_class_specifier" && text(a.src, ch) == "static" {
+			return &pb.Modifiers{Visibility: pb.Visibility_VISIBILITY_PRIVATE}
+		}
+	}
+	return &pb.Modifiers{Visibility: pb.Visibility_VISIBILITY_PUBLIC}
+}
+
+// CountElseIfAsIf: treat else-if as if for complexity aggregation (consistent with the other engines)
+func (a *TreeSitterAdapter) CountElseIfAsIf() bool { return true }
+
+// IsLogicalNode reports whether a node begins a logical line. Declarations
+// of local variables are statements without the "_statement" suffix.
+func (a *TreeSitterAdapter) IsLogicalNode(n *sitter.Node) bool {
+	if n.Type() == "declaration" {
+		p := n.Parent()
+		return p != nil && p.Type() == "compound_statement"
+	}
+	return Treesitter.IsDefaultLogicalNode(n.Type())
+}
+
+// CommentMarkers declares C comment tokens: "//" and "/* */" only.
+// "#" starts a preprocessor directive, not a comment.
+func (a *TreeSitterAdapter) CommentMarkers() engine.CommentMarkers {
+	return engine.CommentMarkers{SlashSlash: true, SlashStar: true}
+}
+
+// IsDocComment tells Doxygen comments ("/**", "/*!", "///", "//!") from
+// plain comments.
+func (a *TreeSitterAdapter) IsDocComment(text string) bool {
+	return strings.HasPrefix(text, "/**") || strings.HasPrefix(text, "/*!") || strings.HasPrefix(text, "///") || strings.HasPrefix(text, "//!")
+}
+
+// CountComments counts C comment lines (//, /* */ and Doxygen blocks) in the given range.
+func (a *TreeSitterAdapter) CountComments(lines []string, start, end int) int {
+	cnt := 0
+	inBlock := false
+	for i := start - 1; i < end && i < len(lines); i++ {
+		ln := strings.TrimSpace(lines[i])
+		if ln == "" {
+			continue
+		}
+		clean := stripCStrings(ln)
+		if inBlock {
+			cnt++
+			if strings.Contains(clean, "*/") {
+				inBlock = false
+			}
+			continue
+		}
+		if strings.HasPrefix(clean, "//") {
+			cnt++
+			continue
+		}
+		if strings.HasPrefix(clean, "/*") {
+			cnt++
+			if !strings.Contains(clean, "*/") {
+				inBlock = true
+			}
+			continue
+		}
+	}
+	return cnt
+}
+
+// cOperatorTokens lists the anonymous token types counted as Halstead
+// operators: arithmetic, comparison, logical, bitwise, assignments, member
+// access ("." and "->"), the argument separator, the subscript, the
+// conditional and the keywords driving the control flow.
+var cOperatorTokens = map[string]bool{
+	"+": true, "-": true, "*": true, "/": true, "%": true,
+	"==": true, "!=": true, "<": true, ">": true, "<=": true, ">=": true,
+	"&&": true, "||": true, "!": true,
+	"&": true, "|": true, "^": true, "~": true, "<<": true, ">>": true,
+	"=": true, "+=": true, "-=": true, "*=": true, "/=": true, "%=": true,
+	"&=": true, "|=": true, "^=": true, "<<=": true, ">>=": true,
+	"++": true, "--": true, ".": true, "->": true,
+	",": true, "[": true, "?": true,
+	"return": true, "if": true, "else": true, "for": true, "while": true,
+	"do": true, "switch": true, "case": true, "default": true,
+	"break": true, "continue": true, "goto": true, "sizeof": true,
+}
+
+// cOperandTypes lists the named node types counted as Halstead operands.
+// Literals are left out on purpose, as in the other engines.
+var cOperandTypes = map[string]bool{"identifier": true}
+
+var cCallTypes = map[string]bool{"call_expression": true}
+
+// cPruneTypes lists the node types never walked: types and specifiers
+// describe the declaration, not what it computes.
+var cPruneTypes = map[string]bool{
+	"primitive_type": true, "type_identifier": true, "sized_type_specifier": true,
+	"type_qualifier": true, "storage_class_specifier": true,
+}
+
+// cPruneFields lists the fields holding a type: the type of a declaration,
+// of a parameter, of a cast.
+var cPruneFields = map[string]bool{"type": true}
+
+// cChainTypes lists the member access node types: p.x, p->x.
+var cChainTypes = map[string]bool{"field_expression": true}
+
+var cOperandSpec = Treesitter.OperandSpec{
+	OperatorTokens: cOperatorTokens,
+	OperandTypes:   cOperandTypes,
+	CallTypes:      cCallTypes,
+	PruneTypes:     cPruneTypes,
+	PruneFields:    cPruneFields,
+	ChainTypes:     cChainTypes,
+}
+
+// ExtractOperatorsOperands collects Halstead operators and operands from the
+// AST within the given 1-based inclusive line range. A member access is a
+// single operand ("p->x").
+func (a *TreeSitterAdapter) ExtractOperatorsOperands(src []byte, startLine, endLine int) ([]string, []string) {
+	root, source := a.ensureRoot(src)
+	if root == nil {
+		return nil, nil
+	}
+	return cOperandSpec.Extract(root, source, startLine, endLine)
+}
+
+// ClassDirectOperands lists the fields declared in the body of a struct.
+func (a *TreeSitterAdapter) ClassDirectOperands(n *sitter.Node) []string {
+	if n == nil || a.src == nil {
+		return nil
+	}
+	body := n.ChildByFieldName("body")
+	if body == nil {
+		return nil
+	}
+	var fields []string
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		decl := body.NamedChild(i)
+		if decl.Type() != "field_declaration" {
+			continue
+		}
+		// int x; char *name; but not a function pointer
+		d := decl.ChildByFieldName("declarator")
+		for d != nil && d.Type() != "field_identifier" && d.Type() != "function_declarator" {
+			d = d.ChildByFieldName("declarator")
+		}
+		if d != nil && d.Type() == "field_identifier" {
+			fields = append(fields, text(a.src, d))
+		}
+	}
+	return fields
+}
+
+// --- helpers ---
+
+func text(src []byte, n *sitter.Node) string {
+	if n == nil || src == nil {
+		return ""
+	}
+	return string(src[n.StartByte():n.EndByte()])
+}
+
+func firstNamedChildOfType(n *sitter.Node, t string) *sitter.Node {
+	if n == nil {
+		return nil
+	}
+	for i := 0; i < int(n.NamedChildCount()); i++ {
+		if c := n.NamedChild(i); c.Type() == t {
+			return c
+		}
+	}
+	return nil
+}
+
+// stripCStrings removes content inside string and char literals to avoid
+// false positives in comment scanning.
+func stripCStrings(s string) string {
+	out := make([]rune, 0, len(s))
+	inDq := false
+	inSq := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '\\' {
+			if i+1 < len(s) {
+				i++
+			}
+			continue
+		}
+		if !inSq && c == '"' {
+			inDq = !inDq
+			continue
+		}
+		if !inDq && c == '\'' {
+			inSq = !inSq
+			continue
+		}
+		if inDq || inSq {
+			continue
+		}
+		out = append(out, rune(c))
+	}
+	return string(out)
+}
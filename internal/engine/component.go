@@ -0,0 +1,133 @@
+package engine
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/ast-metrics/ast-metrics/internal/configuration"
+	filefinder "github.com/ast-metrics/ast-metrics/internal/file"
+)
+
+// Single-file components (Vue, Svelte) hold their logic in <script> blocks,
+// next to a template and styles. The TypeScript and JavaScript engines parse
+// the script blocks and report the component as a class.
+
+// ComponentExtensions lists the extensions of the single-file components.
+var ComponentExtensions = []string{".vue", ".svelte"}
+
+var (
+	componentScriptOpen  = regexp.MustCompile(`(?is)<script\b([^>]*)>`)
+	componentScriptClose = regexp.MustCompile(`(?i)</script\s*>`)
+	componentScriptLang  = regexp.MustCompile(`(?i)\blang\s*=\s*["']?(ts|typescript|tsx)["'\s>]`)
+)
+
+// ComponentScript is the code of a single-file component.
+type ComponentScript struct {
+	// Source is the whole file, with everything but the content of the script
+	// blocks blanked out. Line breaks are kept and every byte keeps its offset:
+	// a position in the parsed script is a position in the component file.
+	Source []byte
+	// TypeScript is true when a script block declares lang="ts".
+	TypeScript bool
+	// Found is false when the component has no script block.
+	Found bool
+}
+
+// IsComponentFile reports whether the file is a single-file component.
+func IsComponentFile(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	for _, e := range ComponentExtensions {
+		if ext == e {
+			return true
+		}
+	}
+	return false
+}
+
+// ExtractComponentScript returns the script blocks of a single-file
+// component. Vue allows a <script> and a <script setup> block, Svelte a
+// <script context="module"> and an instance <script>: they share the scope
+// of the component, and are returned together.
+func ExtractComponentScript(src []byte) ComponentScript {
+	script := ComponentScript{Source: make([]byte, len(src))}
+	for i, c := range src {
+		if c == '\n' || c == '\r' {
+			script.Source[i] = c
+		} else {
+			script.Source[i] = ' '
+		}
+	}
+
+	offset := 0
+	for offset < len(src) {
+		opening := componentScriptOpen.FindSubmatchIndex(src[offset:])
+		if opening == nil {
+			break
+		}
+		start := offset + opening[1]
+		if attrs := string(src[offset+opening[2]:offset+opening[3]]) + ">"; componentScriptLang.MatchString(attrs) {
+			script.TypeScript = true
+		}
+		end := len(src)
+		if closing := componentScriptClose.FindIndex(src[start:]); closing != nil {
+			end = start + closing[0]
+			offset = start + closing[1]
+		} else {
+			offset = end
+		}
+		copy(script.Source[start:end], src[start:end])
+		script.Found = true
+	}
+	return script
+}
+
+// ReadComponentScript reads a single-file component and returns its script.
+func ReadComponentScript(path string) (ComponentScript, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return ComponentScript{}, err
+	}
+	return ExtractComponentScript(src), nil
+}
+
+// ComponentName returns the name of the component a file declares: its base
+// name ("UserCard" for UserCard.vue).
+func ComponentName(path string) string {
+	return strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+}
+
+// SearchComponents lists the single-file components of the project whose
+// script is written in TypeScript (typeScript true) or in JavaScript.
+// Components without script are left out.
+func SearchComponents(finder filefinder.Finder, config *configuration.Configuration, typeScript bool) filefinder.FileList {
+	var lists []filefinder.FileList
+	for _, lang := range []string{"vue", "svelte"} {
+		for _, ext := range config.GetExtensionsForLanguage(lang) {
+			lists = append(lists, finder.Search(ext))
+		}
+	}
+	found := filefinder.MergeFileLists(lists...)
+
+	keep := map[string]bool{}
+	for _, path := range found.Files {
+		script, err := ReadComponentScript(path)
+		keep[path] = err == nil && script.Found && script.TypeScript == typeScript
+	}
+
+	result := filefinder.FileList{Files: []string{}, FilesByDirectory: map[string][]string{}}
+	for _, path := range found.Files {
+		if keep[path] {
+			result.Files = append(result.Files, path)
+		}
+	}
+	for dir, files := range found.FilesByDirectory {
+		for _, path := range files {
+			if keep[path] {
+				result.FilesByDirectory[dir] = append(result.FilesByDirectory[dir], path)
+			}
+		}
+	}
+	return result
+}
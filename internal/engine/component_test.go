@@ -0,0 +1,71 @@
+package engine
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ast-metrics/ast-metrics/internal/configuration"
+	filefinder "github.com/ast-metrics/ast-metrics/internal/file"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractComponentScript(t *testing.T) {
+	src := "<template>\n  <p>{{ a }}</p>\n</template>\n<script setup lang=\"ts\">\nconst a = 1\n</script>\n<style>p { color: red }</style>\n"
+	script := ExtractComponentScript([]byte(src))
+
+	assert.True(t, script.Found)
+	assert.True(t, script.TypeScript)
+	// offsets and lines are kept
+	assert.Equal(t, len(src), len(script.Source))
+	assert.Equal(t, strings.Count(src, "\n"), strings.Count(string(script.Source), "\n"))
+	assert.Equal(t, "const a = 1", strings.TrimSpace(string(script.Source)))
+	assert.Equal(t, strings.Index(src, "const"), strings.Index(string(script.Source), "const"))
+}
+
+func TestExtractComponentScript_SeveralBlocks(t *testing.T) {
+	src := "<script context=\"module\">\nexport const a = 1\n</script>\n<script>\nlet b = 2\n</script>\n<div></div>\n"
+	script := ExtractComponentScript([]byte(src))
+
+	assert.True(t, script.Found)
+	assert.False(t, script.TypeScript)
+	lines := strings.Split(string(script.Source), "\n")
+	assert.Equal(t, "export const a = 1", strings.TrimSpace(lines[1]))
+	assert.Equal(t, "let b = 2", strings.TrimSpace(lines[4]))
+	assert.Equal(t, "", strings.TrimSpace(lines[6]))
+}
+
+func TestExtractComponentScript_NoScript(t *testing.T) {
+	script := ExtractComponentScript([]byte("<template><p>static</p></template>\n"))
+	assert.False(t, script.Found)
+}
+
+func TestSearchComponents(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"Typed.vue":    "<script setup lang=\"ts\">\nconst a: number = 1\n</script>\n",
+		"Plain.vue":    "<script>\nexport default {}\n</script>\n",
+		"Static.vue":   "<template><p>static</p></template>\n",
+		"List.svelte":  "<script lang=\"typescript\">\nlet items: string[] = []\n</script>\n",
+		"Count.svelte": "<script>\nlet count = 0\n</script>\n",
+	}
+	for name, code := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(code), 0644); err != nil {
+			t.Fatalf("failed to create file: %v", err)
+		}
+	}
+	config := configuration.NewConfiguration()
+	config.SourcesToAnalyzePath = []string{dir}
+	finder := filefinder.Finder{Configuration: *config}
+
+	names := func(list filefinder.FileList) []string {
+		result := []string{}
+		for _, path := range list.Files {
+			result = append(result, filepath.Base(path))
+		}
+		return result
+	}
+	assert.ElementsMatch(t, []string{"Typed.vue", "List.svelte"}, names(SearchComponents(finder, config, true)))
+	assert.ElementsMatch(t, []string{"Plain.vue", "Count.svelte"}, names(SearchComponents(finder, config, false)))
+}
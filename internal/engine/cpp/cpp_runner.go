@@ -0,0 +1,190 @@
+package cpp
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/ast-metrics/ast-metrics/internal/configuration"
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	Treesitter "github.com/ast-metrics/ast-metrics/internal/engine/treesitter"
+	"github.com/ast-metrics/ast-metrics/internal/file"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+
+	"github.com/pterm/pterm"
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// HeaderExtensions lists the extensions of the header paired with a source
+// file, by order of preference.
+var HeaderExtensions = []string{".h", ".hpp", ".hh", ".hxx"}
+
+// SourceExtensions lists the extensions of a C++ source file.
+var SourceExtensions = []string{".cpp", ".cc", ".cxx"}
+
+type CppRunner struct {
+	progressbar   *pterm.SpinnerPrinter
+	Configuration *configuration.Configuration
+	foundFiles    file.FileList
+}
+
+func (r CppRunner) Name() string                                     { return "C++" }
+func (r CppRunner) IsRequired() bool                                 { return len(r.getFileList().Files) > 0 }
+func (r *CppRunner) Ensure() error                                   { return nil }
+func (r *CppRunner) SetProgressbar(p *pterm.SpinnerPrinter)          { r.progressbar = p }
+func (r *CppRunner) SetConfiguration(c *configuration.Configuration) { r.Configuration = c }
+
+func (r CppRunner) DumpAST() []*pb.File {
+	return engine.DumpFiles(
+		r.getFileList().Files,
+		r.progressbar,
+		func(path string) (*pb.File, error) { return r.Parse(path) },
+		engine.DumpOptions{Label: r.Name()},
+	)
+}
+
+func (r CppRunner) Finish() error {
+	if r.progressbar != nil {
+		r.progressbar.Stop()
+	}
+	return nil
+}
+
+// Parse analyzes a C++ file. A source file is analyzed together with its
+// header (foo.cpp with foo.h), so that the methods defined out of line
+// ("Foo::bar") are attached to the class the header declares.
+func (r CppRunner) Parse(path string) (*pb.File, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return &pb.File{Path: path, ProgrammingLanguage: "C++"}, err
+	}
+
+	v := visit(path, src, Treesitter.QueriesFor(r.Configuration, "cpp", path))
+	if header := PairedHeader(path); header != "" {
+		if hsrc, err := os.ReadFile(header); err == nil {
+			// the header is not analyzed on its own: its query rules run here
+			v.SetCompanion(visit(header, hsrc, Treesitter.QueriesFor(r.Configuration, "cpp", header)).Result())
+		}
+	}
+
+	file := v.Result()
+	file.ProgrammingLanguage = "C++"
+
+	// Detect if file is a test file
+	file.IsTest = r.isTestFile(path, src)
+
+	return file, nil
+}
+
+// visit walks the tree of a single file, and runs the query rules on it.
+func visit(path string, src []byte, queries []*Treesitter.Query) *Treesitter.Visitor {
+	parser := sitter.NewParser()
+	adapter := NewTreeSitterAdapter(src)
+	parser.SetLanguage(adapter.Language())
+
+	tree := parser.Parse(nil, src)
+	root := tree.RootNode()
+	adapter.SetRootNode(root)
+
+	v := Treesitter.NewVisitor(adapter, path, src)
+	v.SetQueries(queries)
+	v.Visit(root)
+	return v
+}
+
+// PairedHeader returns the header living next to a C++ source file under the
+// same name (foo.h for foo.cpp), or an empty string.
+func PairedHeader(path string) string {
+	ext := strings.ToLower(filepath.Ext(path))
+	if !contains(SourceExtensions, ext) {
+		return ""
+	}
+	stem := strings.TrimSuffix(path, filepath.Ext(path))
+	for _, hext := range HeaderExtensions {
+		if isFile(stem + hext) {
+			return stem + hext
+		}
+	}
+	return ""
+}
+
+// HasPairedSource reports whether a header is analyzed with a C++ source file
+// of the same name. Such a header is not analyzed on its own.
+func HasPairedSource(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	if !contains(HeaderExtensions, ext) {
+		return false
+	}
+	stem := strings.TrimSuffix(path, filepath.Ext(path))
+	for _, sext := range SourceExtensions {
+		if isFile(stem+sext) && PairedHeader(stem+sext) == path {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *CppRunner) getFileList() file.FileList {
+	if r.foundFiles.Files != nil {
+		return r.foundFiles
+	}
+	finder := file.Finder{Configuration: *r.Configuration}
+	if r.Configuration.FileDiscovery != nil {
+		if fd, ok := r.Configuration.FileDiscovery.(*file.FileDiscovery); ok {
+			finder.Discovery = fd
+		}
+	}
+	extensions := r.Configuration.GetExtensionsForLanguage("cpp")
+	var lists []file.FileList
+	for _, ext := range extensions {
+		lists = append(lists, finder.Search(ext))
+	}
+	merged := file.MergeFileLists(lists...)
+
+	// headers are analyzed with their source file
+	files := make([]string, 0, len(merged.Files))
+	for _, path := range merged.Files {
+		if !HasPairedSource(path) {
+			files = append(files, path)
+		}
+	}
+	merged.Files = files
+
+	r.foundFiles = merged
+	return r.foundFiles
+}
+
+var cppTestMarkers = regexp.MustCompile(`#include\s*[<"](gtest/|gmock/|catch2/|catch\.hpp|doctest|boost/test/)|\bTEST(_F|_P)?\s*\(|\bTEST_CASE\s*\(`)
+
+// isTestFile determines if a C++ file is a test file based on:
+// 1. Filename patterns (foo_test.cpp, test_foo.cpp, FooTest.cpp)
+// 2. Conventional test directories (test/, tests/)
+// 3. Test framework includes and macros (GoogleTest, Catch2, doctest, Boost.Test)
+func (r CppRunner) isTestFile(path string, src []byte) bool {
+	base := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	if strings.HasSuffix(base, "_test") || strings.HasPrefix(base, "test_") || strings.HasSuffix(base, "Test") || strings.HasSuffix(base, "Tests") {
+		return true
+	}
+
+	normalized := "/" + filepath.ToSlash(filepath.Dir(path)) + "/"
+	if strings.Contains(normalized, "/test/") || strings.Contains(normalized, "/tests/") {
+		return true
+	}
+
+	return cppTestMarkers.Match(src)
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func isFile(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
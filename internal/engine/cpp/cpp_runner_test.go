@@ -0,0 +1,346 @@
+package cpp
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ast-metrics/ast-metrics/internal/configuration"
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func parseCpp(t *testing.T, src string) *pb.File {
+	t.Helper()
+	result, err := engine.CreateTestFileWithCode(&CppRunner{}, src)
+	assert.Nil(t, err, "Expected no error, got %v", err)
+	assert.NotNil(t, result)
+	return result
+}
+
+func findClass(file *pb.File, name string) *pb.StmtClass {
+	for _, c := range engine.GetClassesInFile(file) {
+		if c.Name != nil && c.Name.Short == name {
+			return c
+		}
+	}
+	return nil
+}
+
+func findFunction(file *pb.File, name string) *pb.StmtFunction {
+	for _, fn := range engine.GetFunctionsInFile(file) {
+		if fn.Name != nil && fn.Name.Short == name {
+			return fn
+		}
+	}
+	return nil
+}
+
+func writeFile(t *testing.T, path string, code string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("failed to create dir: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(code), 0644); err != nil {
+		t.Fatalf("failed to create file: %v", err)
+	}
+}
+
+func TestCppNamespacesClassesAndTemplates(t *testing.T) {
+	src := `
+namespace app {
+namespace core {
+
+template <typename T>
+class Stack : public Base, private util::Tracked<Stack<T>> {
+public:
+    Stack() : size_(0) {}
+    ~Stack() {}
+    void push(const T& value, int* count = nullptr) {
+        items_.push_back(value);
+        size_++;
+    }
+    void grow();
+private:
+    std::vector<T> items_;
+    int size_;
+};
+
+template <typename T>
+void Stack<T>::grow() { size_ *= 2; }
+
+struct Point { int x; int y; };
+
+}
+}
+
+typedef struct { int r; } Color;
+
+static int helper(int a) { return a; }
+`
+	result := parseCpp(t, src)
+	assert.Equal(t, "C++", result.ProgrammingLanguage)
+
+	stack := findClass(result, "Stack")
+	assert.NotNil(t, stack)
+	assert.Equal(t, "app::core::Stack", stack.Name.Qualified)
+	names := []string{}
+	for _, fn := range stack.Stmts.StmtFunction {
+		names = append(names, fn.Name.Short)
+	}
+	assert.ElementsMatch(t, []string{"Stack", "~Stack", "push", "grow"}, names, "out-of-line definitions belong to the class")
+
+	operands := []string{}
+	for _, op := range stack.Operands {
+		operands = append(operands, op.Name)
+	}
+	assert.ElementsMatch(t, []string{"items_", "size_"}, operands)
+
+	extends := []string{}
+	for _, e := range stack.Extends {
+		extends = append(extends, e.Short)
+	}
+	assert.Equal(t, []string{"Base", "Tracked"}, extends)
+
+	push := findFunction(result, "push")
+	assert.Equal(t, 2, len(push.Parameters))
+	assert.Equal(t, "value", push.Parameters[0].Name)
+	assert.Equal(t, "count", push.Parameters[1].Name)
+
+	point := findClass(result, "Point")
+	assert.NotNil(t, point, "structs are classes")
+	assert.Equal(t, "app::core::Point", point.Name.Qualified)
+
+	assert.NotNil(t, findClass(result, "Color"), "a typedef names an anonymous struct")
+	assert.NotNil(t, findFunction(result, "helper"))
+}
+
+func TestCppHeaderSourcePairing(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "foo.h"), `#pragma once
+#include <string>
+
+namespace app { namespace core {
+class Foo {
+public:
+    int bar();
+    void reset() { count_ = 0; }
+private:
+    int count_;
+};
+} }
+`)
+	writeFile(t, filepath.Join(dir, "foo.cpp"), `#include "foo.h"
+
+namespace app { namespace core {
+int Foo::bar() {
+    if (count_ > 0) {
+        return count_;
+    }
+    return 0;
+}
+} }
+
+namespace util {
+int helper() { return 1; }
+}
+`)
+
+	result, err := (&CppRunner{}).Parse(filepath.Join(dir, "foo.cpp"))
+	assert.Nil(t, err)
+
+	foo := findClass(result, "Foo")
+	assert.NotNil(t, foo, "the class of the header is part of the source file")
+	assert.Equal(t, "app::core::Foo", foo.Name.Qualified)
+	assert.Equal(t, 2, len(foo.Stmts.StmtFunction), "bar and reset expected in Foo")
+	assert.Equal(t, "app::core::Foo::bar", foo.Stmts.StmtFunction[1].Name.Qualified)
+
+	// the declarations of the header keep their lines, in the header
+	header := filepath.Join(dir, "foo.h")
+	assert.Equal(t, header, foo.Location.File)
+	assert.Equal(t, int32(5), foo.Location.StartLine)
+	assert.Equal(t, header, foo.Stmts.StmtFunction[0].Location.File, "reset is defined in the header")
+	assert.Equal(t, int32(8), foo.Stmts.StmtFunction[0].Location.StartLine)
+	assert.Equal(t, "", foo.Stmts.StmtFunction[1].Location.File, "bar is defined in the source")
+	assert.Equal(t, int32(4), foo.Stmts.StmtFunction[1].Location.StartLine)
+	assert.NotNil(t, findFunction(result, "helper"))
+
+	deps := map[string]bool{}
+	for _, dep := range engine.GetDependenciesInFile(result) {
+		deps[dep.Namespace] = true
+	}
+	assert.True(t, deps["foo.h"])
+	assert.True(t, deps["string"], "includes of the header are included too")
+
+	assert.Equal(t, "foo.h", filepath.Base(PairedHeader(filepath.Join(dir, "foo.cpp"))))
+	assert.True(t, HasPairedSource(filepath.Join(dir, "foo.h")))
+
+	writeFile(t, filepath.Join(dir, "alone.hpp"), "class Alone {};\n")
+	assert.False(t, HasPairedSource(filepath.Join(dir, "alone.hpp")))
+}
+
+func TestCppQueriesAndDirectivesOfThePairedHeader(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "foo.h"), `#pragma once
+
+class Foo {
+public:
+    int bar() { return (int) 1.5; }
+    // ast-metrics-ignore-next-line no_c_cast: rounding is intended
+    int baz() { return (int) 2.5; }
+};
+`)
+	writeFile(t, filepath.Join(dir, "foo.cpp"), `#include "foo.h"
+
+int helper() { return 1; }
+`)
+	cfg := configuration.NewConfiguration()
+	cfg.Requirements = &configuration.ConfigurationRequirements{Rules: &configuration.ConfigurationRequirementsRules{
+		Queries: []configuration.ConfigurationQueryRule{
+			{Name: "no_c_cast", Language: "cpp", Query: `(cast_expression) @match`, Message: "C-style cast"},
+		},
+	}}
+
+	result, err := (&CppRunner{Configuration: cfg}).Parse(filepath.Join(dir, "foo.cpp"))
+	assert.Nil(t, err)
+
+	assert.Equal(t, []*pb.QueryMatch{
+		{Rule: "no_c_cast", Line: 5, Column: 24, Message: "C-style cast", File: filepath.Join(dir, "foo.h")},
+		{Rule: "no_c_cast", Line: 7, Column: 24, Message: "C-style cast", File: filepath.Join(dir, "foo.h")},
+	}, result.QueryMatches, "the matches of the header are reported in the header")
+
+	if assert.Equal(t, 1, len(result.Directives), "the directives of the header are kept") {
+		assert.Equal(t, filepath.Join(dir, "foo.h"), result.Directives[0].File)
+		assert.Equal(t, int32(6), result.Directives[0].Location.StartLine)
+	}
+}
+
+func TestCppDecisions(t *testing.T) {
+	src := `
+int decide(int a, std::vector<int> items) {
+    if (a > 0) {
+        a++;
+    } else if (a == 0) {
+        a--;
+    } else if (a < -10) {
+        a = 0;
+    } else {
+        a = 1;
+    }
+    for (int i = 0; i < a; i++) {}
+    for (auto& item : items) {}
+    while (a > 0) { a--; }
+    do { a++; } while (a < 3);
+    switch (a) {
+        case 1: return 1;
+        case 2: return 2;
+        default: return 0;
+    }
+}
+`
+	result := parseCpp(t, src)
+	fn := findFunction(result, "decide")
+	assert.NotNil(t, fn)
+	// else-if is counted as an if, like in Java
+	assert.Equal(t, 3, len(fn.Stmts.StmtDecisionIf))
+	assert.Equal(t, 1, len(fn.Stmts.StmtDecisionElse))
+	assert.Equal(t, 4, len(fn.Stmts.StmtLoop))
+	assert.Equal(t, 1, len(fn.Stmts.StmtDecisionSwitch))
+	assert.Equal(t, 3, len(fn.Stmts.StmtDecisionCase))
+}
+
+func TestCppMethodCalls(t *testing.T) {
+	src := `
+class Counter {
+public:
+    void increment() {
+        count_++;
+        log("incremented");
+        this->flush();
+    }
+    void log(const char* msg) {}
+    void flush() { this->count_ = 0; }
+private:
+    int count_;
+};
+`
+	result := parseCpp(t, src)
+	fn := findFunction(result, "increment")
+	assert.NotNil(t, fn)
+	calls := []string{}
+	for _, c := range fn.MethodCalls {
+		calls = append(calls, c.Name)
+	}
+	assert.Contains(t, calls, "this.log", "calls without an object are method calls")
+	assert.Contains(t, calls, "this.flush")
+
+	flush := findFunction(result, "flush")
+	operands := []string{}
+	for _, op := range flush.Operands {
+		operands = append(operands, op.Name)
+	}
+	assert.Contains(t, operands, "this.count_")
+}
+
+func TestCppRunner_IsTest(t *testing.T) {
+	cases := []struct {
+		name     string
+		code     string
+		expected bool
+	}{
+		{"calculator_test.cpp", "int main() {}", true},
+		{"CalculatorTest.cpp", "int main() {}", true},
+		{"tests/calculator.cpp", "int main() {}", true},
+		{"spec.cpp", "#include <gtest/gtest.h>\nTEST(Calc, Add) {}", true},
+		{"catch.cpp", "#include <catch2/catch_test_macros.hpp>\n", true},
+		{"src/calculator.cpp", "int add(int a, int b) { return a + b; }", false},
+		{"src/contest.cpp", "int main() {}", false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tc.name)
+			writeFile(t, path, tc.code)
+			file, err := (&CppRunner{}).Parse(path)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expected, file.IsTest)
+		})
+	}
+}
+
+func TestCppRunner_Parse_NonExistentFile(t *testing.T) {
+	file, err := (&CppRunner{}).Parse("/nonexistent/file.cpp")
+	assert.NotNil(t, err)
+	assert.NotNil(t, file)
+	assert.Equal(t, "C++", file.ProgrammingLanguage)
+}
+
+func TestCppModifiers(t *testing.T) {
+	src := `
+class Shape {
+    int id() { return 0; }
+public:
+    virtual double area() = 0;
+    static Shape* create() { return nullptr; }
+    void draw() final {}
+};
+struct Point {
+    int x() { return 0; }
+};
+static void helper() {}
+`
+	result := parseCpp(t, src)
+	classes := result.Stmts.StmtClass
+	assert.Equal(t, 2, len(classes))
+	assert.True(t, classes[0].Modifiers.IsAbstract, "a class with a pure virtual method is abstract")
+
+	methods := classes[0].Stmts.StmtFunction
+	assert.Equal(t, 4, len(methods))
+	assert.Equal(t, pb.Visibility_VISIBILITY_PRIVATE, methods[0].Modifiers.Visibility, "class members are private by default")
+	assert.Equal(t, pb.Visibility_VISIBILITY_PUBLIC, methods[1].Modifiers.Visibility)
+	assert.True(t, methods[1].Modifiers.IsAbstract)
+	assert.True(t, methods[2].Modifiers.IsStatic)
+	assert.True(t, methods[3].Modifiers.IsFinal)
+	assert.Equal(t, pb.Visibility_VISIBILITY_PUBLIC, classes[1].Stmts.StmtFunction[0].Modifiers.Visibility, "struct members are public by default")
+	assert.Equal(t, pb.Visibility_VISIBILITY_PRIVATE, result.Stmts.StmtFunction[0].Modifiers.Visibility, "a static function is private to its file")
+}
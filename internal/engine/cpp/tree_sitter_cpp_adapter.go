@@ -0,0 +1,596 @@
+package cpp
+
+import (
+	"strings"
+
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	Treesitter "github.com/ast-metrics/ast-metrics/internal/engine/treesitter"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+	sitter "github.com/smacker/go-tree-sitter"
+	tsCpp "github.com/smacker/go-tree-sitter/cpp"
+)
+
+type TreeSitterAdapter struct {
+	src []byte
+	// root caches the tree shared by the runner, to avoid re-parsing
+	root *sitter.Node
+}
+
+func NewTreeSitterAdapter(src []byte) *TreeSitterAdapter   { return &TreeSitterAdapter{src: src} }
+func (a *TreeSitterAdapter) SetSource(src []byte)          { a.src = src; a.root = nil }
+func (a *TreeSitterAdapter) SetRootNode(root *sitter.Node) { a.root = root }
+func (a *TreeSitterAdapter) Language() *sitter.Language    { return tsCpp.GetLanguage() }
+
+// ensureRoot returns the tree shared by the runner, parsing the source when
+// the adapter is used on its own (tests).
+func (a *TreeSitterAdapter) ensureRoot(src []byte) (*sitter.Node, []byte) {
+	source := a.src
+	if source == nil {
+		source = src
+	}
+	if a.root != nil {
+		return a.root, source
+	}
+	if source == nil {
+		return nil, nil
+	}
+	parser := sitter.NewParser()
+	parser.SetLanguage(a.Language())
+	a.root = parser.Parse(nil, source).RootNode()
+	return a.root, source
+}
+
+func (a *TreeSitterAdapter) IsModule(n *sitter.Node) bool { return n.Type() == "translation_unit" }
+
+func (a *TreeSitterAdapter) IsClass(n *sitter.Node) bool {
+	switch n.Type() {
+	case "class_specifier", "struct_specifier":
+		// only a definition is a class: "struct Point p;" and forward
+		// declarations have no body. Templates wrap the class in a
+		// template_declaration, reached by the fallback recursion. An
+		// anonymous struct is a class only when a typedef names it.
+		return n.ChildByFieldName("body") != nil && a.NodeName(n) != ""
+	}
+	return false
+}
+
+func (a *TreeSitterAdapter) IsFunction(n *sitter.Node) bool {
+	// Lambdas are not named functions; their bodies still contribute
+	// decisions to the enclosing function via the fallback recursion.
+	// Declarations without a body (prototypes in a class) are not functions
+	// either: the definition is, wherever it is written.
+	return n.Type() == "function_definition"
+}
+
+func (a *TreeSitterAdapter) NodeName(n *sitter.Node) string {
+	if a.src == nil || n == nil {
+		return ""
+	}
+	if n.Type() == "function_definition" {
+		name := functionNameNode(n)
+		if name == nil {
+			return ""
+		}
+		// Foo::bar and a::b::Foo::bar: the scope is the receiver
+		for name.Type() == "qualified_identifier" {
+			inner := name.ChildByFieldName("name")
+			if inner == nil {
+				break
+			}
+			name = inner
+		}
+		if name.Type() == "template_function" {
+			name = name.ChildByFieldName("name")
+		}
+		return text(a.src, name)
+	}
+	name := n.ChildByFieldName("name")
+	if name == nil {
+		// typedef struct { ... } Point;
+		if p := n.Parent(); p != nil && p.Type() == "type_definition" {
+			if d := p.ChildByFieldName("declarator"); d != nil && d.Type() == "type_identifier" {
+				return text(a.src, d)
+			}
+		}
+		return ""
+	}
+	if name.Type() == "template_type" {
+		// explicit specialization: template <> class Foo<int>
+		name = name.ChildByFieldName("name")
+	}
+	return text(a.src, name)
+}
+
+// functionNameNode returns the declarator naming a function definition,
+// below the pointer and reference declarators of its return type.
+func functionNameNode(n *sitter.Node) *sitter.Node {
+	d := n.ChildByFieldName("declarator")
+	for d != nil && d.Type() != "function_declarator" {
+		d = d.ChildByFieldName("declarator")
+	}
+	if d == nil {
+		return nil
+	}
+	return d.ChildByFieldName("declarator")
+}
+
+// Modifiers reports the modifiers of a class or a function. A member takes
+// the visibility of the last access specifier written before it, private in
+// a class and public in a struct by default. A static function outside a
+// class is private to its file. An out-of-line definition ("void Foo::bar()")
+// repeats no modifier: its visibility is left unknown.
+func (a *TreeSitterAdapter) Modifiers(n *sitter.Node) *pb.Modifiers {
+	m := &pb.Modifiers{Visibility: pb.Visibility_VISIBILITY_PUBLIC}
+	static := false
+	for i := 0; i < int(n.NamedChildCount()); i++ {
+		if ch := n.NamedChild(i); ch.Type() == "storage_class_specifier" && text(a.src, ch) == "static" {
+			static = true
+		}
+	}
+	if n.Type() == "function_definition" {
+		m.IsAbstract = firstNamedChildOfType(n, "pure_virtual_clause") != nil
+		if d := n.ChildByFieldName("declarator"); d != nil {
+			for d != nil && d.Type() != "function_declarator" {
+				d = d.ChildByFieldName("declarator")
+			}
+			if d != nil {
+				if spec := firstNamedChildOfType(d, "virtual_specifier"); spec != nil && text(a.src, spec) == "final" {
+					m.IsFinal = true
+				}
+			}
+		}
+		if name := functionNameNode(n); name != nil && name.Type() == "qualified_identifier" {
+			m.Visibility = pb.Visibility_VISIBILITY_UNKNOWN
+			return m
+		}
+	} else if firstNamedChildOfType(n, "virtual_specifier") != nil {
+		// class Foo final { ... }
+		m.IsFinal = true
+	}
+	body := n.Parent()
+	if body == nil || body.Type() != "field_declaration_list" {
+		if static {
+			m.Visibility = pb.Visibility_VISIBILITY_PRIVATE
+		}
+		return m
+	}
+	m.IsStatic = static
+	if owner := body.Parent(); owner != nil && owner.Type() == "class_specifier" {
+		m.Visibility = pb.Visibility_VISIBILITY_PRIVATE
+	}
+	for prev := n.PrevNamedSibling(); prev != nil; prev = prev.PrevNamedSibling() {
+		if prev.Type() == "access_specifier" {
+			m.Visibility = Treesitter.KeywordModifiers([]string{text(a.src, prev)}, m.Visibility).Visibility
+			break
+		}
+	}
+	return m
+}
+
+// ReceiverTypeName returns the class an out-of-line definition belongs to:
+// "Foo" for `int Foo::bar()` and `Foo<T>::Foo()`. It is empty for a plain
+// function. A scope naming a namespace ("util::helper") binds to no class and
+// leaves the function where it is.
+func (a *TreeSitterAdapter) ReceiverTypeName(n *sitter.Node) string {
+	if n == nil || n.Type() != "function_definition" {
+		return ""
+	}
+	name := functionNameNode(n)
+	if name == nil || name.Type() != "qualified_identifier" {
+		return ""
+	}
+	var scope *sitter.Node
+	for name != nil && name.Type() == "qualified_identifier" {
+		scope = name.ChildByFieldName("scope")
+		name = name.ChildByFieldName("name")
+	}
+	if scope == nil {
+		return ""
+	}
+	if scope.Type() == "template_type" {
+		scope = scope.ChildByFieldName("name")
+	}
+	return text(a.src, scope)
+}
+
+// EnclosingScope returns the namespaces and classes holding a class, joined
+// with "::" (e.g. "app::core").
+func (a *TreeSitterAdapter) EnclosingScope(n *sitter.Node) string {
+	if a.src == nil || n == nil {
+		return ""
+	}
+	var segments []string
+	for p := n.Parent(); p != nil; p = p.Parent() {
+		switch p.Type() {
+		case "namespace_definition":
+			// anonymous namespaces add nothing to the name
+			if name := p.ChildByFieldName("name"); name != nil {
+				segments = append([]string{text(a.src, name)}, segments...)
+			}
+		case "class_specifier", "struct_specifier":
+			if name := a.NodeName(p); name != "" {
+				segments = append([]string{name}, segments...)
+			}
+		}
+	}
+	return strings.Join(segments, "::")
+}
+
+func (a *TreeSitterAdapter) NodeBody(n *sitter.Node) *sitter.Node {
+	if n == nil {
+		return nil
+	}
+	return n.ChildByFieldName("body")
+}
+
+func (a *TreeSitterAdapter) NodeParams(n *sitter.Node) *sitter.Node {
+	if n == nil {
+		return nil
+	}
+	d := n.ChildByFieldName("declarator")
+	for d != nil && d.Type() != "function_declarator" {
+		d = d.ChildByFieldName("declarator")
+	}
+	if d == nil {
+		return nil
+	}
+	return d.ChildByFieldName("parameters")
+}
+
+func (a *TreeSitterAdapter) EachParamIdent(params *sitter.Node, yield func(string)) {
+	if params == nil || a.src == nil {
+		return
+	}
+	for i := 0; i < int(params.NamedChildCount()); i++ {
+		p := params.NamedChild(i)
+		switch p.Type() {
+		case "parameter_declaration", "optional_parameter_declaration", "variadic_parameter_declaration":
+			// int a, const T& b = T(), int* p, Args... args: the identifier is
+			// below the pointer and reference declarators; unnamed
+			// parameters are skipped
+			d := p.ChildByFieldName("declarator")
+			for d != nil && d.Type() != "identifier" {
+				next := d.ChildByFieldName("declarator")
+				if next == nil {
+					next = firstNamedChildOfType(d, "identifier")
+				}
+				d = next
+			}
+			if d != nil {
+				yield(text(a.src, d))
+			}
+		}
+	}
+}
+
+// ModuleNameFromPath returns an empty namespace: the scope of a class comes
+// from the namespaces enclosing it, and a file may open several of them.
+func (a *TreeSitterAdapter) ModuleNameFromPath(path string) string { return "" }
+
+func (a *TreeSitterAdapter) AttachQualified(parentClass string, fn string) string {
+	if parentClass == "" {
+		return fn
+	}
+	return parentClass + "::" + fn
+}
+
+// NamespaceSeparator joins the namespaces and the class name with "::".
+func (a *TreeSitterAdapter) NamespaceSeparator() string { return "::" }
+
+func (a *TreeSitterAdapter) EachChildBody(body *sitter.Node, yield func(*sitter.Node)) {
+	if body == nil {
+		return
+	}
+	for i := 0; i < int(body.ChildCount()); i++ {
+		yield(body.Child(i))
+	}
+}
+
+func (a *TreeSitterAdapter) Decision(n *sitter.Node) (Treesitter.DecisionKind, *sitter.Node) {
+	switch n.Type() {
+	case "if_statement":
+		return Treesitter.DecIf, n.ChildByFieldName("consequence")
+
+	case "else_clause":
+		// else if: re-visit the nested if so that deeper branches keep being
+		// counted
+		if nested := firstNamedChildOfType(n, "if_statement"); nested != nil {
+			return Treesitter.DecElif, nested
+		}
+		return Treesitter.DecElse, n
+
+	case "switch_statement":
+		return Treesitter.DecSwitch, n.ChildByFieldName("body")
+
+	case "case_statement":
+		// "default:" shares the node of a case
+		return Treesitter.DecCase, n
+
+	case "for_statement", "for_range_loop", "while_statement", "do_statement":
+		return Treesitter.DecLoop, n.ChildByFieldName("body")
+	}
+	// conditional expressions and catch clauses intentionally left out,
+	// consistent with the other engines.
+	return Treesitter.DecNone, nil
+}
+
+// Imports reports the #include directives. The module is the included path,
+// without its quotes or angle brackets.
+func (a *TreeSitterAdapter) Imports(n *sitter.Node) []Treesitter.ImportItem {
+	if n == nil || n.Type() != "preproc_include" {
+		return nil
+	}
+	path := n.ChildByFieldName("path")
+	if path == nil {
+		return nil
+	}
+	module := strings.Trim(text(a.src, path), "\"<>")
+	if module == "" {
+		return nil
+	}
+	return []Treesitter.ImportItem{{Module: module, Name: ""}}
+}
+
+// Heritage reports the base classes of a class.
+func (a *TreeSitterAdapter) Heritage(n *sitter.Node) Treesitter.Heritage {
+	var h Treesitter.Heritage
+	if a.src == nil || n == nil {
+		return h
+	}
+	bases := firstNamedChildOfType(n, "base_class_clause")
+	if bases == nil {
+		return h
+	}
+	for i := 0; i < int(bases.NamedChildCount()); i++ {
+		base := bases.NamedChild(i)
+		switch base.Type() {
+		case "type_identifier":
+			name := text(a.src, base)
+			h.Extends = append(h.Extends, &pb.Name{Short: name, Qualified: name})
+		case "template_type":
+			name := text(a.src, base.ChildByFieldName("name"))
+			h.Extends = append(h.Extends, &pb.Name{Short: name, Qualified: name})
+		case "qualified_identifier":
+			short := base
+			for short.Type() == "qualified_identifier" && short.ChildByFieldName("name") != nil {
+				short = short.ChildByFieldName("name")
+			}
+			if short.Type() == "template_type" {
+				short = short.ChildByFieldName("name")
+			}
+			h.Extends = append(h.Extends, &pb.Name{Short: text(a.src, short), Qualified: text(a.src, base)})
+		}
+	}
+	return h
+}
+
+// CountElseIfAsIf: treat else-if as if for complexity aggregation (consistent with the other engines)
+func (a *TreeSitterAdapter) CountElseIfAsIf() bool { return true }
+
+// IsLogicalNode reports whether a node begins a logical line. Declarations
+// of local variables are statements without the "_statement" suffix.
+func (a *TreeSitterAdapter) IsLogicalNode(n *sitter.Node) bool {
+	if n.Type() == "declaration" {
+		p := n.Parent()
+		return p != nil && p.Type() == "compound_statement"
+	}
+	switch n.Type() {
+	case "for_range_loop":
+		return true
+	}
+	return Treesitter.IsDefaultLogicalNode(n.Type())
+}
+
+// CommentMarkers declares C++ comment tokens: "//" and "/* */" only.
+// "#" starts a preprocessor directive, not a comment.
+func (a *TreeSitterAdapter) CommentMarkers() engine.CommentMarkers {
+	return engine.CommentMarkers{SlashSlash: true, SlashStar: true}
+}
+
+// IsDocComment tells Doxygen comments ("/**", "/*!", "///", "//!") from
+// plain comments.
+func (a *TreeSitterAdapter) IsDocComment(text string) bool {
+	return strings.HasPrefix(text, "/**") || strings.HasPrefix(text, "/*!") || strings.HasPrefix(text, "///") || strings.HasPrefix(text, "//!")
+}
+
+// CountComments counts C++ comment lines (//, /* */ and Doxygen blocks) in the given range.
+func (a *TreeSitterAdapter) CountComments(lines []string, start, end int) int {
+	cnt := 0
+	inBlock := false
+	for i := start - 1; i < end && i < len(lines); i++ {
+		ln := strings.TrimSpace(lines[i])
+		if ln == "" {
+			continue
+		}
+		clean := stripCppStrings(ln)
+		if inBlock {
+			cnt++
+			if strings.Contains(clean, "*/") {
+				inBlock = false
+			}
+			continue
+		}
+		if strings.HasPrefix(clean, "//") {
+			cnt++
+			continue
+		}
+		if strings.HasPrefix(clean, "/*") {
+			cnt++
+			if !strings.Contains(clean, "*/") {
+				inBlock = true
+			}
+			continue
+		}
+	}
+	return cnt
+}
+
+// cppOperatorTokens lists the anonymous token types counted as Halstead
+// operators: arithmetic, comparison, logical, bitwise, assignments, member
+// access ("." and "->"), the scope operator, the argument separator, the
+// subscript, the conditional and the keywords driving the control flow or
+// the memory.
+var cppOperatorTokens = map[string]bool{
+	"+": true, "-": true, "*": true, "/": true, "%": true,
+	"==": true, "!=": true, "<": true, ">": true, "<=": true, ">=": true, "<=>": true,
+	"&&": true, "||": true, "!": true,
+	"&": true, "|": true, "^": true, "~": true, "<<": true, ">>": true,
+	"=": true, "+=": true, "-=": true, "*=": true, "/=": true, "%=": true,
+	"&=": true, "|=": true, "^=": true, "<<=": true, ">>=": true,
+	"++": true, "--": true, ".": true, "->": true, "::": true,
+	",": true, "[": true, "?": true,
+	"return": true, "if": true, "else": true, "for": true, "while": true,
+	"do": true, "switch": true, "case": true, "default": true,
+	"break": true, "continue": true, "goto": true,
+	"new": true, "delete": true, "sizeof": true,
+	"throw": true, "try": true, "catch": true, "co_return": true, "co_await": true,
+}
+
+// cppOperandTypes lists the named node types counted as Halstead operands.
+// Literals are left out on purpose, as in the other engines.
+var cppOperandTypes = map[string]bool{"identifier": true}
+
+var cppCallTypes = map[string]bool{"call_expression": true}
+
+// cppPruneTypes lists the node types never walked: types and specifiers
+// describe the declaration, not what it computes.
+var cppPruneTypes = map[string]bool{
+	"primitive_type": true, "type_identifier": true, "sized_type_specifier": true,
+	"template_argument_list": true, "template_parameter_list": true,
+	"type_qualifier": true, "storage_class_specifier": true,
+	"attribute_declaration": true, "access_specifier": true,
+}
+
+// cppPruneFields lists the fields holding a type: the type of a declaration,
+// of a parameter, of a cast.
+var cppPruneFields = map[string]bool{"type": true}
+
+// cppChainTypes lists the member access node types: obj.field, ptr->field.
+var cppChainTypes = map[string]bool{"field_expression": true}
+
+var cppOperandSpec = Treesitter.OperandSpec{
+	OperatorTokens: cppOperatorTokens,
+	OperandTypes:   cppOperandTypes,
+	CallTypes:      cppCallTypes,
+	PruneTypes:     cppPruneTypes,
+	PruneFields:    cppPruneFields,
+	ChainTypes:     cppChainTypes,
+	// no Receiver: the current object is the keyword "this"
+}
+
+// ExtractOperatorsOperands collects Halstead operators and operands from the
+// AST within the given 1-based inclusive line range. A member access is a
+// single operand, and "this->count" reads "this.count".
+func (a *TreeSitterAdapter) ExtractOperatorsOperands(src []byte, startLine, endLine int) ([]string, []string) {
+	root, source := a.ensureRoot(src)
+	if root == nil {
+		return nil, nil
+	}
+	return cppOperandSpec.Extract(root, source, startLine, endLine)
+}
+
+// ExtractMethodCalls extracts the calls made on the current object: through
+// "this->", and without any object, the way members usually call each other
+// in C++. A call to a free function of the same name as a method is rare
+// enough to be ignored.
+func (a *TreeSitterAdapter) ExtractMethodCalls(src []byte, startLine, endLine int) []string {
+	root, source := a.ensureRoot(src)
+	if root == nil || startLine <= 0 || endLine < startLine {
+		return nil
+	}
+	calls := cppOperandSpec.MethodCalls(root, source, startLine, endLine)
+	var walk func(n *sitter.Node)
+	walk = func(n *sitter.Node) {
+		if int(n.EndPoint().Row)+1 < startLine || int(n.StartPoint().Row)+1 > endLine {
+			return
+		}
+		if n.Type() == "call_expression" {
+			if fn := n.ChildByFieldName("function"); fn != nil && fn.Type() == "identifier" {
+				calls = append(calls, "this."+text(source, fn))
+			}
+		}
+		for i := 0; i < int(n.ChildCount()); i++ {
+			walk(n.Child(i))
+		}
+	}
+	walk(root)
+	return calls
+}
+
+// ClassDirectOperands lists the data members declared in the body of a class.
+func (a *TreeSitterAdapter) ClassDirectOperands(n *sitter.Node) []string {
+	if n == nil || a.src == nil {
+		return nil
+	}
+	body := n.ChildByFieldName("body")
+	if body == nil {
+		return nil
+	}
+	var fields []string
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		decl := body.NamedChild(i)
+		if decl.Type() != "field_declaration" {
+			continue
+		}
+		// int count_; std::vector<T>* items; but not a method prototype
+		d := decl.ChildByFieldName("declarator")
+		for d != nil && d.Type() != "field_identifier" && d.Type() != "function_declarator" {
+			d = d.ChildByFieldName("declarator")
+		}
+		if d != nil && d.Type() == "field_identifier" {
+			fields = append(fields, text(a.src, d))
+		}
+	}
+	return fields
+}
+
+// --- helpers ---
+
+func text(src []byte, n *sitter.Node) string {
+	if n == nil || src == nil {
+		return ""
+	}
+	return string(src[n.StartByte():n.EndByte()])
+}
+
+func firstNamedChildOfType(n *sitter.Node, t string) *sitter.Node {
+	if n == nil {
+		return nil
+	}
+	for i := 0; i < int(n.NamedChildCount()); i++ {
+		if c := n.NamedChild(i); c.Type() == t {
+			return c
+		}
+	}
+	return nil
+}
+
+// stripCppStrings removes content inside string and char literals to avoid
+// false positives in comment scanning.
+func stripCppStrings(s string) string {
+	out := make([]rune, 0, len(s))
+	inDq := false
+	inSq := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '\\' {
+			if i+1 < len(s) {
+				i++
+			}
+			continue
+		}
+		if !inSq && c == '"' {
+			inDq = !inDq
+			continue
+		}
+		if !inDq && c == '\'' {
+			inSq = !inSq
+			continue
+		}
+		if inDq || inSq {
+			continue
+		}
+		out = append(out, rune(c))
+	}
+	return string(out)
+}
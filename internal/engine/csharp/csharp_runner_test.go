@@ -873,3 +873,72 @@ public class Discovered
 	assert.Equal(t, 1, len(files[0].Stmts.StmtClass))
 	assert.Equal(t, "App.Discovered", files[0].Stmts.StmtClass[0].Name.Qualified)
 }
+
+func TestCSharpHeritage(t *testing.T) {
+	src := `
+class OrderRepository : Repository<Order>, IDisposable {}
+class Handler : IHandler, System.IComparable {}
+class Failure : System.Exception {}
+struct Point : IEquatable<Point> {}
+`
+	result := parseCSharp(t, src)
+	classes := engine.GetClassesInFile(result)
+	assert.Equal(t, 4, len(classes))
+
+	assert.Equal(t, "Repository", classes[0].Extends[0].Short)
+	assert.Equal(t, "IDisposable", classes[0].Implements[0].Short)
+	// an interface may come first
+	assert.Equal(t, 0, len(classes[1].Extends))
+	assert.Equal(t, 2, len(classes[1].Implements))
+	assert.Equal(t, "System.Exception", classes[2].Extends[0].Qualified)
+	assert.Equal(t, "Exception", classes[2].Extends[0].Short)
+	assert.Equal(t, 0, len(classes[3].Extends))
+	assert.Equal(t, "IEquatable", classes[3].Implements[0].Short)
+}
+
+func TestCSharpModifiers(t *testing.T) {
+	src := `
+namespace App {
+    sealed class Repository {
+        void Reset() {}
+        public static void Create() {}
+        protected internal void Save() {}
+    }
+}
+`
+	result := parseCSharp(t, src)
+	class := engine.GetClassesInFile(result)[0]
+	assert.Equal(t, pb.Visibility_VISIBILITY_PACKAGE, class.Modifiers.Visibility, "a type is internal by default")
+	assert.True(t, class.Modifiers.IsFinal, "a sealed class is final")
+
+	methods := class.Stmts.StmtFunction
+	assert.Equal(t, 3, len(methods))
+	assert.Equal(t, pb.Visibility_VISIBILITY_PRIVATE, methods[0].Modifiers.Visibility, "a member is private by default")
+	assert.Equal(t, pb.Visibility_VISIBILITY_PUBLIC, methods[1].Modifiers.Visibility)
+	assert.True(t, methods[1].Modifiers.IsStatic)
+	assert.Equal(t, pb.Visibility_VISIBILITY_PROTECTED, methods[2].Modifiers.Visibility)
+}
+
+func TestCSharpAnnotations(t *testing.T) {
+	src := `
+namespace App {
+    [ApiController, Route("api/[controller]")]
+    class UsersController {
+        [HttpGet("{id}", Name = "GetUser")]
+        [System.Obsolete]
+        public void Get(int id) {}
+    }
+}
+`
+	result := parseCSharp(t, src)
+	class := engine.GetClassesInFile(result)[0]
+	assert.Equal(t, 2, len(class.Annotations))
+	assert.Equal(t, "ApiController", class.Annotations[0].Name.Short)
+	assert.Equal(t, []string{`"api/[controller]"`}, class.Annotations[1].Arguments)
+
+	method := class.Stmts.StmtFunction[0]
+	assert.Equal(t, 2, len(method.Annotations))
+	assert.Equal(t, []string{`"{id}"`, `Name = "GetUser"`}, method.Annotations[0].Arguments)
+	assert.Equal(t, "Obsolete", method.Annotations[1].Name.Short)
+	assert.Equal(t, "System.Obsolete", method.Annotations[1].Name.Qualified)
+}
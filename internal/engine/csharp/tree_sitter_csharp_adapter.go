@@ -5,6 +5,7 @@ import (
 
 	"github.com/ast-metrics/ast-metrics/internal/engine"
 	Treesitter "github.com/ast-metrics/ast-metrics/internal/engine/treesitter"
+	pb "github.com/ast-metrics/ast-metrics/pb"
 	sitter "github.com/smacker/go-tree-sitter"
 	tsCSharp "github.com/smacker/go-tree-sitter/csharp"
 )
@@ -288,6 +289,112 @@ func (a *TreeSitterAdapter) Imports(n *sitter.Node) []Treesitter.ImportItem {
 	return []Treesitter.ImportItem{{Module: module, Name: alias}}
 }
 
+// Heritage reports the base class and the interfaces of a type. The grammar
+// does not tell them apart in the base list: the base class can only come
+// first, and the naming convention of .NET ("IDisposable") tells whether the
+// first one is an interface. Structs and enums only implement interfaces.
+func (a *TreeSitterAdapter) Heritage(n *sitter.Node) Treesitter.Heritage {
+	var h Treesitter.Heritage
+	if a.src == nil || n == nil {
+		return h
+	}
+	bases := firstChildOfType(n, "base_list")
+	if bases == nil {
+		return h
+	}
+	canExtend := n.Type() == "class_declaration" || n.Type() == "record_declaration"
+	for i := 0; i < int(bases.NamedChildCount()); i++ {
+		name := a.typeName(bases.NamedChild(i))
+		if name == nil {
+			continue
+		}
+		if i == 0 && canExtend && !isInterfaceName(name.Short) {
+			h.Extends = append(h.Extends, name)
+		} else {
+			h.Implements = append(h.Implements, name)
+		}
+	}
+	return h
+}
+
+// Modifiers reports the modifiers of a type or a method. Without keyword, a
+// top-level type is internal, a member is private, and the members of an
+// interface are public. A sealed type or method is final.
+func (a *TreeSitterAdapter) Modifiers(n *sitter.Node) *pb.Modifiers {
+	visibility := pb.Visibility_VISIBILITY_PACKAGE
+	if body := n.Parent(); body != nil && body.Type() == "declaration_list" {
+		visibility = pb.Visibility_VISIBILITY_PRIVATE
+		if owner := body.Parent(); owner != nil {
+			switch owner.Type() {
+			case "interface_declaration":
+				visibility = pb.Visibility_VISIBILITY_PUBLIC
+			case "namespace_declaration":
+				visibility = pb.Visibility_VISIBILITY_PACKAGE
+			}
+		}
+	}
+	keywords := Treesitter.ModifierKeywords(n, map[string]bool{"modifier": true})
+	m := Treesitter.KeywordModifiers(keywords, visibility)
+	if Treesitter.HasKeyword(keywords, "sealed") {
+		m.IsFinal = true
+	}
+	return m
+}
+
+// Annotations reports the attributes of a type or a method: [Serializable],
+// [Route("api/users")] or [System.Obsolete("use Save")], named as written.
+func (a *TreeSitterAdapter) Annotations(n *sitter.Node) []*pb.Annotation {
+	if a.src == nil {
+		return nil
+	}
+	var annotations []*pb.Annotation
+	for i := 0; i < int(n.NamedChildCount()); i++ {
+		list := n.NamedChild(i)
+		if list.Type() != "attribute_list" {
+			continue
+		}
+		for j := 0; j < int(list.NamedChildCount()); j++ {
+			attribute := list.NamedChild(j)
+			if attribute.Type() != "attribute" {
+				continue
+			}
+			name := text(a.src, attribute.ChildByFieldName("name"))
+			arguments := Treesitter.AnnotationArguments(firstChildOfType(attribute, "attribute_argument_list"), a.src)
+			annotations = append(annotations, Treesitter.NewAnnotation(name, ".", arguments))
+		}
+	}
+	return annotations
+}
+
+// typeName returns the name of a base type: "Base", "Repository<User>" (named
+// after Repository) or "System.Exception".
+func (a *TreeSitterAdapter) typeName(t *sitter.Node) *pb.Name {
+	switch t.Type() {
+	case "identifier":
+		name := text(a.src, t)
+		return &pb.Name{Short: name, Qualified: name}
+	case "generic_name":
+		if id := firstChildOfType(t, "identifier"); id != nil {
+			return a.typeName(id)
+		}
+	case "qualified_name":
+		name := a.typeName(t.ChildByFieldName("name"))
+		if name == nil {
+			return nil
+		}
+		qualified := text(a.src, t)
+		if idx := strings.Index(qualified, "<"); idx >= 0 {
+			qualified = qualified[:idx]
+		}
+		return &pb.Name{Short: name.Short, Qualified: qualified}
+	}
+	return nil
+}
+
+func isInterfaceName(name string) bool {
+	return len(name) > 1 && name[0] == 'I' && name[1] >= 'A' && name[1] <= 'Z'
+}
+
 // CountElseIfAsIf: treat else-if as if for complexity aggregation (consistent with Go/PHP/TS)
 func (a *TreeSitterAdapter) CountElseIfAsIf() bool { return true }
 
@@ -298,6 +405,12 @@ func (a *TreeSitterAdapter) CommentMarkers() engine.CommentMarkers {
 	return engine.CommentMarkers{SlashSlash: true, SlashStar: true}
 }
 
+// IsDocComment tells XML documentation comments ("///", "/**") from plain
+// comments.
+func (a *TreeSitterAdapter) IsDocComment(text string) bool {
+	return strings.HasPrefix(text, "///") || strings.HasPrefix(text, "/**")
+}
+
 func (a *TreeSitterAdapter) CountComments(lines []string, start, end int) int {
 	cnt := 0
 	inBlock := false
@@ -0,0 +1,178 @@
+package elixir
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/ast-metrics/ast-metrics/internal/configuration"
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	Treesitter "github.com/ast-metrics/ast-metrics/internal/engine/treesitter"
+	"github.com/ast-metrics/ast-metrics/internal/file"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+
+	"github.com/pterm/pterm"
+	sitter "github.com/smacker/go-tree-sitter"
+	"google.golang.org/protobuf/proto"
+)
+
+type ElixirRunner struct {
+	progressbar   *pterm.SpinnerPrinter
+	Configuration *configuration.Configuration
+	foundFiles    file.FileList
+}
+
+func (r ElixirRunner) Name() string                                     { return "Elixir" }
+func (r ElixirRunner) IsRequired() bool                                 { return len(r.getFileList().Files) > 0 }
+func (r *ElixirRunner) Ensure() error                                   { return nil }
+func (r *ElixirRunner) SetProgressbar(p *pterm.SpinnerPrinter)          { r.progressbar = p }
+func (r *ElixirRunner) SetConfiguration(c *configuration.Configuration) { r.Configuration = c }
+
+func (r ElixirRunner) DumpAST() []*pb.File {
+	return engine.DumpFiles(
+		r.getFileList().Files,
+		r.progressbar,
+		func(path string) (*pb.File, error) { return r.Parse(path) },
+		engine.DumpOptions{Label: r.Name()},
+	)
+}
+
+func (r ElixirRunner) Finish() error {
+	if r.progressbar != nil {
+		r.progressbar.Stop()
+	}
+	return nil
+}
+
+func (r ElixirRunner) Parse(path string) (*pb.File, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return &pb.File{Path: path, ProgrammingLanguage: "Elixir"}, err
+	}
+
+	parser := sitter.NewParser()
+	adapter := NewTreeSitterAdapter(src)
+	parser.SetLanguage(adapter.Language())
+
+	tree := parser.Parse(nil, src)
+	root := tree.RootNode()
+	adapter.SetRootNode(root)
+
+	v := Treesitter.NewVisitor(adapter, path, src)
+	v.SetQueries(Treesitter.QueriesFor(r.Configuration, "elixir", path))
+	v.Visit(root)
+
+	file := v.Result()
+	file.ProgrammingLanguage = "Elixir"
+	mergeClauses(file)
+
+	// Detect if file is a test file
+	file.IsTest = r.isTestFile(path, src)
+
+	return file, nil
+}
+
+func (r *ElixirRunner) getFileList() file.FileList {
+	if r.foundFiles.Files != nil {
+		return r.foundFiles
+	}
+	finder := file.Finder{Configuration: *r.Configuration}
+	if r.Configuration.FileDiscovery != nil {
+		if fd, ok := r.Configuration.FileDiscovery.(*file.FileDiscovery); ok {
+			finder.Discovery = fd
+		}
+	}
+	extensions := r.Configuration.GetExtensionsForLanguage("elixir")
+	var lists []file.FileList
+	for _, ext := range extensions {
+		lists = append(lists, finder.Search(ext))
+	}
+	r.foundFiles = file.MergeFileLists(lists...)
+	return r.foundFiles
+}
+
+// mergeClauses merges the clauses of a multi-clause function into a single
+// function. Elixir identifies a function by its name and its arity: the
+// clauses of "fact/1" are one function, whose branches are the clauses, while
+// "fact/2" is another one. The arity is appended to the qualified name, so
+// that functions of the same name but of different arities stay apart.
+func mergeClauses(file *pb.File) {
+	merged := map[*pb.StmtFunction]bool{}
+	var walk func(stmts *pb.Stmts)
+	walk = func(stmts *pb.Stmts) {
+		if stmts == nil {
+			return
+		}
+		kept := stmts.StmtFunction[:0]
+		var last *pb.StmtFunction
+		for _, fn := range stmts.StmtFunction {
+			if merged[fn] {
+				// already merged where it was first found (a function is
+				// reachable from its module and from the namespace)
+				continue
+			}
+			if fn.Name != nil && !strings.Contains(fn.Name.Qualified, "/") {
+				fn.Name.Qualified += "/" + strconv.Itoa(len(fn.Parameters))
+			}
+			// the clauses of a function must be grouped in the source
+			if last != nil && fn.Name != nil && last.Name != nil && fn.Name.Qualified == last.Name.Qualified {
+				mergeClause(last, fn)
+				merged[fn] = true
+				continue
+			}
+			kept = append(kept, fn)
+			last = fn
+			walk(fn.Stmts)
+		}
+		stmts.StmtFunction = kept
+		for _, c := range stmts.StmtClass {
+			walk(c.Stmts)
+		}
+		for _, ns := range stmts.StmtNamespace {
+			walk(ns.Stmts)
+		}
+	}
+	walk(file.Stmts)
+}
+
+// mergeClause adds a clause to the function holding the previous ones: its
+// statements, operators, operands and calls are appended, its lines added,
+// and the function now spans every clause.
+func mergeClause(fn, clause *pb.StmtFunction) {
+	// proto.Merge merges the messages in place: the lines and the location of
+	// the first clause are kept apart from it
+	parameters, loc, location := fn.Parameters, fn.LinesOfCode, fn.Location
+	fn.LinesOfCode, fn.Location = nil, nil
+	proto.Merge(fn, clause)
+	fn.Parameters = parameters
+	fn.LinesOfCode = loc
+	if loc != nil && clause.LinesOfCode != nil {
+		loc.LinesOfCode += clause.LinesOfCode.LinesOfCode
+		loc.LogicalLinesOfCode += clause.LinesOfCode.LogicalLinesOfCode
+		loc.CommentLinesOfCode += clause.LinesOfCode.CommentLinesOfCode
+		loc.NonCommentLinesOfCode += clause.LinesOfCode.NonCommentLinesOfCode
+	}
+	fn.Location = location
+	if location != nil && clause.Location != nil {
+		location.EndLine = clause.Location.EndLine
+		location.EndFilePos = clause.Location.EndFilePos
+	}
+}
+
+// isTestFile determines if an Elixir file is a test file based on:
+// 1. Filename pattern (foo_test.exs)
+// 2. Mix conventional test directory (test/)
+// 3. Source code using ExUnit
+func (r ElixirRunner) isTestFile(path string, src []byte) bool {
+	base := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	if strings.HasSuffix(base, "_test") {
+		return true
+	}
+	normalized := "/" + filepath.ToSlash(filepath.Dir(path)) + "/"
+	if strings.Contains(normalized, "/test/") {
+		return true
+	}
+
+	return strings.Contains(string(src), "ExUnit.Case")
+}
@@ -0,0 +1,275 @@
+package elixir
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func parseElixir(t *testing.T, src string) *pb.File {
+	t.Helper()
+	result, err := engine.CreateTestFileWithCode(&ElixirRunner{}, src)
+	assert.Nil(t, err, "Expected no error, got %v", err)
+	assert.NotNil(t, result)
+	return result
+}
+
+func findClass(file *pb.File, name string) *pb.StmtClass {
+	for _, c := range engine.GetClassesInFile(file) {
+		if c.Name != nil && c.Name.Short == name {
+			return c
+		}
+	}
+	return nil
+}
+
+func findFunction(file *pb.File, qualified string) *pb.StmtFunction {
+	for _, fn := range engine.GetFunctionsInFile(file) {
+		if fn.Name != nil && fn.Name.Qualified == qualified {
+			return fn
+		}
+	}
+	return nil
+}
+
+func TestElixirModulesAndProtocols(t *testing.T) {
+	src := `defmodule MyApp.Accounts.User do
+  defstruct [:name, age: 0]
+
+  def hello, do: :world
+
+  defp secret(x) when is_integer(x), do: x * 2
+
+  defmacro debug(expr) do
+    quote do: IO.inspect(unquote(expr))
+  end
+
+  defmodule Nested do
+    def ping(), do: :pong
+  end
+end
+
+defprotocol Size do
+  def size(data)
+end
+`
+	result := parseElixir(t, src)
+	assert.Equal(t, "Elixir", result.ProgrammingLanguage)
+
+	user := findClass(result, "MyApp.Accounts.User")
+	assert.NotNil(t, user)
+	assert.Equal(t, 3, len(user.Stmts.StmtFunction))
+	fields := []string{}
+	for _, op := range user.Operands {
+		fields = append(fields, op.Name)
+	}
+	assert.ElementsMatch(t, []string{"name", "age"}, fields)
+
+	nested := findClass(result, "Nested")
+	assert.NotNil(t, nested)
+	assert.Equal(t, "MyApp.Accounts.User.Nested", nested.Name.Qualified)
+
+	// functions are identified by their arity
+	assert.NotNil(t, findFunction(result, "MyApp.Accounts.User.hello/0"))
+	assert.NotNil(t, findFunction(result, "MyApp.Accounts.User.Nested.ping/0"))
+	secret := findFunction(result, "MyApp.Accounts.User.secret/1")
+	assert.NotNil(t, secret)
+	assert.Equal(t, "x", secret.Parameters[0].Name)
+
+	assert.Equal(t, 1, len(result.Stmts.StmtInterface))
+	assert.Equal(t, "Size", result.Stmts.StmtInterface[0].Name.Short)
+}
+
+func TestElixirMultiClauseFunctionsAreMerged(t *testing.T) {
+	src := `defmodule Math do
+  def fact(0), do: 1
+  def fact(n) when n > 0 do
+    n * fact(n - 1)
+  end
+
+  def add(a), do: a
+  def add(a, b), do: a + b
+
+  def scale(x, factor \\ 2), do: x * factor
+end
+`
+	result := parseElixir(t, src)
+	math := findClass(result, "Math")
+	assert.NotNil(t, math)
+	assert.Equal(t, 4, len(math.Stmts.StmtFunction))
+
+	fact := findFunction(result, "Math.fact/1")
+	assert.NotNil(t, fact)
+	assert.Equal(t, int32(2), fact.Location.StartLine)
+	assert.Equal(t, int32(5), fact.Location.EndLine)
+	assert.Equal(t, "0", fact.Parameters[0].Name, "the parameters are the ones of the first clause")
+	calls := []string{}
+	for _, c := range fact.MethodCalls {
+		calls = append(calls, c.Name)
+	}
+	assert.Equal(t, []string{"this.fact"}, calls)
+
+	// same name, another arity: another function
+	assert.NotNil(t, findFunction(result, "Math.add/1"))
+	add2 := findFunction(result, "Math.add/2")
+	assert.NotNil(t, add2)
+	assert.Equal(t, []string{"a", "b"}, []string{add2.Parameters[0].Name, add2.Parameters[1].Name})
+
+	// a default value is not part of the parameter name
+	scale := findFunction(result, "Math.scale/2")
+	assert.NotNil(t, scale)
+	assert.Equal(t, "factor", scale.Parameters[1].Name)
+}
+
+func TestElixirDecisions(t *testing.T) {
+	src := `defmodule Check do
+  def check(a, b) do
+    if a > 0 do
+      :pos
+    else
+      :neg
+    end
+
+    unless b, do: :none, else: :some
+
+    case a do
+      0 -> :zero
+      1 -> :one
+      _ -> :many
+    end
+
+    cond do
+      a > 10 -> :big
+      true -> :small
+    end
+
+    with {:ok, x} <- fetch(a),
+         {:ok, y} <- fetch(b) do
+      x + y
+    else
+      :error -> nil
+    end
+
+    for i <- [1, 2], do: i
+  end
+end
+`
+	result := parseElixir(t, src)
+	fn := findFunction(result, "Check.check/2")
+	assert.NotNil(t, fn)
+	assert.Equal(t, 2, len(fn.Stmts.StmtDecisionIf))
+	assert.Equal(t, 2, len(fn.Stmts.StmtDecisionElse))
+	assert.Equal(t, 3, len(fn.Stmts.StmtDecisionSwitch))
+	// 3 case clauses, 2 cond clauses, 2 with matches and 1 with else clause
+	assert.Equal(t, 8, len(fn.Stmts.StmtDecisionCase))
+	assert.Equal(t, 1, len(fn.Stmts.StmtLoop))
+}
+
+func TestElixirImportsAndHeritage(t *testing.T) {
+	src := `defmodule MyApp.Worker do
+  use GenServer
+  @behaviour MyApp.Job
+  alias MyApp.{Repo, User}
+  import Ecto.Query
+  require Logger
+end
+`
+	result := parseElixir(t, src)
+
+	found := map[string]bool{}
+	for _, dep := range engine.GetDependenciesInFile(result) {
+		found[dep.Namespace+":"+dep.ClassName] = true
+	}
+	for _, expected := range []string{":GenServer", "MyApp:Repo", "MyApp:User", "Ecto:Query", ":Logger"} {
+		assert.True(t, found[expected], "dependency %s not found in %v", expected, found)
+	}
+
+	worker := findClass(result, "MyApp.Worker")
+	assert.NotNil(t, worker)
+	assert.Equal(t, "GenServer", worker.Uses[0].Qualified)
+	assert.Equal(t, "MyApp.Job", worker.Implements[0].Qualified)
+}
+
+func TestElixirCohesion(t *testing.T) {
+	src := `defmodule Cart do
+  def total(items) do
+    items
+    |> Enum.map(&price/1)
+    |> sum()
+    |> __MODULE__.round_total()
+  end
+end
+`
+	result := parseElixir(t, src)
+	fn := findFunction(result, "Cart.total/1")
+	assert.NotNil(t, fn)
+	calls := []string{}
+	for _, c := range fn.MethodCalls {
+		calls = append(calls, c.Name)
+	}
+	assert.Contains(t, calls, "this.sum", "a local call is a call on the module")
+	assert.Contains(t, calls, "this.round_total")
+	assert.NotContains(t, calls, "this.map", "a call on another module says nothing about this one")
+
+	operators := []string{}
+	for _, op := range fn.Operators {
+		operators = append(operators, op.Name)
+	}
+	assert.Contains(t, operators, "|>")
+	assert.Contains(t, operators, "()")
+}
+
+func TestElixirRunner_IsTest(t *testing.T) {
+	cases := []struct {
+		name     string
+		code     string
+		expected bool
+	}{
+		{"cart_test.exs", "defmodule CartTest do\nend", true},
+		{"test/support/factory.ex", "defmodule Factory do\nend", true},
+		{"checks.exs", "defmodule Checks do\n  use ExUnit.Case\nend", true},
+		{"lib/cart.ex", "defmodule Cart do\nend", false},
+		{"latest.ex", "defmodule Latest do\nend", false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tc.name)
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				t.Fatalf("failed to create dir: %v", err)
+			}
+			if err := os.WriteFile(path, []byte(tc.code), 0644); err != nil {
+				t.Fatalf("failed to create file: %v", err)
+			}
+			file, err := (&ElixirRunner{}).Parse(path)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expected, file.IsTest)
+		})
+	}
+}
+
+func TestElixirRunner_Parse_NonExistentFile(t *testing.T) {
+	file, err := (&ElixirRunner{}).Parse("/nonexistent/file.ex")
+	assert.NotNil(t, err)
+	assert.NotNil(t, file)
+	assert.Equal(t, "Elixir", file.ProgrammingLanguage)
+}
+
+func TestElixirModifiers(t *testing.T) {
+	src := `
+defmodule Repository do
+  def find(id), do: lookup(id)
+  defp lookup(id), do: id
+end
+`
+	result := parseElixir(t, src)
+	methods := map[string]*pb.Modifiers{}
+	for _, fn := range engine.GetFunctionsInFile(result) {
+		methods[fn.Name.Short] = fn.Modifiers
+	}
+	assert.Equal(t, pb.Visibility_VISIBILITY_PUBLIC, methods["find"].Visibility)
+	assert.Equal(t, pb.Visibility_VISIBILITY_PRIVATE, methods["lookup"].Visibility)
+}
@@ -0,0 +1,724 @@
+package elixir
+
+import (
+	"strings"
+
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	Treesitter "github.com/ast-metrics/ast-metrics/internal/engine/treesitter"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+	sitter "github.com/smacker/go-tree-sitter"
+	tsElixir "github.com/smacker/go-tree-sitter/elixir"
+)
+
+type TreeSitterAdapter struct {
+	src []byte
+	// root caches the tree shared by the runner, to avoid re-parsing
+	root *sitter.Node
+}
+
+func NewTreeSitterAdapter(src []byte) *TreeSitterAdapter   { return &TreeSitterAdapter{src: src} }
+func (a *TreeSitterAdapter) SetSource(src []byte)          { a.src = src; a.root = nil }
+func (a *TreeSitterAdapter) SetRootNode(root *sitter.Node) { a.root = root }
+func (a *TreeSitterAdapter) Language() *sitter.Language    { return tsElixir.GetLanguage() }
+
+// ensureRoot returns the tree shared by the runner, parsing the source when
+// the adapter is used on its own (tests).
+func (a *TreeSitterAdapter) ensureRoot(src []byte) (*sitter.Node, []byte) {
+	source := a.src
+	if source == nil {
+		source = src
+	}
+	if a.root != nil {
+		return a.root, source
+	}
+	if source == nil {
+		return nil, nil
+	}
+	parser := sitter.NewParser()
+	parser.SetLanguage(a.Language())
+	a.root = parser.Parse(nil, source).RootNode()
+	return a.root, source
+}
+
+// Elixir has no keyword: "defmodule", "def" or "case" are macros, and the
+// grammar parses them as plain calls. They are told apart by the name of the
+// called macro.
+
+// elixirFunctionMacros lists the macros defining a function. Macros are
+// measured like functions: their body is code like any other.
+var elixirFunctionMacros = map[string]bool{
+	"def": true, "defp": true, "defmacro": true, "defmacrop": true,
+}
+
+// elixirImportMacros lists the macros making a module depend on another one.
+var elixirImportMacros = map[string]bool{
+	"alias": true, "import": true, "require": true, "use": true,
+}
+
+// macroName returns the name of the macro called by n ("defmodule", "case"),
+// or an empty string when n is not a local call.
+func (a *TreeSitterAdapter) macroName(n *sitter.Node) string {
+	if n == nil || n.Type() != "call" || a.src == nil {
+		return ""
+	}
+	target := n.ChildByFieldName("target")
+	if target == nil || target.Type() != "identifier" {
+		return ""
+	}
+	return text(a.src, target)
+}
+
+func (a *TreeSitterAdapter) IsModule(n *sitter.Node) bool { return n.Type() == "source" }
+
+// IsClass maps modules to StmtClass: a module groups the functions working on
+// the same data, the way a class does.
+func (a *TreeSitterAdapter) IsClass(n *sitter.Node) bool {
+	return a.macroName(n) == "defmodule"
+}
+
+// IsInterface maps protocols to StmtInterface: a protocol declares functions
+// that every implementation provides.
+func (a *TreeSitterAdapter) IsInterface(n *sitter.Node) bool {
+	return a.macroName(n) == "defprotocol"
+}
+
+func (a *TreeSitterAdapter) IsFunction(n *sitter.Node) bool {
+	// Anonymous functions (fn -> end) are not named functions; their bodies
+	// still contribute decisions to the enclosing function via the fallback
+	// recursion. A function head without a body ("def area(shape)" in a
+	// protocol) has nothing to measure.
+	return elixirFunctionMacros[a.macroName(n)] && a.NodeBody(n) != nil
+}
+
+// functionHead returns the call naming a function and its parameters:
+// "greet(name)" in "def greet(name) when is_binary(name) do".
+func functionHead(n *sitter.Node) *sitter.Node {
+	args := firstChildOfType(n, "arguments")
+	if args == nil || args.NamedChildCount() == 0 {
+		return nil
+	}
+	head := args.NamedChild(0)
+	if head.Type() == "binary_operator" {
+		// a guard: the head is on the left of "when"
+		head = head.ChildByFieldName("left")
+	}
+	return head
+}
+
+func (a *TreeSitterAdapter) NodeName(n *sitter.Node) string {
+	if a.src == nil || n == nil {
+		return ""
+	}
+	if elixirFunctionMacros[a.macroName(n)] {
+		head := functionHead(n)
+		if head == nil {
+			return ""
+		}
+		if head.Type() == "call" {
+			return text(a.src, head.ChildByFieldName("target"))
+		}
+		// a function without parameters may be written without parentheses
+		return text(a.src, head)
+	}
+	// a module: "defmodule MyApp.Accounts.User do"
+	if args := firstChildOfType(n, "arguments"); args != nil && args.NamedChildCount() > 0 {
+		return text(a.src, args.NamedChild(0))
+	}
+	return ""
+}
+
+// EnclosingScope returns the modules holding a declaration, joined with "."
+// (e.g. "MyApp.Accounts"): a nested module is named after its parents.
+func (a *TreeSitterAdapter) EnclosingScope(n *sitter.Node) string {
+	if a.src == nil || n == nil {
+		return ""
+	}
+	var segments []string
+	for p := n.Parent(); p != nil; p = p.Parent() {
+		switch a.macroName(p) {
+		case "defmodule", "defprotocol":
+			if name := a.NodeName(p); name != "" {
+				segments = append([]string{name}, segments...)
+			}
+		}
+	}
+	return strings.Join(segments, ".")
+}
+
+// NodeBody returns the do_block of a module or a function, or the keyword
+// list of a function written on one line ("def hello, do: :world").
+func (a *TreeSitterAdapter) NodeBody(n *sitter.Node) *sitter.Node {
+	if n == nil {
+		return nil
+	}
+	if block := firstChildOfType(n, "do_block"); block != nil {
+		return block
+	}
+	if args := firstChildOfType(n, "arguments"); args != nil {
+		if kw := firstChildOfType(args, "keywords"); kw != nil && a.keywordValue(kw, "do") != nil {
+			return kw
+		}
+	}
+	return nil
+}
+
+// keywordValue returns the value of the given key in a keyword list
+// ("do: :world"), or nil.
+func (a *TreeSitterAdapter) keywordValue(kw *sitter.Node, key string) *sitter.Node {
+	for i := 0; i < int(kw.NamedChildCount()); i++ {
+		pair := kw.NamedChild(i)
+		if pair.Type() == "pair" && keywordKey(a.src, pair) == key {
+			return pair.ChildByFieldName("value")
+		}
+	}
+	return nil
+}
+
+// keywordKey returns the key of a keyword pair without its colon: "do" for
+// "do: :world".
+func keywordKey(src []byte, pair *sitter.Node) string {
+	return strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(text(src, pair.ChildByFieldName("key"))), ":"))
+}
+
+func (a *TreeSitterAdapter) NodeParams(n *sitter.Node) *sitter.Node {
+	if head := functionHead(n); head != nil && head.Type() == "call" {
+		return firstChildOfType(head, "arguments")
+	}
+	return nil
+}
+
+// EachParamIdent yields the name of each parameter. A parameter matched
+// against a pattern ("%{name: name}", "0") is named after the pattern, and a
+// default value ("y \\ 2") is left out of the name.
+func (a *TreeSitterAdapter) EachParamIdent(params *sitter.Node, yield func(string)) {
+	if params == nil || a.src == nil {
+		return
+	}
+	for i := 0; i < int(params.NamedChildCount()); i++ {
+		p := params.NamedChild(i)
+		if p.Type() == "binary_operator" && operatorOf(p) == `\\` {
+			p = p.ChildByFieldName("left")
+		}
+		yield(text(a.src, p))
+	}
+}
+
+// ModuleNameFromPath returns an empty string: an Elixir module carries its
+// full name ("MyApp.Accounts.User") and the file declares no namespace.
+func (a *TreeSitterAdapter) ModuleNameFromPath(path string) string { return "" }
+
+func (a *TreeSitterAdapter) AttachQualified(parentClass string, fn string) string {
+	if parentClass == "" {
+		return fn
+	}
+	return parentClass + "." + fn
+}
+
+// NamespaceSeparator joins a module and the modules it nests with ".".
+func (a *TreeSitterAdapter) NamespaceSeparator() string { return "." }
+
+func (a *TreeSitterAdapter) EachChildBody(body *sitter.Node, yield func(*sitter.Node)) {
+	if body == nil {
+		return
+	}
+	for i := 0; i < int(body.ChildCount()); i++ {
+		yield(body.Child(i))
+	}
+}
+
+func (a *TreeSitterAdapter) Decision(n *sitter.Node) (Treesitter.DecisionKind, *sitter.Node) {
+	switch n.Type() {
+	case "call":
+		switch a.macroName(n) {
+		case "if", "unless":
+			return Treesitter.DecIf, a.NodeBody(n)
+		case "case", "cond", "receive":
+			return Treesitter.DecSwitch, n
+		case "with":
+			// every "<-" clause of a with is a match that may fail: the with
+			// branches like a switch over its clauses
+			return Treesitter.DecSwitch, n
+		case "for":
+			return Treesitter.DecLoop, a.NodeBody(n)
+		}
+
+	case "else_block":
+		if block := n.Parent(); block != nil {
+			switch a.macroName(block.Parent()) {
+			case "if", "unless":
+				return Treesitter.DecElse, n
+			}
+		}
+
+	case "pair":
+		// "if x, do: 1, else: 2"
+		if keywordKey(a.src, n) == "else" && a.isArgumentOf(n.Parent(), "if", "unless") {
+			return Treesitter.DecElse, n.ChildByFieldName("value")
+		}
+
+	case "stab_clause":
+		// the clauses of a case, a cond, a receive, and the else clauses of a
+		// with; the clauses of an anonymous function are left out
+		if block := n.Parent(); block != nil {
+			holder := block.Parent()
+			if block.Type() == "else_block" && holder != nil {
+				holder = holder.Parent()
+			}
+			switch a.macroName(holder) {
+			case "case", "cond", "receive", "with":
+				return Treesitter.DecCase, n
+			}
+		}
+
+	case "binary_operator":
+		if operatorOf(n) == "<-" && a.isArgumentOf(n, "with") {
+			return Treesitter.DecCase, n
+		}
+	}
+	// try/rescue and the boolean operators are intentionally left out,
+	// consistent with the other engines.
+	return Treesitter.DecNone, nil
+}
+
+// isArgumentOf reports whether n is an argument of a call to one of the
+// given macros, directly or in its keyword list.
+func (a *TreeSitterAdapter) isArgumentOf(n *sitter.Node, macros ...string) bool {
+	if n == nil {
+		return false
+	}
+	args := n.Parent()
+	if args != nil && args.Type() == "keywords" {
+		args = args.Parent()
+	}
+	if args == nil || args.Type() != "arguments" {
+		return false
+	}
+	name := a.macroName(args.Parent())
+	for _, m := range macros {
+		if name == m {
+			return true
+		}
+	}
+	return false
+}
+
+// Condition returns the condition of an if or an unless: the first argument
+// of the macro.
+func (a *TreeSitterAdapter) Condition(n *sitter.Node) *sitter.Node {
+	if n.Type() != "call" {
+		return nil
+	}
+	switch a.macroName(n) {
+	case "if", "unless":
+		if args := firstChildOfType(n, "arguments"); args != nil && args.NamedChildCount() > 0 {
+			return args.NamedChild(0)
+		}
+	}
+	return nil
+}
+
+// Imports reports the modules named by alias, import, require and use.
+// "alias MyApp.{Repo, User}" names two modules of MyApp.
+func (a *TreeSitterAdapter) Imports(n *sitter.Node) []Treesitter.ImportItem {
+	if !elixirImportMacros[a.macroName(n)] {
+		return nil
+	}
+	args := firstChildOfType(n, "arguments")
+	if args == nil || args.NamedChildCount() == 0 {
+		return nil
+	}
+	target := args.NamedChild(0)
+	switch target.Type() {
+	case "alias":
+		return []Treesitter.ImportItem{splitModule(text(a.src, target))}
+	case "dot":
+		left, right := target.ChildByFieldName("left"), target.ChildByFieldName("right")
+		if left == nil || right == nil || left.Type() != "alias" || right.Type() != "tuple" {
+			return nil
+		}
+		prefix := text(a.src, left)
+		var items []Treesitter.ImportItem
+		for i := 0; i < int(right.NamedChildCount()); i++ {
+			if m := right.NamedChild(i); m.Type() == "alias" {
+				items = append(items, splitModule(prefix+"."+text(a.src, m)))
+			}
+		}
+		return items
+	}
+	return nil
+}
+
+// splitModule names a module after its last segment, in the namespace of the
+// others: "MyApp.Repo" is Repo, in MyApp.
+func splitModule(module string) Treesitter.ImportItem {
+	if idx := strings.LastIndex(module, "."); idx >= 0 {
+		return Treesitter.ImportItem{Module: module[:idx], Name: module[idx+1:]}
+	}
+	return Treesitter.ImportItem{Module: "", Name: module}
+}
+
+// Heritage reports the behaviours a module implements ("@behaviour
+// GenServer") and the modules it uses ("use GenServer"): "use" injects the
+// code of the used module, like a mixin.
+func (a *TreeSitterAdapter) Heritage(n *sitter.Node) Treesitter.Heritage {
+	var h Treesitter.Heritage
+	body := a.NodeBody(n)
+	if a.src == nil || body == nil {
+		return h
+	}
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		ch := body.NamedChild(i)
+		switch {
+		case a.macroName(ch) == "use":
+			if args := firstChildOfType(ch, "arguments"); args != nil && args.NamedChildCount() > 0 {
+				if m := args.NamedChild(0); m.Type() == "alias" {
+					h.Uses = append(h.Uses, moduleName(text(a.src, m)))
+				}
+			}
+		case ch.Type() == "unary_operator" && operatorOf(ch) == "@":
+			attr := ch.ChildByFieldName("operand")
+			if name := a.macroName(attr); name == "behaviour" || name == "behavior" {
+				if args := firstChildOfType(attr, "arguments"); args != nil && args.NamedChildCount() > 0 {
+					if m := args.NamedChild(0); m.Type() == "alias" {
+						h.Implements = append(h.Implements, moduleName(text(a.src, m)))
+					}
+				}
+			}
+		}
+	}
+	return h
+}
+
+func moduleName(module string) *pb.Name {
+	short := module
+	if idx := strings.LastIndex(module, "."); idx >= 0 {
+		short = module[idx+1:]
+	}
+	return &pb.Name{Short: short, Qualified: module}
+}
+
+// Modifiers reports the visibility of a function: "def" and "defmacro" are
+// public, "defp" and "defmacrop" private to the module. Modules are public.
+func (a *TreeSitterAdapter) Modifiers(n *sitter.Node) *pb.Modifiers {
+	switch a.macroName(n) {
+	case "defp", "defmacrop":
+		return &pb.Modifiers{Visibility: pb.Visibility_VISIBILITY_PRIVATE}
+	}
+	return &pb.Modifiers{Visibility: pb.Visibility_VISIBILITY_PUBLIC}
+}
+
+// IsLogicalNode reports whether a node begins a logical line: the expressions
+// written directly in a do block or in the body of a clause.
+func (a *TreeSitterAdapter) IsLogicalNode(n *sitter.Node) bool {
+	if !n.IsNamed() {
+		return false
+	}
+	switch n.Type() {
+	case "comment", "else_block", "after_block", "rescue_block", "catch_block", "stab_clause":
+		return false
+	}
+	p := n.Parent()
+	if p == nil {
+		return false
+	}
+	switch p.Type() {
+	case "do_block", "else_block", "after_block", "rescue_block", "catch_block", "body":
+		return true
+	}
+	return false
+}
+
+// CommentMarkers declares Elixir comment tokens: only "#" starts a comment.
+func (a *TreeSitterAdapter) CommentMarkers() engine.CommentMarkers {
+	return engine.CommentMarkers{Hash: true}
+}
+
+// DocString returns the @moduledoc attribute of a module, or the @doc
+// attribute written before a function. Other attributes (@spec, @impl) and the
+// previous clauses of the same function may stand between a function and its
+// @doc.
+func (a *TreeSitterAdapter) DocString(n *sitter.Node) *sitter.Node {
+	if a.IsClass(n) {
+		body := a.NodeBody(n)
+		if body == nil {
+			return nil
+		}
+		for i := 0; i < int(body.NamedChildCount()); i++ {
+			if ch := body.NamedChild(i); a.attributeName(ch) == "moduledoc" {
+				return ch
+			}
+		}
+		return nil
+	}
+	name := a.NodeName(n)
+	for prev := n.PrevNamedSibling(); prev != nil; prev = prev.PrevNamedSibling() {
+		switch attribute := a.attributeName(prev); {
+		case attribute == "doc":
+			return prev
+		case attribute != "", prev.Type() == "comment":
+			continue
+		case a.IsFunction(prev) && a.NodeName(prev) == name:
+			continue
+		}
+		return nil
+	}
+	return nil
+}
+
+// attributeName returns the name of a module attribute ("doc" for
+// "@doc ..."), or an empty string when the node is not an attribute.
+func (a *TreeSitterAdapter) attributeName(n *sitter.Node) string {
+	if n == nil || n.Type() != "unary_operator" {
+		return ""
+	}
+	if op := n.ChildByFieldName("operator"); op == nil || op.Type() != "@" {
+		return ""
+	}
+	return a.macroName(n.ChildByFieldName("operand"))
+}
+
+// IsDocComment returns false: Elixir documents with the @doc and @moduledoc
+// attributes, a comment does not document a declaration.
+func (a *TreeSitterAdapter) IsDocComment(text string) bool {
+	return false
+}
+
+// CountComments counts Elixir comment lines in the given range. Module and
+// function docs (@moduledoc, @doc) are attributes holding a string: they are
+// code for the compiler, not comments.
+func (a *TreeSitterAdapter) CountComments(lines []string, start, end int) int {
+	cnt := 0
+	for i := start - 1; i < end && i < len(lines); i++ {
+		if strings.HasPrefix(strings.TrimSpace(lines[i]), "#") {
+			cnt++
+		}
+	}
+	return cnt
+}
+
+// elixirOperatorTokens lists the anonymous token types counted as Halstead
+// operators: arithmetic, comparison, boolean in both spellings, the pipe, the
+// match and its arrows, concatenations, member access, the argument
+// separator, the default value and the guard.
+var elixirOperatorTokens = map[string]bool{
+	"+": true, "-": true, "*": true, "/": true,
+	"==": true, "!=": true, "===": true, "!==": true, "=~": true,
+	"<": true, ">": true, "<=": true, ">=": true,
+	"&&": true, "||": true, "!": true, "and": true, "or": true, "not": true,
+	"in": true, "|>": true, "=": true, "<-": true, "->": true,
+	"<>": true, "++": true, "--": true, "..": true, "|": true, "&": true,
+	".": true, ",": true, `\\`: true, "when": true, "fn": true,
+	"else": true, "rescue": true, "catch": true, "after": true,
+}
+
+// elixirControlMacros lists the macros driving the control flow. The grammar
+// parses them as calls to an identifier: their name is an operator, not an
+// operand.
+var elixirControlMacros = map[string]bool{
+	"if": true, "unless": true, "case": true, "cond": true, "with": true,
+	"for": true, "receive": true, "try": true, "raise": true, "throw": true,
+}
+
+// elixirOperandTypes lists the named node types counted as Halstead operands:
+// variables and function names. Literals (atoms included) are left out on
+// purpose, as in the other engines.
+var elixirOperandTypes = map[string]bool{"identifier": true}
+
+var elixirCallTypes = map[string]bool{"call": true}
+
+// elixirPruneTypes lists the node types never walked: the module attributes
+// (@doc, @spec) document the function, they compute nothing.
+var elixirPruneTypes = map[string]bool{"comment": true}
+
+// elixirChainTypes lists the remote call node type: Module.function.
+var elixirChainTypes = map[string]bool{"dot": true}
+
+var elixirOperandSpec = Treesitter.OperandSpec{
+	OperatorTokens: elixirOperatorTokens,
+	OperandTypes:   elixirOperandTypes,
+	CallTypes:      elixirCallTypes,
+	PruneTypes:     elixirPruneTypes,
+	ChainTypes:     elixirChainTypes,
+}
+
+// ExtractOperatorsOperands collects Halstead operators and operands from the
+// AST within the given 1-based inclusive line range. A function is read from
+// its parameters, its guard and its body: its head ("def greet(name)") is a
+// call for the grammar, not for the reader. The control macros ("case",
+// "if") come out of the walk as identifiers: they are moved to the operators.
+func (a *TreeSitterAdapter) ExtractOperatorsOperands(src []byte, startLine, endLine int) ([]string, []string) {
+	root, source := a.ensureRoot(src)
+	if root == nil {
+		return nil, nil
+	}
+	var ops, operands []string
+	for _, part := range a.functionParts(root, startLine, endLine) {
+		o, p := elixirOperandSpec.Extract(part, source, int(part.StartPoint().Row)+1, int(part.EndPoint().Row)+1)
+		ops, operands = append(ops, o...), append(operands, p...)
+		if part.Type() == "binary_operator" {
+			// the guard itself
+			ops = append(ops, "when")
+		}
+	}
+	kept := operands[:0]
+	for _, operand := range operands {
+		if elixirControlMacros[operand] {
+			ops = append(ops, operand)
+			continue
+		}
+		kept = append(kept, operand)
+	}
+	return ops, kept
+}
+
+// functionParts returns the parameters, the guard and the body of the
+// function spanning the given lines. Anything else in the range is returned
+// whole, as the root.
+func (a *TreeSitterAdapter) functionParts(root *sitter.Node, startLine, endLine int) []*sitter.Node {
+	fn := a.functionAt(root, startLine, endLine)
+	if fn == nil {
+		return []*sitter.Node{root}
+	}
+	var parts []*sitter.Node
+	if params := a.NodeParams(fn); params != nil {
+		parts = append(parts, params)
+	}
+	if args := firstChildOfType(fn, "arguments"); args != nil && args.NamedChildCount() > 0 {
+		if head := args.NamedChild(0); head.Type() == "binary_operator" {
+			if guard := head.ChildByFieldName("right"); guard != nil {
+				parts = append(parts, guard)
+			}
+		}
+	}
+	if body := a.NodeBody(fn); body != nil {
+		if body.Type() == "keywords" {
+			// "do: expr": the key is syntax, the value is the body
+			if value := a.keywordValue(body, "do"); value != nil {
+				parts = append(parts, value)
+			}
+		} else {
+			parts = append(parts, body)
+		}
+	}
+	return parts
+}
+
+// functionAt returns the function spanning exactly the given lines, or nil.
+func (a *TreeSitterAdapter) functionAt(root *sitter.Node, startLine, endLine int) *sitter.Node {
+	var found *sitter.Node
+	var walk func(n *sitter.Node)
+	walk = func(n *sitter.Node) {
+		if found != nil || int(n.EndPoint().Row)+1 < startLine || int(n.StartPoint().Row)+1 > endLine {
+			return
+		}
+		if int(n.StartPoint().Row)+1 == startLine && int(n.EndPoint().Row)+1 == endLine && a.IsFunction(n) {
+			found = n
+			return
+		}
+		for i := 0; i < int(n.NamedChildCount()); i++ {
+			walk(n.NamedChild(i))
+		}
+	}
+	walk(root)
+	return found
+}
+
+// ExtractMethodCalls extracts the calls to the functions of the current
+// module: local calls ("check(x)", "x |> check()") and calls through
+// __MODULE__. Elixir has no object, the module is the closest thing to one.
+func (a *TreeSitterAdapter) ExtractMethodCalls(src []byte, startLine, endLine int) []string {
+	root, source := a.ensureRoot(src)
+	if root == nil || startLine <= 0 || endLine < startLine {
+		return nil
+	}
+	var calls []string
+	var walk func(n *sitter.Node)
+	walk = func(n *sitter.Node) {
+		if n.Type() == "call" {
+			if target := n.ChildByFieldName("target"); target != nil {
+				switch target.Type() {
+				case "identifier":
+					name := text(source, target)
+					if !elixirControlMacros[name] && !elixirFunctionMacros[name] && !elixirImportMacros[name] {
+						calls = append(calls, "this."+name)
+					}
+				case "dot":
+					left, right := target.ChildByFieldName("left"), target.ChildByFieldName("right")
+					if left != nil && right != nil && text(source, left) == "__MODULE__" {
+						calls = append(calls, "this."+text(source, right))
+					}
+				}
+			}
+		}
+		for i := 0; i < int(n.ChildCount()); i++ {
+			walk(n.Child(i))
+		}
+	}
+	for _, part := range a.functionParts(root, startLine, endLine) {
+		walk(part)
+	}
+	return calls
+}
+
+// ClassDirectOperands lists the fields of the struct a module defines
+// ("defstruct [:name, age: 0]").
+func (a *TreeSitterAdapter) ClassDirectOperands(n *sitter.Node) []string {
+	body := a.NodeBody(n)
+	if body == nil || a.src == nil {
+		return nil
+	}
+	var fields []string
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		ch := body.NamedChild(i)
+		if a.macroName(ch) != "defstruct" {
+			continue
+		}
+		var walk func(x *sitter.Node)
+		walk = func(x *sitter.Node) {
+			switch x.Type() {
+			case "atom":
+				fields = append(fields, strings.TrimPrefix(text(a.src, x), ":"))
+				return
+			case "pair":
+				fields = append(fields, keywordKey(a.src, x))
+				return
+			}
+			for j := 0; j < int(x.NamedChildCount()); j++ {
+				walk(x.NamedChild(j))
+			}
+		}
+		if args := firstChildOfType(ch, "arguments"); args != nil {
+			walk(args)
+		}
+	}
+	return fields
+}
+
+// --- helpers ---
+
+func text(src []byte, n *sitter.Node) string {
+	if n == nil || src == nil {
+		return ""
+	}
+	return string(src[n.StartByte():n.EndByte()])
+}
+
+func firstChildOfType(n *sitter.Node, t string) *sitter.Node {
+	if n == nil {
+		return nil
+	}
+	for i := 0; i < int(n.ChildCount()); i++ {
+		if c := n.Child(i); c.Type() == t {
+			return c
+		}
+	}
+	return nil
+}
+
+// operatorOf returns the operator token of a unary or binary operator node.
+func operatorOf(n *sitter.Node) string {
+	if op := n.ChildByFieldName("operator"); op != nil {
+		return op.Type()
+	}
+	return ""
+}
@@ -0,0 +1,161 @@
+package golang
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/ast-metrics/ast-metrics/pb"
+)
+
+// parseGoFiles writes the sources in a temporary module and parses them,
+// keyed by their path relative to the module.
+func parseGoFiles(t *testing.T, sources map[string]string) map[string]*pb.File {
+	t.Helper()
+	dir := t.TempDir()
+	r := &GolangRunner{}
+	files := map[string]*pb.File{}
+	for name, src := range sources {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+		file, err := r.Parse(path)
+		if err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		files[name] = file
+	}
+	list := make([]*pb.File, 0, len(files))
+	for _, file := range files {
+		list = append(list, file)
+	}
+	resolveImplicitInterfaces(list)
+	return files
+}
+
+func implementsOf(file *pb.File, class string) []string {
+	for _, c := range file.Stmts.StmtClass {
+		if c.Name.Short == class {
+			var names []string
+			for _, name := range c.Implements {
+				names = append(names, name.Qualified)
+			}
+			return names
+		}
+	}
+	return nil
+}
+
+func TestGoParser_TreeSitter_Interfaces(t *testing.T) {
+	files := parseGoFiles(t, map[string]string{"store/store.go": `package store
+
+type ReadCloser interface {
+	Reader
+	io.Closer
+	Close() error
+}
+
+type Number interface {
+	~int | ~float64
+}
+
+type File struct {
+	Base
+	*io.PipeReader
+	name string
+}
+`})
+	file := files["store/store.go"]
+	if len(file.Stmts.StmtInterface) != 2 {
+		t.Fatalf("expected 2 interfaces, got %d", len(file.Stmts.StmtInterface))
+	}
+	itf := file.Stmts.StmtInterface[0]
+	if len(itf.Stmts.StmtFunction) != 1 || itf.Stmts.StmtFunction[0].Name.Qualified != "store\\ReadCloser.Close" {
+		t.Errorf("expected the interface to require Close, got %v", itf.Stmts.StmtFunction)
+	}
+	if len(itf.Extends) != 2 || itf.Extends[0].Qualified != "store\\Reader" || itf.Extends[1].Qualified != "io\\Closer" {
+		t.Errorf("expected the interface to embed Reader and io.Closer, got %v", itf.Extends)
+	}
+	if len(file.Stmts.StmtInterface[1].Extends) != 0 {
+		t.Errorf("a union of types embeds no interface")
+	}
+
+	uses := file.Stmts.StmtClass[0].Uses
+	if len(uses) != 2 || uses[0].Qualified != "store\\Base" || uses[1].Qualified != "io\\PipeReader" {
+		t.Errorf("expected the embedded types to be uses of the struct, got %v", uses)
+	}
+}
+
+func TestGoParser_TreeSitter_ImplicitInterfaces(t *testing.T) {
+	files := parseGoFiles(t, map[string]string{
+		"ports/ports.go": `package ports
+
+type Reader interface {
+	Find(id int) error
+}
+
+type Repository interface {
+	Reader
+	Save() error
+}
+
+type Named interface {
+	error
+	Name() string
+}
+
+type sealed interface {
+	seal()
+}
+`,
+		"adapters/base.go": `package adapters
+
+type Base struct{}
+
+func (b Base) Find(id int) error { return nil }
+`,
+		"adapters/sql.go": `package adapters
+
+type SQLStore struct {
+	Base
+}
+
+type Memory struct{}
+
+func (m Memory) Find(id int) error { return nil }
+func (m Memory) seal() {}
+`,
+		// the methods of SQLStore are declared in another file
+		"adapters/sql_save.go": `package adapters
+
+func (s *SQLStore) Save() error { return nil }
+func (s *SQLStore) Name() string { return "" }
+func (s *SQLStore) Error() string { return "" }
+`,
+	})
+
+	sql := implementsOf(files["adapters/sql.go"], "SQLStore")
+	expected := []string{"ports\\Named", "ports\\Reader", "ports\\Repository"}
+	if len(sql) != len(expected) {
+		t.Fatalf("expected SQLStore to implement %v, got %v", expected, sql)
+	}
+	for i := range expected {
+		if sql[i] != expected[i] {
+			t.Errorf("expected SQLStore to implement %v, got %v", expected, sql)
+		}
+	}
+
+	// an unexported method can only be implemented in the package of the
+	// interface
+	memory := implementsOf(files["adapters/sql.go"], "Memory")
+	if len(memory) != 1 || memory[0] != "ports\\Reader" {
+		t.Errorf("expected Memory to implement Reader only, got %v", memory)
+	}
+	if base := implementsOf(files["adapters/base.go"], "Base"); len(base) != 1 {
+		t.Errorf("expected Base to implement Reader, got %v", base)
+	}
+}
@@ -53,14 +53,18 @@ func (r GolangRunner) Finish() error {
 	return nil
 }
 
-// DumpAST parses Go files and returns in-memory AST objects
+// DumpAST parses Go files and returns in-memory AST objects. The interfaces
+// the structs implement are resolved once every file is parsed: a struct and
+// the interfaces it satisfies are usually declared in different files.
 func (r GolangRunner) DumpAST() []*pb.File {
-	return engine.DumpFiles(
+	files := engine.DumpFiles(
 		r.getFileList().Files,
 		r.progressbar,
 		func(path string) (*pb.File, error) { return r.Parse(path) },
 		engine.DumpOptions{Label: r.Name()},
 	)
+	resolveImplicitInterfaces(files)
+	return files
 }
 
 func (r GolangRunner) Name() string {
@@ -120,11 +124,14 @@ func (r *GolangRunner) Parse(path string) (*pb.File, error) {
 	root := tree.RootNode()
 
 	v := Treesitter.NewVisitor(adapter, path, src)
+	v.SetQueries(Treesitter.QueriesFor(r.Configuration, "go", path))
 	v.Visit(root)
 	file := v.Result()
 	file.ProgrammingLanguage = "Golang"
 	if root.HasError() {
-		file.Errors = append(file.Errors, "Parse error")
+		for _, e := range file.ParseErrors {
+			file.Errors = append(file.Errors, Treesitter.ParseErrorText(e))
+		}
 	}
 
 	// Detect if file is a test file
@@ -206,3 +206,36 @@ func Add(a, b int) int {
 		t.Fatalf("expected file NOT to be detected as test")
 	}
 }
+
+func TestGoParser_TreeSitter_Modifiers(t *testing.T) {
+	src := `package store
+
+type Repository struct{}
+type cache struct{}
+
+func (r Repository) Save() {}
+func (r Repository) reset() {}
+`
+	file, err := enginePkg.CreateTestFileWithCode(&GolangRunner{}, src)
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	visibilities := map[string]pb.Visibility{}
+	for _, class := range enginePkg.GetClassesInFile(file) {
+		visibilities[class.Name.Short] = class.Modifiers.GetVisibility()
+	}
+	for _, fn := range enginePkg.GetFunctionsInFile(file) {
+		visibilities[fn.Name.Short] = fn.Modifiers.GetVisibility()
+	}
+	expected := map[string]pb.Visibility{
+		"Repository": pb.Visibility_VISIBILITY_PUBLIC,
+		"cache":      pb.Visibility_VISIBILITY_PACKAGE,
+		"Save":       pb.Visibility_VISIBILITY_PUBLIC,
+		"reset":      pb.Visibility_VISIBILITY_PACKAGE,
+	}
+	for name, visibility := range expected {
+		if visibilities[name] != visibility {
+			t.Errorf("expected %s to be %v, got %v", name, visibility, visibilities[name])
+		}
+	}
+}
@@ -0,0 +1,261 @@
+package golang
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	engine "github.com/ast-metrics/ast-metrics/internal/engine"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+)
+
+// goPackage gathers the types of the files of a directory: the methods of a
+// struct may be declared in any file of its package.
+type goPackage struct {
+	name       string
+	structs    map[string]*pb.StmtClass
+	interfaces map[string]*pb.StmtInterface
+	// methods lists the names of the methods declared on each type, by the
+	// short name of the type
+	methods map[string]map[string]bool
+}
+
+// interfaceResolver finds the interfaces a struct satisfies. Go never says it:
+// a struct implements every interface whose methods it declares.
+type interfaceResolver struct {
+	packages map[string]*goPackage   // by directory
+	byName   map[string][]*goPackage // by package name, for "io.Reader"
+	// method sets already computed, nil while being computed (cycles)
+	interfaceSets map[*pb.StmtInterface]map[string]bool
+	structSets    map[*pb.StmtClass]map[string]bool
+}
+
+// resolveImplicitInterfaces fills the implements of the structs of the
+// analyzed files with the interfaces of these files they satisfy. Method sets
+// are compared by name: the AST does not type the parameters. The methods of
+// a pointer receiver count, as do the methods promoted by embedded types. An
+// interface declared outside of the analyzed files is unknown, and so is any
+// interface embedding it.
+func resolveImplicitInterfaces(files []*pb.File) {
+	r := &interfaceResolver{
+		packages:      map[string]*goPackage{},
+		byName:        map[string][]*goPackage{},
+		interfaceSets: map[*pb.StmtInterface]map[string]bool{},
+		structSets:    map[*pb.StmtClass]map[string]bool{},
+	}
+	for _, file := range files {
+		r.add(file)
+	}
+
+	dirs := make([]string, 0, len(r.packages))
+	for dir := range r.packages {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+
+	for _, dir := range dirs {
+		pkg := r.packages[dir]
+		for _, name := range sortedKeys(pkg.structs) {
+			class := pkg.structs[name]
+			methods := r.structMethods(pkg, class)
+			for _, itfDir := range dirs {
+				itfPkg := r.packages[itfDir]
+				for _, itfName := range sortedKeys(itfPkg.interfaces) {
+					itf := itfPkg.interfaces[itfName]
+					required := r.interfaceMethods(itfPkg, itf)
+					if len(required) == 0 || !satisfies(methods, required, itfPkg == pkg) {
+						continue
+					}
+					addImplements(class, itf.Name)
+				}
+			}
+		}
+	}
+}
+
+func (r *interfaceResolver) add(file *pb.File) {
+	if file == nil || file.Stmts == nil {
+		return
+	}
+	dir := filepath.Dir(file.Path)
+	pkg, ok := r.packages[dir]
+	if !ok {
+		pkg = &goPackage{
+			structs:    map[string]*pb.StmtClass{},
+			interfaces: map[string]*pb.StmtInterface{},
+			methods:    map[string]map[string]bool{},
+		}
+		r.packages[dir] = pkg
+	}
+	if pkg.name == "" {
+		for _, ns := range file.Stmts.StmtNamespace {
+			if ns != nil && ns.Name != nil && ns.Name.Short != "" {
+				pkg.name = ns.Name.Short
+				r.byName[pkg.name] = append(r.byName[pkg.name], pkg)
+				break
+			}
+		}
+	}
+
+	for _, class := range engine.GetClassesInFile(file) {
+		if class.Name != nil {
+			pkg.structs[class.Name.Short] = class
+		}
+	}
+	interfaces := file.Stmts.StmtInterface
+	for _, ns := range file.Stmts.StmtNamespace {
+		if ns != nil && ns.Stmts != nil {
+			interfaces = append(interfaces, ns.Stmts.StmtInterface...)
+		}
+	}
+	for _, itf := range interfaces {
+		if itf != nil && itf.Name != nil {
+			pkg.interfaces[itf.Name.Short] = itf
+		}
+	}
+	// a method is qualified with its receiver ("store\\File.Read"), whether it
+	// was bound to its struct or declared in another file than the struct
+	for _, fn := range engine.GetFunctionsInFile(file) {
+		if fn.Name == nil || fn.Name.Short == "" {
+			continue
+		}
+		owner, found := strings.CutSuffix(fn.Name.Qualified, "."+fn.Name.Short)
+		if !found {
+			continue
+		}
+		receiver := owner[strings.LastIndex(owner, "\\")+1:]
+		if pkg.methods[receiver] == nil {
+			pkg.methods[receiver] = map[string]bool{}
+		}
+		pkg.methods[receiver][fn.Name.Short] = true
+	}
+}
+
+// interfaceMethods returns the methods required by an interface, including
+// the ones of the interfaces it embeds. It is nil when one of them is unknown.
+func (r *interfaceResolver) interfaceMethods(pkg *goPackage, itf *pb.StmtInterface) map[string]bool {
+	if set, ok := r.interfaceSets[itf]; ok {
+		return set
+	}
+	r.interfaceSets[itf] = nil
+	set := map[string]bool{}
+	for _, fn := range itf.Stmts.GetStmtFunction() {
+		set[fn.GetName().GetShort()] = true
+	}
+	for _, name := range itf.Extends {
+		if name.GetQualified() == pkg.name+"\\error" || name.GetQualified() == "error" {
+			if _, declared := pkg.interfaces["error"]; !declared {
+				// the built-in error interface
+				set["Error"] = true
+				continue
+			}
+		}
+		embeddedPkg, embedded := r.lookupInterface(pkg, name)
+		if embedded == nil {
+			return nil
+		}
+		methods := r.interfaceMethods(embeddedPkg, embedded)
+		if methods == nil {
+			return nil
+		}
+		for method := range methods {
+			set[method] = true
+		}
+	}
+	r.interfaceSets[itf] = set
+	return set
+}
+
+// structMethods returns the methods of a struct: its own, and the ones
+// promoted by the types it embeds.
+func (r *interfaceResolver) structMethods(pkg *goPackage, class *pb.StmtClass) map[string]bool {
+	if set, ok := r.structSets[class]; ok {
+		return set
+	}
+	r.structSets[class] = nil
+	set := map[string]bool{}
+	for method := range pkg.methods[class.Name.Short] {
+		set[method] = true
+	}
+	for _, name := range class.Uses {
+		var promoted map[string]bool
+		if embeddedPkg, embedded := r.lookupStruct(pkg, name); embedded != nil {
+			promoted = r.structMethods(embeddedPkg, embedded)
+		} else if embeddedPkg, embedded := r.lookupInterface(pkg, name); embedded != nil {
+			promoted = r.interfaceMethods(embeddedPkg, embedded)
+		}
+		for method := range promoted {
+			set[method] = true
+		}
+	}
+	r.structSets[class] = set
+	return set
+}
+
+// lookupStruct finds the struct a name refers to, in the package of the name
+// or in the package it is qualified with.
+func (r *interfaceResolver) lookupStruct(from *goPackage, name *pb.Name) (*goPackage, *pb.StmtClass) {
+	for _, pkg := range r.packagesOf(from, name) {
+		if class, ok := pkg.structs[name.GetShort()]; ok {
+			return pkg, class
+		}
+	}
+	return nil, nil
+}
+
+// lookupInterface finds the interface a name refers to, like lookupStruct.
+func (r *interfaceResolver) lookupInterface(from *goPackage, name *pb.Name) (*goPackage, *pb.StmtInterface) {
+	for _, pkg := range r.packagesOf(from, name) {
+		if itf, ok := pkg.interfaces[name.GetShort()]; ok {
+			return pkg, itf
+		}
+	}
+	return nil, nil
+}
+
+// packagesOf returns the packages a name may be declared in: the package it
+// is written in, unless it is qualified with the name of another package.
+func (r *interfaceResolver) packagesOf(from *goPackage, name *pb.Name) []*goPackage {
+	qualifier := ""
+	if i := strings.LastIndex(name.GetQualified(), "\\"); i >= 0 {
+		qualifier = name.GetQualified()[:i]
+	}
+	if qualifier == "" || qualifier == from.name {
+		return []*goPackage{from}
+	}
+	return r.byName[qualifier]
+}
+
+// satisfies reports whether a method set holds every required method. An
+// unexported method can only be declared in the package of the interface.
+func satisfies(methods map[string]bool, required map[string]bool, samePackage bool) bool {
+	for method := range required {
+		if !methods[method] {
+			return false
+		}
+		if r, _ := utf8.DecodeRuneInString(method); !samePackage && !unicode.IsUpper(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func addImplements(class *pb.StmtClass, name *pb.Name) {
+	for _, existing := range class.Implements {
+		if existing.GetQualified() == name.GetQualified() {
+			return
+		}
+	}
+	class.Implements = append(class.Implements, &pb.Name{Short: name.Short, Qualified: name.Qualified})
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -3,9 +3,12 @@ package golang
 import (
 	"path/filepath"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/ast-metrics/ast-metrics/internal/engine"
 	Treesitter "github.com/ast-metrics/ast-metrics/internal/engine/treesitter"
+	pb "github.com/ast-metrics/ast-metrics/pb"
 	sitter "github.com/smacker/go-tree-sitter"
 	tsGo "github.com/smacker/go-tree-sitter/golang"
 )
@@ -43,6 +46,9 @@ func (a *TreeSitterAdapter) IsModule(n *sitter.Node) bool { return n.Type() == "
 func (a *TreeSitterAdapter) IsClass(n *sitter.Node) bool {
 	return n.Type() == "type_declaration" && firstChildOfType(n, "type_spec") != nil && firstDescendantOfType(n, "type_identifier") != nil && firstDescendantOfType(n, "type_parameter_list") == nil && firstDescendantOfType(n, "struct_type") != nil
 }
+func (a *TreeSitterAdapter) IsInterface(n *sitter.Node) bool {
+	return n.Type() == "type_declaration" && a.typeOfSpec(n, "interface_type") != nil
+}
 func (a *TreeSitterAdapter) IsFunction(n *sitter.Node) bool {
 	return n.Type() == "function_declaration" || n.Type() == "method_declaration"
 }
@@ -61,6 +67,11 @@ func (a *TreeSitterAdapter) NodeName(n *sitter.Node) string {
 		if id := firstDescendantOfType(n, "type_identifier"); id != nil {
 			return text(a.src, id)
 		}
+	case "method_elem", "method_spec":
+		// a method of an interface
+		if id := n.ChildByFieldName("name"); id != nil {
+			return text(a.src, id)
+		}
 	}
 	return ""
 }
@@ -90,6 +101,99 @@ func (a *TreeSitterAdapter) NodeParams(n *sitter.Node) *sitter.Node {
 	return nil
 }
 
+// typeOfSpec returns the type of a type declaration ("struct_type",
+// "interface_type") when it is of the given kind.
+func (a *TreeSitterAdapter) typeOfSpec(n *sitter.Node, kind string) *sitter.Node {
+	spec := firstChildOfType(n, "type_spec")
+	if spec == nil {
+		return nil
+	}
+	if t := spec.ChildByFieldName("type"); t != nil && t.Type() == kind {
+		return t
+	}
+	return nil
+}
+
+// InterfaceMembers returns the methods an interface requires and the
+// interfaces it embeds. A union of types (~int | ~float64) constrains a type
+// parameter and embeds nothing.
+func (a *TreeSitterAdapter) InterfaceMembers(n *sitter.Node) ([]*sitter.Node, []*pb.Name) {
+	itf := a.typeOfSpec(n, "interface_type")
+	if itf == nil || a.src == nil {
+		return nil, nil
+	}
+	var methods []*sitter.Node
+	var embedded []*pb.Name
+	for i := 0; i < int(itf.NamedChildCount()); i++ {
+		ch := itf.NamedChild(i)
+		switch ch.Type() {
+		case "method_elem", "method_spec":
+			if ch.ChildByFieldName("name") != nil {
+				methods = append(methods, ch)
+			}
+		case "type_elem", "interface_type_name":
+			if ch.NamedChildCount() != 1 {
+				continue
+			}
+			if name := a.typeName(ch.NamedChild(0)); name != nil {
+				embedded = append(embedded, name)
+			}
+		}
+	}
+	return methods, embedded
+}
+
+// Heritage reports the types embedded in a struct as its uses: like a mixin,
+// an embedded type lends its methods to the struct. The interfaces a struct
+// implements are resolved once every file of the module is parsed.
+func (a *TreeSitterAdapter) Heritage(n *sitter.Node) Treesitter.Heritage {
+	var h Treesitter.Heritage
+	st := a.typeOfSpec(n, "struct_type")
+	if st == nil || a.src == nil {
+		return h
+	}
+	fields := firstChildOfType(st, "field_declaration_list")
+	if fields == nil {
+		return h
+	}
+	for i := 0; i < int(fields.NamedChildCount()); i++ {
+		field := fields.NamedChild(i)
+		if field.Type() != "field_declaration" || field.ChildByFieldName("name") != nil {
+			continue
+		}
+		if name := a.typeName(field.ChildByFieldName("type")); name != nil {
+			h.Uses = append(h.Uses, name)
+		}
+	}
+	return h
+}
+
+// typeName returns the name of a named type, qualified like the classes:
+// "store\\Base" for Base, "io\\Reader" for io.Reader.
+func (a *TreeSitterAdapter) typeName(t *sitter.Node) *pb.Name {
+	if t == nil {
+		return nil
+	}
+	switch t.Type() {
+	case "type_identifier":
+		name := text(a.src, t)
+		qualified := name
+		if pkg := a.packageName(); pkg != "" {
+			qualified = pkg + "\\" + name
+		}
+		return &pb.Name{Short: name, Qualified: qualified}
+	case "qualified_type":
+		pkg, name := t.ChildByFieldName("package"), t.ChildByFieldName("name")
+		if pkg == nil || name == nil {
+			return nil
+		}
+		return &pb.Name{Short: text(a.src, name), Qualified: text(a.src, pkg) + "\\" + text(a.src, name)}
+	case "generic_type":
+		return a.typeName(t.ChildByFieldName("type"))
+	}
+	return nil
+}
+
 // ReceiverTypeName returns the type a method is bound to: "Counter" for
 // `func (c *Counter) Add(n int)`. It is empty for a plain function. The visitor
 // uses it to attach the method to its struct, which Go declares separately.
@@ -122,6 +226,15 @@ func (a *TreeSitterAdapter) EachParamIdent(params *sitter.Node, yield func(strin
 
 func (a *TreeSitterAdapter) ModuleNameFromPath(path string) string {
 	// Prefer actual Go package name from source, fallback to file base name
+	if pkg := a.packageName(); pkg != "" {
+		return pkg
+	}
+	base := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	return base
+}
+
+// packageName returns the name declared by the package clause of the source.
+func (a *TreeSitterAdapter) packageName() string {
 	if a != nil && a.src != nil {
 		lines := strings.Split(string(a.src), "\n")
 		for _, ln := range lines {
@@ -148,8 +261,7 @@ func (a *TreeSitterAdapter) ModuleNameFromPath(path string) string {
 			}
 		}
 	}
-	base := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
-	return base
+	return ""
 }
 
 func (a *TreeSitterAdapter) AttachQualified(parent string, fn string) string {
@@ -280,6 +392,16 @@ func (a *TreeSitterAdapter) Imports(n *sitter.Node) []Treesitter.ImportItem {
 	return items
 }
 
+// Modifiers reports the visibility of a type or a function: exported when its
+// name starts with an upper-case letter, private to the package otherwise.
+func (a *TreeSitterAdapter) Modifiers(n *sitter.Node) *pb.Modifiers {
+	name := a.NodeName(n)
+	if r, _ := utf8.DecodeRuneInString(name); unicode.IsUpper(r) {
+		return &pb.Modifiers{Visibility: pb.Visibility_VISIBILITY_PUBLIC}
+	}
+	return &pb.Modifiers{Visibility: pb.Visibility_VISIBILITY_PACKAGE}
+}
+
 // helpers
 func text(src []byte, n *sitter.Node) string { return string(src[n.StartByte():n.EndByte()]) }
 func firstChildOfType(n *sitter.Node, t string) *sitter.Node {
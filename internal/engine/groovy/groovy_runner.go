@@ -0,0 +1,119 @@
+package groovy
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ast-metrics/ast-metrics/internal/configuration"
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	Treesitter "github.com/ast-metrics/ast-metrics/internal/engine/treesitter"
+	"github.com/ast-metrics/ast-metrics/internal/file"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+
+	"github.com/pterm/pterm"
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+type GroovyRunner struct {
+	progressbar   *pterm.SpinnerPrinter
+	Configuration *configuration.Configuration
+	foundFiles    file.FileList
+}
+
+func (r GroovyRunner) Name() string                                     { return "Groovy" }
+func (r GroovyRunner) IsRequired() bool                                 { return len(r.getFileList().Files) > 0 }
+func (r *GroovyRunner) Ensure() error                                   { return nil }
+func (r *GroovyRunner) SetProgressbar(p *pterm.SpinnerPrinter)          { r.progressbar = p }
+func (r *GroovyRunner) SetConfiguration(c *configuration.Configuration) { r.Configuration = c }
+
+func (r GroovyRunner) DumpAST() []*pb.File {
+	return engine.DumpFiles(
+		r.getFileList().Files,
+		r.progressbar,
+		func(path string) (*pb.File, error) { return r.Parse(path) },
+		engine.DumpOptions{Label: r.Name()},
+	)
+}
+
+func (r GroovyRunner) Finish() error {
+	if r.progressbar != nil {
+		r.progressbar.Stop()
+	}
+	return nil
+}
+
+func (r GroovyRunner) Parse(path string) (*pb.File, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return &pb.File{Path: path, ProgrammingLanguage: "Groovy"}, err
+	}
+
+	parser := sitter.NewParser()
+	adapter := NewTreeSitterAdapter(src)
+	parser.SetLanguage(adapter.Language())
+
+	tree := parser.Parse(nil, src)
+	root := tree.RootNode()
+	adapter.SetRootNode(root)
+
+	v := Treesitter.NewVisitor(adapter, path, src)
+	v.SetQueries(Treesitter.QueriesFor(r.Configuration, "groovy", path))
+	v.Visit(root)
+
+	file := v.Result()
+	file.ProgrammingLanguage = "Groovy"
+
+	// Detect if file is a test file
+	file.IsTest = r.isTestFile(path, src)
+
+	return file, nil
+}
+
+func (r *GroovyRunner) getFileList() file.FileList {
+	if r.foundFiles.Files != nil {
+		return r.foundFiles
+	}
+	finder := file.Finder{Configuration: *r.Configuration}
+	if r.Configuration.FileDiscovery != nil {
+		if fd, ok := r.Configuration.FileDiscovery.(*file.FileDiscovery); ok {
+			finder.Discovery = fd
+		}
+	}
+	extensions := r.Configuration.GetExtensionsForLanguage("groovy")
+	var lists []file.FileList
+	for _, ext := range extensions {
+		lists = append(lists, finder.Search(ext))
+	}
+	r.foundFiles = file.MergeFileLists(lists...)
+	return r.foundFiles
+}
+
+// isTestFile determines if a Groovy file is a test file based on:
+// 1. Filename pattern (FooSpec.groovy, FooTest.groovy, FooTests.groovy)
+// 2. Gradle conventional test directories (src/test/, src/integrationTest/)
+// 3. Source code containing test framework markers (Spock, JUnit, groovy.test)
+func (r GroovyRunner) isTestFile(path string, src []byte) bool {
+	base := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	for _, suffix := range []string{"Spec", "Test", "Tests"} {
+		if strings.HasSuffix(base, suffix) {
+			return true
+		}
+	}
+	normalized := filepath.ToSlash(path)
+	if idx := strings.Index(normalized, "/src/"); idx >= 0 {
+		sourceSet := strings.SplitN(normalized[idx+len("/src/"):], "/", 2)[0]
+		if sourceSet == "test" || strings.HasSuffix(sourceSet, "Test") {
+			return true
+		}
+	}
+
+	source := string(src)
+	for _, marker := range []string{"spock.lang", "extends Specification", "org.junit", "groovy.test"} {
+		if strings.Contains(source, marker) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,242 @@
+package groovy
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func parseGroovy(t *testing.T, src string) *pb.File {
+	t.Helper()
+	result, err := engine.CreateTestFileWithCode(&GroovyRunner{}, src)
+	assert.Nil(t, err, "Expected no error, got %v", err)
+	assert.NotNil(t, result)
+	return result
+}
+
+func findClass(file *pb.File, name string) *pb.StmtClass {
+	for _, c := range engine.GetClassesInFile(file) {
+		if c.Name != nil && c.Name.Short == name {
+			return c
+		}
+	}
+	return nil
+}
+
+func findFunction(file *pb.File, name string) *pb.StmtFunction {
+	for _, fn := range engine.GetFunctionsInFile(file) {
+		if fn.Name != nil && fn.Name.Short == name {
+			return fn
+		}
+	}
+	return nil
+}
+
+func TestGroovyClassesAndClosures(t *testing.T) {
+	src := `package com.example
+
+class Calculator extends Base {
+    private int total = 0
+    String name
+
+    int add(int a, int b = 2) {
+        def doubler = { x -> x * 2 }
+        [1, 2].each { println it }
+        return total
+    }
+
+    def log(msg) { println msg }
+}
+
+interface Shape { double area() }
+
+def sum = { a, b -> a + b }
+`
+	result := parseGroovy(t, src)
+	assert.Equal(t, "Groovy", result.ProgrammingLanguage)
+
+	calculator := findClass(result, "Calculator")
+	assert.NotNil(t, calculator)
+	assert.Equal(t, "com.example.Calculator", calculator.Name.Qualified)
+	assert.Equal(t, "Base", calculator.Extends[0].Short)
+	assert.Equal(t, 2, len(calculator.Stmts.StmtFunction))
+	assert.Equal(t, "com.example.Calculator.add", calculator.Stmts.StmtFunction[0].Name.Qualified)
+
+	operands := []string{}
+	for _, op := range calculator.Operands {
+		operands = append(operands, op.Name)
+	}
+	assert.ElementsMatch(t, []string{"total", "name"}, operands)
+
+	add := findFunction(result, "add")
+	assert.Equal(t, 2, len(add.Parameters))
+
+	// a closure bound to a name is a function, a callback is not
+	doubler := findFunction(result, "doubler")
+	assert.NotNil(t, doubler)
+	assert.Equal(t, 1, len(doubler.Parameters))
+	sum := findFunction(result, "sum")
+	assert.NotNil(t, sum)
+	assert.Equal(t, 2, len(sum.Parameters))
+	assert.Equal(t, 4, len(engine.GetFunctionsInFile(result)))
+
+	assert.Equal(t, 1, len(result.Stmts.StmtInterface))
+	assert.Nil(t, findClass(result, "Shape"))
+}
+
+func TestGroovySpockFeatureMethods(t *testing.T) {
+	src := `class CalculatorSpec extends Specification {
+    def "adds two numbers"() {
+        expect:
+        calc.add(a, b) == c
+        where:
+        a | b || c
+        1 | 2 || 3
+    }
+
+    def "fails on #x"(int x) {
+        when:
+        if (x > 0) { calc.add(null, 1) }
+        then:
+        thrown(NullPointerException)
+    }
+
+    def setup() { calc = new Calculator() }
+}
+`
+	result := parseGroovy(t, src)
+
+	spec := findClass(result, "CalculatorSpec")
+	assert.NotNil(t, spec)
+	names := []string{}
+	for _, fn := range spec.Stmts.StmtFunction {
+		names = append(names, fn.Name.Short)
+	}
+	assert.ElementsMatch(t, []string{"adds two numbers", "fails on #x", "setup"}, names)
+
+	fails := findFunction(result, "fails on #x")
+	assert.NotNil(t, fails)
+	assert.Equal(t, "CalculatorSpec.fails on #x", fails.Name.Qualified)
+	assert.Equal(t, 10, int(fails.Location.StartLine))
+	assert.Equal(t, 1, len(fails.Stmts.StmtDecisionIf), "the body of the feature is measured")
+
+	// a call of a string, a closure aside, is not a method
+	result = parseGroovy(t, `"ls"()
+{ println it }
+`)
+	assert.Equal(t, 0, len(engine.GetFunctionsInFile(result)))
+}
+
+func TestGroovyDecisions(t *testing.T) {
+	src := `
+def decide(a, items) {
+    if (a > 0) {
+        a++
+    } else if (a == 0) {
+        a--
+    } else if (a < -10) {
+        a = 0
+    } else {
+        a = 1
+    }
+    for (item in items) {}
+    for (int i = 0; i < 3; i++) {}
+    while (a > 0) { a-- }
+    switch (a) {
+        case 1: break
+        case 2: break
+        default: break
+    }
+}
+`
+	result := parseGroovy(t, src)
+	fn := findFunction(result, "decide")
+	assert.NotNil(t, fn)
+	// else-if is counted as an if, like in Java
+	assert.Equal(t, 3, len(fn.Stmts.StmtDecisionIf))
+	assert.Equal(t, 1, len(fn.Stmts.StmtDecisionElse))
+	assert.Equal(t, 3, len(fn.Stmts.StmtLoop))
+	assert.Equal(t, 1, len(fn.Stmts.StmtDecisionSwitch))
+	assert.Equal(t, 3, len(fn.Stmts.StmtDecisionCase))
+}
+
+func TestGroovyImports(t *testing.T) {
+	src := `package com.example.app
+
+import groovy.transform.CompileStatic
+import spock.lang.*
+import static org.junit.Assert.assertEquals
+
+class App {}
+`
+	result := parseGroovy(t, src)
+	found := map[string]bool{}
+	for _, dep := range engine.GetDependenciesInFile(result) {
+		found[dep.Namespace+":"+dep.ClassName] = true
+	}
+	for _, expected := range []string{"groovy.transform:CompileStatic", "spock.lang:", "org.junit:Assert"} {
+		assert.True(t, found[expected], "dependency %s not found in %v", expected, found)
+	}
+}
+
+func TestGroovyRunner_IsTest(t *testing.T) {
+	cases := []struct {
+		name     string
+		code     string
+		expected bool
+	}{
+		{"CalculatorSpec.groovy", "class CalculatorSpec {}", true},
+		{"CalculatorTest.groovy", "class CalculatorTest {}", true},
+		{"src/test/groovy/Calculator.groovy", "class Calculator {}", true},
+		{"Checks.groovy", "import spock.lang.Specification\nclass Checks extends Specification {}", true},
+		{"src/main/groovy/Calculator.groovy", "class Calculator {}", false},
+		{"Contest.groovy", "class Contest {}", false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tc.name)
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				t.Fatalf("failed to create dir: %v", err)
+			}
+			if err := os.WriteFile(path, []byte(tc.code), 0644); err != nil {
+				t.Fatalf("failed to create file: %v", err)
+			}
+			file, err := (&GroovyRunner{}).Parse(path)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expected, file.IsTest)
+		})
+	}
+}
+
+func TestGroovyRunner_Parse_NonExistentFile(t *testing.T) {
+	file, err := (&GroovyRunner{}).Parse("/nonexistent/file.groovy")
+	assert.NotNil(t, err)
+	assert.NotNil(t, file)
+	assert.Equal(t, "Groovy", file.ProgrammingLanguage)
+}
+
+func TestGroovyModifiers(t *testing.T) {
+	src := `
+abstract class Repository {
+    def find() {}
+    private static void key() {}
+    protected def save() {}
+    final def close() {}
+}
+`
+	result := parseGroovy(t, src)
+	class := result.Stmts.StmtClass[0]
+	assert.True(t, class.Modifiers.IsAbstract)
+
+	methods := class.Stmts.StmtFunction
+	assert.Equal(t, 4, len(methods))
+	assert.Equal(t, pb.Visibility_VISIBILITY_PUBLIC, methods[0].Modifiers.Visibility)
+	assert.Equal(t, pb.Visibility_VISIBILITY_PRIVATE, methods[1].Modifiers.Visibility)
+	assert.True(t, methods[1].Modifiers.IsStatic)
+	assert.Equal(t, pb.Visibility_VISIBILITY_PROTECTED, methods[2].Modifiers.Visibility)
+	assert.True(t, methods[3].Modifiers.IsFinal)
+}
@@ -0,0 +1,545 @@
+package groovy
+
+import (
+	"strings"
+
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	Treesitter "github.com/ast-metrics/ast-metrics/internal/engine/treesitter"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+	sitter "github.com/smacker/go-tree-sitter"
+	tsGroovy "github.com/smacker/go-tree-sitter/groovy"
+)
+
+type TreeSitterAdapter struct {
+	src []byte
+	// root caches the tree shared by the runner, to avoid re-parsing
+	root *sitter.Node
+	// pkg caches the declared package name (read lazily from the tree)
+	pkg       string
+	pkgParsed bool
+}
+
+func NewTreeSitterAdapter(src []byte) *TreeSitterAdapter   { return &TreeSitterAdapter{src: src} }
+func (a *TreeSitterAdapter) SetSource(src []byte)          { a.src = src; a.root = nil }
+func (a *TreeSitterAdapter) SetRootNode(root *sitter.Node) { a.root = root }
+func (a *TreeSitterAdapter) Language() *sitter.Language    { return tsGroovy.GetLanguage() }
+
+// ensureRoot returns the tree shared by the runner, parsing the source when
+// the adapter is used on its own (tests).
+func (a *TreeSitterAdapter) ensureRoot(src []byte) (*sitter.Node, []byte) {
+	source := a.src
+	if source == nil {
+		source = src
+	}
+	if a.root != nil {
+		return a.root, source
+	}
+	if source == nil {
+		return nil, nil
+	}
+	parser := sitter.NewParser()
+	parser.SetLanguage(a.Language())
+	a.root = parser.Parse(nil, source).RootNode()
+	return a.root, source
+}
+
+func (a *TreeSitterAdapter) IsModule(n *sitter.Node) bool { return n.Type() == "source_file" }
+
+func (a *TreeSitterAdapter) IsClass(n *sitter.Node) bool {
+	return n.Type() == "class_definition" && !hasToken(n, "interface")
+}
+
+// IsInterface reports whether a class_definition declares an interface: the
+// Groovy grammar has no dedicated node, only the "interface" keyword.
+func (a *TreeSitterAdapter) IsInterface(n *sitter.Node) bool {
+	return n.Type() == "class_definition" && hasToken(n, "interface")
+}
+
+func (a *TreeSitterAdapter) IsFunction(n *sitter.Node) bool {
+	switch n.Type() {
+	case "function_definition":
+		// abstract methods (function_declaration) have nothing to measure
+		return true
+	case "closure":
+		// a closure bound to a name ("def total = { a, b -> a + b }") is
+		// called like a method. Callbacks and blocks share the closure node:
+		// they stay part of the enclosing function.
+		return closureBinding(n) != nil || featureCall(n) != nil
+	}
+	return false
+}
+
+// featureCall returns the string naming a Spock feature method, when the
+// closure is its body, or nil. The grammar does not know the methods named by
+// a string: 'def "adds two numbers"() { ... }' is read as the "def" keyword
+// (an ERROR, or a declaration missing its name), a call of the string and a
+// closure.
+func featureCall(n *sitter.Node) *sitter.Node {
+	call := n.PrevSibling()
+	if call == nil || call.Type() != "function_call" || call.ChildByFieldName("args") == nil {
+		return nil
+	}
+	name := call.ChildByFieldName("function")
+	if name == nil || name.Type() != "string" {
+		return nil
+	}
+	def := call.PrevSibling()
+	if def == nil || !hasToken(def, "def") {
+		return nil
+	}
+	switch def.Type() {
+	case "ERROR":
+		if def.ChildCount() != 1 {
+			return nil
+		}
+	case "declaration":
+		if id := def.ChildByFieldName("name"); id == nil || id.StartByte() != id.EndByte() {
+			return nil
+		}
+	default:
+		return nil
+	}
+	return name
+}
+
+// closureBinding returns the identifier a closure is bound to, by a
+// declaration or an assignment, or nil.
+func closureBinding(n *sitter.Node) *sitter.Node {
+	p := n.Parent()
+	if p == nil {
+		return nil
+	}
+	switch p.Type() {
+	case "declaration":
+		if value := p.ChildByFieldName("value"); value != nil && value.StartByte() == n.StartByte() {
+			return p.ChildByFieldName("name")
+		}
+	case "assignment":
+		if p.NamedChildCount() == 2 && p.NamedChild(1).StartByte() == n.StartByte() {
+			if left := p.NamedChild(0); left.Type() == "identifier" {
+				return left
+			}
+		}
+	}
+	return nil
+}
+
+func (a *TreeSitterAdapter) NodeName(n *sitter.Node) string {
+	if a.src == nil || n == nil {
+		return ""
+	}
+	switch n.Type() {
+	case "function_definition":
+		return text(a.src, n.ChildByFieldName("function"))
+	case "closure":
+		if name := featureCall(n); name != nil {
+			return text(a.src, firstChildOfType(name, "string_content"))
+		}
+		return text(a.src, closureBinding(n))
+	}
+	return text(a.src, n.ChildByFieldName("name"))
+}
+
+func (a *TreeSitterAdapter) NodeBody(n *sitter.Node) *sitter.Node {
+	if n == nil {
+		return nil
+	}
+	if n.Type() == "closure" {
+		return n
+	}
+	return n.ChildByFieldName("body")
+}
+
+func (a *TreeSitterAdapter) NodeParams(n *sitter.Node) *sitter.Node {
+	if n == nil {
+		return nil
+	}
+	if n.Type() == "closure" {
+		// { a, b -> a + b }: the parameters come before the arrow. Those
+		// of a feature method are not parsed.
+		return firstChildOfType(n, "parameter_list")
+	}
+	return n.ChildByFieldName("parameters")
+}
+
+func (a *TreeSitterAdapter) EachParamIdent(params *sitter.Node, yield func(string)) {
+	if params == nil || a.src == nil {
+		return
+	}
+	for i := 0; i < int(params.NamedChildCount()); i++ {
+		p := params.NamedChild(i)
+		if p.Type() != "parameter" {
+			continue
+		}
+		if name := p.ChildByFieldName("name"); name != nil {
+			yield(text(a.src, name))
+		}
+	}
+}
+
+// ModuleNameFromPath ignores the file path and returns the declared package
+// name (e.g. "com.example.app"). Empty string for the default package.
+func (a *TreeSitterAdapter) ModuleNameFromPath(path string) string {
+	if a.pkgParsed {
+		return a.pkg
+	}
+	a.pkgParsed = true
+	root, source := a.ensureRoot(nil)
+	if root == nil {
+		return ""
+	}
+	if pkg := firstChildOfType(root, "groovy_package"); pkg != nil {
+		if name := firstChildOfType(pkg, "qualified_name"); name != nil {
+			a.pkg = text(source, name)
+		} else if id := firstChildOfType(pkg, "identifier"); id != nil {
+			a.pkg = text(source, id)
+		}
+	}
+	return a.pkg
+}
+
+func (a *TreeSitterAdapter) AttachQualified(parentClass string, fn string) string {
+	if parentClass == "" {
+		return fn
+	}
+	return parentClass + "." + fn
+}
+
+// NamespaceSeparator joins the package and the class name with ".", as in Java.
+func (a *TreeSitterAdapter) NamespaceSeparator() string { return "." }
+
+func (a *TreeSitterAdapter) EachChildBody(body *sitter.Node, yield func(*sitter.Node)) {
+	if body == nil {
+		return
+	}
+	for i := 0; i < int(body.ChildCount()); i++ {
+		yield(body.Child(i))
+	}
+}
+
+// isElseBranch reports whether n is the branch following the "else" keyword
+// of an if_statement. An else-if holds a nested if_statement.
+func isElseBranch(n *sitter.Node) bool {
+	p := n.Parent()
+	if p == nil || p.Type() != "if_statement" || !n.IsNamed() {
+		return false
+	}
+	prev := n.PrevSibling()
+	return prev != nil && prev.Type() == "else"
+}
+
+func (a *TreeSitterAdapter) Decision(n *sitter.Node) (Treesitter.DecisionKind, *sitter.Node) {
+	if isElseBranch(n) {
+		if n.Type() == "if_statement" {
+			// else-if: re-visit the nested if so that deeper branches keep
+			// being counted
+			return Treesitter.DecElif, n
+		}
+		return Treesitter.DecElse, n
+	}
+
+	switch n.Type() {
+	case "if_statement":
+		return Treesitter.DecIf, n.ChildByFieldName("body")
+
+	case "switch_statement":
+		return Treesitter.DecSwitch, n.ChildByFieldName("body")
+
+	case "case":
+		// "default:" shares the node of a case; the "case" keyword is an
+		// anonymous token of the same type
+		if n.IsNamed() {
+			return Treesitter.DecCase, n
+		}
+
+	case "for_loop", "for_in_loop", "while_loop", "do_while_loop":
+		return Treesitter.DecLoop, n.ChildByFieldName("body")
+	}
+	// ternaries, elvis operators and catch blocks intentionally left out,
+	// consistent with Java.
+	return Treesitter.DecNone, nil
+}
+
+// Imports reports the imported classes. A wildcard import depends on the
+// package alone, and a static import on the class holding the member.
+func (a *TreeSitterAdapter) Imports(n *sitter.Node) []Treesitter.ImportItem {
+	if n == nil || n.Type() != "groovy_import" || a.src == nil {
+		return nil
+	}
+	name := n.ChildByFieldName("import")
+	if name == nil {
+		return nil
+	}
+	path := strings.Split(text(a.src, name), ".")
+	if firstChildOfType(n, "modifier") != nil && len(path) > 1 {
+		// import static org.junit.Assert.assertEquals
+		path = path[:len(path)-1]
+	}
+	if firstChildOfType(n, "wildcard_import") != nil || len(path) == 1 {
+		return []Treesitter.ImportItem{{Module: strings.Join(path, "."), Name: ""}}
+	}
+	return []Treesitter.ImportItem{{Module: strings.Join(path[:len(path)-1], "."), Name: path[len(path)-1]}}
+}
+
+// Heritage reports the parent class. The grammar does not parse the
+// "implements" clause yet: the interfaces of a class are not reported.
+func (a *TreeSitterAdapter) Heritage(n *sitter.Node) Treesitter.Heritage {
+	var h Treesitter.Heritage
+	if a.src == nil || n == nil {
+		return h
+	}
+	if parent := n.ChildByFieldName("superclass"); parent != nil {
+		qualified := text(a.src, parent)
+		short := qualified
+		if idx := strings.LastIndex(qualified, "."); idx >= 0 {
+			short = qualified[idx+1:]
+		}
+		h.Extends = append(h.Extends, &pb.Name{Short: short, Qualified: qualified})
+	}
+	return h
+}
+
+// Modifiers reports the modifiers of a class or a function. Groovy
+// declarations are public unless stated otherwise. The grammar does not know
+// the modifiers of a class: "abstract class A" is read as the identifier
+// "abstract" followed by the class.
+func (a *TreeSitterAdapter) Modifiers(n *sitter.Node) *pb.Modifiers {
+	keywords := Treesitter.ModifierKeywords(n, map[string]bool{"access_modifier": true, "modifier": true})
+	if n.Type() == "class_definition" {
+		for prev := n.PrevNamedSibling(); prev != nil && prev.Type() == "identifier"; prev = prev.PrevNamedSibling() {
+			keywords = append(keywords, text(a.src, prev))
+		}
+	}
+	return Treesitter.KeywordModifiers(keywords, pb.Visibility_VISIBILITY_PUBLIC)
+}
+
+// CountElseIfAsIf: treat else-if as if for complexity aggregation (consistent with Java)
+func (a *TreeSitterAdapter) CountElseIfAsIf() bool { return true }
+
+// IsLogicalNode reports whether a node begins a logical line: the statements
+// of a block. Groovy blocks, closures and class bodies share the closure node;
+// class bodies only hold declarations.
+func (a *TreeSitterAdapter) IsLogicalNode(n *sitter.Node) bool {
+	if !n.IsNamed() {
+		return false
+	}
+	switch n.Type() {
+	case "comment", "parameter_list", "ERROR":
+		return false
+	}
+	p := n.Parent()
+	return p != nil && p.Type() == "closure"
+}
+
+// CommentMarkers declares Groovy comment tokens: "//" and "/* */" only.
+func (a *TreeSitterAdapter) CommentMarkers() engine.CommentMarkers {
+	return engine.CommentMarkers{SlashSlash: true, SlashStar: true}
+}
+
+// IsDocComment tells Groovydoc ("/**") from a plain comment.
+func (a *TreeSitterAdapter) IsDocComment(text string) bool {
+	return strings.HasPrefix(text, "/**")
+}
+
+// CountComments counts Groovy comment lines (//, /* */ and Groovydoc) in the given range.
+func (a *TreeSitterAdapter) CountComments(lines []string, start, end int) int {
+	cnt := 0
+	inBlock := false
+	for i := start - 1; i < end && i < len(lines); i++ {
+		ln := strings.TrimSpace(lines[i])
+		if ln == "" {
+			continue
+		}
+		clean := stripGroovyStrings(ln)
+		if inBlock {
+			cnt++
+			if strings.Contains(clean, "*/") {
+				inBlock = false
+			}
+			continue
+		}
+		if strings.HasPrefix(clean, "//") {
+			cnt++
+			continue
+		}
+		if strings.HasPrefix(clean, "/*") {
+			cnt++
+			if !strings.Contains(clean, "*/") {
+				inBlock = true
+			}
+			continue
+		}
+	}
+	return cnt
+}
+
+// groovyOperatorTokens lists the anonymous token types counted as Halstead
+// operators: the Java set, plus the operators Groovy adds (safe navigation,
+// elvis, spread, ranges, regular expressions, spaceship) and the closure
+// arrow.
+var groovyOperatorTokens = map[string]bool{
+	"+": true, "-": true, "*": true, "/": true, "%": true, "**": true,
+	"==": true, "!=": true, "<": true, ">": true, "<=": true, ">=": true, "<=>": true,
+	"&&": true, "||": true, "!": true,
+	"&": true, "|": true, "^": true, "~": true, "<<": true, ">>": true, ">>>": true,
+	"=": true, "+=": true, "-=": true, "*=": true, "/=": true, "%=": true,
+	"++": true, "--": true, ".": true, "?.": true, "*.": true, "?:": true,
+	"..": true, "..<": true, "=~": true, "==~": true, "->": true,
+	",": true, "[": true, "?": true,
+	"return": true, "if": true, "else": true, "for": true, "while": true,
+	"do": true, "switch": true, "case": true, "default": true,
+	"break": true, "continue": true, "in": true, "instanceof": true, "as": true,
+	"new": true, "throw": true, "try": true, "catch": true, "finally": true, "assert": true,
+}
+
+// groovyOperandTypes lists the named node types counted as Halstead operands.
+// Literals are left out on purpose, as in Java.
+var groovyOperandTypes = map[string]bool{"identifier": true}
+
+var groovyCallTypes = map[string]bool{"function_call": true, "juxt_function_call": true}
+
+// groovyPruneTypes lists the node types never walked: types, modifiers and
+// annotations describe the declaration, not what it computes.
+var groovyPruneTypes = map[string]bool{
+	"builtintype": true, "array_type": true, "generic_param": true, "type_with_generics": true,
+	"modifier": true, "access_modifier": true, "annotation": true,
+}
+
+// groovyPruneFields lists the fields holding a type: the type of a
+// declaration or of a parameter.
+var groovyPruneFields = map[string]bool{"type": true}
+
+// groovyChainTypes lists the member access node types: obj.field.
+var groovyChainTypes = map[string]bool{"dotted_identifier": true}
+
+var groovyOperandSpec = Treesitter.OperandSpec{
+	OperatorTokens: groovyOperatorTokens,
+	OperandTypes:   groovyOperandTypes,
+	CallTypes:      groovyCallTypes,
+	PruneTypes:     groovyPruneTypes,
+	PruneFields:    groovyPruneFields,
+	ChainTypes:     groovyChainTypes,
+	// no Receiver: the current object is the keyword "this"
+}
+
+// ExtractOperatorsOperands collects Halstead operators and operands from the
+// AST within the given 1-based inclusive line range. A member access is a
+// single operand.
+func (a *TreeSitterAdapter) ExtractOperatorsOperands(src []byte, startLine, endLine int) ([]string, []string) {
+	root, source := a.ensureRoot(src)
+	if root == nil {
+		return nil, nil
+	}
+	return groovyOperandSpec.Extract(root, source, startLine, endLine)
+}
+
+// ExtractMethodCalls extracts the calls made on the current object, written
+// without any object: the grammar does not parse "this." accesses yet.
+func (a *TreeSitterAdapter) ExtractMethodCalls(src []byte, startLine, endLine int) []string {
+	root, source := a.ensureRoot(src)
+	if root == nil || startLine <= 0 || endLine < startLine {
+		return nil
+	}
+	var calls []string
+	var walk func(n *sitter.Node)
+	walk = func(n *sitter.Node) {
+		if int(n.EndPoint().Row)+1 < startLine || int(n.StartPoint().Row)+1 > endLine {
+			return
+		}
+		if n.Type() == "function_call" {
+			if fn := n.ChildByFieldName("function"); fn != nil && fn.Type() == "identifier" {
+				calls = append(calls, "this."+text(source, fn))
+			}
+		}
+		for i := 0; i < int(n.ChildCount()); i++ {
+			walk(n.Child(i))
+		}
+	}
+	walk(root)
+	return calls
+}
+
+// ClassDirectOperands lists the fields and properties declared in the body
+// of a class.
+func (a *TreeSitterAdapter) ClassDirectOperands(n *sitter.Node) []string {
+	if n == nil || a.src == nil {
+		return nil
+	}
+	body := n.ChildByFieldName("body")
+	if body == nil {
+		return nil
+	}
+	var fields []string
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		decl := body.NamedChild(i)
+		if decl.Type() != "declaration" {
+			continue
+		}
+		if name := decl.ChildByFieldName("name"); name != nil {
+			fields = append(fields, text(a.src, name))
+		}
+	}
+	return fields
+}
+
+// --- helpers ---
+
+func text(src []byte, n *sitter.Node) string {
+	if n == nil || src == nil {
+		return ""
+	}
+	return string(src[n.StartByte():n.EndByte()])
+}
+
+func firstChildOfType(n *sitter.Node, t string) *sitter.Node {
+	if n == nil {
+		return nil
+	}
+	for i := 0; i < int(n.ChildCount()); i++ {
+		if c := n.Child(i); c.Type() == t {
+			return c
+		}
+	}
+	return nil
+}
+
+// hasToken reports whether n has an anonymous child of the given type (a
+// keyword such as "interface").
+func hasToken(n *sitter.Node, t string) bool {
+	for i := 0; i < int(n.ChildCount()); i++ {
+		if ch := n.Child(i); !ch.IsNamed() && ch.Type() == t {
+			return true
+		}
+	}
+	return false
+}
+
+// stripGroovyStrings removes content inside string literals to avoid false
+// positives in comment scanning.
+func stripGroovyStrings(s string) string {
+	out := make([]rune, 0, len(s))
+	inDq := false
+	inSq := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '\\' {
+			if i+1 < len(s) {
+				i++
+			}
+			continue
+		}
+		if !inSq && c == '"' {
+			inDq = !inDq
+			continue
+		}
+		if !inDq && c == '\'' {
+			inSq = !inSq
+			continue
+		}
+		if inDq || inSq {
+			continue
+		}
+		out = append(out, rune(c))
+	}
+	return string(out)
+}
@@ -58,6 +58,7 @@ func (r JavaRunner) Parse(path string) (*pb.File, error) {
 	adapter.SetRootNode(root)
 
 	v := Treesitter.NewVisitor(adapter, path, src)
+	v.SetQueries(Treesitter.QueriesFor(r.Configuration, "java", path))
 	v.Visit(root)
 
 	file := v.Result()
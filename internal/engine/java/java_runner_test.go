@@ -792,3 +792,68 @@ public class Discovered {
 	assert.Equal(t, 1, len(files[0].Stmts.StmtClass))
 	assert.Equal(t, "com.example.Discovered", files[0].Stmts.StmtClass[0].Name.Qualified)
 }
+
+func TestJavaHeritage(t *testing.T) {
+	src := `
+package com.example.app;
+
+public class OrderRepository extends AbstractRepository<Order> implements java.io.Closeable, Auditable {
+}
+`
+	result := parseJava(t, src)
+	class := result.Stmts.StmtClass[0]
+	assert.Equal(t, 1, len(class.Extends))
+	assert.Equal(t, "AbstractRepository", class.Extends[0].Short)
+	assert.Equal(t, 2, len(class.Implements))
+	assert.Equal(t, "java.io.Closeable", class.Implements[0].Qualified)
+	assert.Equal(t, "Closeable", class.Implements[0].Short)
+	assert.Equal(t, "Auditable", class.Implements[1].Short)
+}
+
+func TestJavaModifiers(t *testing.T) {
+	src := `
+public abstract class Repository {
+    void reset() {}
+    private static final String key() { return ""; }
+    protected abstract void save();
+}
+`
+	result := parseJava(t, src)
+	class := result.Stmts.StmtClass[0]
+	assert.Equal(t, pb.Visibility_VISIBILITY_PUBLIC, class.Modifiers.Visibility)
+	assert.True(t, class.Modifiers.IsAbstract)
+
+	methods := class.Stmts.StmtFunction
+	assert.Equal(t, 3, len(methods))
+	assert.Equal(t, pb.Visibility_VISIBILITY_PACKAGE, methods[0].Modifiers.Visibility, "no keyword means package-private")
+	assert.Equal(t, pb.Visibility_VISIBILITY_PRIVATE, methods[1].Modifiers.Visibility)
+	assert.True(t, methods[1].Modifiers.IsStatic)
+	assert.True(t, methods[1].Modifiers.IsFinal)
+	assert.Equal(t, pb.Visibility_VISIBILITY_PROTECTED, methods[2].Modifiers.Visibility)
+	assert.True(t, methods[2].Modifiers.IsAbstract)
+}
+
+func TestJavaAnnotations(t *testing.T) {
+	src := `
+@Entity
+@Table(name = "users", schema = "app")
+public class User {
+    @javax.annotation.Nullable
+    @GetMapping("/users")
+    public String list() { return ""; }
+}
+`
+	result := parseJava(t, src)
+	class := result.Stmts.StmtClass[0]
+	assert.Equal(t, 2, len(class.Annotations))
+	assert.Equal(t, "Entity", class.Annotations[0].Name.Short)
+	assert.Empty(t, class.Annotations[0].Arguments)
+	assert.Equal(t, "Table", class.Annotations[1].Name.Short)
+	assert.Equal(t, []string{`name = "users"`, `schema = "app"`}, class.Annotations[1].Arguments)
+
+	method := class.Stmts.StmtFunction[0]
+	assert.Equal(t, 2, len(method.Annotations))
+	assert.Equal(t, "Nullable", method.Annotations[0].Name.Short)
+	assert.Equal(t, "javax.annotation.Nullable", method.Annotations[0].Name.Qualified)
+	assert.Equal(t, []string{`"/users"`}, method.Annotations[1].Arguments)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ast-metrics/ast-metrics/internal/engine"
 	Treesitter "github.com/ast-metrics/ast-metrics/internal/engine/treesitter"
+	pb "github.com/ast-metrics/ast-metrics/pb"
 	sitter "github.com/smacker/go-tree-sitter"
 	tsJava "github.com/smacker/go-tree-sitter/java"
 )
@@ -267,6 +268,87 @@ func (a *TreeSitterAdapter) Imports(n *sitter.Node) []Treesitter.ImportItem {
 	return []Treesitter.ImportItem{{Module: path, Name: ""}}
 }
 
+// Heritage reports the superclass of a class and the interfaces it implements.
+func (a *TreeSitterAdapter) Heritage(n *sitter.Node) Treesitter.Heritage {
+	var h Treesitter.Heritage
+	if a.src == nil || n == nil {
+		return h
+	}
+	if superclass := n.ChildByFieldName("superclass"); superclass != nil && superclass.NamedChildCount() > 0 {
+		if name := a.typeName(superclass.NamedChild(0)); name != nil {
+			h.Extends = append(h.Extends, name)
+		}
+	}
+	if interfaces := n.ChildByFieldName("interfaces"); interfaces != nil {
+		if list := firstChildOfType(interfaces, "type_list"); list != nil {
+			for i := 0; i < int(list.NamedChildCount()); i++ {
+				if name := a.typeName(list.NamedChild(i)); name != nil {
+					h.Implements = append(h.Implements, name)
+				}
+			}
+		}
+	}
+	return h
+}
+
+// Modifiers reports the modifiers of a class or a method. Without keyword, a
+// declaration is package-private, except the members of an interface: they
+// are public, and abstract unless they have a body.
+func (a *TreeSitterAdapter) Modifiers(n *sitter.Node) *pb.Modifiers {
+	visibility := pb.Visibility_VISIBILITY_PACKAGE
+	inInterface := n.Parent() != nil && n.Parent().Type() == "interface_body"
+	if inInterface {
+		visibility = pb.Visibility_VISIBILITY_PUBLIC
+	}
+	keywords := Treesitter.ModifierKeywords(n, map[string]bool{"modifiers": true})
+	m := Treesitter.KeywordModifiers(keywords, visibility)
+	if inInterface && a.IsFunction(n) && n.ChildByFieldName("body") == nil {
+		m.IsAbstract = true
+	}
+	return m
+}
+
+// Annotations reports the annotations of a class or a method: @Entity,
+// @Table(name = "users") or @javax.persistence.Entity.
+func (a *TreeSitterAdapter) Annotations(n *sitter.Node) []*pb.Annotation {
+	modifiers := firstChildOfType(n, "modifiers")
+	if a.src == nil || modifiers == nil {
+		return nil
+	}
+	var annotations []*pb.Annotation
+	for i := 0; i < int(modifiers.NamedChildCount()); i++ {
+		ch := modifiers.NamedChild(i)
+		if ch.Type() != "annotation" && ch.Type() != "marker_annotation" {
+			continue
+		}
+		name := text(a.src, ch.ChildByFieldName("name"))
+		annotations = append(annotations, Treesitter.NewAnnotation(name, ".", Treesitter.AnnotationArguments(ch.ChildByFieldName("arguments"), a.src)))
+	}
+	return annotations
+}
+
+// typeName returns the name of a parent type: "Shape", "Repository<User>"
+// (named after Repository) or "java.util.AbstractList".
+func (a *TreeSitterAdapter) typeName(t *sitter.Node) *pb.Name {
+	switch t.Type() {
+	case "type_identifier":
+		name := text(a.src, t)
+		return &pb.Name{Short: name, Qualified: name}
+	case "generic_type":
+		if t.NamedChildCount() > 0 {
+			return a.typeName(t.NamedChild(0))
+		}
+	case "scoped_type_identifier":
+		qualified := text(a.src, t)
+		short := qualified
+		if idx := strings.LastIndex(qualified, "."); idx >= 0 {
+			short = qualified[idx+1:]
+		}
+		return &pb.Name{Short: short, Qualified: qualified}
+	}
+	return nil
+}
+
 // CountElseIfAsIf: treat else-if as if for complexity aggregation (consistent with Go/PHP/TS)
 func (a *TreeSitterAdapter) CountElseIfAsIf() bool { return true }
 
@@ -287,6 +369,11 @@ func (a *TreeSitterAdapter) CommentMarkers() engine.CommentMarkers {
 	return engine.CommentMarkers{SlashSlash: true, SlashStar: true}
 }
 
+// IsDocComment tells Javadoc ("/**") from a plain comment.
+func (a *TreeSitterAdapter) IsDocComment(text string) bool {
+	return strings.HasPrefix(text, "/**")
+}
+
 func (a *TreeSitterAdapter) CountComments(lines []string, start, end int) int {
 	cnt := 0
 	inBlock := false
@@ -0,0 +1,119 @@
+package javascript
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ast-metrics/ast-metrics/internal/configuration"
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	Treesitter "github.com/ast-metrics/ast-metrics/internal/engine/treesitter"
+	"github.com/ast-metrics/ast-metrics/internal/file"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+
+	"github.com/pterm/pterm"
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+type JavaScriptRunner struct {
+	progressbar   *pterm.SpinnerPrinter
+	Configuration *configuration.Configuration
+	foundFiles    file.FileList
+}
+
+func (r JavaScriptRunner) Name() string                                     { return "JavaScript" }
+func (r JavaScriptRunner) IsRequired() bool                                 { return len(r.getFileList().Files) > 0 }
+func (r *JavaScriptRunner) Ensure() error                                   { return nil }
+func (r *JavaScriptRunner) SetProgressbar(p *pterm.SpinnerPrinter)          { r.progressbar = p }
+func (r *JavaScriptRunner) SetConfiguration(c *configuration.Configuration) { r.Configuration = c }
+
+func (r JavaScriptRunner) DumpAST() []*pb.File {
+	return engine.DumpFiles(
+		r.getFileList().Files,
+		r.progressbar,
+		func(path string) (*pb.File, error) { return r.Parse(path) },
+		engine.DumpOptions{Label: r.Name()},
+	)
+}
+
+func (r JavaScriptRunner) Finish() error {
+	if r.progressbar != nil {
+		r.progressbar.Stop()
+	}
+	return nil
+}
+
+func (r JavaScriptRunner) Parse(path string) (*pb.File, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return &pb.File{Path: path, ProgrammingLanguage: "JavaScript"}, err
+	}
+
+	component := engine.IsComponentFile(path)
+	if component {
+		// only the script of a component is parsed; positions stay the ones
+		// of the component file
+		src = engine.ExtractComponentScript(src).Source
+	}
+
+	parser := sitter.NewParser()
+	adapter := NewTreeSitterAdapter(src)
+	if component {
+		adapter.SetComponent(engine.ComponentName(path))
+	}
+	parser.SetLanguage(adapter.Language())
+
+	tree := parser.Parse(nil, src)
+	root := tree.RootNode()
+	adapter.SetRootNode(root)
+
+	v := Treesitter.NewVisitor(adapter, path, src)
+	v.SetQueries(Treesitter.QueriesFor(r.Configuration, "javascript", path))
+	v.Visit(root)
+
+	file := v.Result()
+	file.ProgrammingLanguage = "JavaScript"
+
+	// Detect if file is a test file
+	file.IsTest = r.isTestFile(path)
+
+	return file, nil
+}
+
+func (r *JavaScriptRunner) getFileList() file.FileList {
+	if r.foundFiles.Files != nil {
+		return r.foundFiles
+	}
+	finder := file.Finder{Configuration: *r.Configuration}
+	if r.Configuration.FileDiscovery != nil {
+		if fd, ok := r.Configuration.FileDiscovery.(*file.FileDiscovery); ok {
+			finder.Discovery = fd
+		}
+	}
+	extensions := r.Configuration.GetExtensionsForLanguage("javascript")
+	var lists []file.FileList
+	for _, ext := range extensions {
+		lists = append(lists, finder.Search(ext))
+	}
+	lists = append(lists, engine.SearchComponents(finder, r.Configuration, false))
+	r.foundFiles = file.MergeFileLists(lists...)
+	return r.foundFiles
+}
+
+// isTestFile determines if a JavaScript file is a test file based on:
+// 1. Filename pattern (foo.test.js, foo.spec.js, and their .jsx/.mjs/.cjs variants)
+// 2. Jest conventional test directory (__tests__/)
+func (r JavaScriptRunner) isTestFile(path string) bool {
+	baseName := strings.ToLower(filepath.Base(path))
+	base := strings.TrimSuffix(baseName, filepath.Ext(baseName))
+	if strings.HasSuffix(base, ".test") || strings.HasSuffix(base, ".spec") {
+		return true
+	}
+
+	dir := strings.ToLower(filepath.ToSlash(filepath.Dir(path)))
+	if strings.Contains(dir, "__tests__") || strings.Contains(dir, "__test__") {
+		return true
+	}
+
+	return false
+}
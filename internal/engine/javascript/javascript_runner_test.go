@@ -0,0 +1,345 @@
+package javascript
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func parseJavaScript(t *testing.T, src string) *pb.File {
+	t.Helper()
+	result, err := engine.CreateTestFileWithCode(&JavaScriptRunner{}, src)
+	assert.Nil(t, err, "Expected no error, got %v", err)
+	assert.NotNil(t, result)
+	return result
+}
+
+func findFunction(stmts *pb.Stmts, name string) *pb.StmtFunction {
+	for _, fn := range engine.GetFunctionsInFile(&pb.File{Stmts: stmts}) {
+		if fn.Name != nil && fn.Name.Short == name {
+			return fn
+		}
+	}
+	return nil
+}
+
+func TestJavaScriptClassAndMethods(t *testing.T) {
+	src := `
+class Calculator extends Base {
+  #total = 0;
+
+  constructor(start) {
+    super();
+    this.start = start;
+  }
+
+  add(a, b = 1) {
+    return a + b;
+  }
+
+  static create(...args) {
+    return new Calculator(args[0]);
+  }
+}
+`
+	result := parseJavaScript(t, src)
+	assert.Equal(t, "JavaScript", result.ProgrammingLanguage)
+	assert.Equal(t, 1, len(result.Stmts.StmtClass), "Incorrect number of classes")
+	class := result.Stmts.StmtClass[0]
+	assert.Equal(t, "Calculator", class.Name.Short)
+	assert.Equal(t, 3, len(class.Stmts.StmtFunction), "Incorrect number of methods")
+	assert.Equal(t, "constructor", class.Stmts.StmtFunction[0].Name.Short)
+	assert.Equal(t, "add", class.Stmts.StmtFunction[1].Name.Short)
+	assert.True(t, strings.HasSuffix(class.Stmts.StmtFunction[1].Name.Qualified, "Calculator.add"))
+	assert.Equal(t, "create", class.Stmts.StmtFunction[2].Name.Short)
+
+	// the default value is not a parameter
+	params := class.Stmts.StmtFunction[1].Parameters
+	assert.Equal(t, 2, len(params))
+	assert.Equal(t, "a", params[0].Name)
+	assert.Equal(t, "b", params[1].Name)
+
+	assert.Equal(t, 1, len(class.Operands), "private field expected as class operand")
+	assert.Equal(t, "#total", class.Operands[0].Name)
+}
+
+func TestJavaScriptFunctionsAndArrowFunctions(t *testing.T) {
+	src := `
+function declared(a) { return a; }
+
+function* generated() { yield 1; }
+
+const arrow = (x, { y, z }) => x + y + z;
+
+const single = value => value * 2;
+
+const expression = function () { return 1; };
+
+module.exports = {
+  handler: function () { return 2; },
+};
+
+exports.exported = async function () {};
+`
+	result := parseJavaScript(t, src)
+	for _, name := range []string{"declared", "generated", "arrow", "single", "expression", "handler", "exported"} {
+		assert.NotNil(t, findFunction(result.Stmts, name), "function %s not found", name)
+	}
+
+	arrow := findFunction(result.Stmts, "arrow")
+	assert.Equal(t, 3, len(arrow.Parameters), "destructured parameters expected")
+	single := findFunction(result.Stmts, "single")
+	assert.Equal(t, 1, len(single.Parameters), "unparenthesized parameter expected")
+	assert.Equal(t, "value", single.Parameters[0].Name)
+}
+
+func TestJavaScriptClassExpression(t *testing.T) {
+	src := `
+const Widget = class {
+  render() { return null; }
+};
+`
+	result := parseJavaScript(t, src)
+	assert.Equal(t, 1, len(result.Stmts.StmtClass))
+	assert.Equal(t, "Widget", result.Stmts.StmtClass[0].Name.Short)
+	assert.Equal(t, 1, len(result.Stmts.StmtClass[0].Stmts.StmtFunction))
+}
+
+func TestJavaScriptDecisions(t *testing.T) {
+	src := `
+function decide(a, items) {
+  if (a > 0) {
+    a++;
+  } else if (a === 0) {
+    a--;
+  } else if (a < -10) {
+    a = 0;
+  } else {
+    a = 1;
+  }
+  for (let i = 0; i < 3; i++) {}
+  for (const item of items) {}
+  while (a > 0) { a--; }
+  do { a++; } while (a < 3);
+  switch (a) {
+    case 1:
+      break;
+    case 2:
+      break;
+    default:
+      break;
+  }
+}
+`
+	result := parseJavaScript(t, src)
+	fn := findFunction(result.Stmts, "decide")
+	assert.NotNil(t, fn)
+	// else-if is counted as an if, like in TypeScript
+	assert.Equal(t, 3, len(fn.Stmts.StmtDecisionIf))
+	assert.Equal(t, 1, len(fn.Stmts.StmtDecisionElse))
+	assert.Equal(t, 4, len(fn.Stmts.StmtLoop))
+	assert.Equal(t, 1, len(fn.Stmts.StmtDecisionSwitch))
+	assert.Equal(t, 3, len(fn.Stmts.StmtDecisionCase))
+}
+
+func TestJavaScriptImports(t *testing.T) {
+	src := `
+import React, { useState as useLocalState } from 'react';
+import * as path from "path";
+import './polyfill';
+const lodash = require('lodash');
+const { readFile, writeFile: write } = require('fs');
+export { helper } from './helper';
+
+async function load() {
+  const mod = await import('./lazy');
+  return mod;
+}
+`
+	result := parseJavaScript(t, src)
+	deps := engine.GetDependenciesInFile(result)
+
+	found := map[string]bool{}
+	for _, dep := range deps {
+		found[dep.Namespace+":"+dep.ClassName] = true
+	}
+	for _, expected := range []string{
+		"react:React", "react:useState", "path:path", "./polyfill:",
+		"lodash:lodash", "fs:readFile", "fs:writeFile", "./helper:", "./lazy:mod",
+	} {
+		assert.True(t, found[expected], "dependency %s not found in %v", expected, found)
+	}
+}
+
+func TestJavaScriptJSX(t *testing.T) {
+	src := `
+const Greeting = ({ name }) => {
+  if (!name) {
+    return <span>Anonymous</span>;
+  }
+  return <h1>Hello {name}</h1>;
+};
+`
+	result := parseJavaScript(t, src)
+	fn := findFunction(result.Stmts, "Greeting")
+	assert.NotNil(t, fn)
+	assert.Equal(t, 1, len(fn.Stmts.StmtDecisionIf))
+}
+
+func TestJavaScriptMethodCalls(t *testing.T) {
+	src := `
+class Counter {
+  increment() {
+    this.count++;
+    this.log("incremented");
+  }
+
+  log(msg) {
+    console.log(msg);
+  }
+}
+`
+	result := parseJavaScript(t, src)
+	fn := findFunction(result.Stmts, "increment")
+	assert.NotNil(t, fn)
+	calls := []string{}
+	for _, c := range fn.MethodCalls {
+		calls = append(calls, c.Name)
+	}
+	assert.Contains(t, calls, "this.log")
+}
+
+func TestJavaScriptComments(t *testing.T) {
+	src := `// header
+/*
+ * block
+ */
+class Foo {
+  #secret = 1;
+}
+`
+	result := parseJavaScript(t, src)
+	assert.Equal(t, int32(4), result.LinesOfCode.CommentLinesOfCode, "private fields are not comments")
+}
+
+func TestJavaScriptRunner_IsTest(t *testing.T) {
+	code := `export function add(a, b) { return a + b; }`
+	cases := map[string]bool{
+		"calculator.test.js":      true,
+		"calculator.spec.js":      true,
+		"app.test.jsx":            true,
+		"loader.spec.mjs":         true,
+		"__tests__/calculator.js": true,
+		"calculator.js":           false,
+		"contest.js":              false,
+	}
+	for name, expected := range cases {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), name)
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				t.Fatalf("failed to create dir: %v", err)
+			}
+			if err := os.WriteFile(path, []byte(code), 0644); err != nil {
+				t.Fatalf("failed to create file: %v", err)
+			}
+			file, err := (&JavaScriptRunner{}).Parse(path)
+			assert.Nil(t, err)
+			assert.Equal(t, expected, file.IsTest)
+		})
+	}
+}
+
+func TestJavaScriptRunner_Parse_NonExistentFile(t *testing.T) {
+	file, err := (&JavaScriptRunner{}).Parse("/nonexistent/file.js")
+	assert.NotNil(t, err)
+	assert.NotNil(t, file)
+	assert.Equal(t, "JavaScript", file.ProgrammingLanguage)
+}
+
+func TestJavaScriptRunner_Parse_VueOptionsComponent(t *testing.T) {
+	code := `<template>
+  <button @click="save">Save</button>
+</template>
+
+<script>
+import axios from 'axios'
+
+export default {
+  data() {
+    return { saved: false }
+  },
+  methods: {
+    save() {
+      if (!this.saved) {
+        axios.post('/save')
+      }
+    },
+  },
+}
+</script>
+`
+	path := filepath.Join(t.TempDir(), "SaveButton.vue")
+	if err := os.WriteFile(path, []byte(code), 0644); err != nil {
+		t.Fatalf("failed to create file: %v", err)
+	}
+	file, err := (&JavaScriptRunner{}).Parse(path)
+	assert.Nil(t, err)
+
+	classes := engine.GetClassesInFile(file)
+	assert.Equal(t, 1, len(classes))
+	assert.Equal(t, "SaveButton", classes[0].Name.Short)
+
+	save := findFunction(file.Stmts, "save")
+	assert.NotNil(t, save)
+	// lines are the ones of the .vue file
+	assert.Equal(t, int32(13), save.Location.StartLine)
+	assert.Equal(t, 1, len(save.Stmts.StmtDecisionIf))
+	assert.NotNil(t, findFunction(classes[0].Stmts, "data"), "the functions of the script belong to the component")
+
+	assert.Equal(t, "axios", engine.GetDependenciesInFile(file)[0].Namespace)
+}
+
+func TestJavaScriptHeritage(t *testing.T) {
+	src := `
+class Button extends React.Component {}
+class Admin extends User {}
+class Dated extends Timestamped(Base) {}
+`
+	result := parseJavaScript(t, src)
+	classes := engine.GetClassesInFile(result)
+	assert.Equal(t, 3, len(classes))
+	assert.Equal(t, "React.Component", classes[0].Extends[0].Qualified)
+	assert.Equal(t, "Component", classes[0].Extends[0].Short)
+	assert.Equal(t, "User", classes[1].Extends[0].Short)
+	// a mixin names no class statically
+	assert.Equal(t, 0, len(classes[2].Extends))
+}
+
+func TestJavaScriptModifiers(t *testing.T) {
+	src := `
+export class Repository {
+  find() {}
+  static create() {}
+  #reset() {}
+}
+function helper() {}
+`
+	result := parseJavaScript(t, src)
+	classes := engine.GetClassesInFile(result)
+	assert.Equal(t, 1, len(classes))
+	assert.Equal(t, pb.Visibility_VISIBILITY_PUBLIC, classes[0].Modifiers.Visibility, "an exported class is public")
+
+	methods := classes[0].Stmts.StmtFunction
+	assert.Equal(t, 3, len(methods))
+	assert.Equal(t, pb.Visibility_VISIBILITY_PUBLIC, methods[0].Modifiers.Visibility)
+	assert.True(t, methods[1].Modifiers.IsStatic)
+	assert.Equal(t, pb.Visibility_VISIBILITY_PRIVATE, methods[2].Modifiers.Visibility)
+
+	functions := engine.GetFunctionsOutsideClassesInFile(result)
+	assert.Equal(t, pb.Visibility_VISIBILITY_PACKAGE, functions[0].Modifiers.Visibility, "a function not exported stays in its module")
+}
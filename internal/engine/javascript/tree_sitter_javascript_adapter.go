@@ -0,0 +1,654 @@
+package javascript
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	Treesitter "github.com/ast-metrics/ast-metrics/internal/engine/treesitter"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+	sitter "github.com/smacker/go-tree-sitter"
+	tsJavascript "github.com/smacker/go-tree-sitter/javascript"
+)
+
+type TreeSitterAdapter struct {
+	src  []byte
+	root *sitter.Node
+	// component names the single-file component (.vue, .svelte) whose script
+	// is parsed: the whole script is then the body of the component
+	component string
+}
+
+func NewTreeSitterAdapter(src []byte) *TreeSitterAdapter   { return &TreeSitterAdapter{src: src} }
+func (a *TreeSitterAdapter) SetSource(src []byte)          { a.src = src }
+func (a *TreeSitterAdapter) SetRootNode(root *sitter.Node) { a.root = root }
+func (a *TreeSitterAdapter) Language() *sitter.Language    { return tsJavascript.GetLanguage() }
+
+// ensureRoot returns the tree shared by the runner, parsing the source when
+// the adapter is used on its own (tests).
+func (a *TreeSitterAdapter) ensureRoot(src []byte) (*sitter.Node, []byte) {
+	source := a.src
+	if source == nil {
+		source = src
+	}
+	if a.root != nil {
+		return a.root, source
+	}
+	if source == nil {
+		return nil, nil
+	}
+	parser := sitter.NewParser()
+	parser.SetLanguage(a.Language())
+	a.root = parser.Parse(nil, source).RootNode()
+	return a.root, source
+}
+
+// SetComponent declares the source as the script of a single-file
+// component: the component is reported as a class, and the functions of its
+// script as its methods.
+func (a *TreeSitterAdapter) SetComponent(name string) { a.component = name }
+
+func (a *TreeSitterAdapter) IsModule(n *sitter.Node) bool {
+	return n.Type() == "program" && a.component == ""
+}
+
+func (a *TreeSitterAdapter) IsClass(n *sitter.Node) bool {
+	switch n.Type() {
+	case "program":
+		return a.component != ""
+	case "class_declaration", "class":
+		return true
+	}
+	return false
+}
+
+func (a *TreeSitterAdapter) IsFunction(n *sitter.Node) bool {
+	switch n.Type() {
+	case "function_declaration", "generator_function_declaration", "method_definition":
+		return true
+	case "function_expression", "generator_function", "arrow_function":
+		return true
+	}
+	return false
+}
+
+func (a *TreeSitterAdapter) NodeName(n *sitter.Node) string {
+	if a.src == nil || n == nil {
+		return ""
+	}
+	if n.Type() == "program" {
+		return a.component
+	}
+
+	switch n.Type() {
+	case "method_definition":
+		if nm := n.ChildByFieldName("name"); nm != nil {
+			return text(a.src, nm)
+		}
+		return ""
+
+	case "function_expression", "generator_function", "arrow_function", "class":
+		// a named expression keeps its own name ("function named() {}")
+		if nm := n.ChildByFieldName("name"); nm != nil {
+			return text(a.src, nm)
+		}
+		// otherwise, the name comes from where the expression is bound
+		return bindingName(a.src, n)
+	}
+
+	// class_declaration, function_declaration, generator_function_declaration
+	if nm := n.ChildByFieldName("name"); nm != nil {
+		return text(a.src, nm)
+	}
+	if id := firstChildOfType(n, "identifier"); id != nil {
+		return text(a.src, id)
+	}
+	return ""
+}
+
+// bindingName returns the name a function or class expression is bound to:
+// the variable ("const f = () => {}"), the class field ("handle = () => {}"),
+// the object key ("{ h: function() {} }") or the assigned property
+// ("module.exports.k = function() {}"). Callbacks have no name.
+func bindingName(src []byte, n *sitter.Node) string {
+	p := n.Parent()
+	if p == nil {
+		return ""
+	}
+	switch p.Type() {
+	case "variable_declarator":
+		if nm := p.ChildByFieldName("name"); nm != nil && nm.Type() == "identifier" {
+			return text(src, nm)
+		}
+	case "field_definition":
+		if nm := p.ChildByFieldName("property"); nm != nil {
+			return text(src, nm)
+		}
+	case "pair":
+		if nm := p.ChildByFieldName("key"); nm != nil {
+			return stripQuotes(text(src, nm))
+		}
+	case "assignment_expression":
+		left := p.ChildByFieldName("left")
+		if left == nil {
+			return ""
+		}
+		switch left.Type() {
+		case "identifier":
+			return text(src, left)
+		case "member_expression":
+			if prop := left.ChildByFieldName("property"); prop != nil {
+				return text(src, prop)
+			}
+		}
+	}
+	return ""
+}
+
+func (a *TreeSitterAdapter) NodeBody(n *sitter.Node) *sitter.Node {
+	if n == nil {
+		return nil
+	}
+	if n.Type() == "program" {
+		// the script of a component
+		return n
+	}
+	if body := n.ChildByFieldName("body"); body != nil {
+		return body
+	}
+	if b := firstChildOfType(n, "statement_block"); b != nil {
+		return b
+	}
+	if b := firstChildOfType(n, "class_body"); b != nil {
+		return b
+	}
+	return nil
+}
+
+func (a *TreeSitterAdapter) NodeParams(n *sitter.Node) *sitter.Node {
+	if n == nil {
+		return nil
+	}
+	if p := n.ChildByFieldName("parameters"); p != nil {
+		return p
+	}
+	// single parameter arrow function without parentheses: "x => x * 2"
+	if p := n.ChildByFieldName("parameter"); p != nil {
+		return p
+	}
+	return nil
+}
+
+func (a *TreeSitterAdapter) EachParamIdent(params *sitter.Node, yield func(string)) {
+	if params == nil || a.src == nil {
+		return
+	}
+	var walk func(*sitter.Node)
+	walk = func(n *sitter.Node) {
+		if n == nil {
+			return
+		}
+		switch n.Type() {
+		case "identifier", "shorthand_property_identifier_pattern":
+			yield(text(a.src, n))
+			return
+		case "assignment_pattern", "object_assignment_pattern":
+			// the default value is an expression, not a parameter
+			walk(n.ChildByFieldName("left"))
+			return
+		}
+		for i := 0; i < int(n.ChildCount()); i++ {
+			walk(n.Child(i))
+		}
+	}
+	walk(params)
+}
+
+func (a *TreeSitterAdapter) ModuleNameFromPath(path string) string {
+	base := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	return base
+}
+
+func (a *TreeSitterAdapter) AttachQualified(parentClass string, fn string) string {
+	if parentClass == "" {
+		return fn
+	}
+	return parentClass + "." + fn
+}
+
+func (a *TreeSitterAdapter) EachChildBody(body *sitter.Node, yield func(*sitter.Node)) {
+	if body == nil {
+		return
+	}
+	switch body.Type() {
+	case "switch_body":
+		for i := 0; i < int(body.ChildCount()); i++ {
+			ch := body.Child(i)
+			if ch.Type() == "switch_case" || ch.Type() == "switch_default" {
+				yield(ch)
+			}
+		}
+	default:
+		for i := 0; i < int(body.ChildCount()); i++ {
+			yield(body.Child(i))
+		}
+	}
+}
+
+func (a *TreeSitterAdapter) Decision(n *sitter.Node) (Treesitter.DecisionKind, *sitter.Node) {
+	switch n.Type() {
+	case "if_statement":
+		return Treesitter.DecIf, n.ChildByFieldName("consequence")
+
+	case "else_clause":
+		// else-if: return the nested if_statement as body so the whole chain
+		// (condition, consequence, nested else clause) is re-visited and
+		// deeper else-if/else branches keep being counted.
+		if ifNode := firstChildOfType(n, "if_statement"); ifNode != nil {
+			return Treesitter.DecElif, ifNode
+		}
+		return Treesitter.DecElse, n
+
+	case "switch_statement":
+		return Treesitter.DecSwitch, n.ChildByFieldName("body")
+
+	case "switch_case", "switch_default":
+		return Treesitter.DecCase, n
+
+	case "for_statement", "for_in_statement", "while_statement", "do_statement":
+		return Treesitter.DecLoop, n.ChildByFieldName("body")
+	}
+	// ternary_expression and catch_clause intentionally left out, consistent
+	// with the other engines.
+	return Treesitter.DecNone, nil
+}
+
+// Imports returns the modules a node depends on. JavaScript code mixes the
+// ES module syntax ("import x from 'm'", "export { y } from 'm'") with
+// CommonJS ("const x = require('m')"), and both are reported the same way.
+func (a *TreeSitterAdapter) Imports(n *sitter.Node) []Treesitter.ImportItem {
+	if n == nil {
+		return nil
+	}
+	switch n.Type() {
+	case "import_statement":
+		return a.esImports(n)
+	case "export_statement":
+		// re-export: export { a } from './a'; export * from './b'
+		if src := n.ChildByFieldName("source"); src != nil {
+			if module := stripQuotes(text(a.src, src)); module != "" {
+				return []Treesitter.ImportItem{{Module: module}}
+			}
+		}
+	case "call_expression":
+		return a.requireImports(n)
+	}
+	return nil
+}
+
+// Heritage reports the parent of a class. A class can extend any expression:
+// only a name ("Base") or a member access ("React.Component") names a class
+// known statically, a mixin call ("Timestamped(Base)") does not.
+func (a *TreeSitterAdapter) Heritage(n *sitter.Node) Treesitter.Heritage {
+	var h Treesitter.Heritage
+	if a.src == nil || n == nil {
+		return h
+	}
+	heritage := firstChildOfType(n, "class_heritage")
+	if heritage == nil || heritage.NamedChildCount() == 0 {
+		return h
+	}
+	parent := heritage.NamedChild(0)
+	switch parent.Type() {
+	case "identifier":
+		name := text(a.src, parent)
+		h.Extends = append(h.Extends, &pb.Name{Short: name, Qualified: name})
+	case "member_expression":
+		short := text(a.src, parent.ChildByFieldName("property"))
+		h.Extends = append(h.Extends, &pb.Name{Short: short, Qualified: text(a.src, parent)})
+	}
+	return h
+}
+
+// Modifiers reports the modifiers of a class or a function. JavaScript only
+// knows "#name" members, which are private, and static members. A class or a
+// function declared in the module is public when exported and private to the
+// module otherwise.
+func (a *TreeSitterAdapter) Modifiers(n *sitter.Node) *pb.Modifiers {
+	visibility := pb.Visibility_VISIBILITY_PUBLIC
+	switch n.Type() {
+	case "class_declaration", "function_declaration", "generator_function_declaration":
+		if p := n.Parent(); p == nil || p.Type() != "export_statement" {
+			visibility = pb.Visibility_VISIBILITY_PACKAGE
+		}
+	}
+	if name := n.ChildByFieldName("name"); name != nil && name.Type() == "private_property_identifier" {
+		visibility = pb.Visibility_VISIBILITY_PRIVATE
+	}
+	return Treesitter.KeywordModifiers(Treesitter.ModifierKeywords(n, nil), visibility)
+}
+
+// esImports lists the symbols of an ES module import statement.
+func (a *TreeSitterAdapter) esImports(n *sitter.Node) []Treesitter.ImportItem {
+	var module string
+	if src := n.ChildByFieldName("source"); src != nil {
+		module = stripQuotes(text(a.src, src))
+	}
+	if module == "" {
+		return nil
+	}
+
+	items := []Treesitter.ImportItem{}
+	var walkClause func(*sitter.Node)
+	walkClause = func(cl *sitter.Node) {
+		if cl == nil {
+			return
+		}
+		switch cl.Type() {
+		case "import_clause":
+			for i := 0; i < int(cl.ChildCount()); i++ {
+				walkClause(cl.Child(i))
+			}
+		case "identifier":
+			// default import: import X from 'module'
+			items = append(items, Treesitter.ImportItem{Module: module, Name: text(a.src, cl)})
+		case "named_imports":
+			for i := 0; i < int(cl.ChildCount()); i++ {
+				spec := cl.Child(i)
+				if spec.Type() != "import_specifier" {
+					continue
+				}
+				if nm := spec.ChildByFieldName("name"); nm != nil {
+					items = append(items, Treesitter.ImportItem{Module: module, Name: text(a.src, nm)})
+				}
+			}
+		case "namespace_import":
+			// import * as X from 'module'
+			if id := firstChildOfType(cl, "identifier"); id != nil {
+				items = append(items, Treesitter.ImportItem{Module: module, Name: text(a.src, id)})
+			} else {
+				items = append(items, Treesitter.ImportItem{Module: module})
+			}
+		}
+	}
+	for i := 0; i < int(n.ChildCount()); i++ {
+		walkClause(n.Child(i))
+	}
+
+	// side effect import: import './polyfill'
+	if len(items) == 0 {
+		items = append(items, Treesitter.ImportItem{Module: module})
+	}
+	return items
+}
+
+// requireImports lists the symbols loaded by a CommonJS "require('m')" or a
+// dynamic "import('m')". The symbol is the variable the module is bound to,
+// or each destructured name ("const { a, b } = require('m')").
+func (a *TreeSitterAdapter) requireImports(n *sitter.Node) []Treesitter.ImportItem {
+	callee := n.ChildByFieldName("function")
+	if callee == nil {
+		return nil
+	}
+	if callee.Type() != "import" && (callee.Type() != "identifier" || text(a.src, callee) != "require") {
+		return nil
+	}
+	args := n.ChildByFieldName("arguments")
+	if args == nil || args.NamedChildCount() == 0 {
+		return nil
+	}
+	first := args.NamedChild(0)
+	if first.Type() != "string" {
+		// computed module name: nothing reliable to report
+		return nil
+	}
+	module := stripQuotes(text(a.src, first))
+	if module == "" {
+		return nil
+	}
+
+	items := []Treesitter.ImportItem{}
+	p := n.Parent()
+	if p != nil && p.Type() == "await_expression" {
+		// const mod = await import('m')
+		p = p.Parent()
+	}
+	if p != nil && p.Type() == "variable_declarator" {
+		if nm := p.ChildByFieldName("name"); nm != nil {
+			switch nm.Type() {
+			case "identifier":
+				items = append(items, Treesitter.ImportItem{Module: module, Name: text(a.src, nm)})
+			case "object_pattern":
+				for i := 0; i < int(nm.NamedChildCount()); i++ {
+					ch := nm.NamedChild(i)
+					switch ch.Type() {
+					case "shorthand_property_identifier_pattern":
+						items = append(items, Treesitter.ImportItem{Module: module, Name: text(a.src, ch)})
+					case "pair_pattern":
+						// { readFile: rf } loads readFile
+						if key := ch.ChildByFieldName("key"); key != nil {
+							items = append(items, Treesitter.ImportItem{Module: module, Name: text(a.src, key)})
+						}
+					}
+				}
+			}
+		}
+	}
+	if len(items) == 0 {
+		items = append(items, Treesitter.ImportItem{Module: module})
+	}
+	return items
+}
+
+// CountElseIfAsIf: treat else-if as if for complexity aggregation (consistent with Go/PHP)
+func (a *TreeSitterAdapter) CountElseIfAsIf() bool { return true }
+
+// IsLogicalNode reports whether a node begins a logical line. In JavaScript,
+// "const"/"let"/"var" declarations are statements but their node types do not
+// carry the "_statement" suffix.
+func (a *TreeSitterAdapter) IsLogicalNode(n *sitter.Node) bool {
+	switch n.Type() {
+	case "lexical_declaration", "variable_declaration":
+		return true
+	}
+	return Treesitter.IsDefaultLogicalNode(n.Type())
+}
+
+// CommentMarkers declares JavaScript comment tokens: "//" and "/* */" only.
+// "#" introduces private class fields, which are code, not comments.
+func (a *TreeSitterAdapter) CommentMarkers() engine.CommentMarkers {
+	return engine.CommentMarkers{SlashSlash: true, SlashStar: true}
+}
+
+// IsDocComment tells JSDoc ("/**") from a plain comment.
+func (a *TreeSitterAdapter) IsDocComment(text string) bool {
+	return strings.HasPrefix(text, "/**")
+}
+
+// CountComments counts JavaScript comment lines (// and /* */ and /** */) in the given range.
+func (a *TreeSitterAdapter) CountComments(lines []string, start, end int) int {
+	cnt := 0
+	inBlock := false
+	for i := start - 1; i < end && i < len(lines); i++ {
+		ln := strings.TrimSpace(lines[i])
+		if ln == "" {
+			continue
+		}
+		clean := stripJSStrings(ln)
+		if inBlock {
+			cnt++
+			if strings.Contains(clean, "*/") {
+				inBlock = false
+			}
+			continue
+		}
+		if strings.HasPrefix(clean, "//") {
+			cnt++
+			continue
+		}
+		if strings.HasPrefix(clean, "/*") {
+			cnt++
+			if !strings.Contains(clean, "*/") {
+				inBlock = true
+			}
+			continue
+		}
+	}
+	return cnt
+}
+
+// jsOperatorTokens lists the anonymous token types counted as Halstead
+// operators. It is the TypeScript set without the type-level keywords ("as",
+// "satisfies"): the two languages must stay comparable.
+var jsOperatorTokens = map[string]bool{
+	"+": true, "-": true, "*": true, "/": true, "%": true, "**": true,
+	"==": true, "===": true, "!=": true, "!==": true,
+	"<": true, ">": true, "<=": true, ">=": true,
+	"&&": true, "||": true, "??": true, "!": true,
+	"&": true, "|": true, "^": true, "~": true,
+	"<<": true, ">>": true, ">>>": true,
+	"=": true, "+=": true, "-=": true, "*=": true, "/=": true, "%=": true, "**=": true,
+	"&&=": true, "||=": true, "??=": true, "&=": true, "|=": true, "^=": true,
+	"<<=": true, ">>=": true, ">>>=": true,
+	"++": true, "--": true, "=>": true, "...": true,
+	".": true, "?.": true, ",": true, "[": true, "?": true,
+	"return": true, "if": true, "else": true, "for": true, "of": true,
+	"in": true, "while": true, "do": true, "switch": true, "case": true,
+	"default": true, "break": true, "continue": true,
+	"throw": true, "try": true, "catch": true, "finally": true,
+	"new": true, "typeof": true, "instanceof": true, "delete": true,
+	"void": true, "await": true, "yield": true,
+}
+
+// jsOperandTypes lists the named node types counted as Halstead operands.
+// Literals are left out on purpose: the cohesion metrics read the operands,
+// and two methods sharing the literal 0 are not cohesive.
+var jsOperandTypes = map[string]bool{
+	"identifier":                            true,
+	"property_identifier":                   true,
+	"private_property_identifier":           true,
+	"shorthand_property_identifier":         true,
+	"shorthand_property_identifier_pattern": true,
+}
+
+var jsChainTypes = map[string]bool{"member_expression": true}
+
+// jsCallTypes lists the node types counted as one call operator. A "new"
+// expression is left out: it already reports its "new" keyword.
+var jsCallTypes = map[string]bool{"call_expression": true}
+
+var jsOperandSpec = Treesitter.OperandSpec{
+	OperatorTokens: jsOperatorTokens,
+	OperandTypes:   jsOperandTypes,
+	CallTypes:      jsCallTypes,
+	ChainTypes:     jsChainTypes,
+	// no PruneTypes: JavaScript has no type positions
+	// no Receiver: the current object is the keyword "this"
+}
+
+// ExtractOperatorsOperands collects Halstead operators and operands from the
+// AST within the given 1-based inclusive line range. A member access chain is
+// a single operand ("this.total", "console.log").
+func (a *TreeSitterAdapter) ExtractOperatorsOperands(src []byte, startLine, endLine int) ([]string, []string) {
+	root, source := a.ensureRoot(src)
+	if root == nil {
+		return nil, nil
+	}
+	return jsOperandSpec.Extract(root, source, startLine, endLine)
+}
+
+// ExtractMethodCalls returns the methods called on the current object
+// ("this.foo()", "super.bar()").
+func (a *TreeSitterAdapter) ExtractMethodCalls(src []byte, startLine, endLine int) []string {
+	root, source := a.ensureRoot(src)
+	if root == nil {
+		return nil
+	}
+	return jsOperandSpec.MethodCalls(root, source, startLine, endLine)
+}
+
+// ClassDirectOperands scans class body for field declarations and returns field names.
+func (a *TreeSitterAdapter) ClassDirectOperands(n *sitter.Node) []string {
+	if n == nil || a.src == nil {
+		return nil
+	}
+	body := a.NodeBody(n)
+	if body == nil {
+		return nil
+	}
+	var props []string
+	for i := 0; i < int(body.ChildCount()); i++ {
+		ch := body.Child(i)
+		if ch.Type() != "field_definition" {
+			continue
+		}
+		if nm := ch.ChildByFieldName("property"); nm != nil {
+			props = append(props, text(a.src, nm))
+		}
+	}
+	return props
+}
+
+// --- helpers ---
+
+func text(src []byte, n *sitter.Node) string {
+	if n == nil || src == nil {
+		return ""
+	}
+	return string(src[n.StartByte():n.EndByte()])
+}
+
+func firstChildOfType(n *sitter.Node, t string) *sitter.Node {
+	if n == nil {
+		return nil
+	}
+	for i := 0; i < int(n.ChildCount()); i++ {
+		if c := n.Child(i); c.Type() == t {
+			return c
+		}
+	}
+	return nil
+}
+
+func stripQuotes(s string) string {
+	if len(s) >= 2 {
+		if (s[0] == '\'' && s[len(s)-1] == '\'') || (s[0] == '"' && s[len(s)-1] == '"') || (s[0] == '`' && s[len(s)-1] == '`') {
+			return s[1 : len(s)-1]
+		}
+	}
+	return s
+}
+
+// stripJSStrings removes content inside quotes to avoid false positives in comment scanning.
+func stripJSStrings(s string) string {
+	out := make([]rune, 0, len(s))
+	inBack := false
+	inDq := false
+	inSq := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '\\' {
+			if i+1 < len(s) {
+				i++
+			}
+			continue
+		}
+		if !inDq && !inSq && c == '`' {
+			inBack = !inBack
+			continue
+		}
+		if !inBack && !inSq && c == '"' {
+			inDq = !inDq
+			continue
+		}
+		if !inBack && !inDq && c == '\'' {
+			inSq = !inSq
+			continue
+		}
+		if inBack || inDq || inSq {
+			continue
+		}
+		out = append(out, rune(c))
+	}
+	return string(out)
+}
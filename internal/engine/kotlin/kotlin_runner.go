@@ -0,0 +1,117 @@
+package kotlin
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ast-metrics/ast-metrics/internal/configuration"
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	Treesitter "github.com/ast-metrics/ast-metrics/internal/engine/treesitter"
+	"github.com/ast-metrics/ast-metrics/internal/file"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+
+	"github.com/pterm/pterm"
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+type KotlinRunner struct {
+	progressbar   *pterm.SpinnerPrinter
+	Configuration *configuration.Configuration
+	foundFiles    file.FileList
+}
+
+func (r KotlinRunner) Name() string                                     { return "Kotlin" }
+func (r KotlinRunner) IsRequired() bool                                 { return len(r.getFileList().Files) > 0 }
+func (r *KotlinRunner) Ensure() error                                   { return nil }
+func (r *KotlinRunner) SetProgressbar(p *pterm.SpinnerPrinter)          { r.progressbar = p }
+func (r *KotlinRunner) SetConfiguration(c *configuration.Configuration) { r.Configuration = c }
+
+func (r KotlinRunner) DumpAST() []*pb.File {
+	return engine.DumpFiles(
+		r.getFileList().Files,
+		r.progressbar,
+		func(path string) (*pb.File, error) { return r.Parse(path) },
+		engine.DumpOptions{Label: r.Name()},
+	)
+}
+
+func (r KotlinRunner) Finish() error {
+	if r.progressbar != nil {
+		r.progressbar.Stop()
+	}
+	return nil
+}
+
+func (r KotlinRunner) Parse(path string) (*pb.File, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return &pb.File{Path: path, ProgrammingLanguage: "Kotlin"}, err
+	}
+
+	parser := sitter.NewParser()
+	adapter := NewTreeSitterAdapter(src)
+	parser.SetLanguage(adapter.Language())
+
+	tree := parser.Parse(nil, src)
+	root := tree.RootNode()
+	adapter.SetRootNode(root)
+
+	v := Treesitter.NewVisitor(adapter, path, src)
+	v.SetQueries(Treesitter.QueriesFor(r.Configuration, "kotlin", path))
+	v.Visit(root)
+
+	file := v.Result()
+	file.ProgrammingLanguage = "Kotlin"
+
+	// Detect if file is a test file
+	file.IsTest = r.isTestFile(path, src)
+
+	return file, nil
+}
+
+func (r *KotlinRunner) getFileList() file.FileList {
+	if r.foundFiles.Files != nil {
+		return r.foundFiles
+	}
+	finder := file.Finder{Configuration: *r.Configuration}
+	if r.Configuration.FileDiscovery != nil {
+		if fd, ok := r.Configuration.FileDiscovery.(*file.FileDiscovery); ok {
+			finder.Discovery = fd
+		}
+	}
+	extensions := r.Configuration.GetExtensionsForLanguage("kotlin")
+	var lists []file.FileList
+	for _, ext := range extensions {
+		lists = append(lists, finder.Search(ext))
+	}
+	r.foundFiles = file.MergeFileLists(lists...)
+	return r.foundFiles
+}
+
+// isTestFile determines if a Kotlin file is a test file based on:
+// 1. Filename pattern (FooTest.kt, FooTests.kt, TestFoo.kt)
+// 2. Gradle conventional test directories (src/test/, src/androidTest/, src/*Test/ for multiplatform)
+// 3. Source code containing test framework markers (@Test, kotlin.test, org.junit, io.kotest)
+func (r KotlinRunner) isTestFile(path string, src []byte) bool {
+	base := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	if strings.HasSuffix(base, "Test") || strings.HasSuffix(base, "Tests") || strings.HasPrefix(base, "Test") {
+		return true
+	}
+	normalized := filepath.ToSlash(path)
+	if idx := strings.Index(normalized, "/src/"); idx >= 0 {
+		sourceSet := strings.SplitN(normalized[idx+len("/src/"):], "/", 2)[0]
+		if sourceSet == "test" || strings.HasSuffix(sourceSet, "Test") {
+			return true
+		}
+	}
+
+	source := string(src)
+	for _, marker := range []string{"@Test", "kotlin.test", "org.junit", "io.kotest"} {
+		if strings.Contains(source, marker) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,286 @@
+package kotlin
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func parseKotlin(t *testing.T, src string) *pb.File {
+	t.Helper()
+	result, err := engine.CreateTestFileWithCode(&KotlinRunner{}, src)
+	assert.Nil(t, err, "Expected no error, got %v", err)
+	assert.NotNil(t, result)
+	return result
+}
+
+func findClass(file *pb.File, name string) *pb.StmtClass {
+	for _, c := range engine.GetClassesInFile(file) {
+		if c.Name != nil && c.Name.Short == name {
+			return c
+		}
+	}
+	return nil
+}
+
+func findFunction(file *pb.File, name string) *pb.StmtFunction {
+	for _, fn := range engine.GetFunctionsInFile(file) {
+		if fn.Name != nil && fn.Name.Short == name {
+			return fn
+		}
+	}
+	return nil
+}
+
+func TestKotlinClassesObjectsAndCompanions(t *testing.T) {
+	src := `package com.example.shapes
+
+class Circle(val radius: Double, scale: Int) : Shape {
+    private val cache = mutableMapOf<String, Double>()
+
+    constructor(diameter: Int) : this(diameter / 2.0, 1) {
+        println(diameter)
+    }
+
+    override fun area(): Double = radius * radius * 3.14
+
+    companion object {
+        fun unit(): Circle = Circle(1.0, 1)
+    }
+}
+
+object Registry {
+    fun get(name: String) = name
+}
+
+enum class Color { RED, GREEN }
+
+data class Point(val x: Int, val y: Int)
+`
+	result := parseKotlin(t, src)
+	assert.Equal(t, "Kotlin", result.ProgrammingLanguage)
+
+	circle := findClass(result, "Circle")
+	assert.NotNil(t, circle)
+	assert.Equal(t, "com.example.shapes.Circle", circle.Name.Qualified)
+	assert.Equal(t, 2, len(circle.Stmts.StmtFunction), "constructor and area expected")
+	assert.Equal(t, "constructor", circle.Stmts.StmtFunction[0].Name.Short)
+	assert.Equal(t, "com.example.shapes.Circle.area", circle.Stmts.StmtFunction[1].Name.Qualified)
+
+	// val/var constructor parameters are properties, plain parameters are not
+	operands := []string{}
+	for _, op := range circle.Operands {
+		operands = append(operands, op.Name)
+	}
+	assert.ElementsMatch(t, []string{"radius", "cache"}, operands)
+
+	companion := findClass(result, "Circle.Companion")
+	assert.NotNil(t, companion, "companion object expected as a class")
+	assert.Equal(t, 1, len(companion.Stmts.StmtFunction))
+
+	for _, name := range []string{"Registry", "Color", "Point"} {
+		assert.NotNil(t, findClass(result, name), "class %s not found", name)
+	}
+}
+
+func TestKotlinInterface(t *testing.T) {
+	src := `
+interface Shape {
+    fun area(): Double
+}
+`
+	result := parseKotlin(t, src)
+	assert.Equal(t, 1, len(result.Stmts.StmtInterface))
+	assert.Equal(t, "Shape", result.Stmts.StmtInterface[0].Name.Short)
+	assert.Nil(t, findClass(result, "Shape"))
+}
+
+func TestKotlinTopLevelAndExtensionFunctions(t *testing.T) {
+	src := `
+fun String.shout(times: Int = 1): String = this.uppercase().repeat(times)
+
+fun topLevel(a: Int, b: String) {
+    println(a)
+}
+`
+	result := parseKotlin(t, src)
+	shout := findFunction(result, "shout")
+	assert.NotNil(t, shout, "extension function expected")
+	assert.Equal(t, 1, len(shout.Parameters), "the default value is not a parameter")
+	assert.Equal(t, "times", shout.Parameters[0].Name)
+
+	top := findFunction(result, "topLevel")
+	assert.NotNil(t, top)
+	assert.Equal(t, 2, len(top.Parameters))
+}
+
+func TestKotlinDecisions(t *testing.T) {
+	src := `
+fun decide(a: Int, items: List<Int>): String {
+    if (a > 0) {
+        println("positive")
+    } else if (a == 0) {
+        println("zero")
+    } else if (a < -10) {
+        println("very negative")
+    } else {
+        println("negative")
+    }
+    for (item in items) {}
+    while (a > 0) {}
+    do {} while (a < 3)
+    return when (a) {
+        1, 2 -> "low"
+        in 3..9 -> "mid"
+        else -> "high"
+    }
+}
+`
+	result := parseKotlin(t, src)
+	fn := findFunction(result, "decide")
+	assert.NotNil(t, fn)
+	// else-if is counted as an if, like in Java
+	assert.Equal(t, 3, len(fn.Stmts.StmtDecisionIf))
+	assert.Equal(t, 1, len(fn.Stmts.StmtDecisionElse))
+	assert.Equal(t, 3, len(fn.Stmts.StmtLoop))
+	assert.Equal(t, 1, len(fn.Stmts.StmtDecisionSwitch))
+	assert.Equal(t, 3, len(fn.Stmts.StmtDecisionCase))
+}
+
+func TestKotlinImports(t *testing.T) {
+	src := `package com.example.app
+
+import kotlin.math.max
+import com.example.util.*
+import com.example.model.User as Account
+
+class App
+`
+	result := parseKotlin(t, src)
+	deps := engine.GetDependenciesInFile(result)
+
+	found := map[string]bool{}
+	for _, dep := range deps {
+		found[dep.Namespace+":"+dep.ClassName] = true
+	}
+	for _, expected := range []string{"kotlin.math:max", "com.example.util:", "com.example.model:User"} {
+		assert.True(t, found[expected], "dependency %s not found in %v", expected, found)
+	}
+}
+
+func TestKotlinMethodCalls(t *testing.T) {
+	src := `
+class Counter {
+    private var count = 0
+
+    fun increment() {
+        count++
+        log("incremented")
+        this.flush()
+    }
+
+    fun log(msg: String) { println(msg) }
+    fun flush() { count = 0 }
+}
+`
+	result := parseKotlin(t, src)
+	fn := findFunction(result, "increment")
+	assert.NotNil(t, fn)
+	calls := []string{}
+	for _, c := range fn.MethodCalls {
+		calls = append(calls, c.Name)
+	}
+	assert.Contains(t, calls, "this.log", "implicit receiver calls are method calls")
+	assert.Contains(t, calls, "this.flush")
+}
+
+func TestKotlinRunner_IsTest(t *testing.T) {
+	cases := []struct {
+		name     string
+		code     string
+		expected bool
+	}{
+		{"CalculatorTest.kt", "class CalculatorTest", true},
+		{"CalculatorTests.kt", "class CalculatorTests", true},
+		{"src/test/kotlin/Calculator.kt", "class Calculator", true},
+		{"src/androidTest/kotlin/Screen.kt", "class Screen", true},
+		{"src/jvmTest/kotlin/Calculator.kt", "class Calculator", true},
+		{"Spec.kt", "import kotlin.test.assertEquals\nclass Spec", true},
+		{"src/main/kotlin/Calculator.kt", "class Calculator", false},
+		{"Contest.kt", "class Contest", false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tc.name)
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				t.Fatalf("failed to create dir: %v", err)
+			}
+			if err := os.WriteFile(path, []byte(tc.code), 0644); err != nil {
+				t.Fatalf("failed to create file: %v", err)
+			}
+			file, err := (&KotlinRunner{}).Parse(path)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expected, file.IsTest)
+		})
+	}
+}
+
+func TestKotlinRunner_Parse_NonExistentFile(t *testing.T) {
+	file, err := (&KotlinRunner{}).Parse("/nonexistent/file.kt")
+	assert.NotNil(t, err)
+	assert.NotNil(t, file)
+	assert.Equal(t, "Kotlin", file.ProgrammingLanguage)
+}
+
+func TestKotlinHeritage(t *testing.T) {
+	src := `
+class OrderRepository(db: Db) : Repository<Order>(db), Closeable, Auditable by audit {}
+class Failure : kotlin.Exception()
+`
+	result := parseKotlin(t, src)
+
+	repository := findClass(result, "OrderRepository")
+	assert.NotNil(t, repository)
+	assert.Equal(t, 1, len(repository.Extends))
+	assert.Equal(t, "Repository", repository.Extends[0].Short)
+	assert.Equal(t, 2, len(repository.Implements))
+	assert.Equal(t, "Closeable", repository.Implements[0].Short)
+	assert.Equal(t, "Auditable", repository.Implements[1].Short)
+
+	failure := findClass(result, "Failure")
+	assert.NotNil(t, failure)
+	assert.Equal(t, "kotlin.Exception", failure.Extends[0].Qualified)
+	assert.Equal(t, "Exception", failure.Extends[0].Short)
+}
+
+func TestKotlinModifiers(t *testing.T) {
+	src := `
+internal open class Repository {
+    fun find() {}
+    protected open fun save() {}
+    private fun reset() {}
+    companion object {
+        fun create() {}
+    }
+}
+`
+	result := parseKotlin(t, src)
+	class := result.Stmts.StmtClass[0]
+	assert.Equal(t, pb.Visibility_VISIBILITY_PACKAGE, class.Modifiers.Visibility, "internal is package")
+	assert.False(t, class.Modifiers.IsFinal, "an open class is not final")
+
+	methods := map[string]*pb.Modifiers{}
+	for _, fn := range engine.GetFunctionsInFile(result) {
+		methods[fn.Name.Short] = fn.Modifiers
+	}
+	assert.Equal(t, pb.Visibility_VISIBILITY_PUBLIC, methods["find"].Visibility)
+	assert.True(t, methods["find"].IsFinal, "functions are final by default")
+	assert.Equal(t, pb.Visibility_VISIBILITY_PROTECTED, methods["save"].Visibility)
+	assert.False(t, methods["save"].IsFinal)
+	assert.Equal(t, pb.Visibility_VISIBILITY_PRIVATE, methods["reset"].Visibility)
+	assert.True(t, methods["create"].IsStatic, "companion functions are static")
+}
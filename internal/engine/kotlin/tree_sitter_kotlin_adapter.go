@@ -0,0 +1,651 @@
+package kotlin
+
+import (
+	"strings"
+
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	Treesitter "github.com/ast-metrics/ast-metrics/internal/engine/treesitter"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+	sitter "github.com/smacker/go-tree-sitter"
+	tsKotlin "github.com/smacker/go-tree-sitter/kotlin"
+)
+
+type TreeSitterAdapter struct {
+	src []byte
+	// root caches the tree shared by the runner, to avoid re-parsing
+	root *sitter.Node
+	// pkg caches the declared package name (read lazily from the tree)
+	pkg       string
+	pkgParsed bool
+}
+
+func NewTreeSitterAdapter(src []byte) *TreeSitterAdapter   { return &TreeSitterAdapter{src: src} }
+func (a *TreeSitterAdapter) SetSource(src []byte)          { a.src = src; a.root = nil }
+func (a *TreeSitterAdapter) SetRootNode(root *sitter.Node) { a.root = root }
+func (a *TreeSitterAdapter) Language() *sitter.Language    { return tsKotlin.GetLanguage() }
+
+// ensureRoot returns the tree shared by the runner, parsing the source when
+// the adapter is used on its own (tests).
+func (a *TreeSitterAdapter) ensureRoot(src []byte) (*sitter.Node, []byte) {
+	source := a.src
+	if source == nil {
+		source = src
+	}
+	if a.root != nil {
+		return a.root, source
+	}
+	if source == nil {
+		return nil, nil
+	}
+	parser := sitter.NewParser()
+	parser.SetLanguage(a.Language())
+	a.root = parser.Parse(nil, source).RootNode()
+	return a.root, source
+}
+
+func (a *TreeSitterAdapter) IsModule(n *sitter.Node) bool { return n.Type() == "source_file" }
+
+func (a *TreeSitterAdapter) IsClass(n *sitter.Node) bool {
+	switch n.Type() {
+	case "class_declaration":
+		// enum and data classes share the node of a plain class
+		return !isInterface(n)
+	case "object_declaration", "companion_object":
+		// objects are singletons holding properties and functions: they are
+		// measured like classes
+		return true
+	}
+	return false
+}
+
+func (a *TreeSitterAdapter) IsInterface(n *sitter.Node) bool {
+	return n.Type() == "class_declaration" && isInterface(n)
+}
+
+// isInterface reports whether a class_declaration declares an interface: the
+// Kotlin grammar has no dedicated node, only the "interface" keyword.
+func isInterface(n *sitter.Node) bool {
+	for i := 0; i < int(n.ChildCount()); i++ {
+		if ch := n.Child(i); !ch.IsNamed() && ch.Type() == "interface" {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *TreeSitterAdapter) IsFunction(n *sitter.Node) bool {
+	// Lambdas are not named functions; their bodies still contribute
+	// decisions to the enclosing function via the fallback recursion.
+	// Extension functions are plain functions: their receiver is a type of
+	// another file, not the class they belong to.
+	switch n.Type() {
+	case "function_declaration", "secondary_constructor":
+		return true
+	}
+	return false
+}
+
+func (a *TreeSitterAdapter) NodeName(n *sitter.Node) string {
+	if a.src == nil || n == nil {
+		return ""
+	}
+	switch n.Type() {
+	case "secondary_constructor":
+		return "constructor"
+	case "companion_object":
+		// a companion is reached through its outer class (Foo.Companion):
+		// qualifying it keeps two companions of the same file apart
+		name := "Companion"
+		if id := firstChildOfType(n, "type_identifier"); id != nil {
+			name = text(a.src, id)
+		}
+		if outer := enclosingClass(n); outer != nil {
+			if outerName := a.NodeName(outer); outerName != "" {
+				return outerName + "." + name
+			}
+		}
+		return name
+	case "function_declaration":
+		// the receiver of an extension function is a user_type: the first
+		// direct simple_identifier is the function name
+		if id := firstChildOfType(n, "simple_identifier"); id != nil {
+			return text(a.src, id)
+		}
+		return ""
+	}
+	if id := firstChildOfType(n, "type_identifier"); id != nil {
+		return text(a.src, id)
+	}
+	return ""
+}
+
+// enclosingClass returns the class or object whose body holds n, if any.
+func enclosingClass(n *sitter.Node) *sitter.Node {
+	body := n.Parent()
+	if body == nil || body.Type() != "class_body" {
+		return nil
+	}
+	outer := body.Parent()
+	if outer == nil {
+		return nil
+	}
+	switch outer.Type() {
+	case "class_declaration", "object_declaration", "companion_object":
+		return outer
+	}
+	return nil
+}
+
+func (a *TreeSitterAdapter) NodeBody(n *sitter.Node) *sitter.Node {
+	if n == nil {
+		return nil
+	}
+	if n.Type() == "secondary_constructor" {
+		// the statements of a secondary constructor are not wrapped in a block
+		return n
+	}
+	for _, t := range []string{"class_body", "enum_class_body", "function_body", "block"} {
+		if b := firstChildOfType(n, t); b != nil {
+			return b
+		}
+	}
+	return nil
+}
+
+func (a *TreeSitterAdapter) NodeParams(n *sitter.Node) *sitter.Node {
+	if n == nil {
+		return nil
+	}
+	return firstChildOfType(n, "function_value_parameters")
+}
+
+func (a *TreeSitterAdapter) EachParamIdent(params *sitter.Node, yield func(string)) {
+	if params == nil || a.src == nil {
+		return
+	}
+	for i := 0; i < int(params.ChildCount()); i++ {
+		p := params.Child(i)
+		if p.Type() != "parameter" {
+			// default values are siblings of the parameter, not parameters
+			continue
+		}
+		if id := firstChildOfType(p, "simple_identifier"); id != nil {
+			yield(text(a.src, id))
+		}
+	}
+}
+
+// ModuleNameFromPath ignores the file path and returns the declared package
+// name (e.g. "com.example.app"). Empty string for the default package.
+func (a *TreeSitterAdapter) ModuleNameFromPath(path string) string {
+	if a.pkgParsed {
+		return a.pkg
+	}
+	a.pkgParsed = true
+	root, source := a.ensureRoot(nil)
+	if root == nil {
+		return ""
+	}
+	if header := firstChildOfType(root, "package_header"); header != nil {
+		if id := firstChildOfType(header, "identifier"); id != nil {
+			a.pkg = text(source, id)
+		}
+	}
+	return a.pkg
+}
+
+func (a *TreeSitterAdapter) AttachQualified(parentClass string, fn string) string {
+	if parentClass == "" {
+		return fn
+	}
+	return parentClass + "." + fn
+}
+
+// NamespaceSeparator joins the package and the class name with ".", as in Java.
+func (a *TreeSitterAdapter) NamespaceSeparator() string { return "." }
+
+func (a *TreeSitterAdapter) EachChildBody(body *sitter.Node, yield func(*sitter.Node)) {
+	if body == nil {
+		return
+	}
+	switch body.Type() {
+	case "when_expression":
+		// yield only the branches: the subject is not a case
+		for i := 0; i < int(body.ChildCount()); i++ {
+			if ch := body.Child(i); ch.Type() == "when_entry" {
+				yield(ch)
+			}
+		}
+	default:
+		for i := 0; i < int(body.ChildCount()); i++ {
+			yield(body.Child(i))
+		}
+	}
+}
+
+// isElseBranch reports whether n is the branch following the "else" keyword
+// of an if_expression. tree-sitter-kotlin has no else node: both branches are
+// control_structure_body nodes, and an else-if holds a nested if_expression.
+func isElseBranch(n *sitter.Node) bool {
+	if n.Type() != "control_structure_body" {
+		return false
+	}
+	p := n.Parent()
+	if p == nil || p.Type() != "if_expression" {
+		return false
+	}
+	prev := n.PrevSibling()
+	return prev != nil && prev.Type() == "else"
+}
+
+func (a *TreeSitterAdapter) Decision(n *sitter.Node) (Treesitter.DecisionKind, *sitter.Node) {
+	if isElseBranch(n) {
+		if n.NamedChildCount() == 1 {
+			if nested := n.NamedChild(0); nested.Type() == "if_expression" {
+				// else-if: re-visit the nested if so that deeper branches
+				// keep being counted
+				return Treesitter.DecElif, nested
+			}
+		}
+		return Treesitter.DecElse, n
+	}
+
+	switch n.Type() {
+	case "if_expression":
+		return Treesitter.DecIf, firstChildOfType(n, "control_structure_body")
+
+	case "when_expression":
+		return Treesitter.DecSwitch, n
+
+	case "when_entry":
+		return Treesitter.DecCase, n
+
+	case "for_statement", "while_statement", "do_while_statement":
+		return Treesitter.DecLoop, firstChildOfType(n, "control_structure_body")
+	}
+	// elvis operators and catch blocks intentionally left out, consistent
+	// with the other engines.
+	return Treesitter.DecNone, nil
+}
+
+// Condition returns the condition of an if or a loop, which the grammar gives
+// no field: the first child of an if or a while, the last of a do-while.
+func (a *TreeSitterAdapter) Condition(n *sitter.Node) *sitter.Node {
+	count := int(n.NamedChildCount())
+	if count == 0 {
+		return nil
+	}
+	var cond *sitter.Node
+	switch n.Type() {
+	case "if_expression", "while_statement":
+		cond = n.NamedChild(0)
+	case "do_while_statement":
+		cond = n.NamedChild(count - 1)
+	default:
+		return nil
+	}
+	if cond.Type() == "control_structure_body" {
+		return nil
+	}
+	return cond
+}
+
+func (a *TreeSitterAdapter) Imports(n *sitter.Node) []Treesitter.ImportItem {
+	if n == nil || n.Type() != "import_header" {
+		return nil
+	}
+	var path string
+	isWildcard := false
+	for i := 0; i < int(n.ChildCount()); i++ {
+		ch := n.Child(i)
+		switch ch.Type() {
+		case "identifier":
+			path = text(a.src, ch)
+		case "wildcard_import":
+			isWildcard = true
+		}
+	}
+	if path == "" {
+		return nil
+	}
+	if isWildcard {
+		// import com.example.util.*
+		return []Treesitter.ImportItem{{Module: path, Name: ""}}
+	}
+	// import kotlin.math.max / import com.example.Foo as Bar: the alias is
+	// local to the file, the dependency is on the imported name
+	if idx := strings.LastIndex(path, "."); idx >= 0 {
+		return []Treesitter.ImportItem{{Module: path[:idx], Name: path[idx+1:]}}
+	}
+	return []Treesitter.ImportItem{{Module: path, Name: ""}}
+}
+
+// Heritage reports the superclass of a class and the interfaces it
+// implements. Only the superclass is called with its constructor
+// ("Base()"): the interfaces are bare types, delegated or not.
+func (a *TreeSitterAdapter) Heritage(n *sitter.Node) Treesitter.Heritage {
+	var h Treesitter.Heritage
+	if a.src == nil || n == nil {
+		return h
+	}
+	for i := 0; i < int(n.NamedChildCount()); i++ {
+		spec := n.NamedChild(i)
+		if spec.Type() != "delegation_specifier" || spec.NamedChildCount() == 0 {
+			continue
+		}
+		switch target := spec.NamedChild(0); target.Type() {
+		case "constructor_invocation":
+			if name := a.userTypeName(firstChildOfType(target, "user_type")); name != nil {
+				h.Extends = append(h.Extends, name)
+			}
+		case "user_type":
+			if name := a.userTypeName(target); name != nil {
+				h.Implements = append(h.Implements, name)
+			}
+		case "explicit_delegation":
+			if name := a.userTypeName(firstChildOfType(target, "user_type")); name != nil {
+				h.Implements = append(h.Implements, name)
+			}
+		}
+	}
+	return h
+}
+
+// Modifiers reports the modifiers of a class or a function. Kotlin
+// declarations are public and final unless stated otherwise: only open,
+// abstract and sealed classes, and open, abstract or overriding functions, can
+// be extended. The functions of a companion object are static.
+func (a *TreeSitterAdapter) Modifiers(n *sitter.Node) *pb.Modifiers {
+	all := Treesitter.ModifierKeywords(n, map[string]bool{"modifiers": true})
+	var keywords []string
+	for _, kw := range all {
+		// "open" is about inheritance in Kotlin, not visibility
+		if kw != "open" {
+			keywords = append(keywords, kw)
+		}
+	}
+	m := Treesitter.KeywordModifiers(keywords, pb.Visibility_VISIBILITY_PUBLIC)
+	switch n.Type() {
+	case "class_declaration":
+		m.IsFinal = !isInterface(n) && !Treesitter.HasKeyword(all, "open") && !m.IsAbstract && !Treesitter.HasKeyword(all, "sealed")
+	case "object_declaration", "companion_object":
+		m.IsFinal = true
+	case "function_declaration":
+		m.IsFinal = !Treesitter.HasKeyword(all, "open") && !m.IsAbstract && !Treesitter.HasKeyword(all, "override")
+		if body := n.Parent(); body != nil && body.Type() == "class_body" {
+			switch owner := body.Parent(); {
+			case owner == nil:
+			case owner.Type() == "companion_object":
+				m.IsStatic = true
+			case isInterface(owner):
+				// a function of an interface is meant to be overridden
+				m.IsFinal = false
+				m.IsAbstract = firstChildOfType(n, "function_body") == nil
+			}
+		}
+	}
+	return m
+}
+
+// userTypeName returns the name of a user type: "Base", "Repository<User>"
+// (named after Repository) or "kotlin.Exception".
+func (a *TreeSitterAdapter) userTypeName(t *sitter.Node) *pb.Name {
+	if t == nil {
+		return nil
+	}
+	var parts []string
+	for i := 0; i < int(t.NamedChildCount()); i++ {
+		if ch := t.NamedChild(i); ch.Type() == "type_identifier" {
+			parts = append(parts, text(a.src, ch))
+		}
+	}
+	if len(parts) == 0 {
+		return nil
+	}
+	return &pb.Name{Short: parts[len(parts)-1], Qualified: strings.Join(parts, ".")}
+}
+
+// CountElseIfAsIf: treat else-if as if for complexity aggregation (consistent with Java)
+func (a *TreeSitterAdapter) CountElseIfAsIf() bool { return true }
+
+// IsLogicalNode reports whether a node begins a logical line. Kotlin
+// statements carry no "_statement" suffix (most of them are expressions), so
+// a logical line is anything written directly in a block, in a brace-less
+// branch, or as the expression body of a function.
+func (a *TreeSitterAdapter) IsLogicalNode(n *sitter.Node) bool {
+	p := n.Parent()
+	if p == nil {
+		return false
+	}
+	switch p.Type() {
+	case "statements":
+		return true
+	case "control_structure_body", "function_body":
+		return n.IsNamed() && n.Type() != "statements"
+	}
+	return false
+}
+
+// CommentMarkers declares Kotlin comment tokens: "//" and "/* */" only.
+func (a *TreeSitterAdapter) CommentMarkers() engine.CommentMarkers {
+	return engine.CommentMarkers{SlashSlash: true, SlashStar: true}
+}
+
+// IsDocComment tells KDoc ("/**") from a plain comment.
+func (a *TreeSitterAdapter) IsDocComment(text string) bool {
+	return strings.HasPrefix(text, "/**")
+}
+
+// CountComments counts Kotlin comment lines (//, /* */ and KDoc) in the given range.
+func (a *TreeSitterAdapter) CountComments(lines []string, start, end int) int {
+	cnt := 0
+	inBlock := false
+	for i := start - 1; i < end && i < len(lines); i++ {
+		ln := strings.TrimSpace(lines[i])
+		if ln == "" {
+			continue
+		}
+		clean := stripKotlinStrings(ln)
+		if inBlock {
+			cnt++
+			if strings.Contains(clean, "*/") {
+				inBlock = false
+			}
+			continue
+		}
+		if strings.HasPrefix(clean, "//") {
+			cnt++
+			continue
+		}
+		if strings.HasPrefix(clean, "/*") {
+			cnt++
+			if !strings.Contains(clean, "*/") {
+				inBlock = true
+			}
+			continue
+		}
+	}
+	return cnt
+}
+
+// kotlinOperatorTokens lists the anonymous token types counted as Halstead
+// operators: the Java set, plus the null-safety operators ("?.", "?:", "!!"),
+// ranges, and the keywords Kotlin adds to the control flow ("when", "is",
+// "in", "as").
+var kotlinOperatorTokens = map[string]bool{
+	"+": true, "-": true, "*": true, "/": true, "%": true,
+	"==": true, "!=": true, "===": true, "!==": true,
+	"<": true, ">": true, "<=": true, ">=": true,
+	"&&": true, "||": true, "!": true,
+	"=": true, "+=": true, "-=": true, "*=": true, "/=": true, "%=": true,
+	"++": true, "--": true, "->": true, "::": true,
+	".": true, "?.": true, "?:": true, "!!": true, "..": true,
+	",": true, "[": true,
+	"return": true, "if": true, "else": true, "for": true, "while": true,
+	"do": true, "when": true, "break": true, "continue": true,
+	"is": true, "!is": true, "in": true, "!in": true, "as": true, "as?": true,
+	"throw": true, "try": true, "catch": true, "finally": true,
+}
+
+// kotlinOperandTypes lists the named node types counted as Halstead operands.
+// Literals are left out on purpose, as in Java. A navigation ("this.items",
+// "user.name") has no three-part chain node in tree-sitter-kotlin: each name
+// is an operand of its own, and "this.items" reads "items", the name a member
+// access without "this" gives too.
+var kotlinOperandTypes = map[string]bool{"simple_identifier": true}
+
+var kotlinCallTypes = map[string]bool{"call_expression": true}
+
+// kotlinPruneTypes lists the node types never walked: types, modifiers and
+// annotations describe the declaration, not what it computes.
+var kotlinPruneTypes = map[string]bool{
+	"user_type": true, "nullable_type": true, "function_type": true,
+	"type_identifier": true, "type_arguments": true, "type_parameters": true,
+	"type_constraints": true, "modifiers": true, "annotation": true,
+	"delegation_specifier": true, "package_header": true, "import_list": true,
+}
+
+var kotlinOperandSpec = Treesitter.OperandSpec{
+	OperatorTokens: kotlinOperatorTokens,
+	OperandTypes:   kotlinOperandTypes,
+	CallTypes:      kotlinCallTypes,
+	PruneTypes:     kotlinPruneTypes,
+}
+
+// ExtractOperatorsOperands collects Halstead operators and operands from the
+// AST within the given 1-based inclusive line range.
+func (a *TreeSitterAdapter) ExtractOperatorsOperands(src []byte, startLine, endLine int) ([]string, []string) {
+	root, source := a.ensureRoot(src)
+	if root == nil {
+		return nil, nil
+	}
+	return kotlinOperandSpec.Extract(root, source, startLine, endLine)
+}
+
+// ExtractMethodCalls extracts the calls made on the current object. Kotlin
+// calls members without "this" most of the time, so a bare call ("load()")
+// is reported as "this.load" like an explicit one; a call to a top-level
+// function of the same name is rare enough to be ignored.
+func (a *TreeSitterAdapter) ExtractMethodCalls(src []byte, startLine, endLine int) []string {
+	root, source := a.ensureRoot(src)
+	if root == nil || startLine <= 0 || endLine < startLine {
+		return nil
+	}
+	var calls []string
+	var walk func(n *sitter.Node)
+	walk = func(n *sitter.Node) {
+		if int(n.EndPoint().Row)+1 < startLine || int(n.StartPoint().Row)+1 > endLine {
+			return
+		}
+		if n.Type() == "call_expression" && n.NamedChildCount() > 0 {
+			callee := n.NamedChild(0)
+			switch callee.Type() {
+			case "simple_identifier":
+				calls = append(calls, "this."+text(source, callee))
+			case "navigation_expression":
+				if callee.NamedChildCount() == 2 {
+					object, suffix := callee.NamedChild(0), callee.NamedChild(1)
+					name := firstChildOfType(suffix, "simple_identifier")
+					if name != nil {
+						switch object.Type() {
+						case "this_expression":
+							calls = append(calls, "this."+text(source, name))
+						case "super_expression":
+							calls = append(calls, "super."+text(source, name))
+						}
+					}
+				}
+			}
+		}
+		for i := 0; i < int(n.ChildCount()); i++ {
+			walk(n.Child(i))
+		}
+	}
+	walk(root)
+	return calls
+}
+
+// ClassDirectOperands lists the properties declared by a class: in its body,
+// and as val/var parameters of its primary constructor.
+func (a *TreeSitterAdapter) ClassDirectOperands(n *sitter.Node) []string {
+	if n == nil || a.src == nil {
+		return nil
+	}
+	var props []string
+	if ctor := firstChildOfType(n, "primary_constructor"); ctor != nil {
+		for i := 0; i < int(ctor.NamedChildCount()); i++ {
+			p := ctor.NamedChild(i)
+			if p.Type() != "class_parameter" || firstChildOfType(p, "binding_pattern_kind") == nil {
+				continue
+			}
+			if id := firstChildOfType(p, "simple_identifier"); id != nil {
+				props = append(props, text(a.src, id))
+			}
+		}
+	}
+	if body := firstChildOfType(n, "class_body"); body != nil {
+		for i := 0; i < int(body.NamedChildCount()); i++ {
+			decl := body.NamedChild(i)
+			if decl.Type() != "property_declaration" {
+				continue
+			}
+			if vd := firstChildOfType(decl, "variable_declaration"); vd != nil {
+				if id := firstChildOfType(vd, "simple_identifier"); id != nil {
+					props = append(props, text(a.src, id))
+				}
+			}
+		}
+	}
+	return props
+}
+
+// --- helpers ---
+
+func text(src []byte, n *sitter.Node) string {
+	if n == nil || src == nil {
+		return ""
+	}
+	return string(src[n.StartByte():n.EndByte()])
+}
+
+func firstChildOfType(n *sitter.Node, t string) *sitter.Node {
+	if n == nil {
+		return nil
+	}
+	for i := 0; i < int(n.ChildCount()); i++ {
+		if c := n.Child(i); c.Type() == t {
+			return c
+		}
+	}
+	return nil
+}
+
+// stripKotlinStrings removes content inside string and char literals to avoid
+// false positives in comment scanning.
+func stripKotlinStrings(s string) string {
+	out := make([]rune, 0, len(s))
+	inDq := false
+	inSq := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '\\' {
+			if i+1 < len(s) {
+				i++
+			}
+			continue
+		}
+		if !inSq && c == '"' {
+			inDq = !inDq
+			continue
+		}
+		if !inDq && c == '\'' {
+			inSq = !inSq
+			continue
+		}
+		if inDq || inSq {
+			continue
+		}
+		out = append(out, rune(c))
+	}
+	return string(out)
+}
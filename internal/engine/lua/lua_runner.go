@@ -0,0 +1,116 @@
+package lua
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ast-metrics/ast-metrics/internal/configuration"
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	Treesitter "github.com/ast-metrics/ast-metrics/internal/engine/treesitter"
+	"github.com/ast-metrics/ast-metrics/internal/file"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+
+	"github.com/pterm/pterm"
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+type LuaRunner struct {
+	progressbar   *pterm.SpinnerPrinter
+	Configuration *configuration.Configuration
+	foundFiles    file.FileList
+}
+
+func (r LuaRunner) Name() string                                     { return "Lua" }
+func (r LuaRunner) IsRequired() bool                                 { return len(r.getFileList().Files) > 0 }
+func (r *LuaRunner) Ensure() error                                   { return nil }
+func (r *LuaRunner) SetProgressbar(p *pterm.SpinnerPrinter)          { r.progressbar = p }
+func (r *LuaRunner) SetConfiguration(c *configuration.Configuration) { r.Configuration = c }
+
+func (r LuaRunner) DumpAST() []*pb.File {
+	return engine.DumpFiles(
+		r.getFileList().Files,
+		r.progressbar,
+		func(path string) (*pb.File, error) { return r.Parse(path) },
+		engine.DumpOptions{Label: r.Name()},
+	)
+}
+
+func (r LuaRunner) Finish() error {
+	if r.progressbar != nil {
+		r.progressbar.Stop()
+	}
+	return nil
+}
+
+func (r LuaRunner) Parse(path string) (*pb.File, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return &pb.File{Path: path, ProgrammingLanguage: "Lua"}, err
+	}
+
+	parser := sitter.NewParser()
+	adapter := NewTreeSitterAdapter(src)
+	parser.SetLanguage(adapter.Language())
+
+	tree := parser.Parse(nil, src)
+	root := tree.RootNode()
+	adapter.SetRootNode(root)
+
+	v := Treesitter.NewVisitor(adapter, path, src)
+	v.SetQueries(Treesitter.QueriesFor(r.Configuration, "lua", path))
+	v.Visit(root)
+
+	file := v.Result()
+	file.ProgrammingLanguage = "Lua"
+
+	// Detect if file is a test file
+	file.IsTest = r.isTestFile(path, src)
+
+	return file, nil
+}
+
+func (r *LuaRunner) getFileList() file.FileList {
+	if r.foundFiles.Files != nil {
+		return r.foundFiles
+	}
+	finder := file.Finder{Configuration: *r.Configuration}
+	if r.Configuration.FileDiscovery != nil {
+		if fd, ok := r.Configuration.FileDiscovery.(*file.FileDiscovery); ok {
+			finder.Discovery = fd
+		}
+	}
+	extensions := r.Configuration.GetExtensionsForLanguage("lua")
+	var lists []file.FileList
+	for _, ext := range extensions {
+		lists = append(lists, finder.Search(ext))
+	}
+	r.foundFiles = file.MergeFileLists(lists...)
+	return r.foundFiles
+}
+
+// isTestFile determines if a Lua file is a test file based on:
+// 1. Filename pattern (foo_spec.lua, foo_test.lua, test_foo.lua)
+// 2. Conventional test directories (spec/, test/, tests/)
+// 3. Source code loading a test framework (busted, luaunit)
+func (r LuaRunner) isTestFile(path string, src []byte) bool {
+	base := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	if strings.HasSuffix(base, "_spec") || strings.HasSuffix(base, "_test") || strings.HasPrefix(base, "test_") {
+		return true
+	}
+	normalized := "/" + filepath.ToSlash(filepath.Dir(path)) + "/"
+	for _, dir := range []string{"/spec/", "/test/", "/tests/"} {
+		if strings.Contains(normalized, dir) {
+			return true
+		}
+	}
+
+	source := string(src)
+	for _, marker := range []string{"busted", "luaunit"} {
+		if strings.Contains(source, marker) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,261 @@
+package lua
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func parseLua(t *testing.T, src string) *pb.File {
+	t.Helper()
+	result, err := engine.CreateTestFileWithCode(&LuaRunner{}, src)
+	assert.Nil(t, err, "Expected no error, got %v", err)
+	assert.NotNil(t, result)
+	return result
+}
+
+func findClass(file *pb.File, name string) *pb.StmtClass {
+	for _, c := range engine.GetClassesInFile(file) {
+		if c.Name != nil && c.Name.Short == name {
+			return c
+		}
+	}
+	return nil
+}
+
+func findFunction(file *pb.File, name string) *pb.StmtFunction {
+	for _, fn := range engine.GetFunctionsInFile(file) {
+		if fn.Name != nil && fn.Name.Short == name {
+			return fn
+		}
+	}
+	return nil
+}
+
+func TestLuaModulesAndTablesOfFunctions(t *testing.T) {
+	src := `local M = {}
+M.__index = M
+M.version = "1.0"
+
+
+function M.new(name, opts)
+  local self = setmetatable({}, M)
+  self.name = name
+  return self
+end
+
+function M:greet(other) return self.name .. other end
+
+M.reset = function(...) end
+
+local Player = {
+  speed = 10,
+  move = function(self, dx) self.x = self.x + dx end,
+}
+
+local config = { debug = true }
+
+local function helper(x)
+  return x * 2
+end
+
+return M
+`
+	result := parseLua(t, src)
+	assert.Equal(t, "Lua", result.ProgrammingLanguage)
+	assert.Equal(t, 2, len(engine.GetClassesInFile(result)), "a table without functions is not a class")
+	assert.Nil(t, findClass(result, "config"))
+
+	m := findClass(result, "M")
+	assert.NotNil(t, m)
+	assert.Equal(t, 3, len(m.Stmts.StmtFunction))
+	fields := []string{}
+	for _, op := range m.Operands {
+		fields = append(fields, op.Name)
+	}
+	assert.Equal(t, []string{"version"}, fields, "metamethods are not fields")
+
+	newFn := findFunction(result, "new")
+	assert.NotNil(t, newFn)
+	// the module is named after its file
+	assert.True(t, strings.HasSuffix(newFn.Name.Qualified, ".M.new"), newFn.Name.Qualified)
+	assert.Equal(t, 2, len(newFn.Parameters))
+	// the grammar lends the blank lines before a statement to it: they are
+	// not part of the function
+	assert.Equal(t, int32(6), newFn.Location.StartLine)
+	assert.Equal(t, int32(10), newFn.Location.EndLine)
+
+	assert.Equal(t, "...", findFunction(result, "reset").Parameters[0].Name)
+
+	player := findClass(result, "Player")
+	assert.NotNil(t, player)
+	assert.Equal(t, 1, len(player.Stmts.StmtFunction))
+	assert.Equal(t, "speed", player.Operands[0].Name)
+
+	// a local function belongs to no table
+	assert.Equal(t, "helper", findFunction(result, "helper").Name.Qualified)
+}
+
+func TestLuaInheritance(t *testing.T) {
+	src := `local Dog = setmetatable({}, {__index = Animal})
+local Cat = class("Cat", Animal)
+local Bird = Animal:extend()
+`
+	result := parseLua(t, src)
+	for _, name := range []string{"Dog", "Cat", "Bird"} {
+		c := findClass(result, name)
+		assert.NotNil(t, c, name)
+		assert.Equal(t, 1, len(c.Extends), name)
+		assert.Equal(t, "Animal", c.Extends[0].Short, name)
+	}
+}
+
+func TestLuaDecisions(t *testing.T) {
+	src := `
+local function decide(a, items)
+  if a > 0 then
+    print(a)
+  elseif a == 0 then
+    print(0)
+  elseif a < -10 then
+    print(-10)
+  else
+    print(-1)
+  end
+  for i = 1, 10 do print(i) end
+  for _, item in ipairs(items) do print(item) end
+  while a > 0 do a = a - 1 end
+  repeat a = a + 1 until a > 5
+  return a
+end
+`
+	result := parseLua(t, src)
+	fn := findFunction(result, "decide")
+	assert.NotNil(t, fn)
+	assert.Equal(t, 1, len(fn.Stmts.StmtDecisionIf))
+	assert.Equal(t, 2, len(fn.Stmts.StmtDecisionElseIf))
+	assert.Equal(t, 1, len(fn.Stmts.StmtDecisionElse))
+	assert.Equal(t, 4, len(fn.Stmts.StmtLoop))
+}
+
+func TestLuaImports(t *testing.T) {
+	src := `local json = require("cjson")
+local http = require "resty.http"
+local lib = {}
+lib.require("not.a.module")
+`
+	result := parseLua(t, src)
+
+	found := map[string]bool{}
+	for _, dep := range engine.GetDependenciesInFile(result) {
+		found[dep.Namespace+":"+dep.ClassName] = true
+	}
+	assert.Equal(t, map[string]bool{":cjson": true, "resty:http": true}, found)
+}
+
+func TestLuaComments(t *testing.T) {
+	src := `local function deposit(account, v)
+  --[[
+    no overflow check
+  ]]
+  account.balance = account.balance + v -- in cents
+  return "--" .. v
+end
+`
+	result := parseLua(t, src)
+	fn := findFunction(result, "deposit")
+	assert.NotNil(t, fn)
+	assert.Equal(t, int32(4), fn.LinesOfCode.CommentLinesOfCode)
+}
+
+func TestLuaCohesion(t *testing.T) {
+	src := `local Account = {}
+
+function Account:deposit(v)
+  self.balance = self.balance + v
+  self:log("deposit")
+  Account.audit(v)
+  other:log("x")
+end
+`
+	result := parseLua(t, src)
+	fn := findFunction(result, "deposit")
+	assert.NotNil(t, fn)
+	calls := []string{}
+	for _, c := range fn.MethodCalls {
+		calls = append(calls, c.Name)
+	}
+	assert.ElementsMatch(t, []string{"this.log", "this.audit"}, calls)
+
+	operands := []string{}
+	for _, op := range fn.Operands {
+		operands = append(operands, op.Name)
+	}
+	assert.Contains(t, operands, "this.balance")
+	assert.NotContains(t, operands, "this.log", "a method call reads no attribute")
+	assert.NotContains(t, operands, "self")
+
+	operators := []string{}
+	for _, op := range fn.Operators {
+		operators = append(operators, op.Name)
+	}
+	assert.Contains(t, operators, "+")
+	assert.Contains(t, operators, "()")
+}
+
+func TestLuaRunner_IsTest(t *testing.T) {
+	cases := []struct {
+		name     string
+		code     string
+		expected bool
+	}{
+		{"account_spec.lua", "local x = 1", true},
+		{"account_test.lua", "local x = 1", true},
+		{"spec/account.lua", "local x = 1", true},
+		{"checks.lua", "local lu = require('luaunit')", true},
+		{"src/account.lua", "local Account = {}", false},
+		{"latest.lua", "local x = 1", false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tc.name)
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				t.Fatalf("failed to create dir: %v", err)
+			}
+			if err := os.WriteFile(path, []byte(tc.code), 0644); err != nil {
+				t.Fatalf("failed to create file: %v", err)
+			}
+			file, err := (&LuaRunner{}).Parse(path)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expected, file.IsTest)
+		})
+	}
+}
+
+func TestLuaRunner_Parse_NonExistentFile(t *testing.T) {
+	file, err := (&LuaRunner{}).Parse("/nonexistent/file.lua")
+	assert.NotNil(t, err)
+	assert.NotNil(t, file)
+	assert.Equal(t, "Lua", file.ProgrammingLanguage)
+}
+
+func TestLuaModifiers(t *testing.T) {
+	src := `
+local M = {}
+local function helper() end
+function M.find() end
+return M
+`
+	result := parseLua(t, src)
+	methods := map[string]*pb.Modifiers{}
+	for _, fn := range engine.GetFunctionsInFile(result) {
+		methods[fn.Name.Short] = fn.Modifiers
+	}
+	assert.Equal(t, pb.Visibility_VISIBILITY_PRIVATE, methods["helper"].Visibility, "a local function is private")
+	assert.Equal(t, pb.Visibility_VISIBILITY_PUBLIC, methods["find"].Visibility)
+}
@@ -0,0 +1,772 @@
+package lua
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	Treesitter "github.com/ast-metrics/ast-metrics/internal/engine/treesitter"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+	sitter "github.com/smacker/go-tree-sitter"
+	tsLua "github.com/smacker/go-tree-sitter/lua"
+)
+
+type TreeSitterAdapter struct {
+	src []byte
+	// root caches the tree shared by the runner, to avoid re-parsing
+	root *sitter.Node
+	// classes holds the start byte of the declarations of the tables used as
+	// classes, collected once per file
+	classes map[uint32]bool
+}
+
+func NewTreeSitterAdapter(src []byte) *TreeSitterAdapter { return &TreeSitterAdapter{src: src} }
+func (a *TreeSitterAdapter) SetSource(src []byte) {
+	a.src, a.root, a.classes = src, nil, nil
+}
+func (a *TreeSitterAdapter) SetRootNode(root *sitter.Node) { a.root, a.classes = root, nil }
+func (a *TreeSitterAdapter) Language() *sitter.Language    { return tsLua.GetLanguage() }
+
+// ensureRoot returns the tree shared by the runner, parsing the source when
+// the adapter is used on its own (tests).
+func (a *TreeSitterAdapter) ensureRoot(src []byte) (*sitter.Node, []byte) {
+	source := a.src
+	if source == nil {
+		source = src
+	}
+	if a.root != nil {
+		return a.root, source
+	}
+	if source == nil {
+		return nil, nil
+	}
+	parser := sitter.NewParser()
+	parser.SetLanguage(a.Language())
+	a.root = parser.Parse(nil, source).RootNode()
+	return a.root, source
+}
+
+// Lua has no class: a table holding functions plays the role of one, be it a
+// module ("local M = {}" then "function M.new()"), an object prototype
+// ("function Account:deposit(v)") or a table of functions ("{ move =
+// function(self) end }"). The methods are declared outside of the table:
+// they are bound to it by the visitor, as receiver methods.
+
+// classTables returns the declarations of the tables used as classes, keyed
+// by start byte. Only the first top-level declaration of a name is a class:
+// "M = {}" may be assigned again further down.
+func (a *TreeSitterAdapter) classTables() map[uint32]bool {
+	if a.classes != nil {
+		return a.classes
+	}
+	a.classes = map[uint32]bool{}
+	root, _ := a.ensureRoot(nil)
+	if root == nil {
+		return a.classes
+	}
+	declared := map[string]*sitter.Node{}
+	withMethods := map[string]bool{}
+	for i := 0; i < int(root.NamedChildCount()); i++ {
+		st := root.NamedChild(i)
+		if name := a.tableName(st); name != "" {
+			if _, ok := declared[name]; !ok {
+				declared[name] = st
+			}
+		}
+		if receiver := a.ReceiverTypeName(st); receiver != "" {
+			withMethods[receiver] = true
+		}
+	}
+	for name, decl := range declared {
+		value := decl.ChildByFieldName("value")
+		if withMethods[name] || hasFunctionField(value) || a.isClassCall(value) {
+			a.classes[decl.StartByte()] = true
+		}
+	}
+	return a.classes
+}
+
+// tableName returns the name declared by a single "local T = ..." or "T =
+// ...", or an empty string.
+func (a *TreeSitterAdapter) tableName(n *sitter.Node) string {
+	if n.Type() != "variable_declaration" || countField(n, "name") != 1 || countField(n, "value") != 1 {
+		return ""
+	}
+	decl := n.ChildByFieldName("name")
+	if decl == nil || decl.NamedChildCount() != 1 || decl.NamedChild(0).Type() != "identifier" {
+		return ""
+	}
+	if value := n.ChildByFieldName("value"); value == nil || value.Type() == "function" {
+		return ""
+	}
+	return text(a.src, decl.NamedChild(0))
+}
+
+// hasFunctionField reports whether a table constructor holds a function.
+func hasFunctionField(n *sitter.Node) bool {
+	if n == nil || n.Type() != "tableconstructor" {
+		return false
+	}
+	fields := firstChildOfType(n, "fieldlist")
+	if fields == nil {
+		return false
+	}
+	for i := 0; i < int(fields.NamedChildCount()); i++ {
+		if value := fields.NamedChild(i).ChildByFieldName("value"); value != nil && value.Type() == "function" {
+			return true
+		}
+	}
+	return false
+}
+
+// isClassCall reports whether a value builds an object prototype:
+// "setmetatable({}, mt)", "class('Dog', Animal)" (middleclass, 30log) or
+// "Animal:extend()" (classic).
+func (a *TreeSitterAdapter) isClassCall(n *sitter.Node) bool {
+	if n == nil || n.Type() != "function_call" {
+		return false
+	}
+	switch a.calleeName(n) {
+	case "setmetatable", "class", "extend":
+		return true
+	}
+	return false
+}
+
+// calleeName returns the name of the function called by a call: "require"
+// for "require('x')", "extend" for "Animal:extend()".
+func (a *TreeSitterAdapter) calleeName(call *sitter.Node) string {
+	name := ""
+	for i := 0; i < int(call.ChildCount()); i++ {
+		ch := call.Child(i)
+		if ch.Type() == "function_call_paren" || ch.Type() == "function_arguments" || ch.Type() == "string_argument" || ch.Type() == "table_argument" {
+			break
+		}
+		if ch.Type() == "identifier" {
+			name = text(a.src, ch)
+		}
+	}
+	return name
+}
+
+// NodeStart returns the position of the first significant character of a
+// node: the grammar lends the blank lines before a top-level statement to its
+// first token.
+func (a *TreeSitterAdapter) NodeStart(n *sitter.Node) (sitter.Point, uint32) {
+	point, offset := n.StartPoint(), n.StartByte()
+	for a.src != nil && offset < n.EndByte() && int(offset) < len(a.src) {
+		switch a.src[offset] {
+		case '\n':
+			point.Row++
+			point.Column = 0
+		case ' ', '\t', '\r':
+			point.Column++
+		default:
+			return point, offset
+		}
+		offset++
+	}
+	return point, offset
+}
+
+func (a *TreeSitterAdapter) IsModule(n *sitter.Node) bool { return n.Type() == "program" }
+
+func (a *TreeSitterAdapter) IsClass(n *sitter.Node) bool {
+	if n.Type() != "variable_declaration" {
+		return false
+	}
+	if p := n.Parent(); p == nil || p.Type() != "program" {
+		return false
+	}
+	return a.classTables()[n.StartByte()]
+}
+
+// IsFunction reports the named functions: "function f()", "local function
+// f()", "function M.f()", "function M:f()", "M.f = function()" and the
+// functions held by a table ("{ f = function() end }"). Anonymous functions
+// passed as arguments are not named functions: their decisions count for
+// the enclosing function, via the fallback recursion.
+func (a *TreeSitterAdapter) IsFunction(n *sitter.Node) bool {
+	switch n.Type() {
+	case "function_statement":
+		return true
+	case "field":
+		value := n.ChildByFieldName("value")
+		return value != nil && value.Type() == "function" && n.ChildByFieldName("name") != nil
+	case "variable_declaration":
+		if countField(n, "name") != 1 || countField(n, "value") != 1 {
+			return false
+		}
+		value := n.ChildByFieldName("value")
+		return value != nil && value.Type() == "function"
+	}
+	return false
+}
+
+// functionNode returns the node holding the parameters and the body of a
+// function: the function itself, or its value for "f = function() end".
+func functionNode(n *sitter.Node) *sitter.Node {
+	if n.Type() == "function_statement" {
+		return n
+	}
+	if value := n.ChildByFieldName("value"); value != nil && value.Type() == "function" {
+		return value
+	}
+	return nil
+}
+
+// nameSegments returns the identifiers naming a function or a table:
+// ["M", "greet"] for "function M:greet()".
+func (a *TreeSitterAdapter) nameSegments(n *sitter.Node) []string {
+	name := n.ChildByFieldName("name")
+	if name == nil {
+		return nil
+	}
+	if name.Type() == "identifier" {
+		return []string{text(a.src, name)}
+	}
+	var segments []string
+	for i := 0; i < int(name.NamedChildCount()); i++ {
+		if ch := name.NamedChild(i); ch.Type() == "identifier" {
+			segments = append(segments, text(a.src, ch))
+		}
+	}
+	return segments
+}
+
+func (a *TreeSitterAdapter) NodeName(n *sitter.Node) string {
+	if a.src == nil || n == nil {
+		return ""
+	}
+	segments := a.nameSegments(n)
+	if len(segments) == 0 {
+		return ""
+	}
+	return segments[len(segments)-1]
+}
+
+// ReceiverTypeName returns the table a function is declared on: "M" for
+// "function M.new()" and "function M:greet()", "M.sub" for "function
+// M.sub.f()".
+func (a *TreeSitterAdapter) ReceiverTypeName(n *sitter.Node) string {
+	if a.src == nil || n == nil {
+		return ""
+	}
+	if !a.IsFunction(n) || n.Type() == "field" {
+		return ""
+	}
+	segments := a.nameSegments(n)
+	if len(segments) < 2 {
+		return ""
+	}
+	return strings.Join(segments[:len(segments)-1], ".")
+}
+
+// NodeBody returns the table constructor of a class, and the body of a
+// function.
+func (a *TreeSitterAdapter) NodeBody(n *sitter.Node) *sitter.Node {
+	if n == nil {
+		return nil
+	}
+	if a.IsClass(n) {
+		if value := n.ChildByFieldName("value"); value != nil && value.Type() == "tableconstructor" {
+			return value
+		}
+		return nil
+	}
+	if fn := functionNode(n); fn != nil {
+		return firstChildOfType(fn, "function_body")
+	}
+	return nil
+}
+
+func (a *TreeSitterAdapter) NodeParams(n *sitter.Node) *sitter.Node {
+	if fn := functionNode(n); fn != nil {
+		return firstChildOfType(fn, "parameter_list")
+	}
+	return nil
+}
+
+// EachParamIdent yields the name of each parameter; the variadic "..." is one
+// parameter.
+func (a *TreeSitterAdapter) EachParamIdent(params *sitter.Node, yield func(string)) {
+	if params == nil || a.src == nil {
+		return
+	}
+	for i := 0; i < int(params.NamedChildCount()); i++ {
+		switch p := params.NamedChild(i); p.Type() {
+		case "identifier", "ellipsis":
+			yield(text(a.src, p))
+		}
+	}
+}
+
+// ModuleNameFromPath names the module after its file, the way require finds
+// it: "util" for util.lua, and the directory for an init.lua ("require
+// 'http'" loads http/init.lua).
+func (a *TreeSitterAdapter) ModuleNameFromPath(path string) string {
+	base := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	if base == "init" {
+		if dir := filepath.Base(filepath.Dir(path)); dir != "." && dir != string(filepath.Separator) {
+			return dir
+		}
+	}
+	return base
+}
+
+func (a *TreeSitterAdapter) AttachQualified(parentClass string, fn string) string {
+	if parentClass == "" {
+		return fn
+	}
+	return parentClass + "." + fn
+}
+
+// NamespaceSeparator joins a module and its tables with ".", as require does.
+func (a *TreeSitterAdapter) NamespaceSeparator() string { return "." }
+
+// EachChildBody yields the statements of a body. The grammar keeps the
+// branches of an if flat: the "elseif" and "else" keywords are reported by
+// the visitor as branches of the if, not as statements of its body.
+func (a *TreeSitterAdapter) EachChildBody(body *sitter.Node, yield func(*sitter.Node)) {
+	if body == nil {
+		return
+	}
+	if body.Type() == "tableconstructor" {
+		if fields := firstChildOfType(body, "fieldlist"); fields != nil {
+			body = fields
+		}
+	}
+	for i := 0; i < int(body.ChildCount()); i++ {
+		ch := body.Child(i)
+		if body.Type() == "if_statement" && (ch.Type() == "if_elseif" || ch.Type() == "if_else") {
+			continue
+		}
+		yield(ch)
+	}
+}
+
+func (a *TreeSitterAdapter) Decision(n *sitter.Node) (Treesitter.DecisionKind, *sitter.Node) {
+	switch n.Type() {
+	case "if_statement":
+		return Treesitter.DecIf, n
+	case "if_elseif":
+		return Treesitter.DecElif, nil
+	case "if_else":
+		return Treesitter.DecElse, nil
+	case "for_statement", "while_statement", "repeat_statement":
+		return Treesitter.DecLoop, n
+	}
+	// the boolean operators ("a and b or c") are intentionally left out,
+	// consistent with the other engines.
+	return Treesitter.DecNone, nil
+}
+
+// Condition returns the condition of an if, an elseif or a loop: the grammar
+// writes it as the child following the keyword ("if", "elseif", "while",
+// "until").
+func (a *TreeSitterAdapter) Condition(n *sitter.Node) *sitter.Node {
+	keyword := ""
+	switch n.Type() {
+	case "if_statement":
+		keyword = "if_start"
+	case "while_statement":
+		keyword = "while_start"
+	case "repeat_statement":
+		keyword = "repeat_until"
+	case "if_elseif":
+		return n.NextNamedSibling()
+	default:
+		return nil
+	}
+	for i := 0; i+1 < int(n.NamedChildCount()); i++ {
+		if n.NamedChild(i).Type() == keyword {
+			return n.NamedChild(i + 1)
+		}
+	}
+	return nil
+}
+
+// Imports reports the modules loaded by require: "require('resty.http')" is
+// http, in resty.
+func (a *TreeSitterAdapter) Imports(n *sitter.Node) []Treesitter.ImportItem {
+	if n.Type() != "function_call" || a.src == nil || a.calleeName(n) != "require" {
+		return nil
+	}
+	if countField(n, "prefix") != 1 {
+		// "lib.require('x')" is not the global require
+		return nil
+	}
+	args := n.ChildByFieldName("args")
+	if args == nil {
+		return nil
+	}
+	str := args
+	if args.Type() == "function_arguments" {
+		if args.NamedChildCount() != 1 {
+			return nil
+		}
+		str = args.NamedChild(0)
+	}
+	content := str.ChildByFieldName("content")
+	if content == nil {
+		return nil
+	}
+	module := text(a.src, content)
+	if idx := strings.LastIndex(module, "."); idx >= 0 {
+		return []Treesitter.ImportItem{{Module: module[:idx], Name: module[idx+1:]}}
+	}
+	return []Treesitter.ImportItem{{Module: "", Name: module}}
+}
+
+// Modifiers reports the visibility of a function: a "local" function is
+// private to its file. Class tables are public: a module returns them.
+func (a *TreeSitterAdapter) Modifiers(n *sitter.Node) *pb.Modifiers {
+	if !a.IsClass(n) && firstChildOfType(n, "local") != nil {
+		return &pb.Modifiers{Visibility: pb.Visibility_VISIBILITY_PRIVATE}
+	}
+	return &pb.Modifiers{Visibility: pb.Visibility_VISIBILITY_PUBLIC}
+}
+
+// Heritage reports the prototype a class inherits from:
+// "setmetatable({}, {__index = Animal})", "class('Dog', Animal)" or
+// "Animal:extend()".
+func (a *TreeSitterAdapter) Heritage(n *sitter.Node) Treesitter.Heritage {
+	var h Treesitter.Heritage
+	value := n.ChildByFieldName("value")
+	if a.src == nil || value == nil || value.Type() != "function_call" {
+		return h
+	}
+	var parent *sitter.Node
+	args := value.ChildByFieldName("args")
+	switch a.calleeName(value) {
+	case "setmetatable":
+		if args != nil && args.NamedChildCount() == 2 {
+			if mt := args.NamedChild(1); mt.Type() == "tableconstructor" {
+				if fields := firstChildOfType(mt, "fieldlist"); fields != nil {
+					for i := 0; i < int(fields.NamedChildCount()); i++ {
+						f := fields.NamedChild(i)
+						if text(a.src, f.ChildByFieldName("name")) == "__index" {
+							parent = f.ChildByFieldName("value")
+						}
+					}
+				}
+			}
+		}
+	case "class":
+		if args != nil && args.NamedChildCount() == 2 {
+			parent = args.NamedChild(1)
+		}
+	case "extend":
+		parent = value.ChildByFieldName("prefix")
+	}
+	if parent != nil && parent.Type() == "identifier" {
+		name := text(a.src, parent)
+		h.Extends = append(h.Extends, &pb.Name{Short: name, Qualified: name})
+	}
+	return h
+}
+
+// luaStatementTypes lists the node types of a statement.
+var luaStatementTypes = map[string]bool{
+	"variable_declaration": true, "function_call": true, "function_statement": true,
+	"if_statement": true, "for_statement": true, "while_statement": true,
+	"repeat_statement": true, "do_statement": true, "return_statement": true,
+	"module_return_statement": true, "break_statement": true,
+	"goto_statement": true, "label_statement": true,
+}
+
+// luaBlockTypes lists the node types holding statements.
+var luaBlockTypes = map[string]bool{
+	"program": true, "function_body": true, "if_statement": true,
+	"for_statement": true, "while_statement": true, "repeat_statement": true,
+	"do_statement": true,
+}
+
+// IsLogicalNode reports whether a node begins a logical line: a statement
+// written in a block.
+func (a *TreeSitterAdapter) IsLogicalNode(n *sitter.Node) bool {
+	p := n.Parent()
+	return luaStatementTypes[n.Type()] && p != nil && luaBlockTypes[p.Type()]
+}
+
+// CommentMarkers declares Lua comment tokens: "--" and "--[[ ... ]]".
+func (a *TreeSitterAdapter) CommentMarkers() engine.CommentMarkers {
+	return engine.CommentMarkers{DashDash: true}
+}
+
+// DocString returns the LDoc comment ("---") of a function statement, which
+// the grammar holds in the statement itself.
+func (a *TreeSitterAdapter) DocString(n *sitter.Node) *sitter.Node {
+	if n.Type() != "function_statement" {
+		return nil
+	}
+	return n.ChildByFieldName("documentation")
+}
+
+// IsDocComment tells LDoc comments ("---") from plain comments.
+func (a *TreeSitterAdapter) IsDocComment(text string) bool {
+	return strings.HasPrefix(text, "---")
+}
+
+// CountComments counts Lua comment lines in the given range. The grammar
+// leaves the comments opening a function out of its body: they are counted
+// over the whole function.
+func (a *TreeSitterAdapter) CountComments(lines []string, start, end int) int {
+	return int(engine.GetLocPositionFromSourceWithMarkers(lines, start, end, a.CommentMarkers()).CommentLinesOfCode)
+}
+
+// luaOperatorTokens lists the anonymous token types counted as Halstead
+// operators: arithmetic, comparison, boolean, length, concatenation,
+// assignment, the argument separator, the subscript and the jumps.
+var luaOperatorTokens = map[string]bool{
+	"+": true, "-": true, "*": true, "/": true, "//": true, "%": true, "^": true,
+	"==": true, "~=": true, "<": true, ">": true, "<=": true, ">=": true,
+	"and": true, "or": true, "not": true, "#": true, "..": true,
+	"&": true, "|": true, "~": true, "<<": true, ">>": true,
+	"=": true, ",": true, "[": true, ".": true, "return": true, "break": true,
+}
+
+// luaKeywordTypes maps the keywords the grammar gives a named node to the
+// operator they stand for.
+var luaKeywordTypes = map[string]string{
+	"if_start": "if", "if_elseif": "elseif", "if_else": "else",
+	"for_start": "for", "for_in": "in", "while_start": "while",
+	"repeat_start": "repeat", "repeat_until": "until",
+	"table_dot": ".", "table_colon": ":", "self_call_colon": ":",
+}
+
+// ExtractOperatorsOperands collects Halstead operators and operands from the
+// function spanning the given 1-based inclusive line range. The grammar keeps
+// member accesses flat ("self", ".", "name" are siblings): an access is read
+// as one operand, and an access through the object ("self.name", or
+// "M.name" in a function of M) is normalized as "this.name", the form the
+// cohesion metrics (LCOM4) expect.
+func (a *TreeSitterAdapter) ExtractOperatorsOperands(src []byte, startLine, endLine int) ([]string, []string) {
+	root, source := a.ensureRoot(src)
+	if root == nil {
+		return nil, nil
+	}
+	fn := a.functionAt(root, startLine, endLine)
+	if fn == nil {
+		return nil, nil
+	}
+	owner := a.ownerOf(fn)
+	var ops, operands []string
+	var walk func(n *sitter.Node)
+	walk = func(n *sitter.Node) {
+		t := n.Type()
+		switch {
+		case t == "comment" || t == "string":
+			return
+		case t == "function_call":
+			ops = append(ops, Treesitter.CallOperator)
+		case luaKeywordTypes[t] != "":
+			ops = append(ops, luaKeywordTypes[t])
+			return
+		case !n.IsNamed() && n.ChildCount() == 0:
+			if luaOperatorTokens[t] {
+				ops = append(ops, t)
+			}
+			return
+		}
+		for i := 0; i < int(n.ChildCount()); i++ {
+			ch := n.Child(i)
+			if ch.Type() != "identifier" {
+				walk(ch)
+				continue
+			}
+			segments, seps, next := chainAt(n, i, source)
+			i = next - 1
+			ops = append(ops, seps...)
+			if len(segments) > 1 && (segments[0] == "self" || segments[0] == owner) {
+				if isCalled(n, next) {
+					// a method call, reported by ExtractMethodCalls
+					continue
+				}
+				operands = append(operands, "this."+segments[1])
+				continue
+			}
+			if len(segments) == 1 && segments[0] == "self" {
+				// the object alone names no attribute
+				continue
+			}
+			operands = append(operands, strings.Join(segments, "."))
+		}
+	}
+	for _, part := range []*sitter.Node{a.NodeParams(fn), a.NodeBody(fn)} {
+		if part != nil {
+			walk(part)
+		}
+	}
+	return ops, operands
+}
+
+// chainAt reads the access chain starting at the i-th child of n ("a", ".",
+// "b", ":", "c"), and returns its names, its separators and the index of the
+// first child after it.
+func chainAt(n *sitter.Node, i int, src []byte) (segments []string, seps []string, next int) {
+	segments = []string{text(src, n.Child(i))}
+	next = i + 1
+	for next+1 < int(n.ChildCount()) {
+		sep, name := n.Child(next), n.Child(next+1)
+		if (sep.Type() != "." && sep.Type() != "self_call_colon") || name.Type() != "identifier" {
+			break
+		}
+		op := sep.Type()
+		if kw, ok := luaKeywordTypes[op]; ok {
+			op = kw
+		}
+		seps = append(seps, op)
+		segments = append(segments, text(src, name))
+		next += 2
+	}
+	return segments, seps, next
+}
+
+// isCalled reports whether the i-th child of a call opens its arguments: the
+// chain before it is the called function.
+func isCalled(n *sitter.Node, i int) bool {
+	if n.Type() != "function_call" || i >= int(n.ChildCount()) {
+		return false
+	}
+	switch n.Child(i).Type() {
+	case "function_call_paren", "function_arguments", "string_argument", "table_argument":
+		return true
+	}
+	return false
+}
+
+// ownerOf returns the table a function belongs to: the table it is declared
+// on, or the class holding it.
+func (a *TreeSitterAdapter) ownerOf(fn *sitter.Node) string {
+	if receiver := a.ReceiverTypeName(fn); receiver != "" {
+		return receiver
+	}
+	for p := fn.Parent(); p != nil; p = p.Parent() {
+		if a.IsClass(p) {
+			return a.NodeName(p)
+		}
+	}
+	return ""
+}
+
+// functionAt returns the function spanning exactly the given lines, or nil.
+func (a *TreeSitterAdapter) functionAt(root *sitter.Node, startLine, endLine int) *sitter.Node {
+	var found *sitter.Node
+	var walk func(n *sitter.Node)
+	walk = func(n *sitter.Node) {
+		if found != nil || int(n.EndPoint().Row)+1 < startLine || int(n.StartPoint().Row)+1 > endLine {
+			return
+		}
+		if start, _ := a.NodeStart(n); int(start.Row)+1 == startLine && int(n.EndPoint().Row)+1 == endLine && a.IsFunction(n) {
+			found = n
+			return
+		}
+		for i := 0; i < int(n.NamedChildCount()); i++ {
+			walk(n.NamedChild(i))
+		}
+	}
+	walk(root)
+	return found
+}
+
+// ExtractMethodCalls extracts the calls made on the object: "self:save()",
+// "self.save()", and "M.helper()" in a function of M.
+func (a *TreeSitterAdapter) ExtractMethodCalls(src []byte, startLine, endLine int) []string {
+	root, source := a.ensureRoot(src)
+	if root == nil || startLine <= 0 || endLine < startLine {
+		return nil
+	}
+	fn := a.functionAt(root, startLine, endLine)
+	if fn == nil {
+		return nil
+	}
+	owner := a.ownerOf(fn)
+	var calls []string
+	var walk func(n *sitter.Node)
+	walk = func(n *sitter.Node) {
+		if n.Type() == "function_call" && n.ChildCount() > 0 && n.Child(0).Type() == "identifier" {
+			segments, _, next := chainAt(n, 0, source)
+			if len(segments) == 2 && (segments[0] == "self" || segments[0] == owner) && isCalled(n, next) {
+				calls = append(calls, "this."+segments[1])
+			}
+		}
+		for i := 0; i < int(n.ChildCount()); i++ {
+			walk(n.Child(i))
+		}
+	}
+	if body := a.NodeBody(fn); body != nil {
+		walk(body)
+	}
+	return calls
+}
+
+// ClassDirectOperands lists the fields of a class: the non-function fields
+// of its constructor ("{ speed = 10 }") and the values assigned on the table
+// at the top level ("M.version = '1.0'"). Metamethods ("__index") are
+// plumbing, not data.
+func (a *TreeSitterAdapter) ClassDirectOperands(n *sitter.Node) []string {
+	if a.src == nil {
+		return nil
+	}
+	var fields []string
+	add := func(name string) {
+		if name != "" && !strings.HasPrefix(name, "__") {
+			fields = append(fields, name)
+		}
+	}
+	if body := a.NodeBody(n); body != nil {
+		a.EachChildBody(body, func(f *sitter.Node) {
+			if f.Type() == "field" && !a.IsFunction(f) {
+				add(text(a.src, f.ChildByFieldName("name")))
+			}
+		})
+	}
+	class := a.NodeName(n)
+	root := n.Parent()
+	for i := 0; root != nil && i < int(root.NamedChildCount()); i++ {
+		st := root.NamedChild(i)
+		if st.Type() != "variable_declaration" || a.IsFunction(st) || a.IsClass(st) {
+			continue
+		}
+		if segments := a.nameSegments(st); len(segments) == 2 && segments[0] == class {
+			add(segments[1])
+		}
+	}
+	return fields
+}
+
+// --- helpers ---
+
+// text returns the source of a node. The grammar lends the blank lines
+// before a top-level statement to its first token: they are trimmed.
+func text(src []byte, n *sitter.Node) string {
+	if n == nil || src == nil {
+		return ""
+	}
+	return strings.TrimSpace(string(src[n.StartByte():n.EndByte()]))
+}
+
+func firstChildOfType(n *sitter.Node, t string) *sitter.Node {
+	if n == nil {
+		return nil
+	}
+	for i := 0; i < int(n.ChildCount()); i++ {
+		if c := n.Child(i); c.Type() == t {
+			return c
+		}
+	}
+	return nil
+}
+
+// countField returns the number of children of n under the given field:
+// "local a, b = 1, 2" declares two names.
+func countField(n *sitter.Node, field string) int {
+	cnt := 0
+	for i := 0; i < int(n.ChildCount()); i++ {
+		if n.FieldNameForChild(i) == field {
+			cnt++
+		}
+	}
+	return cnt
+}
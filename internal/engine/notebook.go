@@ -0,0 +1,95 @@
+package engine
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	pb "github.com/ast-metrics/ast-metrics/pb"
+)
+
+// Jupyter notebooks (.ipynb) are JSON documents. Their code cells are joined
+// into one Python source, analyzed as a regular file; the cells of the file
+// record which lines belong to which cell.
+
+// notebookMagic matches the lines of a code cell that are not Python: line
+// magics (%time), shell escapes (!pip install), help requests (?obj, obj?)
+// and captured shell commands (files = !ls).
+var notebookMagic = regexp.MustCompile(`^\s*([%!?]|\w+\s*=\s*[!%]|[\w.]+\?\??\s*$)`)
+
+type notebook struct {
+	Cells []struct {
+		CellType string          `json:"cell_type"`
+		Source   json.RawMessage `json:"source"`
+	} `json:"cells"`
+}
+
+// IsNotebookFile reports whether the file is a Jupyter notebook.
+func IsNotebookFile(path string) bool {
+	return strings.EqualFold(filepath.Ext(path), ".ipynb")
+}
+
+// ExtractNotebookCode returns the code of a notebook, and the lines each code
+// cell covers in it. Magics and shell escapes are blanked out, and a cell
+// magic (%%bash, %%html...) blanks its whole cell: line numbers are kept.
+// Cells are separated by an empty line.
+func ExtractNotebookCode(src []byte) ([]byte, []*pb.NotebookCell, error) {
+	var nb notebook
+	if err := json.Unmarshal(src, &nb); err != nil {
+		return nil, nil, err
+	}
+
+	var code strings.Builder
+	var cells []*pb.NotebookCell
+	line := 1
+	for i, cell := range nb.Cells {
+		if cell.CellType != "code" {
+			continue
+		}
+		lines := strings.Split(strings.TrimRight(notebookSource(cell.Source), "\n"), "\n")
+		cellMagic := strings.HasPrefix(strings.TrimSpace(lines[0]), "%%")
+		for _, l := range lines {
+			if cellMagic || notebookMagic.MatchString(l) {
+				l = ""
+			}
+			code.WriteString(l + "\n")
+		}
+		cells = append(cells, &pb.NotebookCell{
+			Index:     int32(i + 1),
+			StartLine: int32(line),
+			EndLine:   int32(line + len(lines) - 1),
+		})
+		code.WriteString("\n")
+		line += len(lines) + 1
+	}
+	return []byte(code.String()), cells, nil
+}
+
+// notebookSource returns the source of a cell, stored either as a string or
+// as a list of lines.
+func notebookSource(raw json.RawMessage) string {
+	var lines []string
+	if err := json.Unmarshal(raw, &lines); err == nil {
+		return strings.Join(lines, "")
+	}
+	var source string
+	_ = json.Unmarshal(raw, &source)
+	return source
+}
+
+// NotebookPosition maps a line of an analyzed notebook to its cell: the
+// 1-based position of the cell in the notebook, and the line in the cell.
+// It returns 0, line when the file is not a notebook or the line belongs to
+// no cell.
+func NotebookPosition(file *pb.File, line int) (int, int) {
+	if file == nil {
+		return 0, line
+	}
+	for _, cell := range file.Cells {
+		if line >= int(cell.StartLine) && line <= int(cell.EndLine) {
+			return int(cell.Index), line - int(cell.StartLine) + 1
+		}
+	}
+	return 0, line
+}
@@ -0,0 +1,47 @@
+package engine
+
+import (
+	"strings"
+	"testing"
+
+	pb "github.com/ast-metrics/ast-metrics/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractNotebookCode(t *testing.T) {
+	src := `{"cells": [
+  {"cell_type": "code", "source": ["%load_ext autoreload\n", "import os\n", "files = !ls\n", "os.path?"]},
+  {"cell_type": "markdown", "source": "text"},
+  {"cell_type": "code", "source": "%%timeit\nsum(range(10))"},
+  {"cell_type": "code", "source": "x = 1 % 2\nprint(x)  # why?\n"}
+]}`
+	code, cells, err := ExtractNotebookCode([]byte(src))
+	assert.Nil(t, err)
+
+	lines := strings.Split(string(code), "\n")
+	assert.Equal(t, []string{"", "import os", "", "", "", "", "", "", "x = 1 % 2", "print(x)  # why?", "", ""}, lines)
+
+	assert.Equal(t, 3, len(cells))
+	assert.Equal(t, &pb.NotebookCell{Index: 1, StartLine: 1, EndLine: 4}, cells[0])
+	assert.Equal(t, &pb.NotebookCell{Index: 3, StartLine: 6, EndLine: 7}, cells[1])
+	assert.Equal(t, &pb.NotebookCell{Index: 4, StartLine: 9, EndLine: 10}, cells[2])
+}
+
+func TestNotebookPosition(t *testing.T) {
+	file := &pb.File{Cells: []*pb.NotebookCell{
+		{Index: 2, StartLine: 1, EndLine: 3},
+		{Index: 5, StartLine: 5, EndLine: 9},
+	}}
+	cell, line := NotebookPosition(file, 7)
+	assert.Equal(t, 5, cell)
+	assert.Equal(t, 3, line)
+
+	// the separator between two cells belongs to no cell
+	cell, line = NotebookPosition(file, 4)
+	assert.Equal(t, 0, cell)
+	assert.Equal(t, 4, line)
+
+	cell, line = NotebookPosition(&pb.File{}, 12)
+	assert.Equal(t, 0, cell)
+	assert.Equal(t, 12, line)
+}
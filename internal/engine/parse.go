@@ -17,7 +17,7 @@ func ParseFiles(config *configuration.Configuration, runners []Engine) ([]*pb.Fi
 	if config.FileDiscovery == nil {
 		discovery := &filefinder.FileDiscovery{}
 		finder := filefinder.Finder{Configuration: *config}
-		allExts := []string{".go", ".php", ".py", ".rs", ".ts", ".tsx", ".java", ".cs"}
+		allExts := []string{".go", ".php", ".py", ".ipynb", ".rs", ".ts", ".tsx", ".java", ".cs", ".js", ".jsx", ".mjs", ".cjs", ".kt", ".kts", ".rb", ".rake", ".c", ".h", ".cpp", ".cc", ".cxx", ".hpp", ".hh", ".hxx", ".scala", ".sc", ".groovy", ".gvy", ".gradle", ".swift", ".ex", ".exs", ".lua", ".vue", ".svelte"}
 		if config.Extensions != nil {
 			for _, exts := range config.Extensions {
 				allExts = append(allExts, exts...)
@@ -78,6 +78,7 @@ func (r PhpRunner) Parse(path string) (*pb.File, error) {
 	adapter.SetRootNode(root)
 
 	v := Treesitter.NewVisitor(adapter, path, src)
+	v.SetQueries(Treesitter.QueriesFor(r.Configuration, "php", path))
 	v.Visit(root)
 	file := v.Result()
 	file.ProgrammingLanguage = "PHP"
@@ -98,7 +99,9 @@ func (r PhpRunner) Parse(path string) (*pb.File, error) {
 		}
 	}
 	if root.HasError() {
-		file.Errors = append(file.Errors, "Parse error")
+		for _, e := range file.ParseErrors {
+			file.Errors = append(file.Errors, Treesitter.ParseErrorText(e))
+		}
 		// Special case: invalid UTF-8 identifiers should not invalidate the file;
 		// if we still managed to extract classes, clear the error list.
 		classes := engine.GetClassesInFile(file)
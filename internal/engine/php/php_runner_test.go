@@ -7,6 +7,7 @@ import (
 
 	"github.com/ast-metrics/ast-metrics/internal/analyzer"
 	"github.com/ast-metrics/ast-metrics/internal/engine"
+	pb "github.com/ast-metrics/ast-metrics/pb"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -995,3 +996,84 @@ class Calculator {
 	assert.Nil(t, err, "Expected no error")
 	assert.False(t, result.IsTest, "Expected file NOT to be detected as test")
 }
+
+func TestPhpHeritage(t *testing.T) {
+	phpSource := `<?php
+namespace App\Mail;
+
+use Lib\Transport\Base as Transport;
+
+class Mailer extends Transport implements \Countable, Sender {
+	use Loggable;
+}
+`
+	result, err := engine.CreateTestFileWithCode(&PhpRunner{}, phpSource)
+	assert.Nil(t, err, "Expected no error, got %s", err)
+
+	classes := engine.GetClassesInFile(result)
+	assert.Equal(t, 1, len(classes), "Incorrect number of classes")
+	class1 := classes[0]
+
+	// names are resolved with the use statements and the namespace
+	assert.Equal(t, 1, len(class1.Extends))
+	assert.Equal(t, "Lib\\Transport\\Base", class1.Extends[0].Qualified)
+	assert.Equal(t, "Base", class1.Extends[0].Short)
+	assert.Equal(t, 2, len(class1.Implements))
+	assert.Equal(t, "Countable", class1.Implements[0].Qualified)
+	assert.Equal(t, "App\\Mail\\Sender", class1.Implements[1].Qualified)
+	assert.Equal(t, 1, len(class1.Uses))
+	assert.Equal(t, "Loggable", class1.Uses[0].Short)
+}
+
+func TestPhpModifiers(t *testing.T) {
+	phpSource := `<?php
+abstract class Repository {
+	function find() {}
+	private static function key() {}
+	abstract protected function save();
+	final public function reset() {}
+}
+`
+	result, err := engine.CreateTestFileWithCode(&PhpRunner{}, phpSource)
+	assert.Nil(t, err, "Expected no error, got %s", err)
+
+	class := engine.GetClassesInFile(result)[0]
+	assert.Equal(t, pb.Visibility_VISIBILITY_PUBLIC, class.Modifiers.Visibility)
+	assert.True(t, class.Modifiers.IsAbstract)
+
+	methods := class.Stmts.StmtFunction
+	assert.Equal(t, 4, len(methods))
+	assert.Equal(t, pb.Visibility_VISIBILITY_PUBLIC, methods[0].Modifiers.Visibility, "no keyword means public")
+	assert.Equal(t, pb.Visibility_VISIBILITY_PRIVATE, methods[1].Modifiers.Visibility)
+	assert.True(t, methods[1].Modifiers.IsStatic)
+	assert.Equal(t, pb.Visibility_VISIBILITY_PROTECTED, methods[2].Modifiers.Visibility)
+	assert.True(t, methods[2].Modifiers.IsAbstract)
+	assert.True(t, methods[3].Modifiers.IsFinal)
+}
+
+func TestPhpAnnotations(t *testing.T) {
+	phpSource := `<?php
+namespace App\Entity;
+
+use Doctrine\ORM\Mapping as ORM;
+
+#[ORM\Entity, Audited]
+class User {
+	#[Route('/users', methods: ['GET'])]
+	public function list() {}
+}
+`
+	result, err := engine.CreateTestFileWithCode(&PhpRunner{}, phpSource)
+	assert.Nil(t, err, "Expected no error, got %s", err)
+
+	class := engine.GetClassesInFile(result)[0]
+	assert.Equal(t, 2, len(class.Annotations))
+	assert.Equal(t, "Entity", class.Annotations[0].Name.Short)
+	assert.Equal(t, "Doctrine\\ORM\\Mapping\\Entity", class.Annotations[0].Name.Qualified, "an attribute is resolved like a class name")
+	assert.Equal(t, "App\\Entity\\Audited", class.Annotations[1].Name.Qualified)
+
+	method := class.Stmts.StmtFunction[0]
+	assert.Equal(t, 1, len(method.Annotations))
+	assert.Equal(t, "Route", method.Annotations[0].Name.Short)
+	assert.Equal(t, []string{"'/users'", "methods: ['GET']"}, method.Annotations[0].Arguments)
+}
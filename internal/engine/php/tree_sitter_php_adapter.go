@@ -6,6 +6,7 @@ import (
 	"unicode/utf8"
 
 	Treesitter "github.com/ast-metrics/ast-metrics/internal/engine/treesitter"
+	pb "github.com/ast-metrics/ast-metrics/pb"
 	sitter "github.com/smacker/go-tree-sitter"
 	tsPhp "github.com/smacker/go-tree-sitter/php"
 )
@@ -368,29 +369,7 @@ func (a *TreeSitterAdapter) computeExternalDependencies() {
 		}
 	}
 
-	// helper to resolve a class name considering alias, FQN and local namespace
-	resolve := func(name string) string {
-		if name == "" {
-			return name
-		}
-		// drop leading ? nullable
-		name = strings.TrimPrefix(name, "?")
-		if strings.HasPrefix(name, "\\") {
-			return strings.TrimPrefix(name, "\\")
-		}
-		// some global classes in PHP's root namespace should not be prefixed
-		switch name {
-		case "stdClass", "InvalidArgumentException":
-			return name
-		}
-		if full, ok := a.aliases[name]; ok {
-			return full
-		}
-		if a.ns != "" {
-			return a.ns + "\\" + name
-		}
-		return name
-	}
+	resolve := a.resolveClassName
 	add := func(class string) {
 		if class == "" {
 			return
@@ -500,6 +479,119 @@ func (a *TreeSitterAdapter) computeExternalDependencies() {
 	// keep duplicates to align with expected metrics (counts each usage)
 }
 
+// resolveClassName resolves a class name considering the use aliases, the
+// fully qualified names and the namespace of the file. It needs the aliases
+// collected by computeExternalDependencies.
+func (a *TreeSitterAdapter) resolveClassName(name string) string {
+	if name == "" {
+		return name
+	}
+	// drop leading ? nullable
+	name = strings.TrimPrefix(name, "?")
+	if strings.HasPrefix(name, "\\") {
+		return strings.TrimPrefix(name, "\\")
+	}
+	// some global classes in PHP's root namespace should not be prefixed
+	switch name {
+	case "stdClass", "InvalidArgumentException":
+		return name
+	}
+	if full, ok := a.aliases[name]; ok {
+		return full
+	}
+	// a relative name starts with an imported namespace: ORM\Entity
+	if i := strings.Index(name, "\\"); i > 0 {
+		if full, ok := a.aliases[name[:i]]; ok {
+			return full + name[i:]
+		}
+	}
+	if a.ns != "" {
+		return a.ns + "\\" + name
+	}
+	return name
+}
+
+// ---- Heritage ----
+// Heritage reports the parent class and the interfaces of a class, and the
+// traits it uses. Names are resolved like the other dependencies of the file.
+func (a *TreeSitterAdapter) Heritage(n *sitter.Node) Treesitter.Heritage {
+	var h Treesitter.Heritage
+	if a.src == nil || n == nil {
+		return h
+	}
+	if !a.computed {
+		a.computeExternalDependencies()
+	}
+	for i := 0; i < int(n.NamedChildCount()); i++ {
+		ch := n.NamedChild(i)
+		switch ch.Type() {
+		case "base_clause":
+			h.Extends = append(h.Extends, a.heritageNames(ch)...)
+		case "class_interface_clause":
+			h.Implements = append(h.Implements, a.heritageNames(ch)...)
+		case "declaration_list":
+			for j := 0; j < int(ch.NamedChildCount()); j++ {
+				if use := ch.NamedChild(j); use.Type() == "use_declaration" {
+					h.Uses = append(h.Uses, a.heritageNames(use)...)
+				}
+			}
+		}
+	}
+	return h
+}
+
+// phpModifierTypes lists the nodes holding the modifier keywords.
+var phpModifierTypes = map[string]bool{
+	"visibility_modifier": true, "static_modifier": true, "final_modifier": true,
+	"abstract_modifier": true, "readonly_modifier": true,
+}
+
+// Modifiers reports the modifiers of a class or a function. A method without
+// visibility keyword is public.
+func (a *TreeSitterAdapter) Modifiers(n *sitter.Node) *pb.Modifiers {
+	return Treesitter.KeywordModifiers(Treesitter.ModifierKeywords(n, phpModifierTypes), pb.Visibility_VISIBILITY_PUBLIC)
+}
+
+// Annotations reports the attributes of a class or a method
+// (#[ORM\Entity], #[Route('/users', methods: ['GET'])]). Their names are
+// resolved like class names: attributes are classes.
+func (a *TreeSitterAdapter) Annotations(n *sitter.Node) []*pb.Annotation {
+	list := n.ChildByFieldName("attributes")
+	if a.src == nil || list == nil {
+		return nil
+	}
+	var annotations []*pb.Annotation
+	for i := 0; i < int(list.NamedChildCount()); i++ {
+		group := list.NamedChild(i)
+		for j := 0; j < int(group.NamedChildCount()); j++ {
+			attribute := group.NamedChild(j)
+			if attribute.Type() != "attribute" || attribute.NamedChildCount() == 0 {
+				continue
+			}
+			name := a.resolveClassName(a.text(attribute.NamedChild(0)))
+			annotations = append(annotations, Treesitter.NewAnnotation(name, "\\", Treesitter.AnnotationArguments(attribute.ChildByFieldName("parameters"), a.src)))
+		}
+	}
+	return annotations
+}
+
+func (a *TreeSitterAdapter) heritageNames(clause *sitter.Node) []*pb.Name {
+	var names []*pb.Name
+	for i := 0; i < int(clause.NamedChildCount()); i++ {
+		ch := clause.NamedChild(i)
+		if ch.Type() != "name" && ch.Type() != "qualified_name" {
+			continue
+		}
+		qualified := a.resolveClassName(a.text(ch))
+		short := qualified
+		if idx := strings.LastIndex(short, "\\"); idx >= 0 {
+			short = short[idx+1:]
+		}
+		names = append(names, &pb.Name{Short: short, Qualified: qualified})
+	}
+	return names
+}
+
 // ---- Class operands (properties) ----
 // ClassDirectOperands returns the direct attributes (properties) declared in the given class node.
 // It scans only the class body and collects variable names from property declarations.
@@ -896,6 +988,11 @@ func (a *TreeSitterAdapter) CountComments(lines []string, start, end int) int {
 	return cnt
 }
 
+// IsDocComment tells PHPDoc ("/**") from a plain comment.
+func (a *TreeSitterAdapter) IsDocComment(text string) bool {
+	return strings.HasPrefix(text, "/**")
+}
+
 func stripStrings(s string) string {
 	out := make([]rune, 0, len(s))
 	inSingle := false
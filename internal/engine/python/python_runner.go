@@ -69,6 +69,14 @@ func (r PythonRunner) Parse(path string) (*pb.File, error) {
 		return &pb.File{Path: path, ProgrammingLanguage: "Python"}, err
 	}
 
+	var cells []*pb.NotebookCell
+	if engine.IsNotebookFile(path) {
+		src, cells, err = engine.ExtractNotebookCode(src)
+		if err != nil {
+			return &pb.File{Path: path, ProgrammingLanguage: "Python"}, err
+		}
+	}
+
 	parser := sitter.NewParser()
 	adapter := NewTreeSitterAdapter(src)
 	parser.SetLanguage(adapter.Language())
@@ -78,9 +86,11 @@ func (r PythonRunner) Parse(path string) (*pb.File, error) {
 	adapter.SetRootNode(root)
 
 	v := Treesitter.NewVisitor(adapter, path, src)
+	v.SetQueries(Treesitter.QueriesFor(r.Configuration, "python", path))
 	v.Visit(root)
 	file := v.Result()
 	file.ProgrammingLanguage = "Python"
+	file.Cells = cells
 
 	// Detect if file is a test file
 	file.IsTest = r.isTestFile(path, file)
@@ -2,6 +2,7 @@ package python
 
 import (
 	"os"
+	"strings"
 	"testing"
 
 	enginePkg "github.com/ast-metrics/ast-metrics/internal/engine"
@@ -291,3 +292,187 @@ func TestPythonFloorDivisionIsNotAComment(t *testing.T) {
 		t.Errorf("expected 5 logical lines, got %d", fn.LinesOfCode.LogicalLinesOfCode)
 	}
 }
+
+const sampleNotebook = `{
+ "cells": [
+  {"cell_type": "markdown", "metadata": {}, "source": ["# Analysis\n"]},
+  {"cell_type": "code", "metadata": {}, "outputs": [], "source": [
+   "%matplotlib inline\n",
+   "!pip install pandas\n",
+   "import pandas as pd\n",
+   "from sklearn import metrics"
+  ]},
+  {"cell_type": "code", "metadata": {}, "outputs": [], "source": "%%bash\nls -la"},
+  {"cell_type": "code", "metadata": {}, "outputs": [], "source": [
+   "def score(x):\n",
+   "    if x > 0:\n",
+   "        return 1\n",
+   "    return 0\n"
+  ]}
+ ],
+ "metadata": {"kernelspec": {"language": "python", "name": "python3"}},
+ "nbformat": 4,
+ "nbformat_minor": 5
+}`
+
+func TestPythonRunner_Parse_Notebook(t *testing.T) {
+	tmpFile := t.TempDir() + "/analysis.ipynb"
+	if err := os.WriteFile(tmpFile, []byte(sampleNotebook), 0644); err != nil {
+		t.Fatalf("failed to create file: %v", err)
+	}
+	file, err := (&PythonRunner{}).Parse(tmpFile)
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	if len(file.Cells) != 3 {
+		t.Fatalf("expected 3 code cells, got %d", len(file.Cells))
+	}
+
+	deps := map[string]bool{}
+	for _, d := range enginePkg.GetDependenciesInFile(file) {
+		deps[d.Namespace] = true
+	}
+	if !deps["pandas"] || !deps["sklearn"] {
+		t.Fatalf("expected the imports of the notebook, got %v", deps)
+	}
+
+	functions := enginePkg.GetFunctionsInFile(file)
+	if len(functions) != 1 || functions[0].Name.Short != "score" {
+		t.Fatalf("expected the function score")
+	}
+	score := functions[0]
+	if len(score.Stmts.StmtDecisionIf) != 1 {
+		t.Fatalf("expected 1 if in score")
+	}
+	cell, line := enginePkg.NotebookPosition(file, int(score.Location.StartLine))
+	if cell != 4 || line != 1 {
+		t.Fatalf("expected score in cell 4, line 1, got cell %d, line %d", cell, line)
+	}
+}
+
+func TestPythonRunner_Parse_InvalidNotebook(t *testing.T) {
+	tmpFile := t.TempDir() + "/broken.ipynb"
+	if err := os.WriteFile(tmpFile, []byte(`{"cells": [`), 0644); err != nil {
+		t.Fatalf("failed to create file: %v", err)
+	}
+	_, err := (&PythonRunner{}).Parse(tmpFile)
+	if err == nil {
+		t.Fatalf("expected an error for an invalid notebook")
+	}
+}
+
+func TestPythonRunner_Heritage(t *testing.T) {
+	src := `class Base(object):
+    pass
+
+class Article(models.Model, Generic[T], Base, metaclass=Meta):
+    pass
+`
+	file, err := enginePkg.CreateTestFileWithCode(&PythonRunner{}, src)
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	classes := enginePkg.GetClassesInFile(file)
+	if len(classes) != 2 {
+		t.Fatalf("expected 2 classes, got %d", len(classes))
+	}
+	if len(classes[0].Extends) != 0 {
+		t.Errorf("expected object to be ignored, got %v", classes[0].Extends)
+	}
+	var names []string
+	for _, parent := range classes[1].Extends {
+		names = append(names, parent.Qualified)
+	}
+	if strings.Join(names, ",") != "models.Model,Generic,Base" {
+		t.Errorf("unexpected parents: %v", names)
+	}
+	if classes[1].Extends[0].Short != "Model" {
+		t.Errorf("expected short name Model, got %s", classes[1].Extends[0].Short)
+	}
+}
+
+func TestPythonRunner_Modifiers(t *testing.T) {
+	src := `class Repository:
+    def __init__(self):
+        pass
+
+    def _load(self):
+        pass
+
+    def __key(self):
+        pass
+
+    @staticmethod
+    @abc.abstractmethod
+    def create():
+        pass
+
+def _helper():
+    pass
+`
+	file, err := enginePkg.CreateTestFileWithCode(&PythonRunner{}, src)
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	class := enginePkg.GetClassesInFile(file)[0]
+	if !class.Modifiers.GetIsAbstract() {
+		t.Errorf("expected a class with an abstract method to be abstract")
+	}
+	expected := map[string]pb.Visibility{
+		"__init__": pb.Visibility_VISIBILITY_PUBLIC,
+		"_load":    pb.Visibility_VISIBILITY_PROTECTED,
+		"__key":    pb.Visibility_VISIBILITY_PRIVATE,
+		"create":   pb.Visibility_VISIBILITY_PUBLIC,
+		"_helper":  pb.Visibility_VISIBILITY_PRIVATE,
+	}
+	for _, fn := range enginePkg.GetFunctionsInFile(file) {
+		if got := fn.Modifiers.GetVisibility(); got != expected[fn.Name.Short] {
+			t.Errorf("expected %s to be %v, got %v", fn.Name.Short, expected[fn.Name.Short], got)
+		}
+		if fn.Name.Short == "create" && (!fn.Modifiers.GetIsStatic() || !fn.Modifiers.GetIsAbstract()) {
+			t.Errorf("expected create to be static and abstract, got %v", fn.Modifiers)
+		}
+	}
+}
+
+func TestPythonRunner_Annotations(t *testing.T) {
+	src := `@dataclass(frozen=True)
+class User:
+    @app.get("/users", response_model=List[User])
+    @property
+    def users(self):
+        pass
+
+def helper():
+    pass
+`
+	file, err := enginePkg.CreateTestFileWithCode(&PythonRunner{}, src)
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	class := enginePkg.GetClassesInFile(file)[0]
+	if len(class.Annotations) != 1 || class.Annotations[0].Name.Short != "dataclass" {
+		t.Fatalf("expected the class to be decorated with dataclass, got %v", class.Annotations)
+	}
+	if args := class.Annotations[0].Arguments; len(args) != 1 || args[0] != "frozen=True" {
+		t.Errorf("expected the argument frozen=True, got %v", args)
+	}
+	for _, fn := range enginePkg.GetFunctionsInFile(file) {
+		switch fn.Name.Short {
+		case "users":
+			if len(fn.Annotations) != 2 {
+				t.Fatalf("expected 2 decorators on users, got %d", len(fn.Annotations))
+			}
+			if name := fn.Annotations[0].Name; name.Short != "get" || name.Qualified != "app.get" {
+				t.Errorf("expected the decorator app.get, got %v", name)
+			}
+			if args := fn.Annotations[0].Arguments; len(args) != 2 || args[1] != "response_model=List[User]" {
+				t.Errorf("unexpected arguments %v", args)
+			}
+		case "helper":
+			if len(fn.Annotations) != 0 {
+				t.Errorf("expected no decorator on helper, got %v", fn.Annotations)
+			}
+		}
+	}
+}
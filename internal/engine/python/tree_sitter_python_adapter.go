@@ -6,6 +6,7 @@ import (
 
 	"github.com/ast-metrics/ast-metrics/internal/engine"
 	Treesitter "github.com/ast-metrics/ast-metrics/internal/engine/treesitter"
+	pb "github.com/ast-metrics/ast-metrics/pb"
 	sitter "github.com/smacker/go-tree-sitter"
 	tsPython "github.com/smacker/go-tree-sitter/python"
 )
@@ -222,6 +223,135 @@ func (a *TreeSitterAdapter) Imports(n *sitter.Node) []Treesitter.ImportItem {
 	}
 }
 
+// Heritage reports the base classes of a class. Python has no interfaces: every
+// base is a parent. The implicit "object" base is left out, so that "class
+// A(object)" and "class A" have the same depth.
+func (a *TreeSitterAdapter) Heritage(n *sitter.Node) Treesitter.Heritage {
+	var h Treesitter.Heritage
+	if a.src == nil || n == nil {
+		return h
+	}
+	bases := n.ChildByFieldName("superclasses")
+	if bases == nil {
+		return h
+	}
+	for i := 0; i < int(bases.NamedChildCount()); i++ {
+		if name := a.baseName(bases.NamedChild(i)); name != nil && name.Qualified != "object" {
+			h.Extends = append(h.Extends, name)
+		}
+	}
+	return h
+}
+
+// Modifiers follows the naming convention: "__x" is private, "_x" is protected
+// in a class and private in a module, and dunder names ("__init__") are
+// public. The decorators @staticmethod, @classmethod, @abstractmethod and
+// @final set the other modifiers.
+func (a *TreeSitterAdapter) Modifiers(n *sitter.Node) *pb.Modifiers {
+	m := &pb.Modifiers{Visibility: pb.Visibility_VISIBILITY_PUBLIC}
+	if a.src == nil || n == nil {
+		return m
+	}
+	name := a.NodeName(n)
+	owner := n.Parent()
+	if owner != nil && owner.Type() == "decorated_definition" {
+		for i := 0; i < int(owner.NamedChildCount()); i++ {
+			decorator := owner.NamedChild(i)
+			if decorator.Type() != "decorator" {
+				continue
+			}
+			switch a.decoratorName(decorator) {
+			case "staticmethod", "classmethod":
+				m.IsStatic = true
+			case "abstractmethod", "abstractproperty":
+				m.IsAbstract = true
+			case "final":
+				m.IsFinal = true
+			}
+		}
+		owner = owner.Parent()
+	}
+	inClass := owner != nil && owner.Type() == "block" && owner.Parent() != nil && owner.Parent().Type() == "class_definition"
+	switch {
+	case strings.HasPrefix(name, "__") && strings.HasSuffix(name, "__"):
+	case strings.HasPrefix(name, "__"):
+		m.Visibility = pb.Visibility_VISIBILITY_PRIVATE
+	case strings.HasPrefix(name, "_") && inClass:
+		m.Visibility = pb.Visibility_VISIBILITY_PROTECTED
+	case strings.HasPrefix(name, "_"):
+		m.Visibility = pb.Visibility_VISIBILITY_PRIVATE
+	}
+	return m
+}
+
+// decoratorName returns the last part of the name of a decorator: "final" for
+// "@typing.final", "abstractmethod" for "@abc.abstractmethod".
+func (a *TreeSitterAdapter) decoratorName(d *sitter.Node) string {
+	for i := 0; i < int(d.NamedChildCount()); i++ {
+		expr := d.NamedChild(i)
+		if expr.Type() == "call" {
+			expr = expr.ChildByFieldName("function")
+		}
+		if expr == nil {
+			return ""
+		}
+		if name := a.baseName(expr); name != nil {
+			return name.Short
+		}
+	}
+	return ""
+}
+
+// Annotations reports the decorators of a class or a function: @dataclass,
+// @app.get("/users") or @pytest.mark.parametrize("x", [1, 2]).
+func (a *TreeSitterAdapter) Annotations(n *sitter.Node) []*pb.Annotation {
+	owner := n.Parent()
+	if a.src == nil || owner == nil || owner.Type() != "decorated_definition" {
+		return nil
+	}
+	var annotations []*pb.Annotation
+	for i := 0; i < int(owner.NamedChildCount()); i++ {
+		decorator := owner.NamedChild(i)
+		if decorator.Type() != "decorator" || decorator.NamedChildCount() == 0 {
+			continue
+		}
+		expr := decorator.NamedChild(0)
+		var arguments []string
+		if expr.Type() == "call" {
+			arguments = Treesitter.AnnotationArguments(expr.ChildByFieldName("arguments"), a.src)
+			expr = expr.ChildByFieldName("function")
+		}
+		if expr == nil {
+			continue
+		}
+		annotations = append(annotations, Treesitter.NewAnnotation(a.text(expr), ".", arguments))
+	}
+	return annotations
+}
+
+// baseName returns the name of a base class: "Base", "models.Model" or
+// "Generic[T]" (named after Generic). Keyword arguments (metaclass=...) name
+// no base.
+func (a *TreeSitterAdapter) baseName(n *sitter.Node) *pb.Name {
+	switch n.Type() {
+	case "identifier":
+		name := a.text(n)
+		return &pb.Name{Short: name, Qualified: name}
+	case "attribute":
+		qualified := a.text(n)
+		short := qualified
+		if attr := n.ChildByFieldName("attribute"); attr != nil {
+			short = a.text(attr)
+		}
+		return &pb.Name{Short: short, Qualified: qualified}
+	case "subscript":
+		if value := n.ChildByFieldName("value"); value != nil {
+			return a.baseName(value)
+		}
+	}
+	return nil
+}
+
 func importsFromImportStatement(a *TreeSitterAdapter, n *sitter.Node) []Treesitter.ImportItem {
 	items := []Treesitter.ImportItem{}
 	// Robust: walk descendants and pick modules
@@ -399,6 +529,32 @@ func (a *TreeSitterAdapter) CommentMarkers() engine.CommentMarkers {
 	return engine.CommentMarkers{Hash: true}
 }
 
+// DocString returns the docstring of a class or a function: a string written
+// as the first statement of its body.
+func (a *TreeSitterAdapter) DocString(n *sitter.Node) *sitter.Node {
+	body := a.NodeBody(n)
+	if body == nil {
+		return nil
+	}
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		stmt := body.NamedChild(i)
+		if stmt.Type() == "comment" {
+			continue
+		}
+		if stmt.Type() == "expression_statement" && stmt.NamedChildCount() == 1 && stmt.NamedChild(0).Type() == "string" {
+			return stmt.NamedChild(0)
+		}
+		return nil
+	}
+	return nil
+}
+
+// IsDocComment returns false: Python documents with docstrings, a comment
+// written before a declaration does not document it.
+func (a *TreeSitterAdapter) IsDocComment(text string) bool {
+	return false
+}
+
 // pythonOperatorTokens lists the anonymous token types counted as Halstead
 // operators: arithmetic, comparison, assignment, bitwise, walrus, attribute
 // access, the argument separator, the subscript and the keywords that drive
@@ -0,0 +1,107 @@
+package ruby
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ast-metrics/ast-metrics/internal/configuration"
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	Treesitter "github.com/ast-metrics/ast-metrics/internal/engine/treesitter"
+	"github.com/ast-metrics/ast-metrics/internal/file"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+
+	"github.com/pterm/pterm"
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+type RubyRunner struct {
+	progressbar   *pterm.SpinnerPrinter
+	Configuration *configuration.Configuration
+	foundFiles    file.FileList
+}
+
+func (r RubyRunner) Name() string                                     { return "Ruby" }
+func (r RubyRunner) IsRequired() bool                                 { return len(r.getFileList().Files) > 0 }
+func (r *RubyRunner) Ensure() error                                   { return nil }
+func (r *RubyRunner) SetProgressbar(p *pterm.SpinnerPrinter)          { r.progressbar = p }
+func (r *RubyRunner) SetConfiguration(c *configuration.Configuration) { r.Configuration = c }
+
+func (r RubyRunner) DumpAST() []*pb.File {
+	return engine.DumpFiles(
+		r.getFileList().Files,
+		r.progressbar,
+		func(path string) (*pb.File, error) { return r.Parse(path) },
+		engine.DumpOptions{Label: r.Name()},
+	)
+}
+
+func (r RubyRunner) Finish() error {
+	if r.progressbar != nil {
+		r.progressbar.Stop()
+	}
+	return nil
+}
+
+func (r RubyRunner) Parse(path string) (*pb.File, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return &pb.File{Path: path, ProgrammingLanguage: "Ruby"}, err
+	}
+
+	parser := sitter.NewParser()
+	adapter := NewTreeSitterAdapter(src)
+	parser.SetLanguage(adapter.Language())
+
+	tree := parser.Parse(nil, src)
+	root := tree.RootNode()
+	adapter.SetRootNode(root)
+
+	v := Treesitter.NewVisitor(adapter, path, src)
+	v.SetQueries(Treesitter.QueriesFor(r.Configuration, "ruby", path))
+	v.Visit(root)
+
+	file := v.Result()
+	file.ProgrammingLanguage = "Ruby"
+
+	// Detect if file is a test file
+	file.IsTest = r.isTestFile(path)
+
+	return file, nil
+}
+
+func (r *RubyRunner) getFileList() file.FileList {
+	if r.foundFiles.Files != nil {
+		return r.foundFiles
+	}
+	finder := file.Finder{Configuration: *r.Configuration}
+	if r.Configuration.FileDiscovery != nil {
+		if fd, ok := r.Configuration.FileDiscovery.(*file.FileDiscovery); ok {
+			finder.Discovery = fd
+		}
+	}
+	extensions := r.Configuration.GetExtensionsForLanguage("ruby")
+	var lists []file.FileList
+	for _, ext := range extensions {
+		lists = append(lists, finder.Search(ext))
+	}
+	r.foundFiles = file.MergeFileLists(lists...)
+	return r.foundFiles
+}
+
+// isTestFile determines if a Ruby file is a test file based on:
+// 1. RSpec and Minitest filename patterns (foo_spec.rb, foo_test.rb, test_foo.rb)
+// 2. Conventional test directories (spec/, test/)
+func (r RubyRunner) isTestFile(path string) bool {
+	base := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	if strings.HasSuffix(base, "_spec") || strings.HasSuffix(base, "_test") || strings.HasPrefix(base, "test_") {
+		return true
+	}
+
+	normalized := "/" + filepath.ToSlash(filepath.Dir(path)) + "/"
+	if strings.Contains(normalized, "/spec/") || strings.Contains(normalized, "/test/") {
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,318 @@
+package ruby
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func parseRuby(t *testing.T, src string) *pb.File {
+	t.Helper()
+	result, err := engine.CreateTestFileWithCode(&RubyRunner{}, src)
+	assert.Nil(t, err, "Expected no error, got %v", err)
+	assert.NotNil(t, result)
+	return result
+}
+
+func findClass(file *pb.File, name string) *pb.StmtClass {
+	for _, c := range engine.GetClassesInFile(file) {
+		if c.Name != nil && c.Name.Short == name {
+			return c
+		}
+	}
+	return nil
+}
+
+func findFunction(file *pb.File, name string) *pb.StmtFunction {
+	for _, fn := range engine.GetFunctionsInFile(file) {
+		if fn.Name != nil && fn.Name.Short == name {
+			return fn
+		}
+	}
+	return nil
+}
+
+func TestRubyModuleAndClassNesting(t *testing.T) {
+	src := `
+module Billing
+  module Core
+    class Invoice < Base
+      attr_reader :total
+
+      def initialize(total)
+        @total = total
+      end
+
+      def self.build(*args, **opts, &blk)
+        new(args.first)
+      end
+
+      def pay(amount = 0, currency:)
+        charge(amount)
+      end
+    end
+  end
+end
+
+class Billing::Util
+  def format; end
+end
+`
+	result := parseRuby(t, src)
+	assert.Equal(t, "Ruby", result.ProgrammingLanguage)
+
+	// modules holding only classes are namespaces, not classes
+	assert.Nil(t, findClass(result, "Billing"))
+	assert.Nil(t, findClass(result, "Core"))
+
+	invoice := findClass(result, "Invoice")
+	assert.NotNil(t, invoice)
+	assert.Equal(t, "Billing::Core::Invoice", invoice.Name.Qualified)
+	assert.Equal(t, 3, len(invoice.Stmts.StmtFunction))
+	assert.Equal(t, "Billing::Core::Invoice.pay", invoice.Stmts.StmtFunction[2].Name.Qualified)
+	assert.Equal(t, 1, len(invoice.Operands))
+	assert.Equal(t, "total", invoice.Operands[0].Name)
+
+	build := findFunction(result, "build")
+	assert.NotNil(t, build, "def self. method expected")
+	assert.Equal(t, 3, len(build.Parameters))
+
+	pay := findFunction(result, "pay")
+	assert.Equal(t, 2, len(pay.Parameters), "default values are not parameters")
+	assert.Equal(t, "amount", pay.Parameters[0].Name)
+	assert.Equal(t, "currency", pay.Parameters[1].Name)
+
+	util := findClass(result, "Util")
+	assert.NotNil(t, util)
+	assert.Equal(t, "Billing::Util", util.Name.Qualified)
+}
+
+func TestRubyModuleWithMethodsIsAClass(t *testing.T) {
+	src := `
+module Helpers
+  def self.slugify(value)
+    value.downcase
+  end
+
+  class << self
+    def titleize(value); value; end
+  end
+end
+`
+	result := parseRuby(t, src)
+	helpers := findClass(result, "Helpers")
+	assert.NotNil(t, helpers)
+	assert.Equal(t, 2, len(helpers.Stmts.StmtFunction), "class << self methods belong to the module")
+}
+
+func TestRubyMixinsAndSuperclass(t *testing.T) {
+	src := `
+class Invoice < ApplicationRecord
+  include Comparable
+  extend Forwardable, ActiveSupport::Concern
+  prepend Auditable
+end
+`
+	result := parseRuby(t, src)
+	invoice := findClass(result, "Invoice")
+	assert.NotNil(t, invoice)
+
+	assert.Equal(t, 1, len(invoice.Extends))
+	assert.Equal(t, "ApplicationRecord", invoice.Extends[0].Short)
+
+	uses := []string{}
+	for _, u := range invoice.Uses {
+		uses = append(uses, u.Qualified)
+	}
+	assert.Equal(t, []string{"Comparable", "Forwardable", "ActiveSupport::Concern", "Auditable"}, uses)
+	assert.Equal(t, "Concern", invoice.Uses[2].Short)
+}
+
+func TestRubyDecisions(t *testing.T) {
+	src := `
+def decide(amount, items)
+  if amount > 0
+    charge(amount)
+  elsif amount == 0
+    noop
+  elsif amount < -10
+    alert
+  else
+    refund
+  end
+  unless paid?
+    log
+  end
+  notify if amount > 100
+  case amount
+  when 1, 2 then :low
+  when 3 then :mid
+  else :high
+  end
+  while amount > 0
+    amount -= 1
+  end
+  until amount > 5
+    amount += 1
+  end
+  for item in items
+    puts item
+  end
+rescue ArgumentError => e
+  log(e)
+rescue StandardError
+  retry
+end
+`
+	result := parseRuby(t, src)
+	fn := findFunction(result, "decide")
+	assert.NotNil(t, fn)
+	// if, two elsif (counted as if), unless and the if modifier
+	assert.Equal(t, 5, len(fn.Stmts.StmtDecisionIf))
+	assert.Equal(t, 1, len(fn.Stmts.StmtDecisionElse))
+	assert.Equal(t, 3, len(fn.Stmts.StmtLoop))
+	assert.Equal(t, 1, len(fn.Stmts.StmtDecisionSwitch))
+	// two when, the else of the case and two rescue clauses
+	assert.Equal(t, 5, len(fn.Stmts.StmtDecisionCase))
+}
+
+func TestRubyImports(t *testing.T) {
+	src := `
+require 'json'
+require "active_support/core_ext"
+require_relative 'lib/helper'
+require File.join(__dir__, 'dynamic')
+
+class App; end
+`
+	result := parseRuby(t, src)
+	deps := engine.GetDependenciesInFile(result)
+
+	found := map[string]bool{}
+	for _, dep := range deps {
+		found[dep.Namespace] = true
+	}
+	for _, expected := range []string{"json", "active_support/core_ext", "lib/helper"} {
+		assert.True(t, found[expected], "dependency %s not found in %v", expected, found)
+	}
+	assert.Equal(t, 3, len(deps), "dynamic requires are ignored: %v", found)
+}
+
+func TestRubyCohesion(t *testing.T) {
+	src := `
+class Counter
+  def increment
+    @count += 1
+    log("incremented")
+    self.flush
+  end
+
+  def log(msg)
+    puts msg
+  end
+
+  def flush
+    @count = 0
+  end
+end
+`
+	result := parseRuby(t, src)
+	fn := findFunction(result, "increment")
+	assert.NotNil(t, fn)
+
+	calls := []string{}
+	for _, c := range fn.MethodCalls {
+		calls = append(calls, c.Name)
+	}
+	assert.Contains(t, calls, "this.log")
+	assert.Contains(t, calls, "this.flush")
+
+	operands := []string{}
+	for _, op := range fn.Operands {
+		operands = append(operands, op.Name)
+	}
+	assert.Contains(t, operands, "this.count", "instance variables are attributes")
+}
+
+func TestRubyComments(t *testing.T) {
+	src := `# header
+=begin
+block
+=end
+class Foo
+  # inline
+  def bar; "#not a comment"; end
+end
+`
+	result := parseRuby(t, src)
+	assert.Equal(t, int32(5), result.LinesOfCode.CommentLinesOfCode, "a # in a string is not a comment")
+}
+
+func TestRubyRunner_IsTest(t *testing.T) {
+	code := "class Calculator; end\n"
+	cases := map[string]bool{
+		"calculator_spec.rb":                    true,
+		"calculator_test.rb":                    true,
+		"test_calculator.rb":                    true,
+		"spec/support/helpers.rb":               true,
+		"test/models/invoice.rb":                true,
+		"app/models/calculator.rb":              false,
+		"app/models/contest.rb":                 false,
+		"app/services/specification_builder.rb": false,
+	}
+	for name, expected := range cases {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), name)
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				t.Fatalf("failed to create dir: %v", err)
+			}
+			if err := os.WriteFile(path, []byte(code), 0644); err != nil {
+				t.Fatalf("failed to create file: %v", err)
+			}
+			file, err := (&RubyRunner{}).Parse(path)
+			assert.Nil(t, err)
+			assert.Equal(t, expected, file.IsTest)
+		})
+	}
+}
+
+func TestRubyRunner_Parse_NonExistentFile(t *testing.T) {
+	file, err := (&RubyRunner{}).Parse("/nonexistent/file.rb")
+	assert.NotNil(t, err)
+	assert.NotNil(t, file)
+	assert.Equal(t, "Ruby", file.ProgrammingLanguage)
+}
+
+func TestRubyModifiers(t *testing.T) {
+	src := `
+class Repository
+  def find; end
+  def self.create; end
+
+  protected def save; end
+
+  private
+
+  def reset; end
+
+  public
+
+  def close; end
+  private :close
+end
+`
+	result := parseRuby(t, src)
+	methods := map[string]*pb.Modifiers{}
+	for _, fn := range engine.GetFunctionsInFile(result) {
+		methods[fn.Name.Short] = fn.Modifiers
+	}
+	assert.Equal(t, pb.Visibility_VISIBILITY_PUBLIC, methods["find"].Visibility)
+	assert.True(t, methods["create"].IsStatic, "singleton methods are static")
+	assert.Equal(t, pb.Visibility_VISIBILITY_PROTECTED, methods["save"].Visibility)
+	assert.Equal(t, pb.Visibility_VISIBILITY_PRIVATE, methods["reset"].Visibility, "a bare private applies to the methods after it")
+	assert.Equal(t, pb.Visibility_VISIBILITY_PRIVATE, methods["close"].Visibility, "private :close applies to close")
+}
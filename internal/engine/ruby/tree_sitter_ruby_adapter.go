@@ -0,0 +1,573 @@
+package ruby
+
+import (
+	"strings"
+
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	Treesitter "github.com/ast-metrics/ast-metrics/internal/engine/treesitter"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+	sitter "github.com/smacker/go-tree-sitter"
+	tsRuby "github.com/smacker/go-tree-sitter/ruby"
+)
+
+type TreeSitterAdapter struct {
+	src []byte
+	// root caches the tree shared by the runner, to avoid re-parsing
+	root *sitter.Node
+}
+
+func NewTreeSitterAdapter(src []byte) *TreeSitterAdapter   { return &TreeSitterAdapter{src: src} }
+func (a *TreeSitterAdapter) SetSource(src []byte)          { a.src = src; a.root = nil }
+func (a *TreeSitterAdapter) SetRootNode(root *sitter.Node) { a.root = root }
+func (a *TreeSitterAdapter) Language() *sitter.Language    { return tsRuby.GetLanguage() }
+
+// ensureRoot returns the tree shared by the runner, parsing the source when
+// the adapter is used on its own (tests).
+func (a *TreeSitterAdapter) ensureRoot(src []byte) (*sitter.Node, []byte) {
+	source := a.src
+	if source == nil {
+		source = src
+	}
+	if a.root != nil {
+		return a.root, source
+	}
+	if source == nil {
+		return nil, nil
+	}
+	parser := sitter.NewParser()
+	parser.SetLanguage(a.Language())
+	a.root = parser.Parse(nil, source).RootNode()
+	return a.root, source
+}
+
+func (a *TreeSitterAdapter) IsModule(n *sitter.Node) bool { return n.Type() == "program" }
+
+func (a *TreeSitterAdapter) IsClass(n *sitter.Node) bool {
+	if !n.IsNamed() {
+		// the "class" and "module" keywords share the type of their node
+		return false
+	}
+	switch n.Type() {
+	case "class":
+		return true
+	case "module":
+		// a module declaring methods is a unit of code (a mixin, a set of
+		// helpers); a module holding nothing but classes is a namespace, and
+		// only shows in the qualified names
+		return declaresMethods(n)
+	}
+	return false
+}
+
+// declaresMethods reports whether the body of a module declares a method,
+// directly or in a "class << self" block.
+func declaresMethods(n *sitter.Node) bool {
+	body := n.ChildByFieldName("body")
+	if body == nil {
+		return false
+	}
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		switch ch := body.NamedChild(i); ch.Type() {
+		case "method", "singleton_method":
+			return true
+		case "singleton_class":
+			if declaresMethods(ch) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (a *TreeSitterAdapter) IsFunction(n *sitter.Node) bool {
+	// Blocks and lambdas are not named functions; their bodies still
+	// contribute decisions to the enclosing method via the fallback recursion.
+	switch n.Type() {
+	case "method", "singleton_method":
+		return true
+	}
+	return false
+}
+
+func (a *TreeSitterAdapter) NodeName(n *sitter.Node) string {
+	if a.src == nil || n == nil {
+		return ""
+	}
+	name := n.ChildByFieldName("name")
+	if name == nil {
+		return ""
+	}
+	if name.Type() == "scope_resolution" {
+		// class Billing::Invoice: the scope is reported by EnclosingScope
+		return text(a.src, name.ChildByFieldName("name"))
+	}
+	return text(a.src, name)
+}
+
+// EnclosingScope returns the modules and classes holding a class, joined with
+// "::" as Ruby writes them (e.g. "Billing::Core"), including the scope written
+// in the class name itself ("class Billing::Invoice").
+func (a *TreeSitterAdapter) EnclosingScope(n *sitter.Node) string {
+	if a.src == nil || n == nil {
+		return ""
+	}
+	var segments []string
+	if name := n.ChildByFieldName("name"); name != nil && name.Type() == "scope_resolution" {
+		if scope := name.ChildByFieldName("scope"); scope != nil {
+			segments = append(segments, text(a.src, scope))
+		}
+	}
+	for p := n.Parent(); p != nil; p = p.Parent() {
+		if p.Type() != "module" && p.Type() != "class" {
+			continue
+		}
+		if name := p.ChildByFieldName("name"); name != nil {
+			segments = append([]string{text(a.src, name)}, segments...)
+		}
+	}
+	return strings.Join(segments, "::")
+}
+
+func (a *TreeSitterAdapter) NodeBody(n *sitter.Node) *sitter.Node {
+	if n == nil {
+		return nil
+	}
+	return n.ChildByFieldName("body")
+}
+
+func (a *TreeSitterAdapter) NodeParams(n *sitter.Node) *sitter.Node {
+	if n == nil {
+		return nil
+	}
+	return n.ChildByFieldName("parameters")
+}
+
+func (a *TreeSitterAdapter) EachParamIdent(params *sitter.Node, yield func(string)) {
+	if params == nil || a.src == nil {
+		return
+	}
+	for i := 0; i < int(params.NamedChildCount()); i++ {
+		p := params.NamedChild(i)
+		switch p.Type() {
+		case "identifier":
+			yield(text(a.src, p))
+		case "optional_parameter", "keyword_parameter", "splat_parameter",
+			"hash_splat_parameter", "block_parameter":
+			// the default value of an optional parameter is not a parameter
+			if nm := p.ChildByFieldName("name"); nm != nil {
+				yield(text(a.src, nm))
+			}
+		}
+	}
+}
+
+// ModuleNameFromPath returns an empty namespace: Ruby files declare no
+// package, the scope of a class comes from the modules enclosing it.
+func (a *TreeSitterAdapter) ModuleNameFromPath(path string) string { return "" }
+
+func (a *TreeSitterAdapter) AttachQualified(parentClass string, fn string) string {
+	if parentClass == "" {
+		return fn
+	}
+	return parentClass + "." + fn
+}
+
+// NamespaceSeparator joins the modules and the class name with "::".
+func (a *TreeSitterAdapter) NamespaceSeparator() string { return "::" }
+
+func (a *TreeSitterAdapter) EachChildBody(body *sitter.Node, yield func(*sitter.Node)) {
+	if body == nil {
+		return
+	}
+	switch body.Type() {
+	case "case", "case_match":
+		// yield only the branches: the value is not a case
+		for i := 0; i < int(body.ChildCount()); i++ {
+			switch ch := body.Child(i); ch.Type() {
+			case "when", "in_clause", "else":
+				yield(ch)
+			}
+		}
+	default:
+		for i := 0; i < int(body.ChildCount()); i++ {
+			yield(body.Child(i))
+		}
+	}
+}
+
+func (a *TreeSitterAdapter) Decision(n *sitter.Node) (Treesitter.DecisionKind, *sitter.Node) {
+	if !n.IsNamed() {
+		// keywords ("if", "when", "else"...) share the type of their node
+		return Treesitter.DecNone, nil
+	}
+	switch n.Type() {
+	case "if", "unless":
+		// unless is an if on the negated condition
+		return Treesitter.DecIf, n.ChildByFieldName("consequence")
+
+	case "if_modifier", "unless_modifier":
+		// do_something if condition
+		return Treesitter.DecIf, n.ChildByFieldName("body")
+
+	case "elsif":
+		// return the node itself as body so that the rest of the chain
+		// (nested elsif and else) keeps being counted
+		return Treesitter.DecElif, n
+
+	case "else":
+		if p := n.Parent(); p != nil {
+			switch p.Type() {
+			case "if", "unless", "elsif":
+				return Treesitter.DecElse, n
+			case "case", "case_match":
+				// the else of a case is its default branch
+				return Treesitter.DecCase, n
+			}
+		}
+		// the else of a begin/rescue runs when nothing was raised: it is
+		// not a branch of its own
+		return Treesitter.DecNone, nil
+
+	case "case", "case_match":
+		return Treesitter.DecSwitch, n
+
+	case "when", "in_clause":
+		return Treesitter.DecCase, n.ChildByFieldName("body")
+
+	case "rescue":
+		// each rescue clause is a branch taken when its exceptions are raised
+		return Treesitter.DecCase, n.ChildByFieldName("body")
+
+	case "rescue_modifier":
+		return Treesitter.DecCase, n
+
+	case "while", "until", "for":
+		return Treesitter.DecLoop, n.ChildByFieldName("body")
+
+	case "while_modifier", "until_modifier":
+		return Treesitter.DecLoop, n.ChildByFieldName("body")
+	}
+	// ternaries and iterator blocks (each, map...) intentionally left out,
+	// consistent with the other engines.
+	return Treesitter.DecNone, nil
+}
+
+// Imports reports require and require_relative calls made with a literal
+// path. A path built at runtime names no file and is ignored.
+func (a *TreeSitterAdapter) Imports(n *sitter.Node) []Treesitter.ImportItem {
+	if n == nil || n.Type() != "call" || n.ChildByFieldName("receiver") != nil {
+		return nil
+	}
+	method := text(a.src, n.ChildByFieldName("method"))
+	if method != "require" && method != "require_relative" {
+		return nil
+	}
+	args := n.ChildByFieldName("arguments")
+	if args == nil || args.NamedChildCount() == 0 {
+		return nil
+	}
+	path := args.NamedChild(0)
+	if path.Type() != "string" || path.NamedChildCount() != 1 || path.NamedChild(0).Type() != "string_content" {
+		return nil
+	}
+	return []Treesitter.ImportItem{{Module: text(a.src, path.NamedChild(0)), Name: ""}}
+}
+
+// Heritage reports the superclass of a class and the modules it mixes in
+// with include, extend or prepend.
+func (a *TreeSitterAdapter) Heritage(n *sitter.Node) Treesitter.Heritage {
+	var h Treesitter.Heritage
+	if a.src == nil || n == nil {
+		return h
+	}
+	if superclass := n.ChildByFieldName("superclass"); superclass != nil && superclass.NamedChildCount() > 0 {
+		if name := a.constantName(superclass.NamedChild(0)); name != nil {
+			h.Extends = append(h.Extends, name)
+		}
+	}
+	body := n.ChildByFieldName("body")
+	if body == nil {
+		return h
+	}
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		call := body.NamedChild(i)
+		if call.Type() != "call" || call.ChildByFieldName("receiver") != nil {
+			continue
+		}
+		switch text(a.src, call.ChildByFieldName("method")) {
+		case "include", "extend", "prepend":
+		default:
+			continue
+		}
+		args := call.ChildByFieldName("arguments")
+		if args == nil {
+			continue
+		}
+		for j := 0; j < int(args.NamedChildCount()); j++ {
+			if name := a.constantName(args.NamedChild(j)); name != nil {
+				h.Uses = append(h.Uses, name)
+			}
+		}
+	}
+	return h
+}
+
+// constantName converts a constant ("Comparable") or a scoped constant
+// ("ActiveSupport::Concern") into a name. Anything else (a variable, a call)
+// names nothing known statically.
+func (a *TreeSitterAdapter) constantName(n *sitter.Node) *pb.Name {
+	switch n.Type() {
+	case "constant":
+		name := text(a.src, n)
+		return &pb.Name{Short: name, Qualified: name}
+	case "scope_resolution":
+		return &pb.Name{Short: text(a.src, n.ChildByFieldName("name")), Qualified: text(a.src, n)}
+	}
+	return nil
+}
+
+// rubyVisibilities maps the methods changing the visibility of a method to
+// that visibility.
+var rubyVisibilities = map[string]pb.Visibility{
+	"public":    pb.Visibility_VISIBILITY_PUBLIC,
+	"protected": pb.Visibility_VISIBILITY_PROTECTED,
+	"private":   pb.Visibility_VISIBILITY_PRIVATE,
+}
+
+// Modifiers reports the visibility of a method. Ruby sets it with a call: a
+// bare "private" applies to the methods defined after it, "private def m" and
+// "private :m" to a single method. Singleton methods ("def self.m") are
+// static. Classes and modules are public.
+func (a *TreeSitterAdapter) Modifiers(n *sitter.Node) *pb.Modifiers {
+	m := &pb.Modifiers{Visibility: pb.Visibility_VISIBILITY_PUBLIC}
+	switch n.Type() {
+	case "singleton_method":
+		m.IsStatic = true
+		return m
+	case "method":
+	default:
+		return m
+	}
+	p := n.Parent()
+	if p != nil && p.Type() == "argument_list" {
+		if call := p.Parent(); call != nil && call.Type() == "call" {
+			if v, ok := rubyVisibilities[text(a.src, call.ChildByFieldName("method"))]; ok {
+				m.Visibility = v
+			}
+		}
+		return m
+	}
+	if p == nil {
+		return m
+	}
+	name := a.NodeName(n)
+	for i := 0; i < int(p.NamedChildCount()); i++ {
+		sibling := p.NamedChild(i)
+		switch sibling.Type() {
+		case "identifier":
+			if v, ok := rubyVisibilities[text(a.src, sibling)]; ok && sibling.StartByte() < n.StartByte() {
+				m.Visibility = v
+			}
+		case "call":
+			v, ok := rubyVisibilities[text(a.src, sibling.ChildByFieldName("method"))]
+			args := sibling.ChildByFieldName("arguments")
+			if !ok || args == nil {
+				continue
+			}
+			for j := 0; j < int(args.NamedChildCount()); j++ {
+				if arg := args.NamedChild(j); arg.Type() == "simple_symbol" && strings.TrimPrefix(text(a.src, arg), ":") == name {
+					m.Visibility = v
+				}
+			}
+		}
+	}
+	return m
+}
+
+// CountElseIfAsIf: treat elsif as if for complexity aggregation (consistent with the other engines)
+func (a *TreeSitterAdapter) CountElseIfAsIf() bool { return true }
+
+// rubyStatementParents lists the node types whose children are statements.
+var rubyStatementParents = map[string]bool{
+	"program": true, "body_statement": true, "block_body": true,
+	"then": true, "else": true, "do": true, "begin": true, "ensure": true,
+}
+
+// IsLogicalNode reports whether a node begins a logical line. Ruby statements
+// carry no "_statement" suffix (everything is an expression), so a logical
+// line is any expression written directly in a body. Declarations and the
+// clauses of a body (rescue, else, ensure) are not statements themselves.
+func (a *TreeSitterAdapter) IsLogicalNode(n *sitter.Node) bool {
+	if !n.IsNamed() {
+		return false
+	}
+	switch n.Type() {
+	case "comment", "empty_statement", "method", "singleton_method", "class",
+		"module", "singleton_class", "rescue", "else", "ensure", "then", "do":
+		return false
+	}
+	p := n.Parent()
+	return p != nil && rubyStatementParents[p.Type()]
+}
+
+// CommentMarkers declares Ruby comment tokens: only "#" starts a line comment.
+func (a *TreeSitterAdapter) CommentMarkers() engine.CommentMarkers {
+	return engine.CommentMarkers{Hash: true}
+}
+
+// CountComments counts Ruby comment lines ("#" and =begin/=end blocks) in the given range.
+func (a *TreeSitterAdapter) CountComments(lines []string, start, end int) int {
+	cnt := 0
+	inBlock := false
+	for i := start - 1; i < end && i < len(lines); i++ {
+		// =begin and =end must start the line
+		raw := lines[i]
+		if inBlock {
+			cnt++
+			if strings.HasPrefix(raw, "=end") {
+				inBlock = false
+			}
+			continue
+		}
+		if strings.HasPrefix(raw, "=begin") {
+			cnt++
+			inBlock = true
+			continue
+		}
+		if strings.HasPrefix(strings.TrimSpace(raw), "#") {
+			cnt++
+		}
+	}
+	return cnt
+}
+
+// rubyOperatorTokens lists the anonymous token types counted as Halstead
+// operators: arithmetic, comparison (including "<=>" and the pattern match
+// "=~"), logical in both spellings, bitwise, assignments, the method call
+// operators ("." and the safe navigation "&."), the scope operator, the
+// argument separator, the subscript and the keywords driving the control flow.
+var rubyOperatorTokens = map[string]bool{
+	"+": true, "-": true, "*": true, "/": true, "%": true, "**": true,
+	"==": true, "!=": true, "===": true, "=~": true, "!~": true, "<=>": true,
+	"<": true, ">": true, "<=": true, ">=": true,
+	"&&": true, "||": true, "!": true, "and": true, "or": true, "not": true,
+	"&": true, "|": true, "^": true, "~": true, "<<": true, ">>": true,
+	"=": true, "+=": true, "-=": true, "*=": true, "/=": true, "%=": true,
+	"**=": true, "||=": true, "&&=": true, "<<=": true, ">>=": true,
+	"|=": true, "&=": true, "^=": true,
+	".": true, "&.": true, "::": true, ",": true, "[": true, "?": true,
+	"..": true, "...": true,
+	"return": true, "if": true, "elsif": true, "else": true, "unless": true,
+	"while": true, "until": true, "for": true, "case": true, "when": true,
+	"in": true, "break": true, "next": true, "redo": true, "retry": true,
+	"yield": true, "begin": true, "rescue": true, "ensure": true,
+	"defined?": true,
+}
+
+// rubyOperandTypes lists the named node types counted as Halstead operands:
+// local names and variables. Constants name classes and modules, like a type
+// in Java, and are left out; so are literals, as in the other engines.
+var rubyOperandTypes = map[string]bool{
+	"identifier": true, "instance_variable": true,
+	"class_variable": true, "global_variable": true,
+}
+
+var rubyCallTypes = map[string]bool{"call": true}
+
+var rubyOperandSpec = Treesitter.OperandSpec{
+	OperatorTokens: rubyOperatorTokens,
+	OperandTypes:   rubyOperandTypes,
+	CallTypes:      rubyCallTypes,
+	// an instance variable is an attribute of the current object: "@total"
+	// is reported as "this.total", the form the cohesion metrics expect
+	Normalize: func(name string) string {
+		if strings.HasPrefix(name, "@") && !strings.HasPrefix(name, "@@") {
+			return "this." + name[1:]
+		}
+		return name
+	},
+}
+
+// ExtractOperatorsOperands collects Halstead operators and operands from the
+// AST within the given 1-based inclusive line range.
+func (a *TreeSitterAdapter) ExtractOperatorsOperands(src []byte, startLine, endLine int) ([]string, []string) {
+	root, source := a.ensureRoot(src)
+	if root == nil {
+		return nil, nil
+	}
+	return rubyOperandSpec.Extract(root, source, startLine, endLine)
+}
+
+// ExtractMethodCalls extracts the calls made on the current object: on
+// "self", or without receiver ("charge(amount)"). A bare name without
+// arguments ("refund") cannot be told apart from a local variable and is not
+// reported.
+func (a *TreeSitterAdapter) ExtractMethodCalls(src []byte, startLine, endLine int) []string {
+	root, source := a.ensureRoot(src)
+	if root == nil || startLine <= 0 || endLine < startLine {
+		return nil
+	}
+	var calls []string
+	var walk func(n *sitter.Node)
+	walk = func(n *sitter.Node) {
+		if int(n.EndPoint().Row)+1 < startLine || int(n.StartPoint().Row)+1 > endLine {
+			return
+		}
+		if n.Type() == "call" {
+			receiver := n.ChildByFieldName("receiver")
+			if method := n.ChildByFieldName("method"); method != nil && method.Type() == "identifier" {
+				if receiver == nil || receiver.Type() == "self" {
+					calls = append(calls, "this."+text(source, method))
+				}
+			}
+		}
+		for i := 0; i < int(n.ChildCount()); i++ {
+			walk(n.Child(i))
+		}
+	}
+	walk(root)
+	return calls
+}
+
+// ClassDirectOperands lists the attributes declared with attr_reader,
+// attr_writer or attr_accessor in the body of a class.
+func (a *TreeSitterAdapter) ClassDirectOperands(n *sitter.Node) []string {
+	if n == nil || a.src == nil {
+		return nil
+	}
+	body := n.ChildByFieldName("body")
+	if body == nil {
+		return nil
+	}
+	var attrs []string
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		call := body.NamedChild(i)
+		if call.Type() != "call" || call.ChildByFieldName("receiver") != nil {
+			continue
+		}
+		switch text(a.src, call.ChildByFieldName("method")) {
+		case "attr_reader", "attr_writer", "attr_accessor":
+		default:
+			continue
+		}
+		args := call.ChildByFieldName("arguments")
+		if args == nil {
+			continue
+		}
+		for j := 0; j < int(args.NamedChildCount()); j++ {
+			if arg := args.NamedChild(j); arg.Type() == "simple_symbol" {
+				attrs = append(attrs, strings.TrimPrefix(text(a.src, arg), ":"))
+			}
+		}
+	}
+	return attrs
+}
+
+// --- helpers ---
+
+func text(src []byte, n *sitter.Node) string {
+	if n == nil || src == nil {
+		return ""
+	}
+	return string(src[n.StartByte():n.EndByte()])
+}
@@ -57,6 +57,7 @@ func (r RustRunner) Parse(path string) (*pb.File, error) {
 	adapter.SetRootNode(root)
 
 	v := Treesitter.NewVisitor(adapter, path, src)
+	v.SetQueries(Treesitter.QueriesFor(r.Configuration, "rust", path))
 	v.Visit(root)
 
 	file := v.Result()
@@ -221,3 +221,97 @@ func TestRustAttributesAreNotComments(t *testing.T) {
 		t.Errorf("expected 3 logical lines, got %d", fn.LinesOfCode.LogicalLinesOfCode)
 	}
 }
+
+func TestRustRunner_Parse_Modifiers(t *testing.T) {
+	tmpFile := filepath.Join(t.TempDir(), "store.rs")
+	rustCode := `pub struct Store;
+pub(crate) trait Named { fn name(&self) -> String; }
+impl Store {
+    pub fn new() -> Store { Store }
+    fn reset(&self) {}
+}
+impl Named for Store {
+    fn name(&self) -> String { String::new() }
+}
+`
+	if err := os.WriteFile(tmpFile, []byte(rustCode), 0644); err != nil {
+		t.Fatalf("failed to create test file: %v", err)
+	}
+	runner := RustRunner{}
+	file, err := runner.Parse(tmpFile)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	classes := file.Stmts.StmtClass
+	if len(classes) != 4 {
+		t.Fatalf("expected 4 class-like items, got %d", len(classes))
+	}
+	if got := classes[0].Modifiers.GetVisibility(); got != pb.Visibility_VISIBILITY_PUBLIC {
+		t.Errorf("expected pub struct to be public, got %v", got)
+	}
+	if got := classes[1].Modifiers.GetVisibility(); got != pb.Visibility_VISIBILITY_PACKAGE {
+		t.Errorf("expected pub(crate) trait to be package, got %v", got)
+	}
+	if !classes[1].Modifiers.GetIsAbstract() {
+		t.Errorf("expected a trait with a required function to be abstract")
+	}
+
+	methods := classes[2].Stmts.StmtFunction
+	if len(methods) != 2 {
+		t.Fatalf("expected 2 methods in impl, got %d", len(methods))
+	}
+	if m := methods[0].Modifiers; m.GetVisibility() != pb.Visibility_VISIBILITY_PUBLIC || !m.GetIsStatic() {
+		t.Errorf("expected new to be public and static, got %v", m)
+	}
+	if m := methods[1].Modifiers; m.GetVisibility() != pb.Visibility_VISIBILITY_PRIVATE || m.GetIsStatic() {
+		t.Errorf("expected reset to be private, got %v", m)
+	}
+	if got := classes[3].Stmts.StmtFunction[0].Modifiers.GetVisibility(); got != pb.Visibility_VISIBILITY_PUBLIC {
+		t.Errorf("expected a trait implementation to be public, got %v", got)
+	}
+}
+
+func TestRustRunner_Parse_Annotations(t *testing.T) {
+	tmpFile := filepath.Join(t.TempDir(), "user.rs")
+	rustCode := `#[derive(Debug, Clone)]
+// a user
+#[serde(rename_all = "camelCase")]
+pub struct User;
+impl User {
+    #[inline]
+    fn id(&self) {}
+}
+#[tokio::main]
+async fn main() {}
+`
+	if err := os.WriteFile(tmpFile, []byte(rustCode), 0644); err != nil {
+		t.Fatalf("failed to create test file: %v", err)
+	}
+	runner := RustRunner{}
+	file, err := runner.Parse(tmpFile)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	annotations := file.Stmts.StmtClass[0].Annotations
+	if len(annotations) != 2 {
+		t.Fatalf("expected 2 attributes on the struct, got %d", len(annotations))
+	}
+	if a := annotations[0]; a.Name.Short != "derive" || len(a.Arguments) != 2 || a.Arguments[1] != "Clone" {
+		t.Errorf("expected #[derive(Debug, Clone)], got %v", a)
+	}
+	if a := annotations[1]; a.Name.Short != "serde" || len(a.Arguments) != 1 || a.Arguments[0] != `rename_all = "camelCase"` {
+		t.Errorf("expected #[serde(rename_all = \"camelCase\")], got %v", a)
+	}
+	if a := file.Stmts.StmtClass[1].Stmts.StmtFunction[0].Annotations; len(a) != 1 || a[0].Name.Short != "inline" {
+		t.Errorf("expected #[inline] on the method, got %v", a)
+	}
+	for _, fn := range file.Stmts.StmtFunction {
+		if fn.Name.Short == "main" {
+			if a := fn.Annotations; len(a) != 1 || a[0].Name.Short != "main" || a[0].Name.Qualified != "tokio::main" {
+				t.Errorf("expected #[tokio::main], got %v", a)
+			}
+		}
+	}
+}
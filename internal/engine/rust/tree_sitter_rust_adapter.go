@@ -6,6 +6,7 @@ import (
 
 	"github.com/ast-metrics/ast-metrics/internal/engine"
 	Treesitter "github.com/ast-metrics/ast-metrics/internal/engine/treesitter"
+	pb "github.com/ast-metrics/ast-metrics/pb"
 	sitter "github.com/smacker/go-tree-sitter"
 	tsRust "github.com/smacker/go-tree-sitter/rust"
 )
@@ -283,6 +284,67 @@ func (a *TreeSitterAdapter) parseUse(n *sitter.Node) []Treesitter.ImportItem {
 	return dedup(items)
 }
 
+// Modifiers reports the modifiers of an item. An item is private to its
+// module unless marked "pub"; "pub(crate)" and "pub(super)" keep it inside
+// the crate. The functions of a trait, and of a trait implementation, are as
+// visible as the trait: they are reported public. A function without "self"
+// in an impl or a trait is static, and a trait function without body is
+// abstract. An impl block has no visibility of its own.
+func (a *TreeSitterAdapter) Modifiers(n *sitter.Node) *pb.Modifiers {
+	m := &pb.Modifiers{Visibility: pb.Visibility_VISIBILITY_PRIVATE}
+	if n.Type() == "impl_item" {
+		m.Visibility = pb.Visibility_VISIBILITY_UNKNOWN
+		return m
+	}
+	if vis := firstChildOfType(n, "visibility_modifier"); vis != nil {
+		m.Visibility = pb.Visibility_VISIBILITY_PACKAGE
+		if a.text(vis) == "pub" {
+			m.Visibility = pb.Visibility_VISIBILITY_PUBLIC
+		}
+	}
+	body := n.Parent()
+	if body == nil || body.Type() != "declaration_list" || body.Parent() == nil {
+		return m
+	}
+	owner := body.Parent()
+	inTrait := owner.Type() == "trait_item"
+	if inTrait || (owner.Type() == "impl_item" && owner.ChildByFieldName("trait") != nil) {
+		m.Visibility = pb.Visibility_VISIBILITY_PUBLIC
+	}
+	if owner.Type() == "impl_item" || inTrait {
+		params := n.ChildByFieldName("parameters")
+		m.IsStatic = params != nil && firstChildOfType(params, "self_parameter") == nil
+	}
+	m.IsAbstract = inTrait && n.Type() == "function_signature_item"
+	return m
+}
+
+// Annotations reports the outer attributes of an item: #[derive(Debug)],
+// #[serde(rename_all = "camelCase")] or #[tokio::main]. They precede the item
+// in the tree.
+func (a *TreeSitterAdapter) Annotations(n *sitter.Node) []*pb.Annotation {
+	if a.src == nil {
+		return nil
+	}
+	var annotations []*pb.Annotation
+	for s := n.PrevNamedSibling(); s != nil; s = s.PrevNamedSibling() {
+		if s.Type() == "line_comment" || s.Type() == "block_comment" {
+			continue
+		}
+		if s.Type() != "attribute_item" {
+			break
+		}
+		attribute := firstChildOfType(s, "attribute")
+		if attribute == nil || attribute.NamedChildCount() == 0 {
+			continue
+		}
+		name := a.text(attribute.NamedChild(0))
+		arguments := Treesitter.AnnotationArguments(attribute.ChildByFieldName("arguments"), a.src)
+		annotations = append([]*pb.Annotation{Treesitter.NewAnnotation(name, "::", arguments)}, annotations...)
+	}
+	return annotations
+}
+
 // helpers
 
 func (a *TreeSitterAdapter) text(n *sitter.Node) string {
@@ -392,6 +454,13 @@ func (a *TreeSitterAdapter) CommentMarkers() engine.CommentMarkers {
 	return engine.CommentMarkers{SlashSlash: true, SlashStar: true}
 }
 
+// IsDocComment tells outer doc comments ("///", "/**") from plain comments.
+// Inner doc comments ("//!") document the enclosing module, not the item
+// following them.
+func (a *TreeSitterAdapter) IsDocComment(text string) bool {
+	return strings.HasPrefix(text, "///") || strings.HasPrefix(text, "/**")
+}
+
 // stripRustStrings removes content inside double-quoted strings so comment
 // markers embedded in literals (e.g. URLs) are not miscounted. Single quotes
 // are left untouched: in Rust they also introduce lifetimes ('a), which have
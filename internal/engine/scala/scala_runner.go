@@ -0,0 +1,119 @@
+package scala
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ast-metrics/ast-metrics/internal/configuration"
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	Treesitter "github.com/ast-metrics/ast-metrics/internal/engine/treesitter"
+	"github.com/ast-metrics/ast-metrics/internal/file"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+
+	"github.com/pterm/pterm"
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+type ScalaRunner struct {
+	progressbar   *pterm.SpinnerPrinter
+	Configuration *configuration.Configuration
+	foundFiles    file.FileList
+}
+
+func (r ScalaRunner) Name() string                                     { return "Scala" }
+func (r ScalaRunner) IsRequired() bool                                 { return len(r.getFileList().Files) > 0 }
+func (r *ScalaRunner) Ensure() error                                   { return nil }
+func (r *ScalaRunner) SetProgressbar(p *pterm.SpinnerPrinter)          { r.progressbar = p }
+func (r *ScalaRunner) SetConfiguration(c *configuration.Configuration) { r.Configuration = c }
+
+func (r ScalaRunner) DumpAST() []*pb.File {
+	return engine.DumpFiles(
+		r.getFileList().Files,
+		r.progressbar,
+		func(path string) (*pb.File, error) { return r.Parse(path) },
+		engine.DumpOptions{Label: r.Name()},
+	)
+}
+
+func (r ScalaRunner) Finish() error {
+	if r.progressbar != nil {
+		r.progressbar.Stop()
+	}
+	return nil
+}
+
+func (r ScalaRunner) Parse(path string) (*pb.File, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return &pb.File{Path: path, ProgrammingLanguage: "Scala"}, err
+	}
+
+	parser := sitter.NewParser()
+	adapter := NewTreeSitterAdapter(src)
+	parser.SetLanguage(adapter.Language())
+
+	tree := parser.Parse(nil, src)
+	root := tree.RootNode()
+	adapter.SetRootNode(root)
+
+	v := Treesitter.NewVisitor(adapter, path, src)
+	v.SetQueries(Treesitter.QueriesFor(r.Configuration, "scala", path))
+	v.Visit(root)
+
+	file := v.Result()
+	file.ProgrammingLanguage = "Scala"
+
+	// Detect if file is a test file
+	file.IsTest = r.isTestFile(path, src)
+
+	return file, nil
+}
+
+func (r *ScalaRunner) getFileList() file.FileList {
+	if r.foundFiles.Files != nil {
+		return r.foundFiles
+	}
+	finder := file.Finder{Configuration: *r.Configuration}
+	if r.Configuration.FileDiscovery != nil {
+		if fd, ok := r.Configuration.FileDiscovery.(*file.FileDiscovery); ok {
+			finder.Discovery = fd
+		}
+	}
+	extensions := r.Configuration.GetExtensionsForLanguage("scala")
+	var lists []file.FileList
+	for _, ext := range extensions {
+		lists = append(lists, finder.Search(ext))
+	}
+	r.foundFiles = file.MergeFileLists(lists...)
+	return r.foundFiles
+}
+
+// isTestFile determines if a Scala file is a test file based on:
+// 1. Filename pattern (FooTest.scala, FooSpec.scala, FooSuite.scala)
+// 2. sbt and Gradle conventional test directories (src/test/, src/it/)
+// 3. Source code containing test framework markers (ScalaTest, MUnit, specs2, uTest, JUnit)
+func (r ScalaRunner) isTestFile(path string, src []byte) bool {
+	base := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	for _, suffix := range []string{"Test", "Tests", "Spec", "Suite"} {
+		if strings.HasSuffix(base, suffix) {
+			return true
+		}
+	}
+	normalized := filepath.ToSlash(path)
+	if idx := strings.Index(normalized, "/src/"); idx >= 0 {
+		sourceSet := strings.SplitN(normalized[idx+len("/src/"):], "/", 2)[0]
+		if sourceSet == "test" || sourceSet == "it" {
+			return true
+		}
+	}
+
+	source := string(src)
+	for _, marker := range []string{"org.scalatest", "munit.", "org.specs2", "utest.", "org.junit"} {
+		if strings.Contains(source, marker) {
+			return true
+		}
+	}
+
+	return false
+}
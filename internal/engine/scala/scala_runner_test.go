@@ -0,0 +1,266 @@
+package scala
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func parseScala(t *testing.T, src string) *pb.File {
+	t.Helper()
+	result, err := engine.CreateTestFileWithCode(&ScalaRunner{}, src)
+	assert.Nil(t, err, "Expected no error, got %v", err)
+	assert.NotNil(t, result)
+	return result
+}
+
+func findClass(file *pb.File, name string) *pb.StmtClass {
+	for _, c := range engine.GetClassesInFile(file) {
+		if c.Name != nil && c.Name.Short == name {
+			return c
+		}
+	}
+	return nil
+}
+
+func findFunction(file *pb.File, name string) *pb.StmtFunction {
+	for _, fn := range engine.GetFunctionsInFile(file) {
+		if fn.Name != nil && fn.Name.Short == name {
+			return fn
+		}
+	}
+	return nil
+}
+
+func TestScalaClassesObjectsAndCaseClasses(t *testing.T) {
+	src := `package com.example
+package shapes
+
+case class Circle(radius: Double, scale: Int = 1) extends Shape with Serializable with Ordered[Circle] {
+  private val cache = Map.empty[String, Double]
+  def area: Double = radius * radius * 3.14
+  def grow(factor: Int)(implicit ctx: Ctx): Circle = copy(radius = radius * factor)
+}
+
+object Circle {
+  def unit(): Circle = Circle(1.0)
+}
+
+class Plain(a: Int, val b: Int) {
+  object Registry {
+    def get(name: String) = name
+  }
+}
+
+object Main
+`
+	result := parseScala(t, src)
+	assert.Equal(t, "Scala", result.ProgrammingLanguage)
+
+	circle := findClass(result, "Circle")
+	assert.NotNil(t, circle)
+	assert.Equal(t, "com.example.shapes.Circle", circle.Name.Qualified)
+	assert.Equal(t, 2, len(circle.Stmts.StmtFunction))
+	assert.Equal(t, "com.example.shapes.Circle.grow", circle.Stmts.StmtFunction[1].Name.Qualified)
+
+	// every parameter of a case class is a field
+	operands := []string{}
+	for _, op := range circle.Operands {
+		operands = append(operands, op.Name)
+	}
+	assert.ElementsMatch(t, []string{"radius", "scale", "cache"}, operands)
+
+	assert.Equal(t, "Shape", circle.Extends[0].Short)
+	mixins := []string{}
+	for _, u := range circle.Uses {
+		mixins = append(mixins, u.Short)
+	}
+	assert.Equal(t, []string{"Serializable", "Ordered"}, mixins)
+
+	grow := findFunction(result, "grow")
+	assert.Equal(t, 2, len(grow.Parameters), "every parameter list counts")
+
+	companion := findClass(result, "Circle$")
+	assert.NotNil(t, companion, "the companion object is kept apart from its class")
+	assert.Equal(t, 1, len(companion.Stmts.StmtFunction))
+
+	plain := findClass(result, "Plain")
+	assert.Equal(t, 1, len(plain.Operands), "only val and var parameters are fields")
+
+	registry := findClass(result, "Registry")
+	assert.NotNil(t, registry)
+	assert.Equal(t, "com.example.shapes.Plain.Registry", registry.Name.Qualified)
+
+	assert.NotNil(t, findClass(result, "Main"), "an object without a companion keeps its name")
+}
+
+func TestScalaTraits(t *testing.T) {
+	src := `package com.example
+
+trait Shape {
+  def area: Double
+  def describe(): String = s"area $area"
+}
+`
+	result := parseScala(t, src)
+	assert.Nil(t, findClass(result, "Shape"))
+	assert.Equal(t, 1, len(result.Stmts.StmtTrait))
+
+	shape := result.Stmts.StmtTrait[0]
+	assert.Equal(t, "com.example.Shape", shape.Name.Qualified)
+	assert.Equal(t, 1, len(shape.Stmts.StmtFunction), "abstract methods have nothing to measure")
+	assert.Equal(t, "com.example.Shape.describe", shape.Stmts.StmtFunction[0].Name.Qualified)
+	assert.NotNil(t, findFunction(result, "describe"), "the methods of a trait are functions of the file")
+}
+
+func TestScalaDecisions(t *testing.T) {
+	src := `
+object Decider {
+  def decide(x: Any, xs: List[Int]): String = {
+    if (xs.isEmpty) "empty" else if (xs.size == 1) "one" else if (xs.size > 9) "many" else "some"
+    for (x <- xs) println(x)
+    while (xs.nonEmpty) {}
+    do {} while (false)
+    try { risky() } catch { case e: Exception => () }
+    x match {
+      case i: Int if i > 0 => "pos"
+      case "a" | "b" => "ab"
+      case _ => "other"
+    }
+  }
+}
+`
+	result := parseScala(t, src)
+	fn := findFunction(result, "decide")
+	assert.NotNil(t, fn)
+	// else-if is counted as an if, like in Java
+	assert.Equal(t, 3, len(fn.Stmts.StmtDecisionIf))
+	assert.Equal(t, 1, len(fn.Stmts.StmtDecisionElse))
+	assert.Equal(t, 3, len(fn.Stmts.StmtLoop))
+	assert.Equal(t, 1, len(fn.Stmts.StmtDecisionSwitch))
+	// the case of the catch block is not a decision of the match
+	assert.Equal(t, 3, len(fn.Stmts.StmtDecisionCase))
+}
+
+func TestScalaImports(t *testing.T) {
+	src := `package com.example.app
+
+import scala.collection.mutable
+import com.example.util.{Helper, Other => Alias}
+import com.example.model._
+
+class App
+`
+	result := parseScala(t, src)
+	found := map[string]bool{}
+	for _, dep := range engine.GetDependenciesInFile(result) {
+		found[dep.Namespace+":"+dep.ClassName] = true
+	}
+	for _, expected := range []string{"scala.collection:mutable", "com.example.util:Helper", "com.example.util:Other", "com.example.model:"} {
+		assert.True(t, found[expected], "dependency %s not found in %v", expected, found)
+	}
+}
+
+func TestScalaCohesion(t *testing.T) {
+	src := `
+class Counter {
+  private var count = 0
+
+  def increment(): Unit = {
+    count += 1
+    log("incremented")
+    this.flush()
+  }
+
+  def log(msg: String): Unit = println(msg)
+  def flush(): Unit = { this.count = 0 }
+}
+`
+	result := parseScala(t, src)
+	fn := findFunction(result, "increment")
+	assert.NotNil(t, fn)
+	calls := []string{}
+	for _, c := range fn.MethodCalls {
+		calls = append(calls, c.Name)
+	}
+	assert.Contains(t, calls, "this.log", "calls without an object are method calls")
+	assert.Contains(t, calls, "this.flush")
+
+	operators := []string{}
+	for _, op := range fn.Operators {
+		operators = append(operators, op.Name)
+	}
+	assert.Contains(t, operators, "+=", "infix operators are operators")
+
+	flush := findFunction(result, "flush")
+	operands := []string{}
+	for _, op := range flush.Operands {
+		operands = append(operands, op.Name)
+	}
+	assert.Contains(t, operands, "this.count")
+}
+
+func TestScalaRunner_IsTest(t *testing.T) {
+	cases := []struct {
+		name     string
+		code     string
+		expected bool
+	}{
+		{"CalculatorSpec.scala", "class CalculatorSpec", true},
+		{"CalculatorSuite.scala", "class CalculatorSuite", true},
+		{"src/test/scala/Calculator.scala", "class Calculator", true},
+		{"Checks.scala", "import org.scalatest.funsuite.AnyFunSuite\nclass Checks", true},
+		{"src/main/scala/Calculator.scala", "class Calculator", false},
+		{"Contest.scala", "class Contest", false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tc.name)
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				t.Fatalf("failed to create dir: %v", err)
+			}
+			if err := os.WriteFile(path, []byte(tc.code), 0644); err != nil {
+				t.Fatalf("failed to create file: %v", err)
+			}
+			file, err := (&ScalaRunner{}).Parse(path)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expected, file.IsTest)
+		})
+	}
+}
+
+func TestScalaRunner_Parse_NonExistentFile(t *testing.T) {
+	file, err := (&ScalaRunner{}).Parse("/nonexistent/file.scala")
+	assert.NotNil(t, err)
+	assert.NotNil(t, file)
+	assert.Equal(t, "Scala", file.ProgrammingLanguage)
+}
+
+func TestScalaModifiers(t *testing.T) {
+	src := `
+abstract class Repository {
+  def find() = 1
+  private def reset() = 2
+  protected def save() = 3
+  final def close() = 4
+}
+object Repository {
+  def create() = 5
+}
+`
+	result := parseScala(t, src)
+	classes := result.Stmts.StmtClass
+	assert.Equal(t, 2, len(classes))
+	assert.True(t, classes[0].Modifiers.IsAbstract)
+
+	methods := classes[0].Stmts.StmtFunction
+	assert.Equal(t, pb.Visibility_VISIBILITY_PUBLIC, methods[0].Modifiers.Visibility)
+	assert.Equal(t, pb.Visibility_VISIBILITY_PRIVATE, methods[1].Modifiers.Visibility)
+	assert.Equal(t, pb.Visibility_VISIBILITY_PROTECTED, methods[2].Modifiers.Visibility)
+	assert.True(t, methods[3].Modifiers.IsFinal)
+	assert.True(t, classes[1].Stmts.StmtFunction[0].Modifiers.IsStatic, "the functions of an object are static")
+}
@@ -0,0 +1,589 @@
+package scala
+
+import (
+	"strings"
+
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	Treesitter "github.com/ast-metrics/ast-metrics/internal/engine/treesitter"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+	sitter "github.com/smacker/go-tree-sitter"
+	tsScala "github.com/smacker/go-tree-sitter/scala"
+)
+
+type TreeSitterAdapter struct {
+	src []byte
+	// root caches the tree shared by the runner, to avoid re-parsing
+	root *sitter.Node
+	// pkg caches the declared package name (read lazily from the tree)
+	pkg       string
+	pkgParsed bool
+}
+
+func NewTreeSitterAdapter(src []byte) *TreeSitterAdapter   { return &TreeSitterAdapter{src: src} }
+func (a *TreeSitterAdapter) SetSource(src []byte)          { a.src = src; a.root = nil }
+func (a *TreeSitterAdapter) SetRootNode(root *sitter.Node) { a.root = root }
+func (a *TreeSitterAdapter) Language() *sitter.Language    { return tsScala.GetLanguage() }
+
+// ensureRoot returns the tree shared by the runner, parsing the source when
+// the adapter is used on its own (tests).
+func (a *TreeSitterAdapter) ensureRoot(src []byte) (*sitter.Node, []byte) {
+	source := a.src
+	if source == nil {
+		source = src
+	}
+	if a.root != nil {
+		return a.root, source
+	}
+	if source == nil {
+		return nil, nil
+	}
+	parser := sitter.NewParser()
+	parser.SetLanguage(a.Language())
+	a.root = parser.Parse(nil, source).RootNode()
+	return a.root, source
+}
+
+func (a *TreeSitterAdapter) IsModule(n *sitter.Node) bool { return n.Type() == "compilation_unit" }
+
+func (a *TreeSitterAdapter) IsClass(n *sitter.Node) bool {
+	switch n.Type() {
+	case "class_definition":
+		// case classes and abstract classes share the node of a plain class
+		return true
+	case "object_definition":
+		// objects are singletons holding values and functions: they are
+		// measured like classes
+		return true
+	}
+	return false
+}
+
+// IsTrait maps Scala traits to StmtTrait: unlike a Java interface, a trait
+// usually carries implemented methods, mixed into the classes extending it.
+func (a *TreeSitterAdapter) IsTrait(n *sitter.Node) bool {
+	return n.Type() == "trait_definition"
+}
+
+func (a *TreeSitterAdapter) IsFunction(n *sitter.Node) bool {
+	// Abstract methods (function_declaration) have no body to measure.
+	// Lambdas are not named functions; their bodies still contribute
+	// decisions to the enclosing function via the fallback recursion.
+	return n.Type() == "function_definition"
+}
+
+func (a *TreeSitterAdapter) NodeName(n *sitter.Node) string {
+	if a.src == nil || n == nil {
+		return ""
+	}
+	name := text(a.src, n.ChildByFieldName("name"))
+	if n.Type() == "object_definition" && hasCompanionClass(a.src, n, name) {
+		// the companion object of a class is compiled as "Name$": it keeps
+		// the object apart from its class in the reports
+		return name + "$"
+	}
+	return name
+}
+
+// hasCompanionClass reports whether a class or a trait of the same name is
+// declared next to the object.
+func hasCompanionClass(src []byte, n *sitter.Node, name string) bool {
+	p := n.Parent()
+	if p == nil || name == "" {
+		return false
+	}
+	for i := 0; i < int(p.NamedChildCount()); i++ {
+		sibling := p.NamedChild(i)
+		switch sibling.Type() {
+		case "class_definition", "trait_definition":
+			if text(src, sibling.ChildByFieldName("name")) == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// EnclosingScope returns the classes, objects and traits holding a
+// declaration, joined with "." (e.g. "Registry.Entry").
+func (a *TreeSitterAdapter) EnclosingScope(n *sitter.Node) string {
+	if a.src == nil || n == nil {
+		return ""
+	}
+	var segments []string
+	for p := n.Parent(); p != nil; p = p.Parent() {
+		switch p.Type() {
+		case "class_definition", "object_definition", "trait_definition":
+			if name := a.NodeName(p); name != "" {
+				segments = append([]string{name}, segments...)
+			}
+		}
+	}
+	return strings.Join(segments, ".")
+}
+
+func (a *TreeSitterAdapter) NodeBody(n *sitter.Node) *sitter.Node {
+	if n == nil {
+		return nil
+	}
+	return n.ChildByFieldName("body")
+}
+
+func (a *TreeSitterAdapter) NodeParams(n *sitter.Node) *sitter.Node {
+	if n == nil {
+		return nil
+	}
+	return n.ChildByFieldName("parameters")
+}
+
+// EachParamIdent yields the parameters of every parameter list: a curried
+// function ("def grow(factor: Int)(implicit ctx: Ctx)") has several.
+func (a *TreeSitterAdapter) EachParamIdent(params *sitter.Node, yield func(string)) {
+	if a.src == nil {
+		return
+	}
+	for list := params; list != nil && list.Type() == "parameters"; list = list.NextNamedSibling() {
+		for i := 0; i < int(list.NamedChildCount()); i++ {
+			p := list.NamedChild(i)
+			if p.Type() != "parameter" {
+				continue
+			}
+			if name := p.ChildByFieldName("name"); name != nil {
+				yield(text(a.src, name))
+			}
+		}
+	}
+}
+
+// ModuleNameFromPath ignores the file path and returns the declared package
+// name (e.g. "com.example.app"). Chained clauses ("package a.b" then
+// "package c") are joined. Empty string for the default package.
+func (a *TreeSitterAdapter) ModuleNameFromPath(path string) string {
+	if a.pkgParsed {
+		return a.pkg
+	}
+	a.pkgParsed = true
+	root, source := a.ensureRoot(nil)
+	if root == nil {
+		return ""
+	}
+	var segments []string
+	for i := 0; i < int(root.NamedChildCount()); i++ {
+		clause := root.NamedChild(i)
+		if clause.Type() != "package_clause" {
+			continue
+		}
+		if name := clause.ChildByFieldName("name"); name != nil {
+			segments = append(segments, text(source, name))
+		}
+	}
+	a.pkg = strings.Join(segments, ".")
+	return a.pkg
+}
+
+func (a *TreeSitterAdapter) AttachQualified(parentClass string, fn string) string {
+	if parentClass == "" {
+		return fn
+	}
+	return parentClass + "." + fn
+}
+
+// NamespaceSeparator joins the package and the class name with ".", as in Java.
+func (a *TreeSitterAdapter) NamespaceSeparator() string { return "." }
+
+func (a *TreeSitterAdapter) EachChildBody(body *sitter.Node, yield func(*sitter.Node)) {
+	if body == nil {
+		return
+	}
+	for i := 0; i < int(body.ChildCount()); i++ {
+		yield(body.Child(i))
+	}
+}
+
+// isElseBranch reports whether n is the branch following the "else" keyword
+// of an if_expression. tree-sitter-scala has no else node: the branch is the
+// "alternative" of the if, and an else-if is a nested if_expression.
+func isElseBranch(n *sitter.Node) bool {
+	p := n.Parent()
+	if p == nil || p.Type() != "if_expression" || !n.IsNamed() {
+		return false
+	}
+	prev := n.PrevSibling()
+	return prev != nil && prev.Type() == "else"
+}
+
+func (a *TreeSitterAdapter) Decision(n *sitter.Node) (Treesitter.DecisionKind, *sitter.Node) {
+	if isElseBranch(n) {
+		if n.Type() == "if_expression" {
+			// else-if: re-visit the nested if so that deeper branches keep
+			// being counted
+			return Treesitter.DecElif, n
+		}
+		return Treesitter.DecElse, n
+	}
+
+	switch n.Type() {
+	case "if_expression":
+		return Treesitter.DecIf, n.ChildByFieldName("consequence")
+
+	case "match_expression":
+		return Treesitter.DecSwitch, n.ChildByFieldName("body")
+
+	case "case_clause":
+		// the cases of a catch block are exception handlers, left out like
+		// the catch clauses of Java
+		if block := n.Parent(); block != nil {
+			if p := block.Parent(); p != nil && p.Type() == "catch_clause" {
+				return Treesitter.DecNone, nil
+			}
+		}
+		return Treesitter.DecCase, n
+
+	case "for_expression", "while_expression", "do_while_expression":
+		return Treesitter.DecLoop, n.ChildByFieldName("body")
+	}
+	return Treesitter.DecNone, nil
+}
+
+// Imports reports the imported names. "import a.b.{C, D => E}" imports two
+// names of "a.b"; a wildcard ("import a.b._") depends on the package alone.
+func (a *TreeSitterAdapter) Imports(n *sitter.Node) []Treesitter.ImportItem {
+	if n == nil || n.Type() != "import_declaration" || a.src == nil {
+		return nil
+	}
+	var path []string
+	var items []Treesitter.ImportItem
+	wildcard := false
+	for i := 0; i < int(n.ChildCount()); i++ {
+		ch := n.Child(i)
+		switch ch.Type() {
+		case "identifier":
+			path = append(path, text(a.src, ch))
+		case "namespace_wildcard":
+			wildcard = true
+		case "namespace_selectors":
+			for j := 0; j < int(ch.NamedChildCount()); j++ {
+				sel := ch.NamedChild(j)
+				switch sel.Type() {
+				case "identifier":
+					items = append(items, Treesitter.ImportItem{Name: text(a.src, sel)})
+				case "arrow_renamed_identifier", "as_renamed_identifier":
+					// the alias is local to the file, the dependency is on
+					// the imported name
+					items = append(items, Treesitter.ImportItem{Name: text(a.src, sel.ChildByFieldName("name"))})
+				case "namespace_wildcard":
+					wildcard = true
+				}
+			}
+		}
+	}
+	if len(path) == 0 {
+		return nil
+	}
+	module := strings.Join(path, ".")
+	if len(items) > 0 {
+		for i := range items {
+			items[i].Module = module
+		}
+		return items
+	}
+	if wildcard || len(path) == 1 {
+		return []Treesitter.ImportItem{{Module: module, Name: ""}}
+	}
+	return []Treesitter.ImportItem{{Module: strings.Join(path[:len(path)-1], "."), Name: path[len(path)-1]}}
+}
+
+// Heritage reports the parent of a class ("extends") and the traits mixed in
+// with "with".
+func (a *TreeSitterAdapter) Heritage(n *sitter.Node) Treesitter.Heritage {
+	var h Treesitter.Heritage
+	if a.src == nil || n == nil {
+		return h
+	}
+	clause := n.ChildByFieldName("extend")
+	if clause == nil {
+		return h
+	}
+	for i := 0; i < int(clause.NamedChildCount()); i++ {
+		name := a.typeName(clause.NamedChild(i))
+		if name == nil {
+			continue
+		}
+		if len(h.Extends) == 0 {
+			h.Extends = append(h.Extends, name)
+		} else {
+			h.Uses = append(h.Uses, name)
+		}
+	}
+	return h
+}
+
+// typeName returns the name of a parent type: "Shape", "Repo[User]" (named
+// after Repo) or "akka.actor.Actor".
+func (a *TreeSitterAdapter) typeName(t *sitter.Node) *pb.Name {
+	switch t.Type() {
+	case "type_identifier":
+		name := text(a.src, t)
+		return &pb.Name{Short: name, Qualified: name}
+	case "generic_type":
+		return a.typeName(t.ChildByFieldName("type"))
+	case "stable_type_identifier":
+		qualified := text(a.src, t)
+		short := qualified
+		if idx := strings.LastIndex(qualified, "."); idx >= 0 {
+			short = qualified[idx+1:]
+		}
+		return &pb.Name{Short: short, Qualified: qualified}
+	}
+	return nil
+}
+
+// Modifiers reports the modifiers of a class or a function. Scala
+// declarations are public unless stated otherwise, and the functions of an
+// object are static: there is a single instance to call them on.
+func (a *TreeSitterAdapter) Modifiers(n *sitter.Node) *pb.Modifiers {
+	m := Treesitter.KeywordModifiers(Treesitter.ModifierKeywords(n, map[string]bool{"modifiers": true}), pb.Visibility_VISIBILITY_PUBLIC)
+	if n.Type() == "function_definition" {
+		if body := n.Parent(); body != nil && body.Type() == "template_body" {
+			if owner := body.Parent(); owner != nil && owner.Type() == "object_definition" {
+				m.IsStatic = true
+			}
+		}
+	}
+	return m
+}
+
+// CountElseIfAsIf: treat else-if as if for complexity aggregation (consistent with Java)
+func (a *TreeSitterAdapter) CountElseIfAsIf() bool { return true }
+
+// IsLogicalNode reports whether a node begins a logical line: the
+// expressions of a block, and the body of a function or a case written
+// without braces.
+func (a *TreeSitterAdapter) IsLogicalNode(n *sitter.Node) bool {
+	if !n.IsNamed() || n.Type() == "comment" || n.Type() == "block" {
+		return false
+	}
+	p := n.Parent()
+	if p == nil {
+		return false
+	}
+	switch p.Type() {
+	case "block":
+		return true
+	case "function_definition", "case_clause":
+		body := p.ChildByFieldName("body")
+		return body != nil && body.StartByte() == n.StartByte() && body.EndByte() == n.EndByte()
+	}
+	return false
+}
+
+// CommentMarkers declares Scala comment tokens: "//" and "/* */" only.
+func (a *TreeSitterAdapter) CommentMarkers() engine.CommentMarkers {
+	return engine.CommentMarkers{SlashSlash: true, SlashStar: true}
+}
+
+// IsDocComment tells Scaladoc ("/**") from a plain comment.
+func (a *TreeSitterAdapter) IsDocComment(text string) bool {
+	return strings.HasPrefix(text, "/**")
+}
+
+// CountComments counts Scala comment lines (//, /* */ and Scaladoc) in the given range.
+func (a *TreeSitterAdapter) CountComments(lines []string, start, end int) int {
+	cnt := 0
+	inBlock := false
+	for i := start - 1; i < end && i < len(lines); i++ {
+		ln := strings.TrimSpace(lines[i])
+		if ln == "" {
+			continue
+		}
+		clean := stripScalaStrings(ln)
+		if inBlock {
+			cnt++
+			if strings.Contains(clean, "*/") {
+				inBlock = false
+			}
+			continue
+		}
+		if strings.HasPrefix(clean, "//") {
+			cnt++
+			continue
+		}
+		if strings.HasPrefix(clean, "/*") {
+			cnt++
+			if !strings.Contains(clean, "*/") {
+				inBlock = true
+			}
+			continue
+		}
+	}
+	return cnt
+}
+
+// scalaOperatorTokens lists the anonymous token types counted as Halstead
+// operators: assignments, member access, the argument separator, the
+// subscript of a type application, the arrows of cases and generators, the
+// prefix operators and the keywords driving the control flow. Infix
+// operators are method names in Scala: see scalaOperatorTypes.
+var scalaOperatorTokens = map[string]bool{
+	"=": true, ".": true, ",": true, "[": true,
+	"=>": true, "<-": true, "!": true, "-": true, "+": true, "~": true,
+	"return": true, "if": true, "else": true, "match": true, "case": true,
+	"for": true, "while": true, "do": true, "yield": true,
+	"throw": true, "try": true, "catch": true, "finally": true, "new": true,
+}
+
+// scalaOperatorTypes lists the named node types read as operators: "a + b"
+// calls the method "+", written as an operator_identifier.
+var scalaOperatorTypes = map[string]bool{"operator_identifier": true}
+
+// scalaOperandTypes lists the named node types counted as Halstead operands.
+// Literals are left out on purpose, as in Java. Types have node types of
+// their own (type_identifier), never counted.
+var scalaOperandTypes = map[string]bool{"identifier": true}
+
+var scalaCallTypes = map[string]bool{"call_expression": true}
+
+// scalaPruneTypes lists the node types never walked: type arguments,
+// modifiers and annotations describe the declaration, not what it computes.
+var scalaPruneTypes = map[string]bool{
+	"type_arguments": true, "type_parameters": true,
+	"modifiers": true, "annotation": true,
+}
+
+// scalaChainTypes lists the member access node types: obj.field.
+var scalaChainTypes = map[string]bool{"field_expression": true}
+
+var scalaOperandSpec = Treesitter.OperandSpec{
+	OperatorTokens: scalaOperatorTokens,
+	OperatorTypes:  scalaOperatorTypes,
+	OperandTypes:   scalaOperandTypes,
+	CallTypes:      scalaCallTypes,
+	PruneTypes:     scalaPruneTypes,
+	ChainTypes:     scalaChainTypes,
+	// no Receiver: the current object is the keyword "this"
+}
+
+// ExtractOperatorsOperands collects Halstead operators and operands from the
+// AST within the given 1-based inclusive line range. A member access is a
+// single operand, and "this.items" reads "this.items".
+func (a *TreeSitterAdapter) ExtractOperatorsOperands(src []byte, startLine, endLine int) ([]string, []string) {
+	root, source := a.ensureRoot(src)
+	if root == nil {
+		return nil, nil
+	}
+	return scalaOperandSpec.Extract(root, source, startLine, endLine)
+}
+
+// ExtractMethodCalls extracts the calls made on the current object: through
+// "this." or "super.", and without any object, the way members of a class
+// usually call each other in Scala.
+func (a *TreeSitterAdapter) ExtractMethodCalls(src []byte, startLine, endLine int) []string {
+	root, source := a.ensureRoot(src)
+	if root == nil || startLine <= 0 || endLine < startLine {
+		return nil
+	}
+	calls := scalaOperandSpec.MethodCalls(root, source, startLine, endLine)
+	var walk func(n *sitter.Node)
+	walk = func(n *sitter.Node) {
+		if int(n.EndPoint().Row)+1 < startLine || int(n.StartPoint().Row)+1 > endLine {
+			return
+		}
+		if n.Type() == "call_expression" {
+			if fn := n.ChildByFieldName("function"); fn != nil && fn.Type() == "identifier" {
+				calls = append(calls, "this."+text(source, fn))
+			}
+		}
+		for i := 0; i < int(n.ChildCount()); i++ {
+			walk(n.Child(i))
+		}
+	}
+	walk(root)
+	return calls
+}
+
+// ClassDirectOperands lists the fields of a class: the val and var defined in
+// its body, and the parameters of its constructor declared with val or var
+// (all of them for a case class).
+func (a *TreeSitterAdapter) ClassDirectOperands(n *sitter.Node) []string {
+	if n == nil || a.src == nil {
+		return nil
+	}
+	var fields []string
+	if params := n.ChildByFieldName("class_parameters"); params != nil {
+		isCase := hasToken(n, "case")
+		for i := 0; i < int(params.NamedChildCount()); i++ {
+			p := params.NamedChild(i)
+			if p.Type() != "class_parameter" {
+				continue
+			}
+			if isCase || hasToken(p, "val") || hasToken(p, "var") {
+				fields = append(fields, text(a.src, p.ChildByFieldName("name")))
+			}
+		}
+	}
+	if body := n.ChildByFieldName("body"); body != nil {
+		for i := 0; i < int(body.NamedChildCount()); i++ {
+			def := body.NamedChild(i)
+			switch def.Type() {
+			case "val_definition", "var_definition":
+				if pattern := def.ChildByFieldName("pattern"); pattern != nil && pattern.Type() == "identifier" {
+					fields = append(fields, text(a.src, pattern))
+				}
+			case "val_declaration", "var_declaration":
+				if name := def.ChildByFieldName("name"); name != nil {
+					fields = append(fields, text(a.src, name))
+				}
+			}
+		}
+	}
+	return fields
+}
+
+// --- helpers ---
+
+func text(src []byte, n *sitter.Node) string {
+	if n == nil || src == nil {
+		return ""
+	}
+	return string(src[n.StartByte():n.EndByte()])
+}
+
+// hasToken reports whether n has an anonymous child of the given type (a
+// keyword such as "case" or "val").
+func hasToken(n *sitter.Node, t string) bool {
+	for i := 0; i < int(n.ChildCount()); i++ {
+		if ch := n.Child(i); !ch.IsNamed() && ch.Type() == t {
+			return true
+		}
+	}
+	return false
+}
+
+// stripScalaStrings removes content inside string and char literals to avoid
+// false positives in comment scanning.
+func stripScalaStrings(s string) string {
+	out := make([]rune, 0, len(s))
+	inDq := false
+	inSq := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '\\' {
+			if i+1 < len(s) {
+				i++
+			}
+			continue
+		}
+		if !inSq && c == '"' {
+			inDq = !inDq
+			continue
+		}
+		if !inDq && c == '\'' {
+			inSq = !inSq
+			continue
+		}
+		if inDq || inSq {
+			continue
+		}
+		out = append(out, rune(c))
+	}
+	return string(out)
+}
@@ -0,0 +1,118 @@
+package swift
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ast-metrics/ast-metrics/internal/configuration"
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	Treesitter "github.com/ast-metrics/ast-metrics/internal/engine/treesitter"
+	"github.com/ast-metrics/ast-metrics/internal/file"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+
+	"github.com/pterm/pterm"
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+type SwiftRunner struct {
+	progressbar   *pterm.SpinnerPrinter
+	Configuration *configuration.Configuration
+	foundFiles    file.FileList
+}
+
+func (r SwiftRunner) Name() string                                     { return "Swift" }
+func (r SwiftRunner) IsRequired() bool                                 { return len(r.getFileList().Files) > 0 }
+func (r *SwiftRunner) Ensure() error                                   { return nil }
+func (r *SwiftRunner) SetProgressbar(p *pterm.SpinnerPrinter)          { r.progressbar = p }
+func (r *SwiftRunner) SetConfiguration(c *configuration.Configuration) { r.Configuration = c }
+
+func (r SwiftRunner) DumpAST() []*pb.File {
+	return engine.DumpFiles(
+		r.getFileList().Files,
+		r.progressbar,
+		func(path string) (*pb.File, error) { return r.Parse(path) },
+		engine.DumpOptions{Label: r.Name()},
+	)
+}
+
+func (r SwiftRunner) Finish() error {
+	if r.progressbar != nil {
+		r.progressbar.Stop()
+	}
+	return nil
+}
+
+func (r SwiftRunner) Parse(path string) (*pb.File, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return &pb.File{Path: path, ProgrammingLanguage: "Swift"}, err
+	}
+
+	parser := sitter.NewParser()
+	adapter := NewTreeSitterAdapter(src)
+	parser.SetLanguage(adapter.Language())
+
+	tree := parser.Parse(nil, src)
+	root := tree.RootNode()
+	adapter.SetRootNode(root)
+
+	v := Treesitter.NewVisitor(adapter, path, src)
+	v.SetQueries(Treesitter.QueriesFor(r.Configuration, "swift", path))
+	v.Visit(root)
+
+	file := v.Result()
+	file.ProgrammingLanguage = "Swift"
+
+	// Detect if file is a test file
+	file.IsTest = r.isTestFile(path, src)
+
+	return file, nil
+}
+
+func (r *SwiftRunner) getFileList() file.FileList {
+	if r.foundFiles.Files != nil {
+		return r.foundFiles
+	}
+	finder := file.Finder{Configuration: *r.Configuration}
+	if r.Configuration.FileDiscovery != nil {
+		if fd, ok := r.Configuration.FileDiscovery.(*file.FileDiscovery); ok {
+			finder.Discovery = fd
+		}
+	}
+	extensions := r.Configuration.GetExtensionsForLanguage("swift")
+	var lists []file.FileList
+	for _, ext := range extensions {
+		lists = append(lists, finder.Search(ext))
+	}
+	r.foundFiles = file.MergeFileLists(lists...)
+	return r.foundFiles
+}
+
+// isTestFile determines if a Swift file is a test file based on:
+// 1. Filename pattern (FooTests.swift, FooTest.swift, FooSpec.swift)
+// 2. Test target directories (Tests/ for SwiftPM, FooTests/ and FooUITests/ for Xcode)
+// 3. Source code containing test framework markers (XCTest, Swift Testing, Quick, Nimble)
+func (r SwiftRunner) isTestFile(path string, src []byte) bool {
+	base := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	for _, suffix := range []string{"Test", "Tests", "Spec"} {
+		if strings.HasSuffix(base, suffix) {
+			return true
+		}
+	}
+	dirs := strings.Split(filepath.ToSlash(filepath.Dir(path)), "/")
+	for _, dir := range dirs {
+		if strings.HasSuffix(dir, "Tests") {
+			return true
+		}
+	}
+
+	source := string(src)
+	for _, marker := range []string{"import XCTest", "XCTestCase", "import Testing", "import Quick", "import Nimble"} {
+		if strings.Contains(source, marker) {
+			return true
+		}
+	}
+
+	return false
+}
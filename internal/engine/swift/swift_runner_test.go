@@ -0,0 +1,290 @@
+package swift
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func parseSwift(t *testing.T, src string) *pb.File {
+	t.Helper()
+	result, err := engine.CreateTestFileWithCode(&SwiftRunner{}, src)
+	assert.Nil(t, err, "Expected no error, got %v", err)
+	assert.NotNil(t, result)
+	return result
+}
+
+func findClass(file *pb.File, name string) *pb.StmtClass {
+	for _, c := range engine.GetClassesInFile(file) {
+		if c.Name != nil && c.Name.Short == name {
+			return c
+		}
+	}
+	return nil
+}
+
+func findFunction(file *pb.File, name string) *pb.StmtFunction {
+	for _, fn := range engine.GetFunctionsInFile(file) {
+		if fn.Name != nil && fn.Name.Short == name {
+			return fn
+		}
+	}
+	return nil
+}
+
+func TestSwiftTypesAndProtocols(t *testing.T) {
+	src := `protocol Shape {
+    var area: Double { get }
+    func describe() -> String
+}
+
+final class Circle: Base, Shape {
+    private var radius: Double = 1
+    let name: String
+    var area: Double { return radius * radius }
+
+    init(radius: Double) { self.radius = radius; self.name = "c" }
+    deinit {}
+
+    func grow(by factor: Double, _ other: Int) -> Double {
+        return radius * factor
+    }
+
+    struct Style { var color = 0 }
+}
+
+struct Point { var x = 0; var y = 0 }
+enum Direction { case north, south
+    func flip() -> Direction { return self }
+}
+actor Counter { var value = 0; func inc() { value += 1 } }
+`
+	result := parseSwift(t, src)
+	assert.Equal(t, "Swift", result.ProgrammingLanguage)
+
+	assert.Equal(t, 1, len(result.Stmts.StmtInterface))
+	assert.Nil(t, findClass(result, "Shape"), "a protocol is an interface")
+
+	circle := findClass(result, "Circle")
+	assert.NotNil(t, circle)
+	assert.Equal(t, 3, len(circle.Stmts.StmtFunction))
+	assert.Equal(t, "Circle.grow", circle.Stmts.StmtFunction[2].Name.Qualified)
+	assert.Equal(t, "Base", circle.Extends[0].Short)
+	assert.Equal(t, "Shape", circle.Implements[0].Short)
+
+	operands := []string{}
+	for _, op := range circle.Operands {
+		operands = append(operands, op.Name)
+	}
+	assert.ElementsMatch(t, []string{"radius", "name"}, operands, "a computed property stores nothing")
+
+	grow := findFunction(result, "grow")
+	assert.Equal(t, "factor", grow.Parameters[0].Name, "the label of a parameter is not its name")
+	assert.Equal(t, 2, len(grow.Parameters))
+
+	style := findClass(result, "Style")
+	assert.NotNil(t, style)
+	assert.Equal(t, "Circle.Style", style.Name.Qualified)
+
+	for _, name := range []string{"Point", "Direction", "Counter"} {
+		assert.NotNil(t, findClass(result, name), "%s should be a class", name)
+	}
+	assert.Equal(t, 0, len(findClass(result, "Point").Implements), "a struct inherits from nothing")
+}
+
+func TestSwiftExtensionsAreMergedIntoTheirType(t *testing.T) {
+	src := `extension Circle: CustomStringConvertible {
+    var description: String { return name }
+    func helper() {}
+}
+
+class Circle {
+    let name = "c"
+    func area() -> Double { return 1 }
+}
+
+extension Circle: Equatable {
+    func other() { helper() }
+}
+
+extension String {
+    func shout() -> String { return uppercased() }
+}
+`
+	result := parseSwift(t, src)
+	assert.Equal(t, 1, len(engine.GetClassesInFile(result)), "an extension is not a class of its own")
+
+	circle := findClass(result, "Circle")
+	assert.Equal(t, 3, len(circle.Stmts.StmtFunction))
+	assert.Equal(t, "Circle.helper", findFunction(result, "helper").Name.Qualified)
+
+	conformances := []string{}
+	for _, name := range circle.Implements {
+		conformances = append(conformances, name.Short)
+	}
+	assert.ElementsMatch(t, []string{"CustomStringConvertible", "Equatable"}, conformances)
+
+	// the extended type is declared in another module: its methods stay
+	// functions of the file
+	assert.NotNil(t, findFunction(result, "shout"))
+	assert.Equal(t, 1, len(result.Stmts.StmtFunction))
+}
+
+func TestSwiftDecisions(t *testing.T) {
+	src := `
+func decide(a: Int, items: [Int]) -> Int {
+    guard a > -100 else { return 0 }
+    if a > 0 {
+        print(a)
+    } else if a == 0 {
+        print(0)
+    } else if a < -10 {
+        print(-10)
+    } else {
+        print(-1)
+    }
+    for item in items { print(item) }
+    while a > 0 { break }
+    repeat { print(a) } while a > 5
+    switch a {
+    case 1: break
+    case 2, 3: break
+    default: break
+    }
+    return a
+}
+`
+	result := parseSwift(t, src)
+	fn := findFunction(result, "decide")
+	assert.NotNil(t, fn)
+	// else-if is counted as an if, like in Java, and a guard is an if
+	assert.Equal(t, 4, len(fn.Stmts.StmtDecisionIf))
+	assert.Equal(t, 1, len(fn.Stmts.StmtDecisionElse))
+	assert.Equal(t, 3, len(fn.Stmts.StmtLoop))
+	assert.Equal(t, 1, len(fn.Stmts.StmtDecisionSwitch))
+	assert.Equal(t, 3, len(fn.Stmts.StmtDecisionCase))
+}
+
+func TestSwiftImports(t *testing.T) {
+	src := `import UIKit
+import struct Foundation.Date
+@testable import MyApp
+
+class App {}
+`
+	result := parseSwift(t, src)
+
+	uses := []string{}
+	for _, use := range result.Stmts.StmtUse {
+		uses = append(uses, use.Name.Qualified)
+	}
+	assert.Equal(t, []string{"UIKit", "Foundation.Date", "MyApp"}, uses)
+
+	found := map[string]bool{}
+	for _, dep := range engine.GetDependenciesInFile(result) {
+		found[dep.Namespace+":"+dep.ClassName] = true
+	}
+	for _, expected := range []string{"UIKit:", "Foundation:Date", "MyApp:"} {
+		assert.True(t, found[expected], "dependency %s not found in %v", expected, found)
+	}
+}
+
+func TestSwiftCohesion(t *testing.T) {
+	src := `
+class Counter {
+    private var count = 0
+
+    func increment() {
+        count += 1
+        log("incremented")
+        self.flush()
+        let other = Counter()
+    }
+
+    func log(_ msg: String) { print(msg) }
+    func flush() { self.count = 0 }
+}
+`
+	result := parseSwift(t, src)
+	fn := findFunction(result, "increment")
+	assert.NotNil(t, fn)
+	calls := []string{}
+	for _, c := range fn.MethodCalls {
+		calls = append(calls, c.Name)
+	}
+	assert.Contains(t, calls, "this.log", "calls without an object are method calls")
+	assert.Contains(t, calls, "this.flush")
+	assert.NotContains(t, calls, "this.Counter", "creating an instance is not a method call")
+
+	operators := []string{}
+	for _, op := range fn.Operators {
+		operators = append(operators, op.Name)
+	}
+	assert.Contains(t, operators, "+=")
+	assert.Contains(t, operators, "()")
+}
+
+func TestSwiftRunner_IsTest(t *testing.T) {
+	cases := []struct {
+		name     string
+		code     string
+		expected bool
+	}{
+		{"CalculatorTests.swift", "class CalculatorTests {}", true},
+		{"Tests/AppTests/Calculator.swift", "struct Calculator {}", true},
+		{"AppUITests/Launch.swift", "class Launch {}", true},
+		{"Checks.swift", "import XCTest\n@testable import App\nfinal class Checks: XCTestCase {}", true},
+		{"Checks.swift", "import Testing\n@Test func adds() {}", true},
+		{"Sources/App/Calculator.swift", "struct Calculator {}", false},
+		{"Contest.swift", "struct Contest {}", false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tc.name)
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				t.Fatalf("failed to create dir: %v", err)
+			}
+			if err := os.WriteFile(path, []byte(tc.code), 0644); err != nil {
+				t.Fatalf("failed to create file: %v", err)
+			}
+			file, err := (&SwiftRunner{}).Parse(path)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expected, file.IsTest)
+		})
+	}
+}
+
+func TestSwiftRunner_Parse_NonExistentFile(t *testing.T) {
+	file, err := (&SwiftRunner{}).Parse("/nonexistent/file.swift")
+	assert.NotNil(t, err)
+	assert.NotNil(t, file)
+	assert.Equal(t, "Swift", file.ProgrammingLanguage)
+}
+
+func TestSwiftModifiers(t *testing.T) {
+	src := `
+open class Repository {
+    func find() {}
+    private static func key() {}
+    fileprivate func reset() {}
+    public final func save() {}
+    class func create() {}
+}
+`
+	result := parseSwift(t, src)
+	class := result.Stmts.StmtClass[0]
+	assert.Equal(t, pb.Visibility_VISIBILITY_PUBLIC, class.Modifiers.Visibility, "open is public")
+
+	methods := class.Stmts.StmtFunction
+	assert.Equal(t, 5, len(methods))
+	assert.Equal(t, pb.Visibility_VISIBILITY_PACKAGE, methods[0].Modifiers.Visibility, "internal by default")
+	assert.Equal(t, pb.Visibility_VISIBILITY_PRIVATE, methods[1].Modifiers.Visibility)
+	assert.True(t, methods[1].Modifiers.IsStatic)
+	assert.Equal(t, pb.Visibility_VISIBILITY_PRIVATE, methods[2].Modifiers.Visibility, "fileprivate is private")
+	assert.True(t, methods[3].Modifiers.IsFinal)
+	assert.True(t, methods[4].Modifiers.IsStatic, "a class func is static")
+}
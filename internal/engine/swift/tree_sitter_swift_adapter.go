@@ -0,0 +1,630 @@
+package swift
+
+import (
+	"strings"
+
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	Treesitter "github.com/ast-metrics/ast-metrics/internal/engine/treesitter"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+	sitter "github.com/smacker/go-tree-sitter"
+	tsSwift "github.com/smacker/go-tree-sitter/swift"
+)
+
+type TreeSitterAdapter struct {
+	src []byte
+	// root caches the tree shared by the runner, to avoid re-parsing
+	root *sitter.Node
+}
+
+func NewTreeSitterAdapter(src []byte) *TreeSitterAdapter   { return &TreeSitterAdapter{src: src} }
+func (a *TreeSitterAdapter) SetSource(src []byte)          { a.src = src; a.root = nil }
+func (a *TreeSitterAdapter) SetRootNode(root *sitter.Node) { a.root = root }
+func (a *TreeSitterAdapter) Language() *sitter.Language    { return tsSwift.GetLanguage() }
+
+// ensureRoot returns the tree shared by the runner, parsing the source when
+// the adapter is used on its own (tests).
+func (a *TreeSitterAdapter) ensureRoot(src []byte) (*sitter.Node, []byte) {
+	source := a.src
+	if source == nil {
+		source = src
+	}
+	if a.root != nil {
+		return a.root, source
+	}
+	if source == nil {
+		return nil, nil
+	}
+	parser := sitter.NewParser()
+	parser.SetLanguage(a.Language())
+	a.root = parser.Parse(nil, source).RootNode()
+	return a.root, source
+}
+
+func (a *TreeSitterAdapter) IsModule(n *sitter.Node) bool { return n.Type() == "source_file" }
+
+// IsClass maps classes, structs, enums and actors to StmtClass: the grammar
+// declares them all with a class_declaration, told apart by their keyword.
+// An extension is not a class of its own: its methods are bound to the
+// extended type (see ReceiverTypeName).
+func (a *TreeSitterAdapter) IsClass(n *sitter.Node) bool {
+	return n.Type() == "class_declaration" && !isExtension(n)
+}
+
+func (a *TreeSitterAdapter) IsInterface(n *sitter.Node) bool {
+	return n.Type() == "protocol_declaration"
+}
+
+// isExtension reports whether a class_declaration is an extension block.
+func isExtension(n *sitter.Node) bool {
+	return declarationKind(n) == "extension"
+}
+
+// declarationKind returns the keyword of a type declaration ("class",
+// "struct", "enum", "actor", "extension").
+func declarationKind(n *sitter.Node) string {
+	if kind := n.ChildByFieldName("declaration_kind"); kind != nil {
+		return kind.Type()
+	}
+	return ""
+}
+
+func (a *TreeSitterAdapter) IsFunction(n *sitter.Node) bool {
+	// Protocol requirements (protocol_function_declaration) have no body to
+	// measure. Closures are not named functions; their bodies still
+	// contribute decisions to the enclosing function via the fallback
+	// recursion.
+	switch n.Type() {
+	case "function_declaration", "init_declaration", "deinit_declaration":
+		return true
+	}
+	return false
+}
+
+func (a *TreeSitterAdapter) NodeName(n *sitter.Node) string {
+	if a.src == nil || n == nil {
+		return ""
+	}
+	switch n.Type() {
+	case "init_declaration":
+		return "init"
+	case "deinit_declaration":
+		return "deinit"
+	case "class_declaration", "protocol_declaration":
+		name := n.ChildByFieldName("name")
+		if name != nil && name.Type() == "user_type" {
+			// the type an extension extends: "Outer.Inner" is named after
+			// Inner, its scope is reported by EnclosingScope
+			segments := typeSegments(a.src, name)
+			if len(segments) == 0 {
+				return ""
+			}
+			return segments[len(segments)-1]
+		}
+		return text(a.src, name)
+	}
+	// the return type of a function is a "name" field too: the first one is
+	// the name of the function
+	return text(a.src, n.ChildByFieldName("name"))
+}
+
+// typeSegments returns the names making a user_type: "Outer.Inner<T>" gives
+// ["Outer", "Inner"].
+func typeSegments(src []byte, t *sitter.Node) []string {
+	var segments []string
+	for i := 0; i < int(t.NamedChildCount()); i++ {
+		if ch := t.NamedChild(i); ch.Type() == "type_identifier" {
+			segments = append(segments, text(src, ch))
+		}
+	}
+	return segments
+}
+
+// EnclosingScope returns the types holding a declaration, joined with "."
+// (e.g. "Outer.Inner"). A type declared in an extension belongs to the
+// extended type.
+func (a *TreeSitterAdapter) EnclosingScope(n *sitter.Node) string {
+	if a.src == nil || n == nil {
+		return ""
+	}
+	var segments []string
+	for p := n.Parent(); p != nil; p = p.Parent() {
+		switch p.Type() {
+		case "class_declaration":
+			if isExtension(p) {
+				if name := p.ChildByFieldName("name"); name != nil {
+					segments = append(typeSegments(a.src, name), segments...)
+				}
+				continue
+			}
+			if name := a.NodeName(p); name != "" {
+				segments = append([]string{name}, segments...)
+			}
+		case "protocol_declaration":
+			if name := a.NodeName(p); name != "" {
+				segments = append([]string{name}, segments...)
+			}
+		}
+	}
+	return strings.Join(segments, ".")
+}
+
+// ReceiverTypeName returns the type extended by the extension holding a
+// method. The visitor moves the method into that type when the file declares
+// it, so that an extension adds to the class it extends instead of making a
+// class of its own.
+func (a *TreeSitterAdapter) ReceiverTypeName(n *sitter.Node) string {
+	body := n.Parent()
+	if body == nil || body.Type() != "class_body" {
+		return ""
+	}
+	if ext := body.Parent(); ext != nil && ext.Type() == "class_declaration" && isExtension(ext) {
+		return a.NodeName(ext)
+	}
+	return ""
+}
+
+func (a *TreeSitterAdapter) NodeBody(n *sitter.Node) *sitter.Node {
+	if n == nil {
+		return nil
+	}
+	return n.ChildByFieldName("body")
+}
+
+// NodeParams returns the declaration itself: the grammar lists the
+// parameters of a function among its direct children, with no list node.
+func (a *TreeSitterAdapter) NodeParams(n *sitter.Node) *sitter.Node {
+	return n
+}
+
+// EachParamIdent yields the internal name of each parameter: in
+// "grow(by factor: Double)", the parameter is "factor", "by" is its label.
+func (a *TreeSitterAdapter) EachParamIdent(params *sitter.Node, yield func(string)) {
+	if params == nil || a.src == nil {
+		return
+	}
+	for i := 0; i < int(params.NamedChildCount()); i++ {
+		p := params.NamedChild(i)
+		if p.Type() != "parameter" {
+			continue
+		}
+		if name := p.ChildByFieldName("name"); name != nil {
+			yield(text(a.src, name))
+		}
+	}
+}
+
+// ModuleNameFromPath returns an empty string: a Swift file declares no
+// namespace, its types belong to the module (the build target) as a whole.
+func (a *TreeSitterAdapter) ModuleNameFromPath(path string) string { return "" }
+
+func (a *TreeSitterAdapter) AttachQualified(parentClass string, fn string) string {
+	if parentClass == "" {
+		return fn
+	}
+	return parentClass + "." + fn
+}
+
+// NamespaceSeparator joins the enclosing types and the type name with ".".
+func (a *TreeSitterAdapter) NamespaceSeparator() string { return "." }
+
+func (a *TreeSitterAdapter) EachChildBody(body *sitter.Node, yield func(*sitter.Node)) {
+	if body == nil {
+		return
+	}
+	for i := 0; i < int(body.ChildCount()); i++ {
+		yield(body.Child(i))
+	}
+}
+
+// isElseBranch reports whether n follows the "else" keyword of an
+// if_statement: a nested if_statement for an else-if, the statements of the
+// braces otherwise.
+func isElseBranch(n *sitter.Node) bool {
+	p := n.Parent()
+	if p == nil || p.Type() != "if_statement" || !n.IsNamed() {
+		return false
+	}
+	prev := n.PrevSibling()
+	if prev != nil && prev.Type() == "{" {
+		prev = prev.PrevSibling()
+	}
+	return prev != nil && prev.Type() == "else"
+}
+
+func (a *TreeSitterAdapter) Decision(n *sitter.Node) (Treesitter.DecisionKind, *sitter.Node) {
+	if isElseBranch(n) {
+		if n.Type() == "if_statement" {
+			// else-if: re-visit the nested if so that deeper branches keep
+			// being counted
+			return Treesitter.DecElif, n
+		}
+		return Treesitter.DecElse, n
+	}
+
+	switch n.Type() {
+	case "if_statement":
+		return Treesitter.DecIf, consequence(n)
+
+	case "guard_statement":
+		// a guard is an if whose only branch is the early exit
+		return Treesitter.DecIf, firstChildOfType(n, "statements")
+
+	case "switch_statement":
+		return Treesitter.DecSwitch, n
+
+	case "switch_entry":
+		return Treesitter.DecCase, n
+
+	case "for_statement", "while_statement", "repeat_while_statement":
+		return Treesitter.DecLoop, firstChildOfType(n, "statements")
+	}
+	// ternaries, nil-coalescing and catch blocks intentionally left out,
+	// consistent with the other engines.
+	return Treesitter.DecNone, nil
+}
+
+// consequence returns the statements run when the condition of an if holds.
+// An empty block has no statements node: the first one found may belong to
+// the else branch.
+func consequence(n *sitter.Node) *sitter.Node {
+	for i := 0; i < int(n.ChildCount()); i++ {
+		switch ch := n.Child(i); ch.Type() {
+		case "statements":
+			return ch
+		case "else":
+			return nil
+		}
+	}
+	return nil
+}
+
+// Imports reports the imported module. A kind import ("import struct
+// Foundation.Date") depends on the declaration it names; a plain import of a
+// submodule ("import Foundation.NSData") on the submodule as a whole.
+func (a *TreeSitterAdapter) Imports(n *sitter.Node) []Treesitter.ImportItem {
+	if n == nil || n.Type() != "import_declaration" || a.src == nil {
+		return nil
+	}
+	id := firstChildOfType(n, "identifier")
+	if id == nil {
+		return nil
+	}
+	var path []string
+	for i := 0; i < int(id.NamedChildCount()); i++ {
+		path = append(path, text(a.src, id.NamedChild(i)))
+	}
+	if len(path) == 0 {
+		return nil
+	}
+	if len(path) > 1 && hasImportKind(n) {
+		return []Treesitter.ImportItem{{Module: strings.Join(path[:len(path)-1], "."), Name: path[len(path)-1]}}
+	}
+	return []Treesitter.ImportItem{{Module: strings.Join(path, "."), Name: ""}}
+}
+
+// hasImportKind reports whether an import names the kind of the imported
+// declaration ("import class UIKit.UIView").
+func hasImportKind(n *sitter.Node) bool {
+	for i := 0; i < int(n.ChildCount()); i++ {
+		switch n.Child(i).Type() {
+		case "typealias", "struct", "class", "enum", "protocol", "let", "var", "func":
+			return true
+		}
+	}
+	return false
+}
+
+// ImportsAsUses records the imports as StmtUse nodes: a Swift import brings a
+// whole module into scope, and the classifier reads the modules a file uses
+// from there.
+func (a *TreeSitterAdapter) ImportsAsUses() bool { return true }
+
+// Heritage reports the parent of a class and the protocols a type conforms
+// to. Swift writes both in the same list: only a class can inherit, and its
+// superclass comes first. The conformances added by the extensions of the
+// file count as those of the extended type.
+func (a *TreeSitterAdapter) Heritage(n *sitter.Node) Treesitter.Heritage {
+	var h Treesitter.Heritage
+	if a.src == nil || n == nil {
+		return h
+	}
+	for i, name := range a.inheritedTypes(n) {
+		if i == 0 && declarationKind(n) == "class" && !a.isProtocolOfFile(name.Short) {
+			h.Extends = append(h.Extends, name)
+			continue
+		}
+		h.Implements = append(h.Implements, name)
+	}
+	root, _ := a.ensureRoot(nil)
+	if root == nil {
+		return h
+	}
+	name := a.NodeName(n)
+	for i := 0; i < int(root.NamedChildCount()); i++ {
+		ext := root.NamedChild(i)
+		if ext.Type() == "class_declaration" && isExtension(ext) && a.NodeName(ext) == name {
+			h.Implements = append(h.Implements, a.inheritedTypes(ext)...)
+		}
+	}
+	return h
+}
+
+// inheritedTypes lists the types after the colon of a declaration.
+func (a *TreeSitterAdapter) inheritedTypes(n *sitter.Node) []*pb.Name {
+	var names []*pb.Name
+	for i := 0; i < int(n.NamedChildCount()); i++ {
+		spec := n.NamedChild(i)
+		if spec.Type() != "inheritance_specifier" {
+			continue
+		}
+		t := spec.ChildByFieldName("inherits_from")
+		if t == nil || t.Type() != "user_type" {
+			continue
+		}
+		segments := typeSegments(a.src, t)
+		if len(segments) == 0 {
+			continue
+		}
+		names = append(names, &pb.Name{Short: segments[len(segments)-1], Qualified: strings.Join(segments, ".")})
+	}
+	return names
+}
+
+// isProtocolOfFile reports whether the file declares a protocol of that name.
+func (a *TreeSitterAdapter) isProtocolOfFile(name string) bool {
+	root, _ := a.ensureRoot(nil)
+	if root == nil {
+		return false
+	}
+	for i := 0; i < int(root.NamedChildCount()); i++ {
+		if p := root.NamedChild(i); p.Type() == "protocol_declaration" && a.NodeName(p) == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Modifiers reports the modifiers of a type or a function. Swift declarations
+// are internal to their module unless stated otherwise; "open" is public and
+// "fileprivate" private. A "class func" is static like a "static func".
+func (a *TreeSitterAdapter) Modifiers(n *sitter.Node) *pb.Modifiers {
+	keywords := Treesitter.ModifierKeywords(n, map[string]bool{"modifiers": true})
+	m := Treesitter.KeywordModifiers(keywords, pb.Visibility_VISIBILITY_PACKAGE)
+	if n.Type() == "function_declaration" && Treesitter.HasKeyword(keywords, "class") {
+		m.IsStatic = true
+	}
+	return m
+}
+
+// CountElseIfAsIf: treat else-if as if for complexity aggregation (consistent with Java)
+func (a *TreeSitterAdapter) CountElseIfAsIf() bool { return true }
+
+// IsLogicalNode reports whether a node begins a logical line. Swift
+// statements carry no "_statement" suffix (calls, assignments and
+// declarations are not statements in the grammar): a logical line is anything
+// written directly in a block.
+func (a *TreeSitterAdapter) IsLogicalNode(n *sitter.Node) bool {
+	p := n.Parent()
+	return p != nil && p.Type() == "statements" && n.IsNamed() && n.Type() != "comment"
+}
+
+// CommentMarkers declares Swift comment tokens: "//" and "/* */" only.
+func (a *TreeSitterAdapter) CommentMarkers() engine.CommentMarkers {
+	return engine.CommentMarkers{SlashSlash: true, SlashStar: true}
+}
+
+// IsDocComment tells documentation comments ("///", "/**") from plain
+// comments.
+func (a *TreeSitterAdapter) IsDocComment(text string) bool {
+	return strings.HasPrefix(text, "///") || strings.HasPrefix(text, "/**")
+}
+
+// CountComments counts Swift comment lines (//, ///, /* */) in the given range.
+func (a *TreeSitterAdapter) CountComments(lines []string, start, end int) int {
+	cnt := 0
+	inBlock := false
+	for i := start - 1; i < end && i < len(lines); i++ {
+		ln := strings.TrimSpace(lines[i])
+		if ln == "" {
+			continue
+		}
+		clean := stripSwiftStrings(ln)
+		if inBlock {
+			cnt++
+			if strings.Contains(clean, "*/") {
+				inBlock = false
+			}
+			continue
+		}
+		if strings.HasPrefix(clean, "//") {
+			cnt++
+			continue
+		}
+		if strings.HasPrefix(clean, "/*") {
+			cnt++
+			if !strings.Contains(clean, "*/") {
+				inBlock = true
+			}
+			continue
+		}
+	}
+	return cnt
+}
+
+// swiftOperatorTokens lists the anonymous token types counted as Halstead
+// operators: the symbolic operators, member access, the argument separator,
+// the subscript, and the keywords driving the control flow.
+var swiftOperatorTokens = map[string]bool{
+	"+": true, "-": true, "*": true, "/": true, "%": true,
+	"==": true, "!=": true, "===": true, "!==": true,
+	"<": true, ">": true, "<=": true, ">=": true,
+	"&&": true, "||": true, "!": true, "??": true,
+	"=": true, "+=": true, "-=": true, "*=": true, "/=": true, "%=": true,
+	"...": true, "..<": true, "->": true,
+	".": true, "?.": true, ",": true, "[": true, "?": true,
+	"return": true, "if": true, "guard": true, "for": true, "while": true,
+	"repeat": true, "switch": true, "case": true, "break": true,
+	"continue": true, "fallthrough": true, "in": true,
+	"is": true, "as": true, "as?": true, "as!": true,
+	"throw": true, "try": true, "catch": true, "do": true, "defer": true,
+	"await": true,
+}
+
+// swiftOperatorTypes lists the named node types read as operators: the grammar
+// gives the "else" and "default" keywords a named node.
+var swiftOperatorTypes = map[string]bool{"else": true, "default_keyword": true}
+
+// swiftOperandTypes lists the named node types counted as Halstead operands.
+// Literals are left out on purpose, as in Java. A navigation ("self.items")
+// has no three-part chain node in tree-sitter-swift: as in Kotlin, each name
+// is an operand of its own.
+var swiftOperandTypes = map[string]bool{"simple_identifier": true}
+
+var swiftCallTypes = map[string]bool{"call_expression": true}
+
+// swiftPruneTypes lists the node types never walked: types, modifiers and
+// attributes describe the declaration, not what it computes.
+var swiftPruneTypes = map[string]bool{
+	"user_type": true, "optional_type": true, "array_type": true,
+	"dictionary_type": true, "function_type": true, "tuple_type": true,
+	"type_annotation": true, "type_arguments": true, "type_parameters": true,
+	"type_constraints": true, "modifiers": true, "attribute": true,
+	"inheritance_specifier": true, "import_declaration": true,
+}
+
+var swiftOperandSpec = Treesitter.OperandSpec{
+	OperatorTokens: swiftOperatorTokens,
+	OperatorTypes:  swiftOperatorTypes,
+	OperandTypes:   swiftOperandTypes,
+	CallTypes:      swiftCallTypes,
+	PruneTypes:     swiftPruneTypes,
+}
+
+// ExtractOperatorsOperands collects Halstead operators and operands from the
+// AST within the given 1-based inclusive line range.
+func (a *TreeSitterAdapter) ExtractOperatorsOperands(src []byte, startLine, endLine int) ([]string, []string) {
+	root, source := a.ensureRoot(src)
+	if root == nil {
+		return nil, nil
+	}
+	return swiftOperandSpec.Extract(root, source, startLine, endLine)
+}
+
+// ExtractMethodCalls extracts the calls made on the current object, through
+// "self." or without any object, as Swift members usually call each other.
+// A capitalized callee creates an instance ("Circle(radius: 1)"): it is not a
+// method call.
+func (a *TreeSitterAdapter) ExtractMethodCalls(src []byte, startLine, endLine int) []string {
+	root, source := a.ensureRoot(src)
+	if root == nil || startLine <= 0 || endLine < startLine {
+		return nil
+	}
+	var calls []string
+	var walk func(n *sitter.Node)
+	walk = func(n *sitter.Node) {
+		if int(n.EndPoint().Row)+1 < startLine || int(n.StartPoint().Row)+1 > endLine {
+			return
+		}
+		if n.Type() == "call_expression" && n.NamedChildCount() > 0 {
+			callee := n.NamedChild(0)
+			switch callee.Type() {
+			case "simple_identifier":
+				if name := text(source, callee); !isCapitalized(name) {
+					calls = append(calls, "this."+name)
+				}
+			case "navigation_expression":
+				object, suffix := callee.ChildByFieldName("target"), callee.ChildByFieldName("suffix")
+				if object != nil && suffix != nil {
+					if name := suffix.ChildByFieldName("suffix"); name != nil && name.Type() == "simple_identifier" {
+						switch object.Type() {
+						case "self_expression":
+							calls = append(calls, "this."+text(source, name))
+						case "super_expression":
+							calls = append(calls, "super."+text(source, name))
+						}
+					}
+				}
+			}
+		}
+		for i := 0; i < int(n.ChildCount()); i++ {
+			walk(n.Child(i))
+		}
+	}
+	walk(root)
+	return calls
+}
+
+// ClassDirectOperands lists the stored properties declared in the body of a
+// type. A computed property holds no state of its own.
+func (a *TreeSitterAdapter) ClassDirectOperands(n *sitter.Node) []string {
+	if n == nil || a.src == nil {
+		return nil
+	}
+	body := n.ChildByFieldName("body")
+	if body == nil {
+		return nil
+	}
+	var props []string
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		decl := body.NamedChild(i)
+		if decl.Type() != "property_declaration" || decl.ChildByFieldName("computed_value") != nil {
+			continue
+		}
+		if pattern := decl.ChildByFieldName("name"); pattern != nil {
+			if id := pattern.ChildByFieldName("bound_identifier"); id != nil {
+				props = append(props, text(a.src, id))
+			}
+		}
+	}
+	return props
+}
+
+// --- helpers ---
+
+func text(src []byte, n *sitter.Node) string {
+	if n == nil || src == nil {
+		return ""
+	}
+	return string(src[n.StartByte():n.EndByte()])
+}
+
+func firstChildOfType(n *sitter.Node, t string) *sitter.Node {
+	if n == nil {
+		return nil
+	}
+	for i := 0; i < int(n.ChildCount()); i++ {
+		if c := n.Child(i); c.Type() == t {
+			return c
+		}
+	}
+	return nil
+}
+
+func isCapitalized(name string) bool {
+	return name != "" && strings.ToUpper(name[:1]) == name[:1] && strings.ToLower(name[:1]) != name[:1]
+}
+
+// stripSwiftStrings removes content inside string literals to avoid false
+// positives in comment scanning.
+func stripSwiftStrings(s string) string {
+	out := make([]rune, 0, len(s))
+	inDq := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '\\' {
+			if i+1 < len(s) {
+				i++
+			}
+			continue
+		}
+		if c == '"' {
+			inDq = !inDq
+			continue
+		}
+		if inDq {
+			continue
+		}
+		out = append(out, rune(c))
+	}
+	return string(out)
+}
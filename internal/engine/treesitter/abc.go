@@ -0,0 +1,215 @@
+package treesitter
+
+import (
+	"strings"
+
+	"github.com/ast-metrics/ast-metrics/internal/engine"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// The ABC size measures the size of a function by what it does rather than by
+// its lines (J. Fitzpatrick, 1997), as RuboCop and Flog report it:
+//
+//   - A counts the assignments: "=", "+=", "++", and the declarations of a
+//     variable with a value;
+//   - B counts the branches: the calls of functions and methods, and the
+//     creation of objects ("new");
+//   - C counts the conditions: the comparisons ("==", "<"...), else, case,
+//     catch and "?", and the tests that compare nothing ("if ok").
+//
+// The size is the magnitude of the vector, sqrt(A² + B² + C²). Lambdas count
+// in the function declaring them; named functions declared in the function
+// are measured on their own.
+
+// abcIncrementTypes lists the nodes that may increment or decrement a variable
+// ("i++", "--i").
+var abcIncrementTypes = map[string]bool{
+	"update_expression":         true,
+	"inc_statement":             true,
+	"dec_statement":             true,
+	"postfix_expression":        true,
+	"prefix_expression":         true,
+	"postfix_unary_expression":  true,
+	"prefix_unary_expression":   true,
+	"postfix_update_expression": true,
+	"prefix_update_expression":  true,
+}
+
+// abcDeclarationTypes lists the declarations of variables, that assign when
+// they are given a value.
+var abcDeclarationTypes = map[string]bool{
+	"variable_declarator":   true,
+	"init_declarator":       true,
+	"short_var_declaration": true,
+	"var_spec":              true,
+	"const_spec":            true,
+	"let_declaration":       true,
+	"property_declaration":  true,
+	"val_definition":        true,
+	"var_definition":        true,
+	"variable_declaration":  true,
+	"declaration":           true, // Groovy; C declares in an init_declarator
+}
+
+// abcCreationTypes lists the creations of objects, that call a constructor.
+var abcCreationTypes = map[string]bool{
+	"object_creation_expression":          true,
+	"implicit_object_creation_expression": true,
+	"new_expression":                      true,
+	"instance_expression":                 true,
+}
+
+// abcComparisonOperators lists the operators comparing two values.
+var abcComparisonOperators = map[string]bool{
+	"==": true, "!=": true, "===": true, "!==": true, "~=": true,
+	"<": true, "<=": true, ">": true, ">=": true, "<=>": true, "<>": true,
+}
+
+type abcWalker struct {
+	ad                                LangAdapter
+	src                               []byte
+	assignments, branches, conditions int32
+}
+
+// AbcSize returns the ABC vector and size of a function node.
+func AbcSize(ad LangAdapter, fn *sitter.Node, src []byte) *pb.AbcSize {
+	w := &abcWalker{ad: ad, src: src}
+	if body := ad.NodeBody(fn); body != nil {
+		if body.Equal(fn) {
+			// the adapter reads the function node as its own body
+			w.children(body)
+		} else {
+			w.walk(body)
+		}
+	}
+	return engine.NewAbcSize(w.assignments, w.branches, w.conditions)
+}
+
+func (w *abcWalker) walk(n *sitter.Node) {
+	if n == nil || !n.IsNamed() {
+		return
+	}
+	if w.ad.IsFunction(n) {
+		// measured on its own
+		return
+	}
+
+	kind, _ := w.ad.Decision(n)
+	switch {
+	case w.isAssignment(n):
+		w.assignments++
+	case kind == DecNone && (isCall(n) || abcCreationTypes[n.Type()]):
+		w.branches++
+	case w.isComparison(n):
+		w.conditions++
+	}
+
+	switch {
+	case kind == DecElse, kind == DecCase, cognitiveCatchTypes[n.Type()]:
+		w.conditions++
+	case kind == DecIf, kind == DecElif, kind == DecLoop:
+		w.test(conditionOf(w.ad, n))
+		if kind != DecLoop {
+			w.plainElse(n)
+		}
+	case cognitiveTernaryTypes[n.Type()]:
+		w.conditions++
+		w.test(conditionOf(w.ad, n))
+	case logicalOperator(n, w.src) != "":
+		w.test(firstNamedChild(n))
+		w.test(n.NamedChild(int(n.NamedChildCount()) - 1))
+	}
+	w.children(n)
+}
+
+func (w *abcWalker) children(n *sitter.Node) {
+	for i := 0; i < int(n.ChildCount()); i++ {
+		w.walk(n.Child(i))
+	}
+}
+
+// test counts the condition of a decision, or an operand of a boolean
+// operator, when it compares nothing: comparisons and boolean operators are
+// counted as such.
+func (w *abcWalker) test(n *sitter.Node) {
+	for n != nil && n.Type() == "parenthesized_expression" && n.NamedChildCount() == 1 {
+		n = firstNamedChild(n)
+	}
+	if n == nil || w.isComparison(n) || logicalOperator(n, w.src) != "" {
+		return
+	}
+	w.conditions++
+}
+
+// plainElse counts the else of an if when the grammar makes a plain block of
+// it, with no node of its own ("} else {" in Java or Go).
+func (w *abcWalker) plainElse(n *sitter.Node) {
+	afterElse := false
+	for i := 0; i < int(n.ChildCount()); i++ {
+		ch := n.Child(i)
+		if !ch.IsNamed() {
+			afterElse = ch.Type() == "else"
+			continue
+		}
+		if afterElse || n.FieldNameForChild(i) == "alternative" {
+			if kind, _ := w.ad.Decision(ch); kind == DecNone {
+				w.conditions++
+			}
+		}
+		afterElse = false
+	}
+}
+
+func (w *abcWalker) isAssignment(n *sitter.Node) bool {
+	t := n.Type()
+	if abcDeclarationTypes[t] {
+		return n.ChildByFieldName("value") != nil || n.ChildByFieldName("right") != nil ||
+			n.ChildByFieldName("initializer") != nil || hasChildToken(n, "=")
+	}
+	if abcIncrementTypes[t] {
+		return hasChildToken(n, "++") || hasChildToken(n, "--")
+	}
+	// "x = 1" in Elixir, "x += 1" in Scala: an operator like the others
+	if field := n.ChildByFieldName("operator"); field != nil && n.NamedChildCount() >= 2 {
+		op := field.Content(w.src)
+		if strings.HasSuffix(op, "=") && !abcComparisonOperators[op] {
+			return true
+		}
+	}
+	// "assignment_expression", "augmented_assignment", "operator_assignment"...
+	// but not the operator of an assignment, when the grammar names it
+	return strings.Contains(t, "assignment") && !strings.HasSuffix(t, "operator") &&
+		!strings.HasSuffix(t, "pattern")
+}
+
+// isComparison reports whether the node compares two values, whatever the
+// grammar calls it.
+func (w *abcWalker) isComparison(n *sitter.Node) bool {
+	if n.NamedChildCount() < 2 {
+		return false
+	}
+	if field := n.ChildByFieldName("operator"); field != nil {
+		return abcComparisonOperators[field.Content(w.src)]
+	}
+	t := n.Type()
+	if !strings.Contains(t, "binary") && !strings.Contains(t, "comparison") && !strings.Contains(t, "equality") {
+		return false
+	}
+	for i := 0; i < int(n.ChildCount()); i++ {
+		if ch := n.Child(i); !ch.IsNamed() && abcComparisonOperators[ch.Type()] {
+			return true
+		}
+	}
+	return false
+}
+
+// hasChildToken reports whether an anonymous child of the node is the token.
+func hasChildToken(n *sitter.Node, token string) bool {
+	for i := 0; i < int(n.ChildCount()); i++ {
+		if ch := n.Child(i); !ch.IsNamed() && ch.Type() == token {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,264 @@
+package treesitter_test
+
+import (
+	"testing"
+
+	enginePkg "github.com/ast-metrics/ast-metrics/internal/engine"
+	"github.com/ast-metrics/ast-metrics/internal/engine/elixir"
+	"github.com/ast-metrics/ast-metrics/internal/engine/golang"
+	"github.com/ast-metrics/ast-metrics/internal/engine/groovy"
+	"github.com/ast-metrics/ast-metrics/internal/engine/java"
+	"github.com/ast-metrics/ast-metrics/internal/engine/kotlin"
+	"github.com/ast-metrics/ast-metrics/internal/engine/lua"
+	"github.com/ast-metrics/ast-metrics/internal/engine/php"
+	"github.com/ast-metrics/ast-metrics/internal/engine/python"
+	"github.com/ast-metrics/ast-metrics/internal/engine/ruby"
+	"github.com/ast-metrics/ast-metrics/internal/engine/rust"
+)
+
+// Each sample assigns, calls and tests the same way in its own grammar.
+// Expected vectors are counted by hand: <assignments, branches, conditions>.
+func TestAbcSize_ByLanguage(t *testing.T) {
+	cases := []struct {
+		lang     string
+		runner   enginePkg.Engine
+		code     string
+		function string
+		expected [3]int32
+	}{
+		// A: :=, var =, +=, ++, := and ++ of the loop, =
+		// B: foo, bar.baz
+		// C: >, ok, else, <, case, default
+		{"go", &golang.GolangRunner{}, `package main
+
+func f(a int, ok bool) int {
+	x := 1
+	var y = 2
+	x += a
+	x++
+	if a > 1 && ok {
+		foo(x)
+	} else {
+		bar.baz(y)
+	}
+	for i := 0; i < a; i++ {
+		x = i
+	}
+	switch a {
+	case 1:
+		return 1
+	default:
+		return 2
+	}
+}
+`, "f", [3]int32{7, 2, 6}},
+		// C: >, ok, ==, else, <, except, the conditional expression
+		{"python", &python.PythonRunner{}, `def f(a, ok):
+    x = 1
+    x += a
+    if a > 1 and ok:
+        foo(x)
+    elif a == 0:
+        bar.baz(x)
+    else:
+        x = Cart()
+    while x < 3:
+        x = x + 1
+    try:
+        g()
+    except ValueError:
+        pass
+    return x if ok else 0
+`, "f", [3]int32{4, 4, 7}},
+		// B: foo, bar.baz, Cart.new; C: >, ok, ==, else, when, rescue
+		{"ruby", &ruby.RubyRunner{}, `def f(a, ok)
+  x = 1
+  x += a
+  if a > 1 && ok
+    foo(x)
+  elsif a == 0
+    bar.baz(x)
+  else
+    x = Cart.new
+  end
+  case a
+  when 1 then x = 2
+  end
+  x
+rescue StandardError
+  0
+end
+`, "f", [3]int32{4, 3, 6}},
+		// B: foo, this.bar, new B(), c, g; C: >, ok, ==, else, catch, ?, ok
+		{"java", &java.JavaRunner{}, `class A {
+  int f(int a, boolean ok) {
+    int x = 1;
+    x += a;
+    x++;
+    if (a > 1 && ok) {
+      foo(x);
+    } else if (a == 0) {
+      this.bar(x);
+    } else {
+      x = new B().c();
+    }
+    try { g(); } catch (Exception e) { }
+    return ok ? x : 0;
+  }
+}
+`, "f", [3]int32{4, 5, 7}},
+		// C: >, $ok, ==, else, ?, $ok
+		{"php", &php.PhpRunner{}, `<?php
+function f($a, $ok) {
+  $x = 1;
+  $x += $a;
+  $x++;
+  if ($a > 1 && $ok) {
+    foo($x);
+  } elseif ($a == 0) {
+    $this->bar($x);
+  } else {
+    $x = new B();
+  }
+  return $ok ? $x : 0;
+}
+`, "f", [3]int32{4, 3, 6}},
+		// C: >, ok, else, and the two arms of the match
+		{"rust", &rust.RustRunner{}, `fn f(a: i32, ok: bool) -> i32 {
+    let mut x = 1;
+    x += a;
+    if a > 1 && ok {
+        foo(x);
+    } else {
+        x = bar.baz(x);
+    }
+    match a {
+        1 => x,
+        _ => 0,
+    }
+}
+`, "f", [3]int32{3, 2, 5}},
+		// "if" is a macro, not a branch
+		{"elixir", &elixir.ElixirRunner{}, `defmodule M do
+  def f(a, ok) do
+    x = 1
+    if a > 1 and ok do
+      foo(x)
+    else
+      Bar.baz(x)
+    end
+  end
+end
+`, "f", [3]int32{1, 2, 3}},
+		{"lua", &lua.LuaRunner{}, `function f(a, ok)
+  local x = 1
+  x = x + a
+  if a > 1 and ok then
+    foo(x)
+  else
+    x = bar.baz(x)
+  end
+  return x
+end
+`, "f", [3]int32{3, 2, 3}},
+		// the tests that compare nothing, where the grammar gives no field to
+		// the condition. C: ok, else, ok
+		{"kotlin: if ok", &kotlin.KotlinRunner{}, `fun f(a: Int, ok: Boolean): Int {
+  var x = 1
+  if (ok) {
+    foo(x)
+  } else {
+    x = bar.baz(x)
+  }
+  while (ok) {
+    x += a
+  }
+  return x
+}
+`, "f", [3]int32{3, 2, 3}},
+		{"lua: if ok", &lua.LuaRunner{}, `function f(a, ok)
+  local x = 1
+  if ok then
+    foo(x)
+  end
+  while ok do
+    x = x + a
+  end
+  return x
+end
+`, "f", [3]int32{2, 1, 2}},
+		{"elixir: if ok", &elixir.ElixirRunner{}, `defmodule M do
+  def f(a, ok) do
+    if ok do
+      foo(a)
+    end
+  end
+end
+`, "f", [3]int32{0, 1, 1}},
+		// A: def x =, int y =, x =, x =; C: ok, else
+		{"groovy", &groovy.GroovyRunner{}, `class A {
+  def f(a, ok) {
+    def x = 1
+    int y = 2
+    if (ok) {
+      x = foo(y)
+    } else {
+      x = 2
+    }
+    return x
+  }
+}
+`, "f", [3]int32{4, 1, 2}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.lang, func(t *testing.T) {
+			file, err := enginePkg.CreateTestFileWithCode(tc.runner, tc.code)
+			if err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+			var found bool
+			for _, fn := range enginePkg.GetFunctionsInFile(file) {
+				if fn.Name.Short != tc.function {
+					continue
+				}
+				found = true
+				abc := fn.Stmts.GetAnalyze().GetAbcSize()
+				got := [3]int32{abc.GetAssignments(), abc.GetBranches(), abc.GetConditions()}
+				if got != tc.expected {
+					t.Errorf("%s(): expected ABC vector %v, got %v", tc.function, tc.expected, got)
+				}
+			}
+			if !found {
+				t.Fatalf("function %s not found", tc.function)
+			}
+		})
+	}
+}
+
+func TestAbcSize_MagnitudeOfTheVector(t *testing.T) {
+	file, err := enginePkg.CreateTestFileWithCode(&golang.GolangRunner{}, `package main
+
+func f(a int) int {
+	x := a
+	y := a
+	z := a
+	if a > 0 && x > y {
+		x = 1
+	}
+	foo(x, y, z)
+	bar()
+	baz()
+	qux()
+	return x
+}
+`)
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	abc := enginePkg.GetFunctionsInFile(file)[0].Stmts.GetAnalyze().GetAbcSize()
+	// <4, 4, 2>: sqrt(16 + 16 + 4) = 6
+	if abc.GetMagnitude() != 6 {
+		t.Errorf("expected an ABC size of 6, got %v <%d, %d, %d>", abc.GetMagnitude(), abc.GetAssignments(), abc.GetBranches(), abc.GetConditions())
+	}
+}
@@ -0,0 +1,63 @@
+package treesitter
+
+import (
+	"strings"
+
+	pb "github.com/ast-metrics/ast-metrics/pb"
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// AnnotationAware lets an adapter report the annotations written on a class
+// or a function: Java annotations, PHP, C# and Rust attributes, Python and
+// TypeScript decorators. Frameworks rely on them to give a role to a class
+// (@Entity, #[Route], [ApiController]).
+type AnnotationAware interface {
+	Annotations(*sitter.Node) []*pb.Annotation
+}
+
+// NewAnnotation builds an annotation from its name as written
+// ("javax.persistence.Entity", "app.get"): the short name is the part after
+// the last separator.
+func NewAnnotation(qualified string, separator string, arguments []string) *pb.Annotation {
+	short := qualified
+	if i := strings.LastIndex(qualified, separator); i >= 0 {
+		short = qualified[i+len(separator):]
+	}
+	return &pb.Annotation{Name: &pb.Name{Short: short, Qualified: qualified}, Arguments: arguments}
+}
+
+// AnnotationArguments returns the source of each argument of an argument
+// list, split on its top-level commas. The parentheses around the list are
+// left out.
+func AnnotationArguments(list *sitter.Node, src []byte) []string {
+	if list == nil || src == nil {
+		return nil
+	}
+	var arguments []string
+	start, end := -1, -1
+	flush := func() {
+		if start >= 0 {
+			arguments = append(arguments, string(src[start:end]))
+		}
+		start, end = -1, -1
+	}
+	count := int(list.ChildCount())
+	for i := 0; i < count; i++ {
+		ch := list.Child(i)
+		if !ch.IsNamed() {
+			switch {
+			case ch.Type() == ",":
+				flush()
+				continue
+			case (i == 0 || i == count-1) && (ch.Type() == "(" || ch.Type() == ")"):
+				continue
+			}
+		}
+		if start < 0 {
+			start = int(ch.StartByte())
+		}
+		end = int(ch.EndByte())
+	}
+	flush()
+	return arguments
+}
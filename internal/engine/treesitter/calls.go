@@ -0,0 +1,144 @@
+package treesitter
+
+import (
+	"regexp"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// callTypes lists the node types of a call, whatever the grammar.
+var callTypes = map[string]bool{
+	"call_expression":                 true, // Go, TypeScript, Rust, C, Kotlin, Swift, Scala
+	"method_invocation":               true, // Java
+	"invocation_expression":           true, // C#
+	"call":                            true, // Python, Ruby, Elixir
+	"function_call":                   true, // Lua, Groovy
+	"juxt_function_call":              true, // Groovy, without parentheses
+	"function_call_expression":        true, // PHP
+	"member_call_expression":          true,
+	"nullsafe_member_call_expression": true,
+	"scoped_call_expression":          true,
+}
+
+// calleeFields lists the fields holding the callee of a call, by order of
+// preference: Java and PHP name the method of a call on an object, Ruby
+// separates the receiver from the method.
+var calleeFields = []string{"name", "method", "function", "target"}
+
+// argumentTypes lists the node types opening the arguments of a call, for the
+// grammars that give no field to the callee (Kotlin, Swift, Lua).
+var argumentTypes = map[string]bool{
+	"call_suffix":         true,
+	"function_call_paren": true,
+	"function_arguments":  true,
+	"arguments":           true,
+	"argument_list":       true,
+	"value_arguments":     true,
+}
+
+// memberFields lists the fields holding the name of the member accessed, from
+// the receiver: "repo.find" names "find".
+var memberFields = []string{"name", "field", "property", "attribute", "method", "right", "suffix"}
+
+var calledName = regexp.MustCompile(`^[\pL_$][\pL\pN_$!?]*$`)
+
+// CalledMethods returns the distinct names of the methods and functions called
+// by a function, on any receiver: "this.load()", "repo.find()" and "format()"
+// give "load", "find" and "format". The receivers are not resolved, so two
+// methods sharing a name count once. A node the adapter reads as a
+// declaration, an import or a decision is not a call (Elixir writes "def" and
+// "if" as calls), and the nested functions keep their calls for themselves.
+func CalledMethods(ad LangAdapter, fn *sitter.Node, src []byte) []string {
+	if fn == nil || src == nil {
+		return nil
+	}
+	// the parameters call nothing, and an Elixir function writes its head
+	// ("run(c)") as a call: both are left out
+	var head *sitter.Node
+	params := ad.NodeParams(fn)
+	if params != nil && params.Equal(fn) {
+		params = nil
+	}
+	if params != nil {
+		head = params.Parent()
+	}
+	seen := map[string]bool{}
+	var names []string
+	var walk func(n *sitter.Node)
+	walk = func(n *sitter.Node) {
+		if !n.Equal(fn) && (ad.IsFunction(n) || ad.IsClass(n)) {
+			return
+		}
+		if params != nil && n.Equal(params) {
+			return
+		}
+		if callTypes[n.Type()] && !n.Equal(fn) && (head == nil || !n.Equal(head)) && !isDeclarationCall(ad, n) {
+			if name := calleeName(n, src); name != "" && !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+		for i := 0; i < int(n.NamedChildCount()); i++ {
+			walk(n.NamedChild(i))
+		}
+	}
+	walk(fn)
+	return names
+}
+
+func isDeclarationCall(ad LangAdapter, n *sitter.Node) bool {
+	if kind, _ := ad.Decision(n); kind != DecNone {
+		return true
+	}
+	return len(ad.Imports(n)) > 0
+}
+
+// calleeName returns the name of the method or function a call invokes, or ""
+// when the callee is not a plain name (a call of a closure, of an index).
+func calleeName(call *sitter.Node, src []byte) string {
+	var callee *sitter.Node
+	for _, field := range calleeFields {
+		if callee = call.ChildByFieldName(field); callee != nil {
+			break
+		}
+	}
+	if callee == nil {
+		for i := 0; i < int(call.NamedChildCount()); i++ {
+			ch := call.NamedChild(i)
+			if argumentTypes[ch.Type()] {
+				break
+			}
+			callee = ch
+		}
+	}
+	for callee != nil && callee.NamedChildCount() > 0 {
+		callee = memberName(callee)
+	}
+	if callee == nil {
+		return ""
+	}
+	if name := nodeText(src, callee); calledName.MatchString(name) {
+		return name
+	}
+	return ""
+}
+
+// memberName returns the part of a callee naming the member called: the
+// member of an access ("repo.find"), the name of a generic function
+// ("Parse<int>").
+func memberName(n *sitter.Node) *sitter.Node {
+	for _, field := range memberFields {
+		if member := n.ChildByFieldName(field); member != nil {
+			return member
+		}
+	}
+	var last *sitter.Node
+	for i := 0; i < int(n.NamedChildCount()); i++ {
+		ch := n.NamedChild(i)
+		if ch.Type() == "type_arguments" || ch.Type() == "type_argument_list" {
+			break
+		}
+		last = ch
+	}
+	return last
+}
@@ -0,0 +1,157 @@
+package treesitter_test
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	enginePkg "github.com/ast-metrics/ast-metrics/internal/engine"
+	"github.com/ast-metrics/ast-metrics/internal/engine/csharp"
+	"github.com/ast-metrics/ast-metrics/internal/engine/elixir"
+	"github.com/ast-metrics/ast-metrics/internal/engine/golang"
+	"github.com/ast-metrics/ast-metrics/internal/engine/groovy"
+	"github.com/ast-metrics/ast-metrics/internal/engine/java"
+	"github.com/ast-metrics/ast-metrics/internal/engine/kotlin"
+	"github.com/ast-metrics/ast-metrics/internal/engine/lua"
+	"github.com/ast-metrics/ast-metrics/internal/engine/php"
+	"github.com/ast-metrics/ast-metrics/internal/engine/python"
+	"github.com/ast-metrics/ast-metrics/internal/engine/ruby"
+	"github.com/ast-metrics/ast-metrics/internal/engine/rust"
+	"github.com/ast-metrics/ast-metrics/internal/engine/scala"
+	"github.com/ast-metrics/ast-metrics/internal/engine/swift"
+	"github.com/ast-metrics/ast-metrics/internal/engine/typescript"
+)
+
+// Every function calls a method on another object, a chained method, a plain
+// function and a method of its own class.
+func TestCalledMethods_ByLanguage(t *testing.T) {
+	cases := []struct {
+		lang   string
+		runner enginePkg.Engine
+		code   string
+	}{
+		{"go", &golang.GolangRunner{}, `package main
+
+func (s *Service) run(c int) {
+	s.repo.find(c).name()
+	format(c)
+	s.load()
+}
+`},
+		{"java", &java.JavaRunner{}, `class Service {
+  void run(int c) {
+    repo.find(c).name();
+    format(c);
+    this.load();
+  }
+}`},
+		{"csharp", &csharp.CSharpRunner{}, `class Service {
+  void run(int c) {
+    repo.find(c).name();
+    format<int>(c);
+    this.load();
+  }
+}`},
+		{"php", &php.PhpRunner{}, `<?php
+class Service {
+  function run($c) {
+    $this->repo->find($c)?->name();
+    format($c);
+    self::load();
+  }
+}`},
+		{"python", &python.PythonRunner{}, `class Service:
+    def run(self, c):
+        self.repo.find(c).name()
+        format(c)
+        self.load()
+`},
+		{"typescript", &typescript.TypeScriptRunner{}, `class Service {
+  run(c: number) {
+    this.repo.find(c).name();
+    format(c);
+    this.load();
+  }
+}`},
+		{"kotlin", &kotlin.KotlinRunner{}, `class Service {
+  fun run(c: Int) {
+    repo.find(c).name()
+    format(c)
+    this.load()
+  }
+}`},
+		{"swift", &swift.SwiftRunner{}, `class Service {
+  func run(c: Int) {
+    repo.find(c).name()
+    format(c)
+    self.load()
+  }
+}`},
+		{"scala", &scala.ScalaRunner{}, `class Service {
+  def run(c: Int) = {
+    repo.find(c).name()
+    format(c)
+    this.load()
+  }
+}`},
+		{"rust", &rust.RustRunner{}, `impl Service {
+    fn run(&self, c: i32) {
+        self.repo.find(c).name();
+        format(c);
+        Service::load();
+    }
+}`},
+		{"ruby", &ruby.RubyRunner{}, `class Service
+  def run(c)
+    repo.find(c).name
+    format(c)
+    self.load
+  end
+end`},
+		{"groovy", &groovy.GroovyRunner{}, `class Service {
+  def run(c) {
+    repo.find(c).name()
+    format(c)
+    load()
+  }
+}`},
+		{"lua", &lua.LuaRunner{}, `function Service:run(c)
+  self.repo.find(c):name()
+  format(c)
+  self:load()
+end
+`},
+		// "def" and "if" are calls for the grammar, not for the metric
+		{"elixir", &elixir.ElixirRunner{}, `defmodule Service do
+  def run(c) do
+    if c, do: Repo.find(c) |> name()
+    format(c)
+    load()
+  end
+end
+`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.lang, func(t *testing.T) {
+			file, err := enginePkg.CreateTestFileWithCode(tc.runner, tc.code)
+			if err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+			var found bool
+			for _, fn := range enginePkg.GetFunctionsInFile(file) {
+				if fn.Name.Short != "run" {
+					continue
+				}
+				found = true
+				calls := append([]string{}, fn.CalledMethods...)
+				sort.Strings(calls)
+				if got := strings.Join(calls, ","); got != "find,format,load,name" {
+					t.Errorf("expected the calls find,format,load,name, got %s", got)
+				}
+			}
+			if !found {
+				t.Fatal("function run() not found")
+			}
+		})
+	}
+}
@@ -0,0 +1,346 @@
+package treesitter
+
+import (
+	"regexp"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// Cognitive complexity measures how hard the control flow of a function is to
+// follow (G. Ann Campbell, SonarSource, 2017). Unlike the cyclomatic
+// complexity, a flat switch costs one point whatever its number of cases,
+// while every level of nesting makes the structures it holds more expensive:
+//
+//   - if, ternary, switch, loop and catch cost 1, plus 1 per level of nesting;
+//   - else if and else cost 1, whatever their nesting;
+//   - a sequence of like boolean operators costs 1 ("a && b && c" costs 1,
+//     "a && b || c" costs 2);
+//   - a recursive call, a goto and a jump to a label cost 1;
+//   - a nested function or a lambda adds a level of nesting to its content.
+//
+// Decisions are recognized through the adapter; the other structures share
+// the same node types in most grammars.
+
+// cognitiveTernaryTypes lists the conditional expressions ("a ? b : c").
+var cognitiveTernaryTypes = map[string]bool{
+	"ternary_expression":     true,
+	"conditional_expression": true,
+}
+
+// cognitiveCatchTypes lists the clauses handling an exception.
+var cognitiveCatchTypes = map[string]bool{
+	"catch_clause":  true,
+	"catch_block":   true,
+	"except_clause": true,
+	"rescue":        true,
+}
+
+// cognitiveLambdaTypes lists the anonymous functions, that nest their content.
+var cognitiveLambdaTypes = map[string]bool{
+	"lambda":                                   true,
+	"lambda_expression":                        true,
+	"lambda_literal":                           true,
+	"arrow_function":                           true,
+	"function_expression":                      true,
+	"func_literal":                             true,
+	"closure_expression":                       true,
+	"closure":                                  true,
+	"anonymous_function":                       true,
+	"anonymous_function_creation_expression":   true,
+	"anonymous_method_expression":              true,
+	"anonymous_function_use_clause_expression": true,
+}
+
+// cognitiveJumpTypes lists the statements that may jump to a label.
+var cognitiveJumpTypes = map[string]bool{
+	"break_statement":     true,
+	"continue_statement":  true,
+	"break_expression":    true,
+	"continue_expression": true,
+}
+
+// trailingIdentifier matches the name ending a callee ("this.visit",
+// "Foo::bar", "self.walk").
+var trailingIdentifier = regexp.MustCompile(`[A-Za-z_][A-Za-z0-9_]*[!?]?$`)
+
+type cognitiveWalker struct {
+	ad    LangAdapter
+	src   []byte
+	name  string
+	score int32
+}
+
+// CognitiveComplexity returns the cognitive complexity of a function node.
+func CognitiveComplexity(ad LangAdapter, fn *sitter.Node, src []byte) int32 {
+	body := ad.NodeBody(fn)
+	if body == nil {
+		return 0
+	}
+	c := &cognitiveWalker{ad: ad, src: src, name: ad.NodeName(fn)}
+	if body.Equal(fn) {
+		// the adapter reads the function node as its own body
+		c.children(body, 0)
+	} else {
+		c.walk(body, 0)
+	}
+	return c.score
+}
+
+func (c *cognitiveWalker) walk(n *sitter.Node, nesting int32) {
+	if n == nil || !n.IsNamed() {
+		return
+	}
+
+	switch {
+	case cognitiveCatchTypes[n.Type()]:
+		c.score += 1 + nesting
+		c.children(n, nesting+1)
+		return
+	case cognitiveTernaryTypes[n.Type()]:
+		c.score += 1 + nesting
+		c.children(n, nesting+1)
+		return
+	case isCatchBody(n):
+		c.score += 1 + nesting
+		c.children(n, nesting+1)
+		return
+	case cognitiveLambdaTypes[n.Type()] && !isBlockClosure(n):
+		c.children(n, nesting+1)
+		return
+	case n.Type() == "goto_statement":
+		c.score++
+		return
+	case cognitiveJumpTypes[n.Type()]:
+		if hasLabel(n) {
+			c.score++
+		}
+		c.children(n, nesting)
+		return
+	}
+
+	if c.logicalOperator(n) != "" {
+		c.booleanSequence(n, nesting)
+		return
+	}
+	if c.isRecursiveCall(n) {
+		c.score++
+	}
+
+	switch kind, _ := c.ad.Decision(n); kind {
+	case DecIf:
+		c.branch(n, nesting, false)
+		return
+	case DecLoop, DecSwitch:
+		c.score += 1 + nesting
+		c.children(n, nesting+1)
+		return
+	}
+
+	if c.ad.IsFunction(n) {
+		// a function declared in the function: its content is nested
+		c.children(n, nesting+1)
+		return
+	}
+	c.children(n, nesting)
+}
+
+func (c *cognitiveWalker) children(n *sitter.Node, nesting int32) {
+	for i := 0; i < int(n.ChildCount()); i++ {
+		c.walk(n.Child(i), nesting)
+	}
+}
+
+// branch scores an if (or the if of an else if, when continued) and its
+// alternatives. The alternatives are found whatever the grammar makes of them:
+// an "alternative" field, the statement following an "else" keyword, or an
+// elif / else clause.
+func (c *cognitiveWalker) branch(n *sitter.Node, nesting int32, continued bool) {
+	if continued {
+		c.score++
+	} else {
+		c.score += 1 + nesting
+	}
+
+	afterElse := false
+	for i := 0; i < int(n.ChildCount()); i++ {
+		ch := n.Child(i)
+		if !ch.IsNamed() {
+			afterElse = ch.Type() == "else"
+			continue
+		}
+		if afterElse || n.FieldNameForChild(i) == "alternative" || c.isElseClause(ch) {
+			c.alternative(ch, nesting)
+		} else {
+			c.walk(ch, nesting+1)
+		}
+		afterElse = false
+	}
+}
+
+func (c *cognitiveWalker) isElseClause(n *sitter.Node) bool {
+	kind, _ := c.ad.Decision(n)
+	return kind == DecElif || kind == DecElse
+}
+
+// alternative scores the else branch of an if: an else if continues the
+// chain of the if without nesting it deeper.
+func (c *cognitiveWalker) alternative(n *sitter.Node, nesting int32) {
+	// "else if" parsed as an else clause holding an if
+	if inner := firstNamedChild(n); inner != nil && n.NamedChildCount() == 1 {
+		if innerKind, _ := c.ad.Decision(inner); innerKind == DecIf {
+			c.branch(inner, nesting, true)
+			return
+		}
+	}
+	kind, _ := c.ad.Decision(n)
+	if kind == DecIf || kind == DecElif {
+		c.branch(n, nesting, true)
+		return
+	}
+	c.score++
+	if kind == DecElse {
+		c.children(n, nesting+1)
+		return
+	}
+	c.walk(n, nesting+1)
+}
+
+func (c *cognitiveWalker) logicalOperator(n *sitter.Node) string {
+	return logicalOperator(n, c.src)
+}
+
+// logicalOperator returns "&&" or "||" when the node is a binary boolean
+// expression, whatever the spelling of the operator in the language.
+func logicalOperator(n *sitter.Node, src []byte) string {
+	switch n.Type() {
+	case "conjunction_expression":
+		return "&&"
+	case "disjunction_expression":
+		return "||"
+	}
+	if n.NamedChildCount() < 2 {
+		return ""
+	}
+	op := ""
+	if field := n.ChildByFieldName("operator"); field != nil {
+		op = field.Content(src)
+	} else if t := n.Type(); strings.Contains(t, "binary") || strings.Contains(t, "boolean") {
+		for i := 0; i < int(n.ChildCount()); i++ {
+			if ch := n.Child(i); !ch.IsNamed() {
+				op = ch.Type()
+				break
+			}
+		}
+	}
+	switch op {
+	case "&&", "and":
+		return "&&"
+	case "||", "or":
+		return "||"
+	}
+	return ""
+}
+
+// booleanSequence scores a chain of boolean operators: one point for each
+// run of like operators, read from left to right.
+func (c *cognitiveWalker) booleanSequence(n *sitter.Node, nesting int32) {
+	var ops []string
+	var flatten func(x *sitter.Node)
+	flatten = func(x *sitter.Node) {
+		op := c.logicalOperator(x)
+		if op == "" {
+			c.walk(x, nesting)
+			return
+		}
+		flatten(firstNamedChild(x))
+		ops = append(ops, op)
+		flatten(x.NamedChild(int(x.NamedChildCount()) - 1))
+	}
+	flatten(n)
+	for i, op := range ops {
+		if i == 0 || ops[i-1] != op {
+			c.score++
+		}
+	}
+}
+
+// isRecursiveCall reports whether the node calls the function being measured.
+func (c *cognitiveWalker) isRecursiveCall(n *sitter.Node) bool {
+	if c.name == "" || !isCall(n) {
+		return false
+	}
+	callee := n.ChildByFieldName("function")
+	for _, field := range []string{"name", "method"} {
+		if callee != nil {
+			break
+		}
+		callee = n.ChildByFieldName(field)
+	}
+	if callee == nil {
+		callee = firstNamedChild(n)
+	}
+	if callee == nil {
+		return false
+	}
+	return trailingIdentifier.FindString(callee.Content(c.src)) == c.name
+}
+
+// isCall reports whether the node calls a function or a method.
+func isCall(n *sitter.Node) bool {
+	switch t := n.Type(); {
+	case strings.HasSuffix(t, "call_expression"), t == "call", t == "method_call",
+		t == "function_call", t == "method_invocation", t == "invocation_expression":
+		return true
+	}
+	return false
+}
+
+// isBlockClosure reports whether a closure is the block of a statement rather
+// than an anonymous function: Groovy writes the body of a method, of an if, a
+// loop or a try as a closure, held by a "body" field ("body", "else_body").
+func isBlockClosure(n *sitter.Node) bool {
+	return n.Type() == "closure" && strings.HasSuffix(fieldNameOf(n), "body")
+}
+
+// isCatchBody reports whether the node is the body of a Groovy catch, which
+// has no clause of its own.
+func isCatchBody(n *sitter.Node) bool {
+	return n.Type() == "closure" && fieldNameOf(n) == "catch_body"
+}
+
+// fieldNameOf returns the name of the field holding the node in its parent.
+func fieldNameOf(n *sitter.Node) string {
+	parent := n.Parent()
+	if parent == nil {
+		return ""
+	}
+	for i := 0; i < int(parent.ChildCount()); i++ {
+		if parent.Child(i).Equal(n) {
+			return parent.FieldNameForChild(i)
+		}
+	}
+	return ""
+}
+
+// hasLabel reports whether a break or a continue names the loop it leaves.
+func hasLabel(n *sitter.Node) bool {
+	for i := 0; i < int(n.NamedChildCount()); i++ {
+		t := n.NamedChild(i).Type()
+		if strings.Contains(t, "label") || t == "lifetime" {
+			return true
+		}
+		// "break outer" in Java and JavaScript; Rust breaks with a value
+		if strings.HasSuffix(n.Type(), "_statement") && (t == "identifier" || t == "statement_identifier") {
+			return true
+		}
+	}
+	return false
+}
+
+func firstNamedChild(n *sitter.Node) *sitter.Node {
+	if n == nil || n.NamedChildCount() == 0 {
+		return nil
+	}
+	return n.NamedChild(0)
+}
@@ -0,0 +1,235 @@
+package treesitter_test
+
+import (
+	"testing"
+
+	enginePkg "github.com/ast-metrics/ast-metrics/internal/engine"
+	"github.com/ast-metrics/ast-metrics/internal/engine/golang"
+	"github.com/ast-metrics/ast-metrics/internal/engine/groovy"
+	"github.com/ast-metrics/ast-metrics/internal/engine/java"
+	"github.com/ast-metrics/ast-metrics/internal/engine/javascript"
+	"github.com/ast-metrics/ast-metrics/internal/engine/kotlin"
+	"github.com/ast-metrics/ast-metrics/internal/engine/lua"
+	"github.com/ast-metrics/ast-metrics/internal/engine/php"
+	"github.com/ast-metrics/ast-metrics/internal/engine/python"
+	"github.com/ast-metrics/ast-metrics/internal/engine/ruby"
+	"github.com/ast-metrics/ast-metrics/internal/engine/rust"
+)
+
+// Every engine goes through the shared walker: each sample mixes an if /
+// else if / else chain, boolean sequences, nested structures and a recursive
+// call, so that the grammars of the decisions are all checked. Expected
+// values are computed by hand from the rules of the metric.
+func TestCognitiveComplexity_ByLanguage(t *testing.T) {
+	cases := []struct {
+		lang     string
+		runner   enginePkg.Engine
+		code     string
+		expected map[string]int32
+	}{
+		{"java", &java.JavaRunner{}, `class A {
+  int sumOfPrimes(int max) {
+    int total = 0;
+    OUT: for (int i = 1; i <= max; ++i) {
+      for (int j = 2; j < i; ++j) {
+        if (i % j == 0) {
+          continue OUT;
+        }
+      }
+      total += i;
+    }
+    return total;
+  }
+  String getWords(int number) {
+    switch (number) {
+      case 1: return "one";
+      case 2: return "a couple";
+      default: return "lots";
+    }
+  }
+  int chain(int a, boolean b, boolean c) {
+    if (a > 1 && b && c) {
+      return 1;
+    } else if (a < 0 || b) {
+      return 2;
+    } else {
+      return a > 0 ? chain(a - 1, b, c) : 0;
+    }
+  }
+}`, map[string]int32{"sumOfPrimes": 7, "getWords": 1, "chain": 8}},
+		{"go", &golang.GolangRunner{}, `package main
+
+func chain(a int, b, c bool) int {
+	if a > 1 && b && c {
+		return 1
+	} else if a < 0 || b {
+		return 2
+	} else {
+		for i := 0; i < a; i++ {
+			if i > 2 {
+				return chain(i, b, c)
+			}
+		}
+	}
+	f := func() int {
+		if b {
+			return 1
+		}
+		return 0
+	}
+	return f()
+}
+`, map[string]int32{"chain": 13}},
+		{"python", &python.PythonRunner{}, `def chain(a, b, c):
+    if a > 1 and b and c:
+        return 1
+    elif a < 0 or b:
+        return 2
+    else:
+        for i in range(a):
+            if i > 2:
+                return chain(i, b, c)
+    try:
+        pass
+    except ValueError:
+        pass
+    return 1 if b else 0
+`, map[string]int32{"chain": 13}},
+		{"php", &php.PhpRunner{}, `<?php
+function chain($a, $b, $c) {
+    if ($a > 1 && $b && $c) {
+        return 1;
+    } elseif ($a < 0 || $b) {
+        return 2;
+    } else if ($b) {
+        return 3;
+    } else {
+        foreach ($a as $i) {
+            if ($i > 2) {
+                return chain($i, $b, $c);
+            }
+        }
+    }
+    return 0;
+}
+`, map[string]int32{"chain": 12}},
+		{"js", &javascript.JavaScriptRunner{}, `function chain(a, b, c) {
+  if (a > 1 && b && c) {
+    return 1;
+  } else if (a < 0 || b) {
+    return 2;
+  } else {
+    for (let i = 0; i < a; i++) {
+      if (i > 2) {
+        return chain(i, b, c);
+      }
+    }
+  }
+  const f = () => { if (b) { return 1; } return 0; };
+  return f();
+}
+`, map[string]int32{"chain": 13, "f": 1}},
+		{"ruby", &ruby.RubyRunner{}, `def chain(a, b, c)
+  if a > 1 && b && c
+    return 1
+  elsif a < 0 || b
+    return 2
+  else
+    a.times do |i|
+      if i > 2
+        return chain(i, b, c)
+      end
+    end
+  end
+  0
+end
+`, map[string]int32{"chain": 8}},
+		{"rust", &rust.RustRunner{}, `fn chain(a: i32, b: bool, c: bool) -> i32 {
+    if a > 1 && b && c {
+        return 1;
+    } else if a < 0 || b {
+        return 2;
+    } else {
+        for i in 0..a {
+            if i > 2 {
+                return chain(i, b, c);
+            }
+        }
+    }
+    0
+}
+`, map[string]int32{"chain": 11}},
+		// the bodies of the methods, ifs, loops and trys are closures for the
+		// grammar: only the closure passed to each() nests its content
+		{"groovy", &groovy.GroovyRunner{}, `class A {
+  def chain(a, b, c) {
+    if (a > 1 && b && c) {
+      return 1
+    } else if (a < 0 || b) {
+      return 2
+    } else {
+      for (i in 0..a) {
+        if (i > 2) {
+          return chain(i, b, c)
+        }
+      }
+    }
+    0
+  }
+  def each(a) {
+    try {
+      a.each { x -> if (x) { println x } }
+    } catch (Exception e) {
+      println e
+    }
+  }
+}`, map[string]int32{"chain": 11, "each": 3}},
+		{"kotlin", &kotlin.KotlinRunner{}, `fun chain(a: Int, b: Boolean, c: Boolean): Int {
+    if (a > 1 && b && c) {
+        return 1
+    } else if (a < 0 || b) {
+        return 2
+    } else {
+        for (i in 0..a) {
+            if (i > 2) {
+                return chain(i, b, c)
+            }
+        }
+    }
+    return 0
+}
+`, map[string]int32{"chain": 11}},
+		{"lua", &lua.LuaRunner{}, `function chain(a, b, c)
+  if a > 1 and b and c then
+    return 1
+  elseif a < 0 or b then
+    return 2
+  else
+    for i = 0, a do
+      if i > 2 then
+        return chain(i, b, c)
+      end
+    end
+  end
+  return 0
+end
+`, map[string]int32{"chain": 11}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.lang, func(t *testing.T) {
+			file, err := enginePkg.CreateTestFileWithCode(tc.runner, tc.code)
+			if err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+			got := map[string]int32{}
+			for _, fn := range enginePkg.GetFunctionsInFile(file) {
+				got[fn.Name.Short] = fn.Stmts.GetAnalyze().GetComplexity().GetCognitive()
+			}
+			for name, want := range tc.expected {
+				if got[name] != want {
+					t.Errorf("%s(): expected cognitive complexity %d, got %d", name, want, got[name])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package treesitter
+
+import (
+	"fmt"
+	"strings"
+
+	pb "github.com/ast-metrics/ast-metrics/pb"
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// maxParseErrorSnippet caps the code quoted in the message of a parse error.
+const maxParseErrorSnippet = 40
+
+// ParseErrors lists the syntax problems tree-sitter recovered from: the
+// ERROR nodes (code the grammar could not place) and the MISSING nodes
+// (tokens the parser assumed). An ERROR node is reported once, whatever
+// problems it holds.
+func ParseErrors(root *sitter.Node, src []byte) []*pb.ParseError {
+	var errors []*pb.ParseError
+	if root == nil || !root.HasError() {
+		return errors
+	}
+	var walk func(n *sitter.Node)
+	walk = func(n *sitter.Node) {
+		switch {
+		case n.IsMissing():
+			errors = append(errors, newParseError(n, true, "missing "+n.Type()))
+			return
+		case n.IsError():
+			errors = append(errors, newParseError(n, false, "unexpected "+snippet(n, src)))
+			return
+		case !n.HasError():
+			return
+		}
+		for i := 0; i < int(n.ChildCount()); i++ {
+			walk(n.Child(i))
+		}
+	}
+	walk(root)
+	return errors
+}
+
+func newParseError(n *sitter.Node, missing bool, message string) *pb.ParseError {
+	start := n.StartPoint()
+	return &pb.ParseError{
+		Line:    int32(start.Row) + 1,
+		Column:  int32(start.Column) + 1,
+		Missing: missing,
+		Message: message,
+	}
+}
+
+// snippet quotes the first line of the code of a node.
+func snippet(n *sitter.Node, src []byte) string {
+	code := strings.TrimSpace(n.Content(src))
+	if i := strings.IndexByte(code, '\n'); i >= 0 {
+		code = strings.TrimSpace(code[:i])
+	}
+	if runes := []rune(code); len(runes) > maxParseErrorSnippet {
+		code = string(runes[:maxParseErrorSnippet]) + "..."
+	}
+	if code == "" {
+		return "end of code"
+	}
+	return fmt.Sprintf("%q", code)
+}
+
+// ParseErrorText formats a parse error for the errors of a file.
+func ParseErrorText(e *pb.ParseError) string {
+	return fmt.Sprintf("Parse error at line %d, column %d: %s", e.Line, e.Column, e.Message)
+}
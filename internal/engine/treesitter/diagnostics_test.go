@@ -0,0 +1,65 @@
+package treesitter_test
+
+import (
+	"testing"
+
+	enginePkg "github.com/ast-metrics/ast-metrics/internal/engine"
+	"github.com/ast-metrics/ast-metrics/internal/engine/golang"
+	"github.com/ast-metrics/ast-metrics/internal/engine/python"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseErrors_ReportsUnexpectedCodeWithItsPosition(t *testing.T) {
+	code := "x = 1\n" + // 1
+		"$ $\n" + // 2
+		"\n" + // 3
+		"def ok():\n" + // 4
+		"    return 1\n" // 5
+
+	file, err := enginePkg.CreateTestFileWithCode(&python.PythonRunner{}, code)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(file.ParseErrors))
+	e := file.ParseErrors[0]
+	assert.Equal(t, int32(2), e.Line)
+	assert.Equal(t, int32(1), e.Column)
+	assert.False(t, e.Missing)
+	assert.Equal(t, `unexpected "$ $"`, e.Message)
+
+	// the rest of the file is still analyzed
+	assert.Equal(t, 1, len(file.Stmts.StmtFunction))
+}
+
+func TestParseErrors_ReportsMissingTokens(t *testing.T) {
+	code := "def ok():\n" + // 1
+		"    return 1\n" + // 2
+		"\n" + // 3
+		"def broken(:\n" + // 4
+		"    return 2\n" // 5
+
+	file, err := enginePkg.CreateTestFileWithCode(&python.PythonRunner{}, code)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(file.ParseErrors))
+	e := file.ParseErrors[0]
+	assert.Equal(t, int32(4), e.Line)
+	assert.True(t, e.Missing)
+	assert.Equal(t, "missing )", e.Message)
+}
+
+func TestParseErrors_AreListedInTheErrorsOfGoFiles(t *testing.T) {
+	code := "package main\n" + // 1
+		"\n" + // 2
+		"$$$\n" + // 3
+		"\n" + // 4
+		"func main() {}\n" // 5
+
+	file, err := enginePkg.CreateTestFileWithCode(&golang.GolangRunner{}, code)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{`Parse error at line 3, column 1: unexpected "$$$"`}, file.Errors)
+}
+
+func TestParseErrors_NoneForValidCode(t *testing.T) {
+	file, err := enginePkg.CreateTestFileWithCode(&golang.GolangRunner{}, "package main\n\nfunc main() {}\n")
+	assert.Nil(t, err)
+	assert.Empty(t, file.ParseErrors)
+	assert.Empty(t, file.Errors)
+}
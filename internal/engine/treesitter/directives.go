@@ -0,0 +1,71 @@
+package treesitter
+
+import (
+	"bytes"
+	"strings"
+
+	pb "github.com/ast-metrics/ast-metrics/pb"
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// DirectivePrefix opens the comments addressed to ast-metrics, such as
+// "ast-metrics-ignore-next-line".
+const DirectivePrefix = "ast-metrics-"
+
+// directives returns the comments of the file holding a directive, wherever
+// they are written. The requirements read them to suppress violations.
+func (v *Visitor) directives(root *sitter.Node) []*pb.StmtComment {
+	var comments []*pb.StmtComment
+	if root == nil || !bytes.Contains(v.src, []byte(DirectivePrefix)) {
+		return comments
+	}
+	var walk func(n *sitter.Node)
+	walk = func(n *sitter.Node) {
+		if isCommentNode(n) {
+			if strings.Contains(n.Content(v.src), DirectivePrefix) {
+				comment := v.commentOf(n, n)
+				comment.TargetLine = targetLine(root, n)
+				comments = append(comments, comment)
+			}
+			return
+		}
+		for i := 0; i < int(n.ChildCount()); i++ {
+			walk(n.Child(i))
+		}
+	}
+	walk(root)
+	return comments
+}
+
+// targetLine returns the line of the code a comment stands for: the first
+// token after it, past the decorators, attributes and comments in between, so
+// that a comment above "@property" stands for the "def" below. It returns 0
+// when nothing follows the comment.
+func targetLine(root *sitter.Node, comment *sitter.Node) int32 {
+	end := comment.EndByte()
+	var found *sitter.Node
+	var walk func(n *sitter.Node)
+	walk = func(n *sitter.Node) {
+		if found != nil || n.EndByte() <= end || isCommentNode(n) {
+			return
+		}
+		// a Python "attribute" is a member access, not a decoration
+		if isDecoration(n) && n.ChildByFieldName("object") == nil {
+			return
+		}
+		if n.ChildCount() == 0 {
+			if n.StartByte() >= end {
+				found = n
+			}
+			return
+		}
+		for i := 0; i < int(n.ChildCount()) && found == nil; i++ {
+			walk(n.Child(i))
+		}
+	}
+	walk(root)
+	if found == nil {
+		return 0
+	}
+	return int32(found.StartPoint().Row) + 1
+}
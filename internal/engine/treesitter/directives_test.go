@@ -0,0 +1,127 @@
+package treesitter_test
+
+import (
+	"testing"
+
+	enginePkg "github.com/ast-metrics/ast-metrics/internal/engine"
+	"github.com/ast-metrics/ast-metrics/internal/engine/golang"
+	"github.com/ast-metrics/ast-metrics/internal/engine/java"
+	"github.com/ast-metrics/ast-metrics/internal/engine/php"
+	"github.com/ast-metrics/ast-metrics/internal/engine/python"
+	"github.com/ast-metrics/ast-metrics/internal/engine/rust"
+	"github.com/stretchr/testify/assert"
+)
+
+// Only the comments addressed to ast-metrics are kept, wherever they are
+// written: at the top of the file or in a body.
+func TestVisitor_CollectsDirectives(t *testing.T) {
+	cases := []struct {
+		lang   string
+		runner enginePkg.Engine
+		code   string
+		texts  []string
+		lines  []int32
+	}{
+		{
+			lang:   "golang",
+			runner: &golang.GolangRunner{},
+			code: `// ast-metrics-ignore-file: generated code
+package main
+
+// Run does things.
+func Run() {
+	// ast-metrics-ignore-next-line max_npath: see ADR-12
+	if true {
+	}
+}
+`,
+			texts: []string{
+				"// ast-metrics-ignore-file: generated code",
+				"// ast-metrics-ignore-next-line max_npath: see ADR-12",
+			},
+			lines: []int32{1, 6},
+		},
+		{
+			lang:   "python",
+			runner: &python.PythonRunner{},
+			code: `# a plain comment
+# ast-metrics-ignore-next-line max_cyclomatic: legacy
+def run():
+    pass
+`,
+			texts: []string{"# ast-metrics-ignore-next-line max_cyclomatic: legacy"},
+			lines: []int32{2},
+		},
+		{
+			lang:   "php",
+			runner: &php.PhpRunner{},
+			code: `<?php
+/* ast-metrics-ignore-next-line max_cyclomatic: legacy */
+function run() {}
+`,
+			texts: []string{"/* ast-metrics-ignore-next-line max_cyclomatic: legacy */"},
+			lines: []int32{2},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.lang, func(t *testing.T) {
+			file, err := enginePkg.CreateTestFileWithCode(tc.runner, tc.code)
+			assert.Nil(t, err)
+
+			var texts []string
+			var lines []int32
+			for _, directive := range file.Directives {
+				texts = append(texts, directive.Text)
+				lines = append(lines, directive.Location.StartLine)
+			}
+			assert.Equal(t, tc.texts, texts)
+			assert.Equal(t, tc.lines, lines)
+		})
+	}
+}
+
+// A directive stands for the declaration below it, past its decorators,
+// attributes and comments.
+func TestVisitor_DirectivesTargetTheDeclaration(t *testing.T) {
+	cases := []struct {
+		lang   string
+		runner enginePkg.Engine
+		code   string
+		target int32
+	}{
+		{"python", &python.PythonRunner{}, `class Parser:
+    # ast-metrics-ignore-next-line max_cyclomatic: legacy
+    @property
+    def run(self):
+        pass
+`, 4},
+		{"java", &java.JavaRunner{}, `class Parser {
+  // ast-metrics-ignore-next-line max_cyclomatic: legacy
+  @Override
+  // checked by the caller
+  public void run() {}
+}`, 5},
+		{"rust", &rust.RustRunner{}, `// ast-metrics-ignore-next-line max_cyclomatic: legacy
+#[inline]
+/// Runs the parser.
+fn run() {}
+`, 4},
+		{"golang", &golang.GolangRunner{}, `package main
+
+func run() {
+	// ast-metrics-ignore-next-line max_npath: see ADR-12
+	if true {
+	}
+}
+`, 5},
+	}
+	for _, tc := range cases {
+		t.Run(tc.lang, func(t *testing.T) {
+			file, err := enginePkg.CreateTestFileWithCode(tc.runner, tc.code)
+			assert.Nil(t, err)
+			if assert.Len(t, file.Directives, 1) {
+				assert.Equal(t, tc.target, file.Directives[0].TargetLine)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package treesitter
+
+import (
+	"strings"
+
+	pb "github.com/ast-metrics/ast-metrics/pb"
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// DocCommentAware lets an adapter tell a documentation comment from a plain
+// one: Javadoc, PHPDoc and JSDoc open with "/**", Rust and C# document with
+// "///". Without it, any comment written right before a declaration documents
+// it, as in Go or Ruby.
+type DocCommentAware interface {
+	IsDocComment(text string) bool
+}
+
+// DocStringAware lets an adapter find a documentation that is not a comment
+// written before the declaration: the docstring opening the body of a Python
+// function, the @doc attribute of an Elixir function.
+type DocStringAware interface {
+	// DocString returns the node holding the documentation, or nil.
+	DocString(*sitter.Node) *sitter.Node
+}
+
+// docComment returns the documentation of a declaration, or nil. A doc
+// comment documents the declaration it is written right before, with no blank
+// line in between; decorators and attributes may stand between them, as well
+// as the keywords wrapping the declaration on its first line ("export",
+// "template <typename T>"). Consecutive line comments ("//", "///") make one
+// documentation.
+func (v *Visitor) docComment(node *sitter.Node) *pb.StmtComment {
+	if da, ok := v.ad.(DocStringAware); ok {
+		if doc := da.DocString(node); doc != nil {
+			return v.commentOf(doc, doc)
+		}
+	}
+	isDoc := func(string) bool { return true }
+	if da, ok := v.ad.(DocCommentAware); ok {
+		isDoc = da.IsDocComment
+	}
+
+	for cur := node; cur != nil; cur = cur.Parent() {
+		start := cur
+		prev := cur.PrevSibling()
+		for prev != nil && (isDecoration(prev) || isKeyword(prev)) {
+			if prev.IsNamed() {
+				start = prev
+			}
+			prev = prev.PrevSibling()
+		}
+		if prev != nil && isCommentNode(prev) {
+			if endRow(prev)+1 < start.StartPoint().Row || !isDoc(prev.Content(v.src)) {
+				return nil
+			}
+			first := prev
+			for p := first.PrevSibling(); p != nil && isCommentNode(p) && endRow(p)+1 >= first.StartPoint().Row && isDoc(p.Content(v.src)); p = p.PrevSibling() {
+				first = p
+			}
+			return v.commentOf(first, prev)
+		}
+		if prev != nil && !prev.IsNamed() {
+			// punctuation: the declaration opens a body ("{ void run()")
+			return nil
+		}
+		// the declaration is the first element of a node wrapping it
+		// from the same line ("export class", "@decorator def")
+		parent := cur.Parent()
+		if parent == nil || parent.StartPoint().Row != start.StartPoint().Row {
+			return nil
+		}
+	}
+	return nil
+}
+
+// commentOf builds the comment spanning the nodes from first to last, without
+// the blank lines some grammars hold in their comment nodes.
+func (v *Visitor) commentOf(first *sitter.Node, last *sitter.Node) *pb.StmtComment {
+	start, end := first.StartByte(), last.EndByte()
+	startLine, endLine := int32(first.StartPoint().Row)+1, int32(last.EndPoint().Row)+1
+	for start < end && isSpace(v.src[start]) {
+		if v.src[start] == '\n' {
+			startLine++
+		}
+		start++
+	}
+	for end > start && isSpace(v.src[end-1]) {
+		if v.src[end-1] == '\n' {
+			endLine--
+		}
+		end--
+	}
+	return &pb.StmtComment{
+		Text: string(v.src[start:end]),
+		Location: &pb.StmtLocationInFile{
+			StartLine:    startLine,
+			EndLine:      endLine,
+			StartFilePos: int32(start),
+			EndFilePos:   int32(end),
+		},
+	}
+}
+
+func isSpace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\n' || b == '\r'
+}
+
+func isCommentNode(n *sitter.Node) bool {
+	return n.IsNamed() && strings.Contains(n.Type(), "comment")
+}
+
+// isDecoration reports whether a node decorates the declaration following it:
+// a decorator, an attribute, an annotation or the parameters of a template.
+func isDecoration(n *sitter.Node) bool {
+	if !n.IsNamed() {
+		return false
+	}
+	t := n.Type()
+	return strings.Contains(t, "decorator") || strings.Contains(t, "attribute") ||
+		strings.Contains(t, "annotation") || t == "template_parameter_list"
+}
+
+// isKeyword reports whether a node is an anonymous keyword ("export",
+// "default", "template"), as opposed to punctuation.
+func isKeyword(n *sitter.Node) bool {
+	if n.IsNamed() {
+		return false
+	}
+	for _, r := range n.Type() {
+		if (r < 'a' || r > 'z') && r != '_' {
+			return false
+		}
+	}
+	return n.Type() != ""
+}
+
+// endRow returns the 0-based row on which a node ends. Line comments holding
+// their newline end at the first column of the next row.
+func endRow(n *sitter.Node) uint32 {
+	end := n.EndPoint()
+	if end.Column == 0 && end.Row > n.StartPoint().Row {
+		return end.Row - 1
+	}
+	return end.Row
+}
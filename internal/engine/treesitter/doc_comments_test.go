@@ -0,0 +1,283 @@
+package treesitter_test
+
+import (
+	"testing"
+
+	enginePkg "github.com/ast-metrics/ast-metrics/internal/engine"
+	"github.com/ast-metrics/ast-metrics/internal/engine/c"
+	"github.com/ast-metrics/ast-metrics/internal/engine/cpp"
+	"github.com/ast-metrics/ast-metrics/internal/engine/csharp"
+	"github.com/ast-metrics/ast-metrics/internal/engine/elixir"
+	"github.com/ast-metrics/ast-metrics/internal/engine/golang"
+	"github.com/ast-metrics/ast-metrics/internal/engine/java"
+	"github.com/ast-metrics/ast-metrics/internal/engine/kotlin"
+	"github.com/ast-metrics/ast-metrics/internal/engine/lua"
+	"github.com/ast-metrics/ast-metrics/internal/engine/php"
+	"github.com/ast-metrics/ast-metrics/internal/engine/python"
+	"github.com/ast-metrics/ast-metrics/internal/engine/ruby"
+	"github.com/ast-metrics/ast-metrics/internal/engine/rust"
+	"github.com/ast-metrics/ast-metrics/internal/engine/scala"
+	"github.com/ast-metrics/ast-metrics/internal/engine/swift"
+	"github.com/ast-metrics/ast-metrics/internal/engine/typescript"
+	"github.com/stretchr/testify/assert"
+)
+
+// Each language documents its declarations its own way: the visitor finds the
+// doc comment of the class and of the documented function, and none for the
+// function preceded by a plain comment (or by nothing).
+func TestVisitor_FindsDocComments(t *testing.T) {
+	cases := []struct {
+		lang      string
+		runner    enginePkg.Engine
+		code      string
+		className string
+		classDoc  string
+		docLine   int32 // first line of the doc of the documented function
+		funcDoc   string
+	}{
+		{
+			lang:   "golang",
+			runner: &golang.GolangRunner{},
+			code: "package main\n" +
+				"\n" +
+				"// Cart holds the items.\n" +
+				"// It is not safe for concurrent use.\n" +
+				"type Cart struct{}\n" +
+				"\n" +
+				"// Total sums the items.\n" +
+				"func (c Cart) Total() int { return 0 }\n" +
+				"\n" +
+				"// not a doc: a blank line follows\n" +
+				"\n" +
+				"func (c Cart) Plain() int { return 0 }\n",
+			className: "Cart", classDoc: "// Cart holds the items.\n// It is not safe for concurrent use.",
+			docLine: 7, funcDoc: "// Total sums the items.",
+		},
+		{
+			lang:   "java",
+			runner: &java.JavaRunner{},
+			code: "/** Holds the items. */\n" +
+				"@Entity\n" +
+				"public class Cart {\n" +
+				"    /** Sums the items. */\n" +
+				"    @Override\n" +
+				"    public int total() { return 0; }\n" +
+				"    // a plain comment\n" +
+				"    public int plain() { return 0; }\n" +
+				"}\n",
+			className: "Cart", classDoc: "/** Holds the items. */",
+			docLine: 4, funcDoc: "/** Sums the items. */",
+		},
+		{
+			lang:   "php",
+			runner: &php.PhpRunner{},
+			code: "<?php\n" +
+				"/**\n" +
+				" * Holds the items.\n" +
+				" */\n" +
+				"final class Cart {\n" +
+				"    /** Sums the items. */\n" +
+				"    public function total() { return 0; }\n" +
+				"    /* a plain comment */\n" +
+				"    public function plain() { return 0; }\n" +
+				"}\n",
+			className: "Cart", classDoc: "/**\n * Holds the items.\n */",
+			docLine: 6, funcDoc: "/** Sums the items. */",
+		},
+		{
+			lang:   "typescript",
+			runner: &typescript.TypeScriptRunner{},
+			code: "/** Holds the items. */\n" +
+				"export class Cart {\n" +
+				"    plain() { return 0; }\n" +
+				"}\n" +
+				"/** Sums the items. */\n" +
+				"export function total() { return 0; }\n",
+			className: "Cart", classDoc: "/** Holds the items. */",
+			docLine: 5, funcDoc: "/** Sums the items. */",
+		},
+		{
+			lang:   "kotlin",
+			runner: &kotlin.KotlinRunner{},
+			code: "/** Holds the items. */\n" +
+				"class Cart {\n" +
+				"    /** Sums the items. */\n" +
+				"    fun total(): Int { return 0 }\n" +
+				"    fun plain(): Int { return 0 }\n" +
+				"}\n",
+			className: "Cart", classDoc: "/** Holds the items. */",
+			docLine: 3, funcDoc: "/** Sums the items. */",
+		},
+		{
+			lang:   "scala",
+			runner: &scala.ScalaRunner{},
+			code: "/** Holds the items. */\n" +
+				"class Cart {\n" +
+				"  /** Sums the items. */\n" +
+				"  def total(): Int = 0\n" +
+				"  def plain(): Int = 0\n" +
+				"}\n",
+			className: "Cart", classDoc: "/** Holds the items. */",
+			docLine: 3, funcDoc: "/** Sums the items. */",
+		},
+		{
+			lang:   "csharp",
+			runner: &csharp.CSharpRunner{},
+			code: "/// <summary>Holds the items.</summary>\n" +
+				"[Serializable]\n" +
+				"public class Cart {\n" +
+				"    /// <summary>\n" +
+				"    /// Sums the items.\n" +
+				"    /// </summary>\n" +
+				"    public int Total() { return 0; }\n" +
+				"    // a plain comment\n" +
+				"    public int Plain() { return 0; }\n" +
+				"}\n",
+			className: "Cart", classDoc: "/// <summary>Holds the items.</summary>",
+			docLine: 4, funcDoc: "/// <summary>\n    /// Sums the items.\n    /// </summary>",
+		},
+		{
+			lang:   "rust",
+			runner: &rust.RustRunner{},
+			code: "/// Holds the items.\n" +
+				"#[derive(Debug)]\n" +
+				"pub struct Cart {}\n" +
+				"\n" +
+				"/// Sums the items.\n" +
+				"pub fn total() -> i32 { 0 }\n" +
+				"\n" +
+				"// a plain comment\n" +
+				"pub fn plain() -> i32 { 0 }\n",
+			className: "Cart", classDoc: "/// Holds the items.",
+			docLine: 5, funcDoc: "/// Sums the items.",
+		},
+		{
+			lang:   "swift",
+			runner: &swift.SwiftRunner{},
+			code: "/// Holds the items.\n" +
+				"public class Cart {\n" +
+				"    /** Sums the items. */\n" +
+				"    public func total() -> Int { return 0 }\n" +
+				"    public func plain() -> Int { return 0 }\n" +
+				"}\n",
+			className: "Cart", classDoc: "/// Holds the items.",
+			docLine: 3, funcDoc: "/** Sums the items. */",
+		},
+		{
+			lang:   "c",
+			runner: &c.CRunner{},
+			code: "/** Sums the items. */\n" +
+				"int total(void) { return 0; }\n" +
+				"/* a plain comment */\n" +
+				"int plain(void) { return 0; }\n",
+			docLine: 1, funcDoc: "/** Sums the items. */",
+		},
+		{
+			lang:   "cpp",
+			runner: &cpp.CppRunner{},
+			code: "/// Holds the items.\n" +
+				"class Cart {};\n" +
+				"/** Sums the items. */\n" +
+				"template <typename T>\n" +
+				"T total(T t) { return t; }\n" +
+				"int plain() { return 0; }\n",
+			className: "Cart", classDoc: "/// Holds the items.",
+			docLine: 3, funcDoc: "/** Sums the items. */",
+		},
+		{
+			lang:   "ruby",
+			runner: &ruby.RubyRunner{},
+			code: "# Holds the items.\n" +
+				"class Cart\n" +
+				"  # Sums the items.\n" +
+				"  def total\n" +
+				"    0\n" +
+				"  end\n" +
+				"\n" +
+				"  def plain\n" +
+				"    0\n" +
+				"  end\n" +
+				"end\n",
+			className: "Cart", classDoc: "# Holds the items.",
+			docLine: 3, funcDoc: "# Sums the items.",
+		},
+		{
+			lang:   "python",
+			runner: &python.PythonRunner{},
+			code: "# a comment is not a docstring\n" +
+				"class Cart:\n" +
+				"    \"\"\"Holds the items.\"\"\"\n" +
+				"\n" +
+				"    @property\n" +
+				"    def total(self):\n" +
+				"        \"\"\"Sums the items.\"\"\"\n" +
+				"        return 0\n" +
+				"\n" +
+				"    # a plain comment\n" +
+				"    def plain(self):\n" +
+				"        return 0\n",
+			className: "Cart", classDoc: "\"\"\"Holds the items.\"\"\"",
+			docLine: 7, funcDoc: "\"\"\"Sums the items.\"\"\"",
+		},
+		{
+			lang:   "elixir",
+			runner: &elixir.ElixirRunner{},
+			code: "defmodule Cart do\n" +
+				"  @moduledoc \"Holds the items.\"\n" +
+				"\n" +
+				"  @doc \"Sums the items.\"\n" +
+				"  @spec total() :: integer\n" +
+				"  def total(), do: 0\n" +
+				"\n" +
+				"  # a plain comment\n" +
+				"  def plain(), do: 0\n" +
+				"end\n",
+			className: "Cart", classDoc: "@moduledoc \"Holds the items.\"",
+			docLine: 4, funcDoc: "@doc \"Sums the items.\"",
+		},
+		{
+			lang:   "lua",
+			runner: &lua.LuaRunner{},
+			code: "--- Sums the items.\n" +
+				"function total()\n" +
+				"  return 0\n" +
+				"end\n" +
+				"\n" +
+				"-- a plain comment\n" +
+				"function plain()\n" +
+				"  return 0\n" +
+				"end\n",
+			docLine: 1, funcDoc: "--- Sums the items.",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.lang, func(t *testing.T) {
+			file, err := enginePkg.CreateTestFileWithCode(tc.runner, tc.code)
+			if err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+
+			if tc.className != "" {
+				class := findClass(file.Stmts, tc.className)
+				if assert.NotNil(t, class) {
+					assert.Equal(t, tc.classDoc, class.GetDocComment().GetText())
+				}
+			}
+
+			var documented, plain string
+			for _, fn := range enginePkg.GetFunctionsInFile(file) {
+				switch fn.GetName().GetShort() {
+				case "total", "Total":
+					documented = fn.GetDocComment().GetText()
+					assert.Equal(t, tc.docLine, fn.GetDocComment().GetLocation().GetStartLine())
+				case "plain", "Plain":
+					plain = fn.GetDocComment().GetText()
+					assert.Nil(t, fn.GetDocComment())
+				}
+			}
+			assert.Equal(t, tc.funcDoc, documented)
+			assert.Empty(t, plain)
+		})
+	}
+}
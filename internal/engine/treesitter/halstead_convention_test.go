@@ -5,12 +5,18 @@ import (
 
 	"github.com/ast-metrics/ast-metrics/internal/analyzer"
 	"github.com/ast-metrics/ast-metrics/internal/engine"
+	"github.com/ast-metrics/ast-metrics/internal/engine/cpp"
 	"github.com/ast-metrics/ast-metrics/internal/engine/csharp"
 	"github.com/ast-metrics/ast-metrics/internal/engine/golang"
 	"github.com/ast-metrics/ast-metrics/internal/engine/java"
+	"github.com/ast-metrics/ast-metrics/internal/engine/javascript"
+	"github.com/ast-metrics/ast-metrics/internal/engine/kotlin"
 	"github.com/ast-metrics/ast-metrics/internal/engine/php"
 	"github.com/ast-metrics/ast-metrics/internal/engine/python"
+	"github.com/ast-metrics/ast-metrics/internal/engine/ruby"
 	"github.com/ast-metrics/ast-metrics/internal/engine/rust"
+	"github.com/ast-metrics/ast-metrics/internal/engine/scala"
+	"github.com/ast-metrics/ast-metrics/internal/engine/swift"
 	"github.com/ast-metrics/ast-metrics/internal/engine/typescript"
 	pb "github.com/ast-metrics/ast-metrics/pb"
 )
@@ -97,6 +103,109 @@ impl Cart {
     this.items[qty] = qty;
   }
 }
+`,
+		},
+		{
+			language: "JavaScript",
+			runner:   &javascript.JavaScriptRunner{},
+			access:   ".",
+			source: `class Cart {
+  items = [];
+
+  keys() {
+    return count(this.items);
+  }
+
+  add(name, qty) {
+    this.items[qty] = qty;
+  }
+}
+`,
+		},
+		{
+			language: "Kotlin",
+			runner:   &kotlin.KotlinRunner{},
+			access:   ".",
+			source: `class Cart {
+    private val items = IntArray(10)
+
+    fun keys(): Int {
+        return count(this.items)
+    }
+
+    fun add(name: String, qty: Int) {
+        this.items[qty] = qty
+    }
+}
+`,
+		},
+		{
+			language: "Ruby",
+			runner:   &ruby.RubyRunner{},
+			access:   ".",
+			source: `class Cart
+  attr_reader :items
+
+  def keys
+    return count(self.items)
+  end
+
+  def add(name, qty)
+    @items[qty] = qty
+  end
+end
+`,
+		},
+		{
+			language: "C++",
+			runner:   &cpp.CppRunner{},
+			access:   "->",
+			source: `class Cart {
+    int items[10];
+
+public:
+    int keys() {
+        return count(this->items);
+    }
+
+    void add(const char* name, int qty) {
+        this->items[qty] = qty;
+    }
+};
+`,
+		},
+		{
+			language: "Scala",
+			runner:   &scala.ScalaRunner{},
+			access:   ".",
+			source: `class Cart {
+  private val items = new Array[Int](10)
+
+  def keys(): Int = {
+    return count(this.items)
+  }
+
+  def add(name: String, qty: Int): Unit = {
+    this.items(qty) = qty
+  }
+}
+`,
+		},
+		{
+			language: "Swift",
+			runner:   &swift.SwiftRunner{},
+			access:   ".",
+			source: `class Cart {
+    private var items: [Int] = []
+
+    func keys() -> Int {
+        return count(self.items)
+    }
+
+    func add(name: String, qty: Int) {
+        self.items[qty] = qty
+    }
+}
 `,
 		},
 		{
@@ -0,0 +1,90 @@
+package treesitter
+
+import (
+	pb "github.com/ast-metrics/ast-metrics/pb"
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// ModifierAware lets an adapter report the visibility and the modifiers
+// (abstract, static, final) of a class or a function. Each language has its
+// own default when nothing is written: public in PHP, package-private in
+// Java, private for a member in C#.
+type ModifierAware interface {
+	Modifiers(*sitter.Node) *pb.Modifiers
+}
+
+// ModifierKeywords lists the keywords written on a declaration: its own
+// anonymous tokens ("static" in TypeScript) and the tokens held by its direct
+// children whose type is listed in containers ("modifiers" in Java,
+// "visibility_modifier" in PHP), at any depth.
+func ModifierKeywords(n *sitter.Node, containers map[string]bool) []string {
+	var keywords []string
+	var collect func(x *sitter.Node)
+	collect = func(x *sitter.Node) {
+		for i := 0; i < int(x.ChildCount()); i++ {
+			ch := x.Child(i)
+			if !ch.IsNamed() {
+				keywords = append(keywords, ch.Type())
+				continue
+			}
+			collect(ch)
+		}
+	}
+	for i := 0; i < int(n.ChildCount()); i++ {
+		ch := n.Child(i)
+		switch {
+		case !ch.IsNamed():
+			keywords = append(keywords, ch.Type())
+		case containers[ch.Type()]:
+			collect(ch)
+		}
+	}
+	return keywords
+}
+
+// KeywordModifiers builds the modifiers of a declaration from its keywords.
+// The first visibility keyword wins ("protected internal" is protected);
+// visibility is used when none is written.
+func KeywordModifiers(keywords []string, visibility pb.Visibility) *pb.Modifiers {
+	m := &pb.Modifiers{}
+	for _, kw := range keywords {
+		switch kw {
+		case "public", "open":
+			if m.Visibility == pb.Visibility_VISIBILITY_UNKNOWN {
+				m.Visibility = pb.Visibility_VISIBILITY_PUBLIC
+			}
+		case "protected":
+			if m.Visibility == pb.Visibility_VISIBILITY_UNKNOWN {
+				m.Visibility = pb.Visibility_VISIBILITY_PROTECTED
+			}
+		case "private", "fileprivate":
+			if m.Visibility == pb.Visibility_VISIBILITY_UNKNOWN {
+				m.Visibility = pb.Visibility_VISIBILITY_PRIVATE
+			}
+		case "internal":
+			if m.Visibility == pb.Visibility_VISIBILITY_UNKNOWN {
+				m.Visibility = pb.Visibility_VISIBILITY_PACKAGE
+			}
+		case "abstract":
+			m.IsAbstract = true
+		case "static":
+			m.IsStatic = true
+		case "final":
+			m.IsFinal = true
+		}
+	}
+	if m.Visibility == pb.Visibility_VISIBILITY_UNKNOWN {
+		m.Visibility = visibility
+	}
+	return m
+}
+
+// HasKeyword reports whether a keyword is in the list.
+func HasKeyword(keywords []string, keyword string) bool {
+	for _, kw := range keywords {
+		if kw == keyword {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,308 @@
+package treesitter
+
+import (
+	"math"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// The NPath complexity counts the acyclic execution paths through a function
+// (B. A. Nejmeh, 1988): the paths of consecutive statements multiply, while
+// the branches of a decision add up. Each boolean operator of a condition adds
+// one path, and an if without else or a switch without default adds the path
+// that skips it. The value grows exponentially, so it saturates instead of
+// overflowing.
+//
+// Functions and lambdas declared in the function are measured on their own:
+// they count as a single path where they are declared.
+
+// ConditionAware lets an adapter locate the condition of an if or a loop when
+// the grammar gives it no "condition" field: Kotlin and Lua write it as a
+// plain child, Elixir as the first argument of the if macro.
+type ConditionAware interface {
+	// Condition returns the condition of the decision, or nil.
+	Condition(*sitter.Node) *sitter.Node
+}
+
+// conditionOf returns the condition of an if or a loop, or nil.
+func conditionOf(ad LangAdapter, n *sitter.Node) *sitter.Node {
+	if ca, ok := ad.(ConditionAware); ok {
+		if cond := ca.Condition(n); cond != nil {
+			return cond
+		}
+	}
+	return n.ChildByFieldName("condition")
+}
+
+type pathWalker struct {
+	ad  LangAdapter
+	src []byte
+}
+
+// NPathComplexity returns the NPath complexity of a function node.
+func NPathComplexity(ad LangAdapter, fn *sitter.Node, src []byte) int64 {
+	body := ad.NodeBody(fn)
+	if body == nil {
+		return 1
+	}
+	w := &pathWalker{ad: ad, src: src}
+	if body.Equal(fn) {
+		return w.sequence(body)
+	}
+	return w.paths(body)
+}
+
+// MaxNestingDepth returns the deepest nesting of control structures (if,
+// loop, switch) in a function. An else if stays at the depth of its if, and
+// the cases of a switch do not add a level.
+func MaxNestingDepth(ad LangAdapter, fn *sitter.Node, src []byte) int32 {
+	body := ad.NodeBody(fn)
+	if body == nil {
+		return 0
+	}
+	w := &pathWalker{ad: ad, src: src}
+	if body.Equal(fn) {
+		return w.childrenDepth(body, 0)
+	}
+	return w.depth(body, 0)
+}
+
+func (w *pathWalker) paths(n *sitter.Node) int64 {
+	if n == nil || !n.IsNamed() {
+		return 1
+	}
+	if (cognitiveLambdaTypes[n.Type()] && !isBlockClosure(n)) || w.ad.IsFunction(n) {
+		return 1
+	}
+	if cognitiveTernaryTypes[n.Type()] {
+		return w.ternary(n)
+	}
+
+	switch kind, _ := w.ad.Decision(n); kind {
+	case DecIf:
+		total, hasElse := w.branch(n)
+		if !hasElse {
+			total = addPaths(total, 1)
+		}
+		return total
+	case DecLoop:
+		cond := conditionOf(w.ad, n)
+		return addPaths(addPaths(w.sequenceExcept(n, cond), 1), w.booleanOperators(cond))
+	case DecSwitch:
+		return w.switchPaths(n)
+	}
+	return w.sequence(n)
+}
+
+// sequence multiplies the paths of the children of a node. The catch clauses
+// of a try are alternatives to its body, so they add up instead.
+func (w *pathWalker) sequence(n *sitter.Node) int64 {
+	return w.sequenceExcept(n, nil)
+}
+
+func (w *pathWalker) sequenceExcept(n *sitter.Node, skip *sitter.Node) int64 {
+	total, catches := int64(1), int64(0)
+	for i := 0; i < int(n.NamedChildCount()); i++ {
+		ch := n.NamedChild(i)
+		if skip != nil && ch.Equal(skip) {
+			continue
+		}
+		if cognitiveCatchTypes[ch.Type()] || isCatchBody(ch) {
+			catches = addPaths(catches, w.sequence(ch))
+			continue
+		}
+		total = mulPaths(total, w.paths(ch))
+	}
+	return addPaths(total, catches)
+}
+
+// branch returns the paths of an if and of the alternatives chained to it,
+// and whether the chain ends with an else.
+func (w *pathWalker) branch(n *sitter.Node) (int64, bool) {
+	cond := conditionOf(w.ad, n)
+	total := w.booleanOperators(cond)
+	consequence := int64(1)
+	var alternatives []*sitter.Node
+
+	afterElse := false
+	for i := 0; i < int(n.ChildCount()); i++ {
+		ch := n.Child(i)
+		if !ch.IsNamed() {
+			afterElse = ch.Type() == "else"
+			continue
+		}
+		switch {
+		case afterElse || n.FieldNameForChild(i) == "alternative" || w.isElseClause(ch):
+			alternatives = append(alternatives, ch)
+		case cond == nil || !ch.Equal(cond):
+			consequence = mulPaths(consequence, w.paths(ch))
+		}
+		afterElse = false
+	}
+	total = addPaths(total, consequence)
+
+	hasElse := false
+	for _, alt := range alternatives {
+		// "else if" parsed as an else clause holding an if
+		if inner := firstNamedChild(alt); inner != nil && alt.NamedChildCount() == 1 {
+			if kind, _ := w.ad.Decision(inner); kind == DecIf {
+				paths, ends := w.branch(inner)
+				total, hasElse = addPaths(total, paths), hasElse || ends
+				continue
+			}
+		}
+		if kind, _ := w.ad.Decision(alt); kind == DecIf || kind == DecElif {
+			paths, ends := w.branch(alt)
+			total, hasElse = addPaths(total, paths), hasElse || ends
+			continue
+		}
+		total, hasElse = addPaths(total, w.paths(alt)), true
+	}
+	return total, hasElse
+}
+
+func (w *pathWalker) isElseClause(n *sitter.Node) bool {
+	kind, _ := w.ad.Decision(n)
+	return kind == DecElif || kind == DecElse
+}
+
+// ternary adds the paths of both outcomes of a conditional expression.
+func (w *pathWalker) ternary(n *sitter.Node) int64 {
+	cond := firstNamedChild(n)
+	total := w.booleanOperators(cond)
+	for i := 1; i < int(n.NamedChildCount()); i++ {
+		total = addPaths(total, w.paths(n.NamedChild(i)))
+	}
+	return total
+}
+
+// switchPaths adds the paths of the cases, plus one when no default case
+// catches the other values.
+func (w *pathWalker) switchPaths(n *sitter.Node) int64 {
+	var cases []*sitter.Node
+	var collect func(x *sitter.Node)
+	collect = func(x *sitter.Node) {
+		for i := 0; i < int(x.NamedChildCount()); i++ {
+			ch := x.NamedChild(i)
+			switch kind, _ := w.ad.Decision(ch); kind {
+			case DecCase:
+				cases = append(cases, ch)
+			case DecNone:
+				if !w.ad.IsFunction(ch) && (!cognitiveLambdaTypes[ch.Type()] || isBlockClosure(ch)) {
+					collect(ch)
+				}
+			}
+		}
+	}
+	collect(n)
+	if len(cases) == 0 {
+		return addPaths(w.sequence(n), 1)
+	}
+
+	total, hasDefault := int64(0), false
+	for _, c := range cases {
+		total = addPaths(total, w.sequence(c))
+		hasDefault = hasDefault || w.isDefaultCase(c)
+	}
+	if !hasDefault {
+		total = addPaths(total, 1)
+	}
+	return total
+}
+
+func (w *pathWalker) isDefaultCase(n *sitter.Node) bool {
+	if strings.Contains(n.Type(), "default") {
+		return true
+	}
+	head := strings.TrimSpace(n.Content(w.src))
+	return strings.HasPrefix(head, "default") || strings.HasPrefix(head, "else")
+}
+
+// booleanOperators counts the && and || of a condition.
+func (w *pathWalker) booleanOperators(n *sitter.Node) int64 {
+	if n == nil || cognitiveLambdaTypes[n.Type()] {
+		return 0
+	}
+	count := int64(0)
+	if logicalOperator(n, w.src) != "" {
+		count++
+	}
+	for i := 0; i < int(n.NamedChildCount()); i++ {
+		count += w.booleanOperators(n.NamedChild(i))
+	}
+	return count
+}
+
+func (w *pathWalker) depth(n *sitter.Node, current int32) int32 {
+	if n == nil || !n.IsNamed() || w.ad.IsFunction(n) {
+		return current
+	}
+
+	switch kind, _ := w.ad.Decision(n); kind {
+	case DecIf:
+		return w.branchDepth(n, current)
+	case DecLoop, DecSwitch:
+		return w.childrenDepth(n, current+1)
+	}
+	return w.childrenDepth(n, current)
+}
+
+func (w *pathWalker) childrenDepth(n *sitter.Node, current int32) int32 {
+	deepest := current
+	for i := 0; i < int(n.NamedChildCount()); i++ {
+		if d := w.depth(n.NamedChild(i), current); d > deepest {
+			deepest = d
+		}
+	}
+	return deepest
+}
+
+// branchDepth measures an if: its body and its else are one level deeper,
+// an else if chained to it stays at its level.
+func (w *pathWalker) branchDepth(n *sitter.Node, current int32) int32 {
+	deepest := current + 1
+	afterElse := false
+	for i := 0; i < int(n.ChildCount()); i++ {
+		ch := n.Child(i)
+		if !ch.IsNamed() {
+			afterElse = ch.Type() == "else"
+			continue
+		}
+		d := int32(0)
+		isAlternative := afterElse || n.FieldNameForChild(i) == "alternative" || w.isElseClause(ch)
+		afterElse = false
+		if !isAlternative {
+			d = w.depth(ch, current+1)
+		} else if inner := firstNamedChild(ch); inner != nil && ch.NamedChildCount() == 1 && w.isIf(inner) {
+			d = w.branchDepth(inner, current)
+		} else if kind, _ := w.ad.Decision(ch); kind == DecIf || kind == DecElif {
+			d = w.branchDepth(ch, current)
+		} else {
+			d = w.childrenDepth(ch, current+1)
+		}
+		if d > deepest {
+			deepest = d
+		}
+	}
+	return deepest
+}
+
+func (w *pathWalker) isIf(n *sitter.Node) bool {
+	kind, _ := w.ad.Decision(n)
+	return kind == DecIf
+}
+
+func addPaths(a, b int64) int64 {
+	if a > math.MaxInt64-b {
+		return math.MaxInt64
+	}
+	return a + b
+}
+
+func mulPaths(a, b int64) int64 {
+	if a != 0 && b > math.MaxInt64/a {
+		return math.MaxInt64
+	}
+	return a * b
+}
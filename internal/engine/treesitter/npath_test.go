@@ -0,0 +1,179 @@
+package treesitter_test
+
+import (
+	"testing"
+
+	enginePkg "github.com/ast-metrics/ast-metrics/internal/engine"
+	"github.com/ast-metrics/ast-metrics/internal/engine/golang"
+	"github.com/ast-metrics/ast-metrics/internal/engine/groovy"
+	"github.com/ast-metrics/ast-metrics/internal/engine/java"
+	"github.com/ast-metrics/ast-metrics/internal/engine/javascript"
+	"github.com/ast-metrics/ast-metrics/internal/engine/kotlin"
+	"github.com/ast-metrics/ast-metrics/internal/engine/lua"
+	"github.com/ast-metrics/ast-metrics/internal/engine/python"
+)
+
+// Expected values are computed by hand: the paths of consecutive statements
+// multiply, the branches of a decision add up.
+func TestNPathAndNesting_ByLanguage(t *testing.T) {
+	cases := []struct {
+		lang    string
+		runner  enginePkg.Engine
+		code    string
+		npath   int64
+		nesting int32
+	}{
+		// if / else if without else: 1 (&&) + 1 + 1 + 1 = 4
+		// for: 2 (inner if) + 1 = 3
+		// switch without default: 1 + 1 + 1 = 3
+		{"go", &golang.GolangRunner{}, `package main
+
+func paths(a int, b, c bool) int {
+	if a > 1 && b {
+		return 1
+	} else if a < 0 {
+		return 2
+	}
+	for i := 0; i < a; i++ {
+		if i > 2 {
+			return i
+		}
+	}
+	switch a {
+	case 1:
+		return 1
+	case 2:
+		return 2
+	}
+	return 0
+}
+`, 36, 2},
+		// if / elif / else: 1 (and) + 1 + 1 + 1 = 4, while: 2, ternary: 2
+		{"python", &python.PythonRunner{}, `def paths(a, b):
+    if a and b:
+        x = 1
+    elif a:
+        x = 2
+    else:
+        x = 3
+    while a > 0:
+        a -= 1
+    return x if b else 0
+`, 16, 1},
+		// try: 2 (inner if) + 1 + 1 (catches) = 4, switch with default: 2
+		{"java", &java.JavaRunner{}, `class A {
+  int paths(int a) {
+    try {
+      if (a > 0) { a++; }
+    } catch (IllegalStateException e) {
+      a = 0;
+    } catch (RuntimeException e) {
+      a = 1;
+    }
+    switch (a) {
+      case 1: a++; break;
+      default: a--;
+    }
+    return a;
+  }
+}`, 8, 1},
+		// if: (for: (if: 2) + 1 = 3) + 1 (else if) + 1 (else) = 5; the else if
+		// keeps the depth of its if
+		{"js", &javascript.JavaScriptRunner{}, `function paths(a) {
+  if (a) {
+    for (let i = 0; i < a; i++) {
+      if (i > 2) {
+        return i;
+      }
+    }
+  } else if (a < 0) {
+    return 1;
+  } else {
+    return 2;
+  }
+  return 0;
+}
+`, 5, 3},
+		// if / else if / else: 1 (&&) + 1 + 1 + 1 = 4, while: 2, try: 2
+		// (inner if) + 1 (catch) = 3; the bodies are closures for the grammar
+		{"groovy", &groovy.GroovyRunner{}, `class A {
+  def paths(a, b) {
+    def x = 0
+    if (a && b) {
+      x = 1
+    } else if (a) {
+      x = 2
+    } else {
+      x = 3
+    }
+    while (a > 0) {
+      a--
+    }
+    try {
+      if (b) { x++ }
+    } catch (Exception e) {
+      x = 0
+    }
+    x
+  }
+}`, 24, 1},
+		// if / else if / else: 1 (&&) + 1 + 1 + 1 = 4, while: 1 (||) + 2 = 3;
+		// the conditions have no field in the grammar
+		{"kotlin", &kotlin.KotlinRunner{}, `fun paths(a: Int, b: Boolean): Int {
+    var x = 0
+    if (a > 1 && b) {
+        x = 1
+    } else if (a > 0) {
+        x = 2
+    } else {
+        x = 3
+    }
+    var i = a
+    while (i > 0 || b) {
+        i--
+    }
+    return x
+}
+`, 12, 1},
+		{"lua", &lua.LuaRunner{}, `function paths(a, b)
+  local x = 0
+  if a > 1 and b then
+    x = 1
+  elseif a > 0 then
+    x = 2
+  else
+    x = 3
+  end
+  while a > 0 or b do
+    a = a - 1
+  end
+  return x
+end
+`, 12, 1},
+	}
+	for _, tc := range cases {
+		t.Run(tc.lang, func(t *testing.T) {
+			file, err := enginePkg.CreateTestFileWithCode(tc.runner, tc.code)
+			if err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+			var found bool
+			for _, fn := range enginePkg.GetFunctionsInFile(file) {
+				if fn.Name.Short != "paths" {
+					continue
+				}
+				found = true
+				c := fn.Stmts.GetAnalyze().GetComplexity()
+				if c.GetNpath() != tc.npath {
+					t.Errorf("expected NPath complexity %d, got %d", tc.npath, c.GetNpath())
+				}
+				if c.GetMaxNesting() != tc.nesting {
+					t.Errorf("expected max nesting %d, got %d", tc.nesting, c.GetMaxNesting())
+				}
+			}
+			if !found {
+				t.Fatal("function paths() not found")
+			}
+		})
+	}
+}
@@ -29,6 +29,10 @@ type OperandSpec struct {
 	// Keywords are anonymous leaves in every tree-sitter grammar, so a keyword
 	// operator ("return", "new", "instanceof") is declared here like a symbol.
 	OperatorTokens map[string]bool
+	// OperatorTypes lists the named node types whose text is an operator. In
+	// Scala, "a + b" calls the method "+": the grammar gives the operator a
+	// named node (operator_identifier), and its text is the operator.
+	OperatorTypes map[string]bool
 	// OperandTypes lists the named node types counted as operands.
 	OperandTypes map[string]bool
 	// CallTypes lists the node types counted as one CallOperator: a call is an
@@ -113,6 +117,10 @@ func (s OperandSpec) Extract(root *sitter.Node, src []byte, startLine, endLine i
 			}
 			return
 		}
+		if n.IsNamed() && s.OperatorTypes[t] {
+			ops = append(ops, nodeText(src, n))
+			return
+		}
 		if n.IsNamed() && s.OperandTypes[t] {
 			// the object alone ("return e") names no attribute
 			if txt := nodeText(src, n); s.Receiver == "" || txt != s.Receiver {
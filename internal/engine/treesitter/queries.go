@@ -0,0 +1,266 @@
+package treesitter
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ast-metrics/ast-metrics/internal/configuration"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+	sitter "github.com/smacker/go-tree-sitter"
+	tsC "github.com/smacker/go-tree-sitter/c"
+	tsCpp "github.com/smacker/go-tree-sitter/cpp"
+	tsCSharp "github.com/smacker/go-tree-sitter/csharp"
+	tsElixir "github.com/smacker/go-tree-sitter/elixir"
+	tsGo "github.com/smacker/go-tree-sitter/golang"
+	tsGroovy "github.com/smacker/go-tree-sitter/groovy"
+	tsJava "github.com/smacker/go-tree-sitter/java"
+	tsJavascript "github.com/smacker/go-tree-sitter/javascript"
+	tsKotlin "github.com/smacker/go-tree-sitter/kotlin"
+	tsLua "github.com/smacker/go-tree-sitter/lua"
+	tsPhp "github.com/smacker/go-tree-sitter/php"
+	tsPython "github.com/smacker/go-tree-sitter/python"
+	tsRuby "github.com/smacker/go-tree-sitter/ruby"
+	tsRust "github.com/smacker/go-tree-sitter/rust"
+	tsScala "github.com/smacker/go-tree-sitter/scala"
+	tsSwift "github.com/smacker/go-tree-sitter/swift"
+	tsTsx "github.com/smacker/go-tree-sitter/typescript/tsx"
+)
+
+// queryLanguage is a language the query rules can be written for: the name
+// of its files once parsed, and the grammar its engine parses them with.
+type queryLanguage struct {
+	name    string
+	grammar func() *sitter.Language
+}
+
+// queryLanguages lists the languages by their name in the configuration, as
+// for the extensions.
+var queryLanguages = map[string]queryLanguage{
+	"c":          {"C", tsC.GetLanguage},
+	"cpp":        {"C++", tsCpp.GetLanguage},
+	"csharp":     {"C#", tsCSharp.GetLanguage},
+	"elixir":     {"Elixir", tsElixir.GetLanguage},
+	"go":         {"Golang", tsGo.GetLanguage},
+	"groovy":     {"Groovy", tsGroovy.GetLanguage},
+	"java":       {"Java", tsJava.GetLanguage},
+	"javascript": {"JavaScript", tsJavascript.GetLanguage},
+	"kotlin":     {"Kotlin", tsKotlin.GetLanguage},
+	"lua":        {"Lua", tsLua.GetLanguage},
+	"php":        {"PHP", tsPhp.GetLanguage},
+	"python":     {"Python", tsPython.GetLanguage},
+	"ruby":       {"Ruby", tsRuby.GetLanguage},
+	"rust":       {"Rust", tsRust.GetLanguage},
+	"scala":      {"Scala", tsScala.GetLanguage},
+	"swift":      {"Swift", tsSwift.GetLanguage},
+	"typescript": {"TypeScript", tsTsx.GetLanguage},
+}
+
+// reportedCapture names the capture of the node to report, when a query has
+// several captures.
+const reportedCapture = "match"
+
+// Query is a query rule of the configuration, compiled for the grammar of its
+// language.
+type Query struct {
+	Rule     configuration.ConfigurationQueryRule
+	query    *sitter.Query
+	reported uint32 // id of the capture reported
+	paths    []*regexp.Regexp
+	exclude  []*regexp.Regexp
+}
+
+type compiledQuery struct {
+	query *Query
+	err   error
+}
+
+// compiledQueries caches the queries by rule: the same rules are compiled
+// for each parsed file.
+var compiledQueries sync.Map
+
+// CompileQuery compiles a query rule of the configuration. It fails when the
+// language is not known, or the query cannot be read by its grammar.
+func CompileQuery(rule configuration.ConfigurationQueryRule) (*Query, error) {
+	key := fmt.Sprintf("%#v", rule)
+	if cached, ok := compiledQueries.Load(key); ok {
+		return cached.(compiledQuery).query, cached.(compiledQuery).err
+	}
+	q, err := compileQuery(rule)
+	compiledQueries.Store(key, compiledQuery{query: q, err: err})
+	return q, err
+}
+
+func compileQuery(rule configuration.ConfigurationQueryRule) (*Query, error) {
+	language, ok := queryLanguages[rule.Language]
+	if !ok {
+		names := make([]string, 0, len(queryLanguages))
+		for name := range queryLanguages {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("unknown language %q (expected one of: %s)", rule.Language, strings.Join(names, ", "))
+	}
+	if strings.TrimSpace(rule.Query) == "" {
+		return nil, fmt.Errorf("the query is empty")
+	}
+	query, err := sitter.NewQuery([]byte(rule.Query), language.grammar())
+	if err != nil {
+		// the error quotes the query on the next lines
+		message, _, _ := strings.Cut(err.Error(), "\n")
+		return nil, fmt.Errorf("invalid query: %s", message)
+	}
+	if query.CaptureCount() == 0 {
+		return nil, fmt.Errorf("the query captures no node to report (ex: @match)")
+	}
+
+	q := &Query{Rule: rule, query: query}
+	for id := uint32(0); id < query.CaptureCount(); id++ {
+		if query.CaptureNameForId(id) == reportedCapture {
+			q.reported = id
+		}
+	}
+	for _, path := range rule.Paths {
+		re, err := configuration.CompileGlob(path)
+		if err != nil {
+			return nil, fmt.Errorf("invalid path %q: %v", path, err)
+		}
+		q.paths = append(q.paths, re)
+	}
+	for _, path := range rule.Exclude {
+		re, err := configuration.CompileGlob(path)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exclusion %q: %v", path, err)
+		}
+		q.exclude = append(q.exclude, re)
+	}
+	return q, nil
+}
+
+// Concerns reports whether the query runs on a file: a file of its language,
+// among its paths and not excluded.
+func (q *Query) Concerns(file *pb.File) bool {
+	if file.GetProgrammingLanguage() != queryLanguages[q.Rule.Language].name {
+		return false
+	}
+	return q.concernsPath(file.GetPath())
+}
+
+func (q *Query) concernsPath(path string) bool {
+	for _, re := range q.exclude {
+		if re.MatchString(path) {
+			return false
+		}
+	}
+	if len(q.paths) == 0 {
+		return true
+	}
+	for _, re := range q.paths {
+		if re.MatchString(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// QueriesFor returns the query rules of the configuration to run on a file of
+// the language (its name in the configuration: go, php...). The invalid rules
+// are left out: the requirements report them.
+func QueriesFor(cfg *configuration.Configuration, language string, path string) []*Query {
+	if cfg == nil || cfg.Requirements == nil || cfg.Requirements.Rules == nil {
+		return nil
+	}
+	var queries []*Query
+	for _, rule := range cfg.Requirements.Rules.Queries {
+		if rule.Language != language {
+			continue
+		}
+		q, err := CompileQuery(rule)
+		if err != nil || !q.concernsPath(path) {
+			continue
+		}
+		queries = append(queries, q)
+	}
+	return queries
+}
+
+// MatchQueries runs the queries on the tree of a file. The predicates of the
+// queries (#eq?, #match?...) are applied; a node matched twice by a query is
+// reported once.
+func MatchQueries(root *sitter.Node, src []byte, queries []*Query) []*pb.QueryMatch {
+	var matches []*pb.QueryMatch
+	for _, q := range queries {
+		seen := map[[2]uint32]bool{}
+		cursor := sitter.NewQueryCursor()
+		cursor.Exec(q.query, root)
+		for {
+			m, ok := cursor.NextMatch()
+			if !ok {
+				break
+			}
+			m = cursor.FilterPredicates(m, src)
+			if len(m.Captures) == 0 {
+				continue
+			}
+
+			node := m.Captures[0].Node
+			captures := map[string]string{}
+			for _, c := range m.Captures {
+				name := q.query.CaptureNameForId(c.Index)
+				if c.Index == q.reported {
+					node = c.Node
+				}
+				if _, ok := captures[name]; !ok {
+					captures[name] = capturedCode(c.Node, src)
+				}
+			}
+			start := node.StartPoint()
+			if seen[[2]uint32{start.Row, start.Column}] {
+				continue
+			}
+			seen[[2]uint32{start.Row, start.Column}] = true
+
+			matches = append(matches, &pb.QueryMatch{
+				Rule:    q.Rule.Name,
+				Line:    int32(start.Row) + 1,
+				Column:  int32(start.Column) + 1,
+				Message: q.message(capturedCode(node, src), captures),
+			})
+		}
+		cursor.Close()
+	}
+	return matches
+}
+
+// capturedCode returns the first line of the code of a node, as a message
+// quotes it.
+func capturedCode(n *sitter.Node, src []byte) string {
+	code := strings.TrimSpace(n.Content(src))
+	if i := strings.IndexByte(code, '\n'); i >= 0 {
+		code = strings.TrimSpace(code[:i]) + "..."
+	}
+	if runes := []rune(code); len(runes) > maxParseErrorSnippet {
+		code = string(runes[:maxParseErrorSnippet]) + "..."
+	}
+	return code
+}
+
+// queryPlaceholder matches the "{capture}" of a message template.
+var queryPlaceholder = regexp.MustCompile(`\{(\w+)\}`)
+
+// message fills the template of the rule with the code of the captures: in
+// "Use the logger rather than {fn}", {fn} is replaced by the code captured as
+// @fn. Unknown placeholders are left as they are.
+func (q *Query) message(code string, captures map[string]string) string {
+	if q.Rule.Message == "" {
+		return fmt.Sprintf("Forbidden code: %s", code)
+	}
+	return queryPlaceholder.ReplaceAllStringFunc(q.Rule.Message, func(placeholder string) string {
+		if text, ok := captures[placeholder[1:len(placeholder)-1]]; ok {
+			return text
+		}
+		return placeholder
+	})
+}
@@ -0,0 +1,101 @@
+package treesitter_test
+
+import (
+	"testing"
+
+	"github.com/ast-metrics/ast-metrics/internal/configuration"
+	enginePkg "github.com/ast-metrics/ast-metrics/internal/engine"
+	"github.com/ast-metrics/ast-metrics/internal/engine/golang"
+	"github.com/ast-metrics/ast-metrics/internal/engine/php"
+	"github.com/ast-metrics/ast-metrics/internal/engine/treesitter"
+	pb "github.com/ast-metrics/ast-metrics/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func configurationWithQueries(queries ...configuration.ConfigurationQueryRule) *configuration.Configuration {
+	cfg := configuration.NewConfiguration()
+	cfg.Requirements = &configuration.ConfigurationRequirements{Rules: &configuration.ConfigurationRequirementsRules{Queries: queries}}
+	return cfg
+}
+
+func TestMatchQueries_ReportsCapturedNodesWithTheirPosition(t *testing.T) {
+	cfg := configurationWithQueries(configuration.ConfigurationQueryRule{
+		Name:     "no_println",
+		Language: "go",
+		Query: `((call_expression
+			function: (selector_expression operand: (identifier) @pkg field: (field_identifier) @fn)) @match
+			(#eq? @pkg "fmt")
+			(#match? @fn "^Print"))`,
+		Message: "Use the logger rather than {pkg}.{fn} ({unknown})",
+	})
+	code := `package main
+
+import "fmt"
+
+func main() {
+	fmt.Println("hello")
+	log.Println("ignored")
+	x := fmt.Sprintf("ignored")
+	if true {
+		fmt.Printf("%s", x)
+	}
+}
+`
+	file, err := enginePkg.CreateTestFileWithCode(&golang.GolangRunner{Configuration: cfg}, code)
+	assert.Nil(t, err)
+
+	assert.Equal(t, []*pb.QueryMatch{
+		{Rule: "no_println", Line: 6, Column: 2, Message: "Use the logger rather than fmt.Println ({unknown})"},
+		{Rule: "no_println", Line: 10, Column: 3, Message: "Use the logger rather than fmt.Printf ({unknown})"},
+	}, file.QueryMatches)
+}
+
+func TestMatchQueries_OnlyRunsTheQueriesOfTheLanguageAndPaths(t *testing.T) {
+	cfg := configurationWithQueries(
+		configuration.ConfigurationQueryRule{Name: "no_eval", Language: "php", Query: `(function_call_expression function: (name) @fn (#eq? @fn "eval"))`},
+		configuration.ConfigurationQueryRule{Name: "go_only", Language: "go", Query: `(identifier) @match`},
+		configuration.ConfigurationQueryRule{Name: "elsewhere", Language: "php", Query: `(name) @match`, Paths: []string{"src/**"}},
+		configuration.ConfigurationQueryRule{Name: "excluded", Language: "php", Query: `(name) @match`, Exclude: []string{"*.src"}},
+	)
+	code := `<?php
+$result = eval($code);
+`
+	file, err := enginePkg.CreateTestFileWithCode(&php.PhpRunner{Configuration: cfg}, code)
+	assert.Nil(t, err)
+
+	assert.Equal(t, []*pb.QueryMatch{
+		{Rule: "no_eval", Line: 2, Column: 11, Message: "Forbidden code: eval"},
+	}, file.QueryMatches)
+}
+
+func TestQueriesFor_MatchesThePathsAsGlobs(t *testing.T) {
+	cfg := configurationWithQueries(configuration.ConfigurationQueryRule{
+		Name: "no_println", Language: "go", Query: `(identifier) @match`, Paths: []string{"src/**"}, Exclude: []string{"**/*_test.go"},
+	})
+
+	// the paths of "ast-metrics lint src" are absolute
+	assert.Equal(t, 1, len(treesitter.QueriesFor(cfg, "go", "/tmp/proj/src/main.go")))
+	assert.Equal(t, 1, len(treesitter.QueriesFor(cfg, "go", "src/pkg/main.go")))
+	assert.Empty(t, treesitter.QueriesFor(cfg, "go", "/tmp/proj/cmd/main.go"))
+	assert.Empty(t, treesitter.QueriesFor(cfg, "go", "/tmp/proj/src/main_test.go"))
+}
+
+func TestCompileQuery_Errors(t *testing.T) {
+	cases := []struct {
+		rule     configuration.ConfigurationQueryRule
+		expected string
+	}{
+		{configuration.ConfigurationQueryRule{Language: "cobol", Query: "(x) @match"}, `unknown language "cobol" (expected one of: c, cpp, csharp, elixir, go, groovy, java, javascript, kotlin, lua, php, python, ruby, rust, scala, swift, typescript)`},
+		{configuration.ConfigurationQueryRule{Language: "go", Query: " "}, "the query is empty"},
+		{configuration.ConfigurationQueryRule{Language: "go", Query: "(no_such_node) @match"}, "invalid query: invalid node type 'no_such_node' at line 1 column 0"},
+		{configuration.ConfigurationQueryRule{Language: "go", Query: "(identifier @match"}, "invalid query: invalid syntax at line 1 column 0"},
+		{configuration.ConfigurationQueryRule{Language: "go", Query: "(identifier)"}, "the query captures no node to report (ex: @match)"},
+		{configuration.ConfigurationQueryRule{Language: "go", Query: "(identifier) @match", Exclude: []string{""}}, `invalid exclusion "": empty path`},
+	}
+	for _, c := range cases {
+		_, err := treesitter.CompileQuery(c.rule)
+		if assert.Error(t, err, c.rule.Query) {
+			assert.Equal(t, c.expected, err.Error())
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package treesitter
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// referenceTypes lists the leaf node types that may name a type. The grammars
+// do not agree on a node type for the type positions: Java, Go or Kotlin have
+// a "type_identifier", C#, Groovy or Python write a type as any identifier,
+// PHP as a "name", Ruby as a "constant", Elixir as an "alias".
+var referenceTypes = map[string]bool{
+	"type_identifier":   true,
+	"identifier":        true,
+	"simple_identifier": true,
+	"name":              true,
+	"constant":          true,
+	"alias":             true,
+}
+
+// TypeReferences returns the names of the types mentioned in a class: the
+// types of its fields and parameters, the classes it instantiates or calls
+// statically. A name is kept when the grammar says it is a type, or when it is
+// written as one (an identifier starting with an upper-case letter). The names
+// are not resolved: the coupling between objects keeps those naming an
+// imported class or a class of the project. Nested classes keep their
+// references for themselves.
+func TypeReferences(ad LangAdapter, class *sitter.Node, src []byte) []string {
+	if class == nil || src == nil {
+		return nil
+	}
+	seen := map[string]bool{}
+	var names []string
+	var walk func(n *sitter.Node)
+	walk = func(n *sitter.Node) {
+		if !n.Equal(class) && ad.IsClass(n) {
+			return
+		}
+		if n.NamedChildCount() == 0 {
+			if !referenceTypes[n.Type()] {
+				return
+			}
+			name := nodeText(src, n)
+			first, _ := utf8.DecodeRuneInString(name)
+			if n.Type() != "type_identifier" && !unicode.IsUpper(first) {
+				return
+			}
+			if name != "" && !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+			return
+		}
+		for i := 0; i < int(n.NamedChildCount()); i++ {
+			walk(n.NamedChild(i))
+		}
+	}
+	walk(class)
+	return names
+}
@@ -0,0 +1,96 @@
+package treesitter
+
+import (
+	"hash/fnv"
+	"strings"
+
+	pb "github.com/ast-metrics/ast-metrics/pb"
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+const (
+	// IdentifierToken stands for any name: variables, functions, types...
+	IdentifierToken = "$id"
+	// LiteralToken stands for any value written in the code.
+	LiteralToken = "$lit"
+)
+
+// literalLeaves lists the node types of the values that are not numbers,
+// characters or strings ("None" in Python, "nil" in Go).
+var literalLeaves = map[string]bool{
+	"true": true, "false": true, "null": true, "nil": true, "none": true, "undefined": true,
+}
+
+// Tokens returns the normalized token stream of a file. Comments are left out,
+// names become IdentifierToken and values LiteralToken, so that two pieces of
+// code renamed from one another share their tokens. The other tokens are kept
+// as the grammar names them: keywords and punctuation are their own text, and
+// they are the same across the grammars of similar languages.
+func Tokens(root *sitter.Node, src []byte) *pb.TokenStream {
+	stream := &pb.TokenStream{}
+	if root == nil {
+		return stream
+	}
+	hashes := map[string]uint32{}
+	emit := func(n *sitter.Node, kind string) {
+		h, ok := hashes[kind]
+		if !ok {
+			hasher := fnv.New32a()
+			hasher.Write([]byte(kind))
+			h = hasher.Sum32()
+			hashes[kind] = h
+		}
+		stream.Kinds = append(stream.Kinds, h)
+		stream.Lines = append(stream.Lines, int32(n.StartPoint().Row)+1)
+	}
+
+	var walk func(n *sitter.Node)
+	walk = func(n *sitter.Node) {
+		kind := n.Type()
+		switch {
+		case strings.Contains(kind, "comment"):
+			return
+		case n.IsNamed() && isLiteral(n):
+			emit(n, LiteralToken)
+			return
+		case n.ChildCount() > 0:
+			for i := 0; i < int(n.ChildCount()); i++ {
+				walk(n.Child(i))
+			}
+			return
+		case n.StartByte() == n.EndByte() || strings.TrimSpace(kind) == "":
+			// tokens assumed by the parser, and the line breaks and
+			// indentation some grammars report
+			return
+		case n.IsNamed() && (strings.Contains(kind, "identifier") || kind == "name"):
+			emit(n, IdentifierToken)
+		default:
+			emit(n, kind)
+		}
+	}
+	walk(root)
+	return stream
+}
+
+// isLiteral reports whether a node is a value. Strings have children in most
+// grammars (quotes, escapes, interpolations), they are still one value. The
+// other literal types only count as leaves: a "func_literal" or a
+// "composite_literal" hold code.
+func isLiteral(n *sitter.Node) bool {
+	kind := strings.ToLower(n.Type())
+	if strings.Contains(kind, "string") {
+		return true
+	}
+	if n.ChildCount() > 0 {
+		return false
+	}
+	if literalLeaves[kind] {
+		return true
+	}
+	for _, part := range []string{"literal", "number", "integer", "float", "char"} {
+		if strings.Contains(kind, part) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,47 @@
+package treesitter_test
+
+import (
+	"testing"
+
+	enginePkg "github.com/ast-metrics/ast-metrics/internal/engine"
+	"github.com/ast-metrics/ast-metrics/internal/engine/golang"
+	"github.com/ast-metrics/ast-metrics/internal/engine/python"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokens_AbstractNamesAndValues(t *testing.T) {
+	first, err := enginePkg.CreateTestFileWithCode(&python.PythonRunner{}, ""+
+		"def total(items):\n"+ // 1
+		"    # sum the prices\n"+ // 2
+		"    return sum(items) + 10\n") // 3
+	assert.Nil(t, err)
+	renamed, err := enginePkg.CreateTestFileWithCode(&python.PythonRunner{}, ""+
+		"def count(values):\n"+
+		"    return len(values) + \"a\"\n")
+	assert.Nil(t, err)
+
+	assert.Equal(t, first.Tokens.Kinds, renamed.Tokens.Kinds)
+	assert.Equal(t, int32(1), first.Tokens.Lines[0])
+	// the comment gives no token
+	assert.Equal(t, int32(3), first.Tokens.Lines[len(first.Tokens.Lines)-1])
+	assert.Equal(t, len(first.Tokens.Kinds), len(first.Tokens.Lines))
+}
+
+func TestTokens_KeepTheStructureOfTheCode(t *testing.T) {
+	loop, err := enginePkg.CreateTestFileWithCode(&golang.GolangRunner{}, ""+
+		"package main\n"+
+		"func run() { for { work(1) } }\n")
+	assert.Nil(t, err)
+	condition, err := enginePkg.CreateTestFileWithCode(&golang.GolangRunner{}, ""+
+		"package main\n"+
+		"func run() { if ok { work(1) } }\n")
+	assert.Nil(t, err)
+
+	assert.NotEqual(t, loop.Tokens.Kinds, condition.Tokens.Kinds)
+	// an anonymous function is code, not a value
+	closure, err := enginePkg.CreateTestFileWithCode(&golang.GolangRunner{}, ""+
+		"package main\n"+
+		"var run = func() { for { work(1) } }\n")
+	assert.Nil(t, err)
+	assert.Greater(t, len(closure.Tokens.Kinds), 10)
+}
@@ -13,9 +13,11 @@ type Visitor struct {
 	ad    LangAdapter
 	file  *pb.File
 	ns    *pb.StmtNamespace
+	src   []byte
 	lines []string
 
 	classStk []*pb.StmtClass
+	traitStk []*pb.StmtTrait
 	funcStk  []*pb.StmtFunction
 
 	// receiverMethods holds the methods declared outside of the class they
@@ -23,10 +25,17 @@ type Visitor struct {
 	// file has been visited, because a method may be declared before its type.
 	receiverMethods []receiverMethod
 
+	// companion holds the statements of a file analyzed together with this
+	// one (the header of a C++ source file), merged into the result.
+	companion *pb.File
+
 	// logicalLines holds the 1-based line numbers on which a statement starts.
 	// LLOC at every level (file, class, function) is the number of such lines
 	// in the scope's range.
 	logicalLines map[int]bool
+
+	// queries holds the query rules of the configuration to run on the file.
+	queries []*Query
 }
 
 // receiverMethod is a method waiting to be attached to the class of its
@@ -61,7 +70,7 @@ func (v *Visitor) collectLogicalLines(node *sitter.Node) {
 	var walk func(n *sitter.Node)
 	walk = func(n *sitter.Node) {
 		if isLogical(n) {
-			v.logicalLines[int(n.StartPoint().Row)+1] = true
+			v.logicalLines[v.startLine(n)] = true
 		}
 		for i := 0; i < int(n.ChildCount()); i++ {
 			walk(n.Child(i))
@@ -85,18 +94,34 @@ func (v *Visitor) countLogicalLines(start, end int) int {
 // locationOf converts a tree-sitter node position into a 1-based file
 // location. Downstream consumers (rules, review, SARIF) rely on it to anchor
 // findings to the exact line.
-func locationOf(node *sitter.Node) *pb.StmtLocationInFile {
+func (v *Visitor) locationOf(node *sitter.Node) *pb.StmtLocationInFile {
 	if node == nil {
 		return nil
 	}
+	start, offset := v.startOf(node)
 	return &pb.StmtLocationInFile{
-		StartLine:    int32(node.StartPoint().Row) + 1,
+		StartLine:    int32(start.Row) + 1,
 		EndLine:      int32(node.EndPoint().Row) + 1,
-		StartFilePos: int32(node.StartByte()),
+		StartFilePos: int32(offset),
 		EndFilePos:   int32(node.EndByte()),
 	}
 }
 
+// startOf returns the position and the byte offset at which a node starts,
+// as reported by the adapter when it is StartAware.
+func (v *Visitor) startOf(node *sitter.Node) (sitter.Point, uint32) {
+	if sa, ok := v.ad.(StartAware); ok {
+		return sa.NodeStart(node)
+	}
+	return node.StartPoint(), node.StartByte()
+}
+
+// startLine returns the 1-based line on which a node starts.
+func (v *Visitor) startLine(node *sitter.Node) int {
+	start, _ := v.startOf(node)
+	return int(start.Row) + 1
+}
+
 func (v *Visitor) curStmts() *pb.Stmts {
 	if f := v.curFunc(); f != nil {
 		return f.Stmts
@@ -115,6 +140,7 @@ func NewVisitor(ad LangAdapter, path string, src []byte) *Visitor {
 		ad:    ad,
 		file:  &pb.File{Path: path, ProgrammingLanguage: "", Stmts: engine.FactoryStmts(), LinesOfCode: &pb.LinesOfCode{LinesOfCode: int32(len(lines))}},
 		ns:    &pb.StmtNamespace{Name: &pb.Name{Short: mod, Qualified: mod}, Stmts: engine.FactoryStmts(), LinesOfCode: &pb.LinesOfCode{}},
+		src:   src,
 		lines: lines,
 	}
 }
@@ -129,6 +155,10 @@ func (v *Visitor) commentMarkers() engine.CommentMarkers {
 }
 
 func (v *Visitor) Result() *pb.File {
+	// the classes of the companion file are known before binding: the methods
+	// of a C++ class are usually defined in the source, out of its header
+	v.mergeCompanion()
+
 	// methods declared outside of their class (Go receivers) are attached now
 	// that every class of the file is known
 	v.bindReceiverMethods()
@@ -147,6 +177,14 @@ func (v *Visitor) Result() *pb.File {
 	// LLOC counts the lines on which a statement starts
 	v.file.LinesOfCode.LogicalLinesOfCode = int32(len(v.logicalLines))
 
+	if v.companion != nil && v.companion.LinesOfCode != nil {
+		loc, other := v.file.LinesOfCode, v.companion.LinesOfCode
+		loc.LinesOfCode += other.LinesOfCode
+		loc.LogicalLinesOfCode += other.LogicalLinesOfCode
+		loc.CommentLinesOfCode += other.CommentLinesOfCode
+		loc.NonCommentLinesOfCode += other.NonCommentLinesOfCode
+	}
+
 	return v.file
 }
 
@@ -165,6 +203,13 @@ func (v *Visitor) curClass() *pb.StmtClass {
 	return v.classStk[len(v.classStk)-1]
 }
 
+func (v *Visitor) curTrait() *pb.StmtTrait {
+	if len(v.traitStk) == 0 {
+		return nil
+	}
+	return v.traitStk[len(v.traitStk)-1]
+}
+
 func (v *Visitor) pushFunc(f *pb.StmtFunction) {
 	v.funcStk = append(v.funcStk, f)
 }
@@ -203,6 +248,11 @@ func (v *Visitor) attachFunction(fn *pb.StmtFunction) {
 		pc.Stmts.StmtFunction = append(pc.Stmts.StmtFunction, fn)
 		return
 	}
+	if t := v.curTrait(); t != nil {
+		// reachable from the namespace, like any method
+		t.Stmts.StmtFunction = append(t.Stmts.StmtFunction, fn)
+		return
+	}
 	v.file.Stmts.StmtFunction = append(v.file.Stmts.StmtFunction, fn)
 }
 
@@ -212,6 +262,21 @@ type InterfaceAware interface {
 	IsInterface(*sitter.Node) bool
 }
 
+// InterfaceMembersAware lets an adapter describe an interface whose body holds
+// no function to visit: a Go interface lists its methods, named by NodeName,
+// and the interfaces it embeds.
+type InterfaceMembersAware interface {
+	InterfaceMembers(*sitter.Node) (methods []*sitter.Node, embedded []*pb.Name)
+}
+
+// TraitAware lets an adapter create StmtTrait nodes: the traits of Scala and
+// Groovy carry methods with a body, mixed into the classes using them. Their
+// methods are measured like any other, but they do not make a class of their
+// own (cohesion, methods per class).
+type TraitAware interface {
+	IsTrait(*sitter.Node) bool
+}
+
 // ReceiverAware lets an adapter tell that a function node is a method bound to a
 // type declared elsewhere in the file. Go declares its methods at the top level,
 // outside of the struct they belong to: without this, a struct would hold no
@@ -223,6 +288,144 @@ type ReceiverAware interface {
 	ReceiverTypeName(*sitter.Node) string
 }
 
+// StartAware lets an adapter report where a node really starts. The Lua
+// grammar lends the blank lines before a top-level statement to its first
+// token: without it, a function would start on the line of the statement
+// before it.
+type StartAware interface {
+	// NodeStart returns the position and the byte offset of the first
+	// significant character of the node.
+	NodeStart(*sitter.Node) (sitter.Point, uint32)
+}
+
+// ScopeAware lets an adapter qualify a class with the scopes enclosing it in
+// the source. Ruby nests classes in modules instead of declaring a namespace
+// for the whole file: without this, two "Base" classes of different modules
+// would share the same qualified name.
+type ScopeAware interface {
+	// EnclosingScope returns the qualified name of the scope holding the class
+	// (e.g. "Billing::Core"), or an empty string at the top level.
+	EnclosingScope(*sitter.Node) string
+}
+
+// Heritage lists what a class inherits from or mixes in.
+type Heritage struct {
+	Extends    []*pb.Name
+	Implements []*pb.Name
+	Uses       []*pb.Name
+}
+
+// HeritageAware lets an adapter report the parents and the mixins of a class,
+// so that they count as dependencies of the class (coupling, community graph).
+type HeritageAware interface {
+	Heritage(*sitter.Node) Heritage
+}
+
+// qualifiedClassName prefixes a class name with its enclosing scope, when the
+// adapter reports one, and with the namespace of the file.
+func (v *Visitor) qualifiedClassName(node *sitter.Node, name string) string {
+	qualified := name
+	if sa, ok := v.ad.(ScopeAware); ok {
+		if scope := sa.EnclosingScope(node); scope != "" {
+			qualified = scope + v.namespaceSeparator() + name
+		}
+	}
+	if v.ns != nil && v.ns.Name != nil {
+		if ns := v.ns.Name.Qualified; ns != "" {
+			qualified = ns + v.namespaceSeparator() + qualified
+		}
+	}
+	return qualified
+}
+
+// SetCompanion registers a file analyzed together with this one: a C++
+// header paired with its source file. Its statements are merged into the
+// result, and the methods the source defines out of line ("Foo::bar") bind to
+// the classes it declares.
+func (v *Visitor) SetCompanion(companion *pb.File) {
+	v.companion = companion
+}
+
+// SetQueries sets the query rules of the configuration to run on the file.
+// Their matches are recorded in the result.
+func (v *Visitor) SetQueries(queries []*Query) {
+	v.queries = queries
+}
+
+// mergeCompanion moves the statements of the companion file into the result.
+// Their locations point into the companion, not into this file: they keep
+// their lines, with the path of the companion, like the matches of the query
+// rules and the directives.
+func (v *Visitor) mergeCompanion() {
+	if v.companion == nil {
+		return
+	}
+	for _, match := range v.companion.QueryMatches {
+		if match.File == "" {
+			match.File = v.companion.Path
+		}
+		v.file.QueryMatches = append(v.file.QueryMatches, match)
+	}
+	for _, directive := range v.companion.Directives {
+		if directive.File == "" {
+			directive.File = v.companion.Path
+		}
+		v.file.Directives = append(v.file.Directives, directive)
+	}
+	if v.companion.Stmts == nil {
+		return
+	}
+	top := v.companion.Stmts
+	locateIn(top, v.companion.Path)
+	v.file.Stmts.StmtClass = append(v.file.Stmts.StmtClass, top.StmtClass...)
+	v.file.Stmts.StmtInterface = append(v.file.Stmts.StmtInterface, top.StmtInterface...)
+	v.file.Stmts.StmtTrait = append(v.file.Stmts.StmtTrait, top.StmtTrait...)
+	v.file.Stmts.StmtFunction = append(v.file.Stmts.StmtFunction, top.StmtFunction...)
+	v.file.Stmts.StmtExternalDependencies = append(v.file.Stmts.StmtExternalDependencies, top.StmtExternalDependencies...)
+	for _, ns := range top.StmtNamespace {
+		if ns == nil || ns.Stmts == nil {
+			continue
+		}
+		v.ns.Stmts.StmtClass = append(v.ns.Stmts.StmtClass, ns.Stmts.StmtClass...)
+		v.ns.Stmts.StmtInterface = append(v.ns.Stmts.StmtInterface, ns.Stmts.StmtInterface...)
+		v.ns.Stmts.StmtTrait = append(v.ns.Stmts.StmtTrait, ns.Stmts.StmtTrait...)
+		v.ns.Stmts.StmtFunction = append(v.ns.Stmts.StmtFunction, ns.Stmts.StmtFunction...)
+		v.ns.Stmts.StmtExternalDependencies = append(v.ns.Stmts.StmtExternalDependencies, ns.Stmts.StmtExternalDependencies...)
+	}
+}
+
+// locateIn records the path of the file holding the classes, interfaces,
+// traits and functions found in stmts, recursively.
+func locateIn(stmts *pb.Stmts, path string) {
+	if stmts == nil {
+		return
+	}
+	locate := func(location *pb.StmtLocationInFile) {
+		if location != nil && location.File == "" {
+			location.File = path
+		}
+	}
+	for _, c := range stmts.StmtClass {
+		locate(c.Location)
+		locateIn(c.Stmts, path)
+	}
+	for _, i := range stmts.StmtInterface {
+		locate(i.Location)
+		locateIn(i.Stmts, path)
+	}
+	for _, t := range stmts.StmtTrait {
+		locate(t.Location)
+		locateIn(t.Stmts, path)
+	}
+	for _, f := range stmts.StmtFunction {
+		locate(f.Location)
+		locateIn(f.Stmts, path)
+	}
+	for _, ns := range stmts.StmtNamespace {
+		locateIn(ns.Stmts, path)
+	}
+}
+
 // bindReceiverMethods moves the methods declared with a receiver into the class
 // of that receiver. The method is moved and not copied, so that it stays
 // reachable exactly once from the file.
@@ -242,7 +445,15 @@ func (v *Visitor) bindReceiverMethods() {
 		class, ok := classes[rm.receiver]
 		if !ok {
 			// the receiver type is declared in another file of the package: the
-			// method stays where it is
+			// method stays where it is, qualified with its receiver so that it
+			// can be bound to its type once the whole package is parsed
+			if rm.fn.Name != nil {
+				receiver := rm.receiver
+				if v.ns != nil && v.ns.Name != nil && v.ns.Name.Qualified != "" {
+					receiver = v.ns.Name.Qualified + v.namespaceSeparator() + receiver
+				}
+				rm.fn.Name.Qualified = v.ad.AttachQualified(receiver, rm.fn.Name.Short)
+			}
 			continue
 		}
 		if class.Stmts == nil {
@@ -279,11 +490,15 @@ func (v *Visitor) namespaceSeparator() string {
 }
 
 func (v *Visitor) Visit(node *sitter.Node) {
-	// The first call receives the root node: collect logical lines for the
-	// whole file before descending.
+	// The first call receives the root node: collect logical lines, syntax
+	// problems, tokens and directives for the whole file before descending.
 	if v.logicalLines == nil {
 		v.logicalLines = map[int]bool{}
 		v.collectLogicalLines(node)
+		v.file.ParseErrors = ParseErrors(node, v.src)
+		v.file.Tokens = Tokens(node, v.src)
+		v.file.QueryMatches = MatchQueries(node, v.src, v.queries)
+		v.file.Directives = v.directives(node)
 	}
 
 	switch {
@@ -299,17 +514,33 @@ func (v *Visitor) Visit(node *sitter.Node) {
 		return false
 	}():
 		name := v.ad.NodeName(node)
-		qualified := name
-		if v.ns != nil && v.ns.Name != nil {
-			ns := v.ns.Name.Qualified
-			if ns != "" {
-				qualified = ns + v.namespaceSeparator() + name
-			}
-		}
+		qualified := v.qualifiedClassName(node, name)
 		itf := &pb.StmtInterface{
 			Name:     &pb.Name{Short: name, Qualified: qualified},
 			Stmts:    engine.FactoryStmts(),
-			Location: locationOf(node),
+			Location: v.locationOf(node),
+		}
+		modifiers, _ := v.ad.(ModifierAware)
+		if modifiers != nil {
+			itf.Modifiers = modifiers.Modifiers(node)
+		}
+		itf.DocComment = v.docComment(node)
+		if ma, ok := v.ad.(InterfaceMembersAware); ok {
+			methods, embedded := ma.InterfaceMembers(node)
+			itf.Extends = embedded
+			for _, method := range methods {
+				methodName := v.ad.NodeName(method)
+				fn := &pb.StmtFunction{
+					Name:       &pb.Name{Short: methodName, Qualified: v.ad.AttachQualified(qualified, methodName)},
+					Stmts:      engine.FactoryStmts(),
+					Location:   v.locationOf(method),
+					DocComment: v.docComment(method),
+				}
+				if modifiers != nil {
+					fn.Modifiers = modifiers.Modifiers(method)
+				}
+				itf.Stmts.StmtFunction = append(itf.Stmts.StmtFunction, fn)
+			}
 		}
 		body := v.ad.NodeBody(node)
 		// attach to namespace and file
@@ -319,24 +550,41 @@ func (v *Visitor) Visit(node *sitter.Node) {
 		v.ad.EachChildBody(body, func(ch *sitter.Node) { v.Visit(ch) })
 		return
 
+	case func() bool {
+		if ta, ok := v.ad.(TraitAware); ok {
+			return ta.IsTrait(node)
+		}
+		return false
+	}():
+		name := v.ad.NodeName(node)
+		t := &pb.StmtTrait{
+			Name:     &pb.Name{Short: name, Qualified: v.qualifiedClassName(node, name)},
+			Stmts:    engine.FactoryStmts(),
+			Location: v.locationOf(node),
+		}
+		v.ns.Stmts.StmtTrait = append(v.ns.Stmts.StmtTrait, t)
+		v.file.Stmts.StmtTrait = append(v.file.Stmts.StmtTrait, t)
+		// the methods of the trait belong to it, not to an enclosing class
+		classes := v.classStk
+		v.classStk = nil
+		v.traitStk = append(v.traitStk, t)
+		v.ad.EachChildBody(v.ad.NodeBody(node), func(ch *sitter.Node) { v.Visit(ch) })
+		v.traitStk = v.traitStk[:len(v.traitStk)-1]
+		v.classStk = classes
+		return
+
 	case v.ad.IsClass(node):
 		name := v.ad.NodeName(node)
-		qualified := name
 		// qualify with namespace if provided (PHP namespaces, even single segment)
-		if v.ns != nil && v.ns.Name != nil {
-			ns := v.ns.Name.Qualified
-			if ns != "" {
-				qualified = ns + v.namespaceSeparator() + name
-			}
-		}
+		qualified := v.qualifiedClassName(node, name)
 		c := &pb.StmtClass{
 			Name:        &pb.Name{Short: name, Qualified: qualified},
 			Stmts:       engine.FactoryStmts(),
 			LinesOfCode: &pb.LinesOfCode{},
-			Location:    locationOf(node),
+			Location:    v.locationOf(node),
 		}
 		body := v.ad.NodeBody(node)
-		start := int(node.StartPoint().Row) + 1
+		start := v.startLine(node)
 		end := start
 		if body != nil {
 			// For class LOC, count from the class declaration line up to the closing brace line inclusively.
@@ -364,6 +612,19 @@ func (v *Visitor) Visit(node *sitter.Node) {
 		cl := c.LinesOfCode.CommentLinesOfCode
 		c.Stmts.Analyze.Volume.Cloc = &cl
 
+		if ha, ok := v.ad.(HeritageAware); ok {
+			h := ha.Heritage(node)
+			c.Extends, c.Implements, c.Uses = h.Extends, h.Implements, h.Uses
+		}
+		if ma, ok := v.ad.(ModifierAware); ok {
+			c.Modifiers = ma.Modifiers(node)
+		}
+		if aa, ok := v.ad.(AnnotationAware); ok {
+			c.Annotations = aa.Annotations(node)
+		}
+		c.DocComment = v.docComment(node)
+		c.References = TypeReferences(v.ad, node, v.src)
+
 		v.attachClass(c)
 		// Attach any class-level externals provided by adapter
 		if items := v.ad.Imports(node); len(items) > 0 {
@@ -400,6 +661,17 @@ func (v *Visitor) Visit(node *sitter.Node) {
 		v.pushClass(c)
 		v.ad.EachChildBody(body, func(ch *sitter.Node) { v.Visit(ch) })
 		v.popClass()
+		// a class declaring an abstract method is abstract, whether the
+		// language says it (Java) or not (a pure virtual method in C++, an
+		// @abstractmethod in Python)
+		if c.Modifiers != nil && !c.Modifiers.IsAbstract {
+			for _, method := range c.Stmts.StmtFunction {
+				if method.GetModifiers().GetIsAbstract() {
+					c.Modifiers.IsAbstract = true
+					break
+				}
+			}
+		}
 		return
 
 	case v.ad.IsFunction(node):
@@ -407,13 +679,15 @@ func (v *Visitor) Visit(node *sitter.Node) {
 		qualified := name
 		if cls := v.curClass(); cls != nil {
 			qualified = v.ad.AttachQualified(cls.Name.Qualified, name)
+		} else if t := v.curTrait(); t != nil {
+			qualified = v.ad.AttachQualified(t.Name.Qualified, name)
 		}
 
 		fn := &pb.StmtFunction{
 			Name:        &pb.Name{Short: name, Qualified: qualified},
 			Stmts:       engine.FactoryStmts(),
 			LinesOfCode: &pb.LinesOfCode{},
-			Location:    locationOf(node),
+			Location:    v.locationOf(node),
 		}
 		if params := v.ad.NodeParams(node); params != nil {
 			v.ad.EachParamIdent(params, func(id string) {
@@ -421,25 +695,43 @@ func (v *Visitor) Visit(node *sitter.Node) {
 			})
 		}
 		body := v.ad.NodeBody(node)
-		nodeStart := int(node.StartPoint().Row) + 1
+		nodeStart := v.startLine(node)
 		nodeEnd := int(node.EndPoint().Row) + 1
 		locStart := nodeStart
 		locEnd := nodeEnd
 		if body != nil {
-			locStart = int(body.StartPoint().Row) + 1
+			locStart = v.startLine(body)
 			locEnd = int(body.EndPoint().Row) + 1
 		}
 		fn.LinesOfCode = engine.GetLocPositionFromSourceWithMarkers(v.lines, locStart, locEnd, v.commentMarkers())
 
 		// allow adapter to provide a better comment count
 		if cc, ok := v.ad.(interface{ CountComments([]string, int, int) int }); ok {
-			cs := int(node.StartPoint().Row) + 1
+			cs := nodeStart
 			ce := int(node.EndPoint().Row) + 1
 			newC := int32(cc.CountComments(v.lines, cs, ce))
 			fn.LinesOfCode.CommentLinesOfCode = newC
 		}
 		fn.LinesOfCode.LogicalLinesOfCode = int32(v.countLogicalLines(nodeStart, nodeEnd))
 
+		cognitive := CognitiveComplexity(v.ad, node, v.src)
+		npath := NPathComplexity(v.ad, node, v.src)
+		nesting := MaxNestingDepth(v.ad, node, v.src)
+		fn.Stmts.Analyze = &pb.Analyze{Complexity: &pb.Complexity{
+			Cognitive:  &cognitive,
+			Npath:      &npath,
+			MaxNesting: &nesting,
+		}, AbcSize: AbcSize(v.ad, node, v.src)}
+
+		if ma, ok := v.ad.(ModifierAware); ok {
+			fn.Modifiers = ma.Modifiers(node)
+		}
+		if aa, ok := v.ad.(AnnotationAware); ok {
+			fn.Annotations = aa.Annotations(node)
+		}
+		fn.DocComment = v.docComment(node)
+		fn.CalledMethods = CalledMethods(v.ad, node, v.src)
+
 		v.attachFunction(fn)
 		if ra, ok := v.ad.(ReceiverAware); ok && v.curClass() == nil {
 			if receiver := ra.ReceiverTypeName(node); receiver != "" {
@@ -508,6 +800,20 @@ func (v *Visitor) Visit(node *sitter.Node) {
 			st.StmtExternalDependencies = append(st.StmtExternalDependencies, dep)
 			v.ns.Stmts.StmtExternalDependencies = append(v.ns.Stmts.StmtExternalDependencies, dep)
 		}
+		// adapters whose imports bring whole modules into scope (Swift) record
+		// them as uses too
+		if x, ok := v.ad.(interface{ ImportsAsUses() bool }); ok && x.ImportsAsUses() {
+			for _, it := range items {
+				short, qualified := it.Module, it.Module
+				if it.Name != "" {
+					short, qualified = it.Name, v.ad.AttachQualified(it.Module, it.Name)
+				}
+				st.StmtUse = append(st.StmtUse, &pb.StmtUse{
+					Name:     &pb.Name{Short: short, Qualified: qualified},
+					Location: v.locationOf(node),
+				})
+			}
+		}
 	}
 
 	// Decisions
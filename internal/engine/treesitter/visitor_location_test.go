@@ -4,12 +4,21 @@ import (
 	"testing"
 
 	enginePkg "github.com/ast-metrics/ast-metrics/internal/engine"
+	"github.com/ast-metrics/ast-metrics/internal/engine/cpp"
 	"github.com/ast-metrics/ast-metrics/internal/engine/csharp"
+	"github.com/ast-metrics/ast-metrics/internal/engine/elixir"
 	"github.com/ast-metrics/ast-metrics/internal/engine/golang"
+	"github.com/ast-metrics/ast-metrics/internal/engine/groovy"
 	"github.com/ast-metrics/ast-metrics/internal/engine/java"
+	"github.com/ast-metrics/ast-metrics/internal/engine/javascript"
+	"github.com/ast-metrics/ast-metrics/internal/engine/kotlin"
+	"github.com/ast-metrics/ast-metrics/internal/engine/lua"
 	"github.com/ast-metrics/ast-metrics/internal/engine/php"
 	"github.com/ast-metrics/ast-metrics/internal/engine/python"
+	"github.com/ast-metrics/ast-metrics/internal/engine/ruby"
 	"github.com/ast-metrics/ast-metrics/internal/engine/rust"
+	"github.com/ast-metrics/ast-metrics/internal/engine/scala"
+	"github.com/ast-metrics/ast-metrics/internal/engine/swift"
 	"github.com/ast-metrics/ast-metrics/internal/engine/typescript"
 	pb "github.com/ast-metrics/ast-metrics/pb"
 )
@@ -109,6 +118,107 @@ func TestVisitor_PopulatesLocations(t *testing.T) {
 			funcName: "bar", funcLine: 2,
 			className: "Foo", classLine: 1,
 		},
+		{
+			lang:   "javascript",
+			runner: &javascript.JavaScriptRunner{},
+			code: "class Foo {\n" + // 1
+				"    bar() {\n" + // 2
+				"        return 1;\n" + // 3
+				"    }\n" + // 4
+				"}\n", // 5
+			funcName: "bar", funcLine: 2,
+			className: "Foo", classLine: 1,
+		},
+		{
+			lang:   "kotlin",
+			runner: &kotlin.KotlinRunner{},
+			code: "class Foo {\n" + // 1
+				"    fun bar(): Int {\n" + // 2
+				"        return 1\n" + // 3
+				"    }\n" + // 4
+				"}\n", // 5
+			funcName: "bar", funcLine: 2,
+			className: "Foo", classLine: 1,
+		},
+		{
+			lang:   "ruby",
+			runner: &ruby.RubyRunner{},
+			code: "class Foo\n" + // 1
+				"  def bar\n" + // 2
+				"    1\n" + // 3
+				"  end\n" + // 4
+				"end\n", // 5
+			funcName: "bar", funcLine: 2,
+			className: "Foo", classLine: 1,
+		},
+		{
+			lang:   "cpp",
+			runner: &cpp.CppRunner{},
+			code: "class Foo {\n" + // 1
+				"    int bar() {\n" + // 2
+				"        return 1;\n" + // 3
+				"    }\n" + // 4
+				"};\n", // 5
+			funcName: "bar", funcLine: 2,
+			className: "Foo", classLine: 1,
+		},
+		{
+			lang:   "scala",
+			runner: &scala.ScalaRunner{},
+			code: "class Foo {\n" + // 1
+				"  def bar(): Int = {\n" + // 2
+				"    1\n" + // 3
+				"  }\n" + // 4
+				"}\n", // 5
+			funcName: "bar", funcLine: 2,
+			className: "Foo", classLine: 1,
+		},
+		{
+			lang:   "swift",
+			runner: &swift.SwiftRunner{},
+			code: "class Foo {\n" + // 1
+				"    func bar() -> Int {\n" + // 2
+				"        return 1\n" + // 3
+				"    }\n" + // 4
+				"}\n", // 5
+			funcName: "bar", funcLine: 2,
+			className: "Foo", classLine: 1,
+		},
+		{
+			lang:   "groovy",
+			runner: &groovy.GroovyRunner{},
+			code: "class Foo {\n" + // 1
+				"    int bar() {\n" + // 2
+				"        return 1\n" + // 3
+				"    }\n" + // 4
+				"}\n", // 5
+			funcName: "bar", funcLine: 2,
+			className: "Foo", classLine: 1,
+		},
+		{
+			lang:   "elixir",
+			runner: &elixir.ElixirRunner{},
+			code: "defmodule Foo do\n" + // 1
+				"  def bar do\n" + // 2
+				"    1\n" + // 3
+				"  end\n" + // 4
+				"end\n", // 5
+			funcName: "bar", funcLine: 2,
+			className: "Foo", classLine: 1,
+		},
+		{
+			// the grammar lends the blank lines to the next statement
+			lang:   "lua",
+			runner: &lua.LuaRunner{},
+			code: "local Foo = {}\n" + // 1
+				"\n" + // 2
+				"\n" + // 3
+				"function Foo.bar()\n" + // 4
+				"  return 1\n" + // 5
+				"end\n", // 6
+			funcName: "bar", funcLine: 4,
+			className: "Foo", classLine: 1,
+		},
 	}
 
 	for _, tc := range cases {
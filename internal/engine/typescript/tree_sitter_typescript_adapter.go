@@ -6,6 +6,7 @@ import (
 
 	"github.com/ast-metrics/ast-metrics/internal/engine"
 	Treesitter "github.com/ast-metrics/ast-metrics/internal/engine/treesitter"
+	pb "github.com/ast-metrics/ast-metrics/pb"
 	sitter "github.com/smacker/go-tree-sitter"
 	tsTsx "github.com/smacker/go-tree-sitter/typescript/tsx"
 )
@@ -13,6 +14,9 @@ import (
 type TreeSitterAdapter struct {
 	src  []byte
 	root *sitter.Node
+	// component names the single-file component (.vue, .svelte) whose script
+	// is parsed: the whole script is then the body of the component
+	component string
 }
 
 func NewTreeSitterAdapter(src []byte) *TreeSitterAdapter   { return &TreeSitterAdapter{src: src} }
@@ -39,10 +43,19 @@ func (a *TreeSitterAdapter) ensureRoot(src []byte) (*sitter.Node, []byte) {
 	return a.root, source
 }
 
-func (a *TreeSitterAdapter) IsModule(n *sitter.Node) bool { return n.Type() == "program" }
+// SetComponent declares the source as the script of a single-file
+// component: the component is reported as a class, and the functions of its
+// script as its methods.
+func (a *TreeSitterAdapter) SetComponent(name string) { a.component = name }
+
+func (a *TreeSitterAdapter) IsModule(n *sitter.Node) bool {
+	return n.Type() == "program" && a.component == ""
+}
 
 func (a *TreeSitterAdapter) IsClass(n *sitter.Node) bool {
 	switch n.Type() {
+	case "program":
+		return a.component != ""
 	case "class_declaration", "abstract_class_declaration", "enum_declaration":
 		return true
 	}
@@ -67,6 +80,9 @@ func (a *TreeSitterAdapter) NodeName(n *sitter.Node) string {
 	if a.src == nil || n == nil {
 		return ""
 	}
+	if n.Type() == "program" {
+		return a.component
+	}
 
 	// Arrow functions get their name from the parent variable_declarator
 	if n.Type() == "arrow_function" || n.Type() == "function" {
@@ -117,6 +133,10 @@ func (a *TreeSitterAdapter) NodeBody(n *sitter.Node) *sitter.Node {
 	if n == nil {
 		return nil
 	}
+	if n.Type() == "program" {
+		// the script of a component
+		return n
+	}
 	if body := n.ChildByFieldName("body"); body != nil {
 		return body
 	}
@@ -308,6 +328,113 @@ func (a *TreeSitterAdapter) Imports(n *sitter.Node) []Treesitter.ImportItem {
 	return items
 }
 
+// Heritage reports the parent of a class and the interfaces it implements. A
+// mixin call ("extends Timestamped(Base)") names no class known statically.
+func (a *TreeSitterAdapter) Heritage(n *sitter.Node) Treesitter.Heritage {
+	var h Treesitter.Heritage
+	if a.src == nil || n == nil {
+		return h
+	}
+	heritage := firstChildOfType(n, "class_heritage")
+	if heritage == nil {
+		return h
+	}
+	if extends := firstChildOfType(heritage, "extends_clause"); extends != nil {
+		if name := a.typeName(extends.ChildByFieldName("value")); name != nil {
+			h.Extends = append(h.Extends, name)
+		}
+	}
+	if implements := firstChildOfType(heritage, "implements_clause"); implements != nil {
+		for i := 0; i < int(implements.NamedChildCount()); i++ {
+			if name := a.typeName(implements.NamedChild(i)); name != nil {
+				h.Implements = append(h.Implements, name)
+			}
+		}
+	}
+	return h
+}
+
+// Modifiers reports the modifiers of a class or a function. Members are public
+// unless an accessibility modifier or a "#name" says otherwise. A class or a
+// function declared in the module is public when exported and private to the
+// module otherwise.
+func (a *TreeSitterAdapter) Modifiers(n *sitter.Node) *pb.Modifiers {
+	visibility := pb.Visibility_VISIBILITY_PUBLIC
+	switch n.Type() {
+	case "class_declaration", "abstract_class_declaration", "enum_declaration", "function_declaration", "generator_function_declaration":
+		if p := n.Parent(); p == nil || p.Type() != "export_statement" {
+			visibility = pb.Visibility_VISIBILITY_PACKAGE
+		}
+	}
+	if name := n.ChildByFieldName("name"); name != nil && name.Type() == "private_property_identifier" {
+		visibility = pb.Visibility_VISIBILITY_PRIVATE
+	}
+	m := Treesitter.KeywordModifiers(Treesitter.ModifierKeywords(n, map[string]bool{"accessibility_modifier": true}), visibility)
+	if n.Type() == "abstract_class_declaration" {
+		m.IsAbstract = true
+	}
+	return m
+}
+
+// Annotations reports the decorators of a class or a method: @Injectable(),
+// @Component({ selector: 'app' }) or @Get(':id'). The decorators of an
+// exported class belong to the export statement, and those of a method
+// precede it in the class body.
+func (a *TreeSitterAdapter) Annotations(n *sitter.Node) []*pb.Annotation {
+	if a.src == nil {
+		return nil
+	}
+	var decorators []*sitter.Node
+	if p := n.Parent(); p != nil && p.Type() == "export_statement" {
+		decorators = append(decorators, childrenOfType(p, "decorator")...)
+	}
+	decorators = append(decorators, childrenOfType(n, "decorator")...)
+	if n.Type() == "method_definition" {
+		var preceding []*sitter.Node
+		for s := n.PrevNamedSibling(); s != nil && s.Type() == "decorator"; s = s.PrevNamedSibling() {
+			preceding = append([]*sitter.Node{s}, preceding...)
+		}
+		decorators = append(preceding, decorators...)
+	}
+	var annotations []*pb.Annotation
+	for _, d := range decorators {
+		if d.NamedChildCount() == 0 {
+			continue
+		}
+		expr := d.NamedChild(0)
+		var arguments []string
+		if expr.Type() == "call_expression" {
+			arguments = Treesitter.AnnotationArguments(expr.ChildByFieldName("arguments"), a.src)
+			expr = expr.ChildByFieldName("function")
+		}
+		if expr == nil {
+			continue
+		}
+		annotations = append(annotations, Treesitter.NewAnnotation(text(a.src, expr), ".", arguments))
+	}
+	return annotations
+}
+
+// typeName returns the name of a parent type: "Base", "Repository<User>"
+// (named after Repository) or "React.Component".
+func (a *TreeSitterAdapter) typeName(t *sitter.Node) *pb.Name {
+	if t == nil {
+		return nil
+	}
+	switch t.Type() {
+	case "identifier", "type_identifier":
+		name := text(a.src, t)
+		return &pb.Name{Short: name, Qualified: name}
+	case "generic_type":
+		return a.typeName(t.ChildByFieldName("name"))
+	case "member_expression":
+		return &pb.Name{Short: text(a.src, t.ChildByFieldName("property")), Qualified: text(a.src, t)}
+	case "nested_type_identifier":
+		return &pb.Name{Short: text(a.src, t.ChildByFieldName("name")), Qualified: text(a.src, t)}
+	}
+	return nil
+}
+
 // CountElseIfAsIf: treat else-if as if for complexity aggregation (consistent with Go/PHP)
 func (a *TreeSitterAdapter) CountElseIfAsIf() bool { return true }
 
@@ -329,6 +456,11 @@ func (a *TreeSitterAdapter) CommentMarkers() engine.CommentMarkers {
 	return engine.CommentMarkers{SlashSlash: true, SlashStar: true}
 }
 
+// IsDocComment tells TSDoc ("/**") from a plain comment.
+func (a *TreeSitterAdapter) IsDocComment(text string) bool {
+	return strings.HasPrefix(text, "/**")
+}
+
 func (a *TreeSitterAdapter) CountComments(lines []string, start, end int) int {
 	cnt := 0
 	inBlock := false
@@ -501,6 +633,16 @@ func firstChildOfType(n *sitter.Node, t string) *sitter.Node {
 	return nil
 }
 
+func childrenOfType(n *sitter.Node, t string) []*sitter.Node {
+	var children []*sitter.Node
+	for i := 0; i < int(n.NamedChildCount()); i++ {
+		if c := n.NamedChild(i); c.Type() == t {
+			children = append(children, c)
+		}
+	}
+	return children
+}
+
 func stripQuotes(s string) string {
 	if len(s) >= 2 {
 		if (s[0] == '\'' && s[len(s)-1] == '\'') || (s[0] == '"' && s[len(s)-1] == '"') || (s[0] == '`' && s[len(s)-1] == '`') {
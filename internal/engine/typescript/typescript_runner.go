@@ -62,14 +62,25 @@ func (r TypeScriptRunner) Parse(path string) (*pb.File, error) {
 		return &pb.File{Path: path, ProgrammingLanguage: "TypeScript"}, err
 	}
 
+	component := engine.IsComponentFile(path)
+	if component {
+		// only the script of a component is parsed; positions stay the ones
+		// of the component file
+		src = engine.ExtractComponentScript(src).Source
+	}
+
 	parser := sitter.NewParser()
 	adapter := NewTreeSitterAdapter(src)
+	if component {
+		adapter.SetComponent(engine.ComponentName(path))
+	}
 	parser.SetLanguage(adapter.Language())
 
 	tree := parser.Parse(nil, src)
 	root := tree.RootNode()
 
 	v := Treesitter.NewVisitor(adapter, path, src)
+	v.SetQueries(Treesitter.QueriesFor(r.Configuration, "typescript", path))
 	v.Visit(root)
 	file := v.Result()
 	file.ProgrammingLanguage = "TypeScript"
@@ -106,6 +117,7 @@ func (r *TypeScriptRunner) getFileList() file.FileList {
 	for _, ext := range extensions {
 		lists = append(lists, finder.Search(ext))
 	}
+	lists = append(lists, engine.SearchComponents(finder, r.Configuration, true))
 	r.foundFiles = file.MergeFileLists(lists...)
 	return r.foundFiles
 }
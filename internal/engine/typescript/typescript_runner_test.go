@@ -932,3 +932,202 @@ func TestTypeScriptParser_TreeSitter_DestructuringAndRest(t *testing.T) {
 		t.Fatalf("function withRest not found")
 	}
 }
+
+const sampleVueComponent = `<template>
+  <div v-if="user">{{ label }}</div>
+</template>
+
+<script setup lang="ts">
+import { ref, computed } from 'vue'
+import { useStore } from '@/stores/user'
+
+const count = ref(0)
+
+function increment(step: number) {
+  if (step > 0) {
+    count.value += step
+  } else {
+    count.value--
+  }
+}
+
+const label = computed(() => count.value > 10 ? 'many' : 'few')
+</script>
+
+<style scoped>
+div { color: red; }
+</style>
+`
+
+func TestTypeScriptRunner_Parse_VueComponent(t *testing.T) {
+	tmpFile := t.TempDir() + "/UserCard.vue"
+	if err := os.WriteFile(tmpFile, []byte(sampleVueComponent), 0644); err != nil {
+		t.Fatalf("failed to create file: %v", err)
+	}
+	file, err := (&TypeScriptRunner{}).Parse(tmpFile)
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+
+	classes := enginePkg.GetClassesInFile(file)
+	if len(classes) != 1 || classes[0].Name.Short != "UserCard" {
+		t.Fatalf("expected the component to be the class UserCard, got %v", classes)
+	}
+	component := classes[0]
+
+	var increment *pb.StmtFunction
+	for _, fn := range component.Stmts.StmtFunction {
+		if fn.Name != nil && fn.Name.Short == "increment" {
+			increment = fn
+		}
+	}
+	if increment == nil {
+		t.Fatalf("function increment not found in the component")
+	}
+	// lines are the ones of the .vue file
+	if increment.Location.StartLine != 11 || increment.Location.EndLine != 17 {
+		t.Fatalf("expected increment at lines 11-17, got %d-%d", increment.Location.StartLine, increment.Location.EndLine)
+	}
+	if len(increment.Stmts.StmtDecisionIf) != 1 || len(increment.Stmts.StmtDecisionElse) != 1 {
+		t.Fatalf("expected 1 if and 1 else in increment")
+	}
+
+	deps := map[string]bool{}
+	for _, d := range enginePkg.GetDependenciesInFile(file) {
+		deps[d.Namespace] = true
+	}
+	if !deps["vue"] || !deps["@/stores/user"] {
+		t.Fatalf("expected the imports of the component as dependencies, got %v", deps)
+	}
+}
+
+func TestTypeScriptRunner_Parse_SvelteComponent(t *testing.T) {
+	code := `<script context="module" lang="ts">
+  export const prerender = true
+</script>
+
+<script lang="ts">
+  export let items: string[] = []
+  function total(): number {
+    return items.length
+  }
+</script>
+
+<ul>{#each items as item}<li>{item}</li>{/each}</ul>
+`
+	tmpFile := t.TempDir() + "/List.svelte"
+	if err := os.WriteFile(tmpFile, []byte(code), 0644); err != nil {
+		t.Fatalf("failed to create file: %v", err)
+	}
+	file, err := (&TypeScriptRunner{}).Parse(tmpFile)
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	classes := enginePkg.GetClassesInFile(file)
+	if len(classes) != 1 || classes[0].Name.Short != "List" {
+		t.Fatalf("expected the component to be the class List")
+	}
+	if len(classes[0].Stmts.StmtFunction) != 1 || classes[0].Stmts.StmtFunction[0].Location.StartLine != 7 {
+		t.Fatalf("expected the function total at line 7")
+	}
+}
+
+func TestTypeScriptParser_TreeSitter_Heritage(t *testing.T) {
+	src := `class OrderRepository extends Repository<Order> implements Disposable, events.Listener<Order> {}
+`
+	file, err := enginePkg.CreateTestFileWithCode(&TypeScriptRunner{}, src)
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	classes := enginePkg.GetClassesInFile(file)
+	if len(classes) != 1 {
+		t.Fatalf("expected 1 class, got %d", len(classes))
+	}
+	cls := classes[0]
+	if len(cls.Extends) != 1 || cls.Extends[0].Short != "Repository" {
+		t.Errorf("expected to extend Repository, got %v", cls.Extends)
+	}
+	if len(cls.Implements) != 2 {
+		t.Fatalf("expected 2 interfaces, got %v", cls.Implements)
+	}
+	if cls.Implements[1].Qualified != "events.Listener" || cls.Implements[1].Short != "Listener" {
+		t.Errorf("unexpected interface: %v", cls.Implements[1])
+	}
+}
+
+func TestTypeScriptParser_TreeSitter_Modifiers(t *testing.T) {
+	src := `export abstract class Repository {
+  find() {}
+  private static key() {}
+  protected save() {}
+  #cache() {}
+}
+class Helper {}
+`
+	file, err := enginePkg.CreateTestFileWithCode(&TypeScriptRunner{}, src)
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	classes := enginePkg.GetClassesInFile(file)
+	if len(classes) != 2 {
+		t.Fatalf("expected 2 classes, got %d", len(classes))
+	}
+	if m := classes[0].Modifiers; m.GetVisibility() != pb.Visibility_VISIBILITY_PUBLIC || !m.GetIsAbstract() {
+		t.Errorf("expected an exported abstract class, got %v", m)
+	}
+	if m := classes[1].Modifiers; m.GetVisibility() != pb.Visibility_VISIBILITY_PACKAGE {
+		t.Errorf("expected a class private to the module, got %v", m)
+	}
+	expected := map[string]pb.Visibility{
+		"find":   pb.Visibility_VISIBILITY_PUBLIC,
+		"key":    pb.Visibility_VISIBILITY_PRIVATE,
+		"save":   pb.Visibility_VISIBILITY_PROTECTED,
+		"#cache": pb.Visibility_VISIBILITY_PRIVATE,
+	}
+	for _, fn := range classes[0].Stmts.StmtFunction {
+		if got := fn.Modifiers.GetVisibility(); got != expected[fn.Name.Short] {
+			t.Errorf("expected %s to be %v, got %v", fn.Name.Short, expected[fn.Name.Short], got)
+		}
+		if fn.Name.Short == "key" && !fn.Modifiers.GetIsStatic() {
+			t.Errorf("expected key to be static")
+		}
+	}
+}
+
+func TestTypeScriptParser_TreeSitter_Annotations(t *testing.T) {
+	src := `@Component({ selector: 'app' })
+export class AppComponent {
+  @HostListener('click', ['$event'])
+  onClick(e) {}
+  reset() {}
+}
+@Injectable()
+class UserService {}
+`
+	file, err := enginePkg.CreateTestFileWithCode(&TypeScriptRunner{}, src)
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	classes := enginePkg.GetClassesInFile(file)
+	if len(classes) != 2 {
+		t.Fatalf("expected 2 classes, got %d", len(classes))
+	}
+	if a := classes[0].Annotations; len(a) != 1 || a[0].Name.Short != "Component" || len(a[0].Arguments) != 1 {
+		t.Errorf("expected the exported class to be decorated with Component, got %v", a)
+	}
+	if a := classes[1].Annotations; len(a) != 1 || a[0].Name.Short != "Injectable" || len(a[0].Arguments) != 0 {
+		t.Errorf("expected the class to be decorated with Injectable, got %v", a)
+	}
+	for _, fn := range classes[0].Stmts.StmtFunction {
+		switch fn.Name.Short {
+		case "onClick":
+			if a := fn.Annotations; len(a) != 1 || a[0].Name.Short != "HostListener" || len(a[0].Arguments) != 2 {
+				t.Errorf("expected onClick to be decorated with HostListener, got %v", a)
+			}
+		case "reset":
+			if len(fn.Annotations) != 0 {
+				t.Errorf("expected no decorator on reset, got %v", fn.Annotations)
+			}
+		}
+	}
+}
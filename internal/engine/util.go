@@ -21,10 +21,14 @@ type CommentMarkers struct {
 	SlashSlash bool // "//" line comments
 	Hash       bool // "#" line comments
 	SlashStar  bool // "/* ... */" block comments
+	// DashDash honors the Lua "--" line comments and "--[[ ... ]]" long
+	// comments. It is not part of AllCommentMarkers: "--" is the decrement
+	// operator of the C family.
+	DashDash bool
 }
 
 // AllCommentMarkers is the default used when a language declares nothing:
-// every marker is honored.
+// every C-style and hash marker is honored.
 func AllCommentMarkers() CommentMarkers {
 	return CommentMarkers{SlashSlash: true, Hash: true, SlashStar: true}
 }
@@ -50,6 +54,7 @@ func GetLocPositionFromSourceWithMarkers(sourceCode []string, start int, end int
 	blank := 0
 
 	inBlock := false // for /* ... */
+	longEnd := ""    // closing delimiter of the Lua long comment being read
 	for i := start - 1; i < end && i < len(sourceCode); i++ {
 		line := strings.TrimSpace(sourceCode[i])
 		if line == "" {
@@ -60,6 +65,14 @@ func GetLocPositionFromSourceWithMarkers(sourceCode []string, start int, end int
 		// remove contents inside quotes to avoid counting comment markers in strings
 		clean := stripQuotes(line)
 
+		if longEnd != "" {
+			cloc++
+			if strings.Contains(line, longEnd) {
+				longEnd = ""
+			}
+			continue
+		}
+
 		if inBlock {
 			// Every line of a block comment is a comment line, including the
 			// closing "*/" delimiter line.
@@ -88,6 +101,15 @@ func GetLocPositionFromSourceWithMarkers(sourceCode []string, start int, end int
 			}
 			continue
 		}
+		if idx := strings.Index(clean, "--"); markers.DashDash && idx >= 0 {
+			cloc++
+			// "--[[" and "--[==[" open a long comment, closed by "]]" and "]==]"
+			rest := clean[idx+2:]
+			if level, ok := luaLongBracket(rest); ok && !strings.Contains(rest[level+2:], "]"+strings.Repeat("=", level)+"]") {
+				longEnd = "]" + strings.Repeat("=", level) + "]"
+			}
+			continue
+		}
 		// not a comment line here
 	}
 
@@ -108,6 +130,22 @@ func GetLocPositionFromSourceWithMarkers(sourceCode []string, start int, end int
 	return &linesOfCode
 }
 
+// luaLongBracket reports whether s starts with a Lua long bracket ("[[",
+// "[==[") and returns its level: the number of "=" signs.
+func luaLongBracket(s string) (int, bool) {
+	if !strings.HasPrefix(s, "[") {
+		return 0, false
+	}
+	level := 0
+	for level+1 < len(s) && s[level+1] == '=' {
+		level++
+	}
+	if level+1 >= len(s) || s[level+1] != '[' {
+		return 0, false
+	}
+	return level, true
+}
+
 // stripQuotes removes content inside single or double quotes (non-escaped) to avoid counting comment tokens inside strings
 func stripQuotes(s string) string {
 	inSingle := false
@@ -634,120 +672,198 @@ func GetDependenciesInFile(file *pb.File) []*pb.StmtExternalDependency {
 		}
 	}
 
-	uniq := make(map[string]*pb.StmtExternalDependency)
-	add := func(dep *pb.StmtExternalDependency) {
-		if dep == nil {
-			return
-		}
-		k := dep.Namespace + "|" + dep.ClassName + "|" + dep.FunctionName + "|" + dep.From
-		if k == "|||" { // empty
-			return
-		}
-		if _, ok := uniq[k]; ok {
-			return
-		}
-		// Make a shallow copy to avoid accidental mutation
-		cpy := *dep
-		uniq[k] = &cpy
-	}
+	deps := newDependencySet()
 
 	// 1) file-level externals
-	for _, d := range file.Stmts.StmtExternalDependencies {
-		add(d)
-	}
+	deps.add(file.Stmts.StmtExternalDependencies...)
 	// 2) namespace-level externals
 	for _, ns := range file.Stmts.StmtNamespace {
 		if ns == nil || ns.Stmts == nil {
 			continue
 		}
-		for _, d := range ns.Stmts.StmtExternalDependencies {
-			add(d)
-		}
+		deps.add(ns.Stmts.StmtExternalDependencies...)
 	}
 	// 3) classes/interfaces/traits and their externals
-	classes := GetClassesInFile(file)
-	for _, c := range classes {
-		if c == nil {
-			continue
+	for _, c := range GetClassesInFile(file) {
+		deps.addClass(c)
+	}
+	// 4) function-level externals (top-level and in classes)
+	for _, f := range GetFunctionsInFile(file) {
+		deps.addFunction(f)
+	}
+
+	res := deps.list()
+
+	// Save in cache
+	if file.Path != "" && !mtime.IsZero() {
+		depCache.items[file.Path] = struct {
+			mtime time.Time
+			deps  []*pb.StmtExternalDependency
+		}{mtime: mtime, deps: res}
+	}
+
+	return res
+}
+
+// GetDependenciesInClass returns the dependencies of a class: its parents,
+// its traits and the externals of the class and of its methods.
+func GetDependenciesInClass(class *pb.StmtClass) []*pb.StmtExternalDependency {
+	deps := newDependencySet()
+	deps.addClass(class)
+	if class != nil && class.Stmts != nil {
+		for _, f := range class.Stmts.StmtFunction {
+			deps.addFunction(f)
 		}
-		// explicit externals attached to class stmts
-		if c.Stmts != nil {
-			for _, d := range c.Stmts.StmtExternalDependencies {
-				add(d)
+	}
+	return deps.list()
+}
+
+// GetImportsUsedByClass returns the imports of the file that a class makes use
+// of. Java, Kotlin or Python write their imports for the whole file: an import
+// belongs to the class when the class mentions the imported name, as a parent,
+// a field or parameter type, or anywhere in its code.
+func GetImportsUsedByClass(file *pb.File, class *pb.StmtClass) []*pb.StmtExternalDependency {
+	if file == nil || file.Stmts == nil || class == nil {
+		return nil
+	}
+	mentioned := make(map[string]bool, len(class.References))
+	for _, name := range class.References {
+		mentioned[name] = true
+	}
+	for _, parents := range [][]*pb.Name{class.Extends, class.Implements, class.Uses} {
+		for _, p := range parents {
+			if p != nil {
+				mentioned[p.Short] = true
 			}
 		}
-		from := ""
-		if c.Name != nil {
-			from = c.Name.Qualified
-			if from == "" {
-				from = c.Name.Short
-			}
+	}
+	imports := append([]*pb.StmtExternalDependency{}, file.Stmts.StmtExternalDependencies...)
+	for _, ns := range file.Stmts.StmtNamespace {
+		if ns != nil && ns.Stmts != nil {
+			imports = append(imports, ns.Stmts.StmtExternalDependencies...)
 		}
-		// extends / implements / uses as dependencies
-		for _, p := range c.Extends {
-			if p == nil {
-				continue
-			}
-			add(&pb.StmtExternalDependency{Namespace: p.Qualified, From: from, ClassName: p.Short})
+	}
+	deps := newDependencySet()
+	for _, dep := range imports {
+		if dep != nil && mentioned[ShortClassName(dep.ClassName)] {
+			deps.add(dep)
 		}
-		for _, p := range c.Implements {
-			if p == nil {
-				continue
-			}
-			add(&pb.StmtExternalDependency{Namespace: p.Qualified, From: from, ClassName: p.Short})
+	}
+	return deps.list()
+}
+
+// ShortClassName returns a class name without its namespace, whatever the
+// separator of the language ("App\Models\User", "app.models.User" and
+// "App::User" all give "User").
+func ShortClassName(name string) string {
+	if i := strings.LastIndexAny(name, `\./:`); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
+// DependencyName returns the name of the class a dependency stands for. PHP
+// records the whole name of the class as the namespace, Java or Python the
+// package alone.
+func DependencyName(dependency *pb.StmtExternalDependency) *pb.Name {
+	short := ShortClassName(dependency.ClassName)
+	qualified := dependency.Namespace
+	switch {
+	case qualified == "":
+		qualified = dependency.ClassName
+	case ShortClassName(qualified) != short:
+		qualified += "." + short
+	}
+	return &pb.Name{Qualified: qualified, Short: short}
+}
+
+// dependencySet collects dependencies without duplicates.
+type dependencySet struct {
+	uniq map[string]*pb.StmtExternalDependency
+}
+
+func newDependencySet() *dependencySet {
+	return &dependencySet{uniq: make(map[string]*pb.StmtExternalDependency)}
+}
+
+func (s *dependencySet) add(deps ...*pb.StmtExternalDependency) {
+	for _, dep := range deps {
+		if dep == nil {
+			continue
 		}
-		for _, p := range c.Uses {
-			if p == nil {
-				continue
-			}
-			add(&pb.StmtExternalDependency{Namespace: p.Qualified, From: from, ClassName: p.Short})
+		k := dep.Namespace + "|" + dep.ClassName + "|" + dep.FunctionName + "|" + dep.From
+		if k == "|||" { // empty
+			continue
 		}
-	}
-	// 4) function-level externals (top-level and in classes)
-	funcs := GetFunctionsInFile(file)
-	for _, f := range funcs {
-		if f == nil {
+		if _, ok := s.uniq[k]; ok {
 			continue
 		}
-		from := ""
-		if f.Name != nil {
-			from = f.Name.Qualified
-			if from == "" {
-				from = f.Name.Short
-			}
+		// Make a shallow copy to avoid accidental mutation
+		cpy := *dep
+		s.uniq[k] = &cpy
+	}
+}
+
+// addClass adds the externals attached to a class, and its parents and traits.
+func (s *dependencySet) addClass(c *pb.StmtClass) {
+	if c == nil {
+		return
+	}
+	// explicit externals attached to class stmts
+	if c.Stmts != nil {
+		s.add(c.Stmts.StmtExternalDependencies...)
+	}
+	from := ""
+	if c.Name != nil {
+		from = c.Name.Qualified
+		if from == "" {
+			from = c.Name.Short
 		}
-		for _, n := range f.Externals {
-			if n == nil {
+	}
+	// extends / implements / uses as dependencies
+	for _, parents := range [][]*pb.Name{c.Extends, c.Implements, c.Uses} {
+		for _, p := range parents {
+			if p == nil {
 				continue
 			}
-			ns := n.Qualified
-			if ns == "" {
-				ns = n.Short
-			}
-			add(&pb.StmtExternalDependency{Namespace: ns, From: from, ClassName: n.Short})
-		}
-		// Also account for explicit StmtExternalDependencies attached to function stmts if any
-		if f.Stmts != nil {
-			for _, d := range f.Stmts.StmtExternalDependencies {
-				add(d)
-			}
+			s.add(&pb.StmtExternalDependency{Namespace: p.Qualified, From: from, ClassName: p.Short})
 		}
 	}
+}
 
-	// Build final list
-	res := make([]*pb.StmtExternalDependency, 0, len(uniq))
-	for _, v := range uniq {
-		res = append(res, v)
+// addFunction adds the externals of a function.
+func (s *dependencySet) addFunction(f *pb.StmtFunction) {
+	if f == nil {
+		return
 	}
-
-	// Save in cache
-	if file.Path != "" && !mtime.IsZero() {
-		depCache.items[file.Path] = struct {
-			mtime time.Time
-			deps  []*pb.StmtExternalDependency
-		}{mtime: mtime, deps: res}
+	from := ""
+	if f.Name != nil {
+		from = f.Name.Qualified
+		if from == "" {
+			from = f.Name.Short
+		}
+	}
+	for _, n := range f.Externals {
+		if n == nil {
+			continue
+		}
+		ns := n.Qualified
+		if ns == "" {
+			ns = n.Short
+		}
+		s.add(&pb.StmtExternalDependency{Namespace: ns, From: from, ClassName: n.Short})
+	}
+	// Also account for explicit StmtExternalDependencies attached to function stmts if any
+	if f.Stmts != nil {
+		s.add(f.Stmts.StmtExternalDependencies...)
 	}
+}
 
+func (s *dependencySet) list() []*pb.StmtExternalDependency {
+	res := make([]*pb.StmtExternalDependency, 0, len(s.uniq))
+	for _, v := range s.uniq {
+		res = append(res, v)
+	}
 	return res
 }
 
@@ -374,4 +374,18 @@ func TestGetLocPositionFromSourceWithMarkers(t *testing.T) {
 	if loc.LogicalLinesOfCode != 2 { // 5 - (1 + 0 + 2)
 		t.Errorf("rust: expected 2 logical lines, got %d", loc.LogicalLinesOfCode)
 	}
+
+	// Lua: "--" starts a comment, "--[[ ... ]]" spans several lines
+	luaSource := []string{
+		"--[[ a long",
+		"comment ]]",
+		"local function dec(n)",
+		"  -- a real comment",
+		"  return n - 1 --[==[ trailing ]==]",
+		"end",
+	}
+	loc = GetLocPositionFromSourceWithMarkers(luaSource, 1, 6, CommentMarkers{DashDash: true})
+	if loc.CommentLinesOfCode != 4 {
+		t.Errorf("lua: expected 4 comment lines, got %d", loc.CommentLinesOfCode)
+	}
 }
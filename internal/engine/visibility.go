@@ -0,0 +1,61 @@
+package engine
+
+import (
+	"strings"
+
+	pb "github.com/ast-metrics/ast-metrics/pb"
+)
+
+// IsPublic reports whether a function can be called from outside its class
+// or module. When the adapter could not tell (or the AST was dumped before
+// visibility was recorded), a name starting with an underscore is private.
+func IsPublic(function *pb.StmtFunction) bool {
+	if function == nil {
+		return false
+	}
+	return isPublic(function.GetModifiers(), function.GetName().GetShort())
+}
+
+// IsPublicClass reports whether a class can be used from outside its module,
+// following the same conventions as IsPublic.
+func IsPublicClass(class *pb.StmtClass) bool {
+	if class == nil {
+		return false
+	}
+	return isPublic(class.GetModifiers(), class.GetName().GetShort())
+}
+
+// IsPublicInterface reports whether an interface can be used from outside its
+// module, following the same conventions as IsPublic.
+func IsPublicInterface(itf *pb.StmtInterface) bool {
+	if itf == nil {
+		return false
+	}
+	return isPublic(itf.GetModifiers(), itf.GetName().GetShort())
+}
+
+func isPublic(m *pb.Modifiers, name string) bool {
+	switch m.GetVisibility() {
+	case pb.Visibility_VISIBILITY_PUBLIC:
+		return true
+	case pb.Visibility_VISIBILITY_UNKNOWN:
+		return name != "" && !strings.HasPrefix(name, "_")
+	}
+	return false
+}
+
+// VisibilityName returns the name of a visibility, as written in reports:
+// "public", "protected", "private" or "package". It is empty when unknown.
+func VisibilityName(m *pb.Modifiers) string {
+	switch m.GetVisibility() {
+	case pb.Visibility_VISIBILITY_PUBLIC:
+		return "public"
+	case pb.Visibility_VISIBILITY_PROTECTED:
+		return "protected"
+	case pb.Visibility_VISIBILITY_PRIVATE:
+		return "private"
+	case pb.Visibility_VISIBILITY_PACKAGE:
+		return "package"
+	}
+	return ""
+}
@@ -0,0 +1,35 @@
+package engine
+
+import (
+	"testing"
+
+	pb "github.com/ast-metrics/ast-metrics/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsPublic(t *testing.T) {
+	public := &pb.Modifiers{Visibility: pb.Visibility_VISIBILITY_PUBLIC}
+	private := &pb.Modifiers{Visibility: pb.Visibility_VISIBILITY_PRIVATE}
+
+	assert.True(t, IsPublic(&pb.StmtFunction{Name: &pb.Name{Short: "_save"}, Modifiers: public}), "the visibility wins over the name")
+	assert.False(t, IsPublic(&pb.StmtFunction{Name: &pb.Name{Short: "save"}, Modifiers: private}))
+	assert.False(t, IsPublic(&pb.StmtFunction{Name: &pb.Name{Short: "save"}, Modifiers: &pb.Modifiers{Visibility: pb.Visibility_VISIBILITY_PACKAGE}}))
+
+	// without visibility, the name tells
+	assert.True(t, IsPublic(&pb.StmtFunction{Name: &pb.Name{Short: "save"}}))
+	assert.False(t, IsPublic(&pb.StmtFunction{Name: &pb.Name{Short: "_save"}}))
+	assert.False(t, IsPublic(nil))
+}
+
+func TestIsPublicClass(t *testing.T) {
+	assert.True(t, IsPublicClass(&pb.StmtClass{Name: &pb.Name{Short: "Cart"}, Modifiers: &pb.Modifiers{Visibility: pb.Visibility_VISIBILITY_PUBLIC}}))
+	assert.False(t, IsPublicClass(&pb.StmtClass{Name: &pb.Name{Short: "cart"}, Modifiers: &pb.Modifiers{Visibility: pb.Visibility_VISIBILITY_PACKAGE}}))
+	assert.False(t, IsPublicClass(&pb.StmtClass{Name: &pb.Name{Short: "_Cart"}}))
+	assert.False(t, IsPublicClass(nil))
+}
+
+func TestVisibilityName(t *testing.T) {
+	assert.Equal(t, "public", VisibilityName(&pb.Modifiers{Visibility: pb.Visibility_VISIBILITY_PUBLIC}))
+	assert.Equal(t, "package", VisibilityName(&pb.Modifiers{Visibility: pb.Visibility_VISIBILITY_PACKAGE}))
+	assert.Equal(t, "", VisibilityName(nil))
+}
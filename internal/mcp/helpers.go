@@ -34,6 +34,23 @@ func fillAnalyzeMetrics(m map[string]any, a *pb.Analyze) {
 	if a.Complexity != nil && a.Complexity.Cyclomatic != nil {
 		m["cyclomatic_complexity"] = *a.Complexity.Cyclomatic
 	}
+	if a.Complexity != nil && a.Complexity.Cognitive != nil {
+		m["cognitive_complexity"] = *a.Complexity.Cognitive
+	}
+	if a.Complexity != nil && a.Complexity.Npath != nil {
+		m["npath_complexity"] = *a.Complexity.Npath
+	}
+	if a.Complexity != nil && a.Complexity.MaxNesting != nil {
+		m["max_nesting_depth"] = *a.Complexity.MaxNesting
+	}
+	if a.AbcSize != nil && a.AbcSize.Magnitude != nil {
+		m["abc_size"] = map[string]any{
+			"assignments": a.AbcSize.GetAssignments(),
+			"branches":    a.AbcSize.GetBranches(),
+			"conditions":  a.AbcSize.GetConditions(),
+			"magnitude":   *a.AbcSize.Magnitude,
+		}
+	}
 
 	if a.Volume != nil {
 		vol := map[string]any{}
@@ -100,6 +117,28 @@ func fillAnalyzeMetrics(m map[string]any, a *pb.Analyze) {
 			m["cohesion"] = coh
 		}
 	}
+
+	if a.ObjectOriented != nil {
+		ck := map[string]any{}
+		if a.ObjectOriented.Wmc != nil {
+			ck["wmc"] = *a.ObjectOriented.Wmc
+		}
+		if a.ObjectOriented.Dit != nil {
+			ck["dit"] = *a.ObjectOriented.Dit
+		}
+		if a.ObjectOriented.Noc != nil {
+			ck["noc"] = *a.ObjectOriented.Noc
+		}
+		if a.ObjectOriented.Cbo != nil {
+			ck["cbo"] = *a.ObjectOriented.Cbo
+		}
+		if a.ObjectOriented.Rfc != nil {
+			ck["rfc"] = *a.ObjectOriented.Rfc
+		}
+		if len(ck) > 0 {
+			m["chidamber_kemerer"] = ck
+		}
+	}
 }
 
 // safeToolResultJSON is like mcp.NewToolResultJSON but sanitizes NaN/Inf first.
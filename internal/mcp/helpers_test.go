@@ -94,6 +94,35 @@ func TestBuildFileMetrics(t *testing.T) {
 	assert.Equal(t, 85.5, maint["maintainability_index"])
 }
 
+func TestFillAnalyzeMetrics_PathsAndNesting(t *testing.T) {
+	m := map[string]any{}
+	fillAnalyzeMetrics(m, &pb.Analyze{Complexity: &pb.Complexity{
+		Cyclomatic: proto.Int32(6),
+		Npath:      proto.Int64(48),
+		MaxNesting: proto.Int32(3),
+	}})
+
+	assert.Equal(t, int64(48), m["npath_complexity"])
+	assert.Equal(t, int32(3), m["max_nesting_depth"])
+}
+
+func TestFillAnalyzeMetrics_AbcSize(t *testing.T) {
+	m := map[string]any{}
+	fillAnalyzeMetrics(m, &pb.Analyze{AbcSize: &pb.AbcSize{
+		Assignments: proto.Int32(3),
+		Branches:    proto.Int32(4),
+		Conditions:  proto.Int32(0),
+		Magnitude:   proto.Float64(5),
+	}})
+
+	assert.Equal(t, map[string]any{
+		"assignments": int32(3),
+		"branches":    int32(4),
+		"conditions":  int32(0),
+		"magnitude":   float64(5),
+	}, m["abc_size"])
+}
+
 func TestBuildFileMetrics_Minimal(t *testing.T) {
 	f := &pb.File{
 		Path:                "empty.py",
@@ -56,6 +56,7 @@ func (s *AnalysisService) Analyze(forceRefresh bool) (*analyzer.ProjectAggregate
 	// 4. Aggregate results
 	aggregator := analyzer.NewAggregator(allResults, gitSummaries)
 	aggregator.WithAggregateAnalyzer(Activity.NewBusFactor())
+	aggregator.WithDuplicationThresholds(s.config.Duplication.MinTokens, s.config.Duplication.MinLines)
 	projectAggregated := aggregator.Aggregates()
 
 	// 5. Risk analysis
@@ -111,9 +111,12 @@ func (v *HtmlReportGenerator) Generate(files []*pb.File, projectAggregated analy
 		"dependencies.html",
 		"busfactor.html",
 		"testquality.html",
+		"duplication.html",
+		"documentation.html",
 		"partials/suggestions.html",
 		"partials/file_explorer_sidebar.html",
 		"partials/language_tabs.html",
+		"partials/distribution_row.html",
 	} {
 		// read the file
 		bytes, err := htmlContent.ReadFile(fmt.Sprintf("templates/html/%s", file))
@@ -263,6 +266,8 @@ func (v *HtmlReportGenerator) Generate(files []*pb.File, projectAggregated analy
 		"linters.html",
 		"busfactor.html",
 		"testquality.html",
+		"duplication.html",
+		"documentation.html",
 		"classification.html",
 	} {
 		for _, scope := range scopeDefs {
@@ -378,6 +383,8 @@ func hashPathForExplorer(path string) string {
 }
 
 func pruneFile(f *pb.File) {
+	// the tokens only serve the detection of duplicated code
+	f.Tokens = nil
 	if f.Stmts == nil {
 		return
 	}
@@ -411,6 +418,7 @@ func pruneFile(f *pb.File) {
 func pruneClass(c *pb.StmtClass) {
 	c.Location = nil
 	c.Comments = nil
+	c.DocComment = nil
 	c.Operators = nil
 	c.Operands = nil
 	c.Extends = nil
@@ -439,6 +447,7 @@ func pruneClass(c *pb.StmtClass) {
 func pruneFunction(m *pb.StmtFunction) {
 	m.Location = nil
 	m.Comments = nil
+	m.DocComment = nil
 	m.Operators = nil
 	m.Operands = nil
 	m.MethodCalls = nil
@@ -495,7 +504,8 @@ func buildRisksJSON(risksByPath map[string][]riskItemForTpl, dict *StringDiction
 
 // linterDataJS builds the content of data/linters.js: a dictionary-encoded
 // representation of linter errors and successes.
-// Format: window.__AST_LINTERS__={d:{hash:string,...},e:[[ruleHash,sevHash,fileHash,msg],...],s:[[ruleHash,sevHash,fileHash,msg],...]}
+// Format: window.__AST_LINTERS__={d:{hash:string,...},e:[[ruleHash,sevHash,fileHash,msg],...],s:[[ruleHash,sevHash,fileHash,msg],...],u:[[fileHash,line,rules,reason,silenced,wholeFile],...]}
+// where u lists the suppression comments that silenced violations.
 func buildLinterDataJS(eval *requirement.EvaluationResult) string {
 	dict := NewStringDictionary()
 	encodeOutcomes := func(outcomes []requirement.RuleOutcome) string {
@@ -518,13 +528,30 @@ func buildLinterDataJS(eval *requirement.EvaluationResult) string {
 		return b.String()
 	}
 
-	var errJSON, succJSON string
+	encodeSuppressions := func(suppressions []requirement.Suppression) string {
+		var b strings.Builder
+		b.WriteString("[")
+		for i, s := range suppressions {
+			if i > 0 {
+				b.WriteString(",")
+			}
+			rulesBytes, _ := json.Marshal(s.RulesLabel())
+			reasonBytes, _ := json.Marshal(s.Reason)
+			fmt.Fprintf(&b, "[%q,%d,%s,%s,%d,%t]", dict.Add(s.File), s.Line, rulesBytes, reasonBytes, s.Used, s.Target == 0)
+		}
+		b.WriteString("]")
+		return b.String()
+	}
+
+	var errJSON, succJSON, suppJSON string
 	if eval == nil {
 		errJSON = "[]"
 		succJSON = "[]"
+		suppJSON = "[]"
 	} else {
 		errJSON = encodeOutcomes(eval.Errors)
 		succJSON = encodeOutcomes(eval.Successes)
+		suppJSON = encodeSuppressions(eval.Suppressions)
 	}
 
 	var js strings.Builder
@@ -534,6 +561,8 @@ func buildLinterDataJS(eval *requirement.EvaluationResult) string {
 	js.WriteString(errJSON)
 	js.WriteString(",s:")
 	js.WriteString(succJSON)
+	js.WriteString(",u:")
+	js.WriteString(suppJSON)
 	js.WriteString("};")
 	return js.String()
 }
@@ -76,6 +76,7 @@ func (j *JsonReportGenerator) buildReport(projectAggregated analyzer.ProjectAggr
 			if f.Stmts.Analyze.Complexity != nil {
 				concernedFile.Complexity = complexity{
 					Cyclomatic: *f.Stmts.Analyze.Complexity.Cyclomatic,
+					Cognitive:  f.Stmts.Analyze.Complexity.GetCognitive(),
 				}
 			}
 
@@ -154,6 +155,16 @@ func (j *JsonReportGenerator) buildReport(projectAggregated analyzer.ProjectAggr
 	r.AverageCyclomaticComplexityPerClass = combined.CyclomaticComplexityPerClass.Avg
 	r.MinCyclomaticComplexity = int(combined.CyclomaticComplexityPerMethod.Min)
 	r.MaxCyclomaticComplexity = int(combined.CyclomaticComplexityPerMethod.Max)
+	r.AverageCognitiveComplexityPerMethod = combined.CognitiveComplexityPerMethod.Avg
+	r.AverageCognitiveComplexityPerClass = combined.CognitiveComplexityPerClass.Avg
+	r.MaxCognitiveComplexity = int(combined.CognitiveComplexityPerMethod.Max)
+	r.AverageNPathPerMethod = combined.NPathPerMethod.Avg
+	r.MaxNPath = combined.NPathPerMethod.Max
+	r.AverageNestingPerMethod = combined.NestingPerMethod.Avg
+	r.MaxNesting = int(combined.NestingPerMethod.Max)
+	r.AverageAbcSizePerMethod = combined.AbcSizePerMethod.Avg
+	r.AverageAbcSizePerClass = combined.AbcSizePerClass.Avg
+	r.MaxAbcSize = combined.AbcSizePerMethod.Max
 	r.AverageHalsteadDifficulty = combined.HalsteadDifficulty.Avg
 	r.AverageHalsteadEffort = combined.HalsteadEffort.Avg
 	r.AverageHalsteadVolume = combined.HalsteadVolume.Avg
@@ -174,6 +185,14 @@ func (j *JsonReportGenerator) buildReport(projectAggregated analyzer.ProjectAggr
 	r.AverageEfferentCoupling = combined.EfferentCoupling.Avg
 	r.AverageInstability = combined.Instability.Avg
 	r.AverageLcom4 = combined.Lcom4PerClass.Avg
+	r.AverageWmc = combined.WmcPerClass.Avg
+	r.AverageDit = combined.DitPerClass.Avg
+	r.MaxDit = int(combined.DitPerClass.Max)
+	r.AverageNoc = combined.NocPerClass.Avg
+	r.AverageCbo = combined.CboPerClass.Avg
+	r.AverageRfc = combined.RfcPerClass.Avg
+	r.AveragePublicMethodsPerClass = combined.PublicMethodsPerClass.Avg
+	r.NbAbstractClasses = combined.NbAbstractClasses
 	r.AverageCcn = combined.CyclomaticComplexity.Avg
 	r.MaxLoc = combined.Loc.Max
 	r.CommitCountForPeriod = combined.CommitCountForPeriod
@@ -181,5 +200,51 @@ func (j *JsonReportGenerator) buildReport(projectAggregated analyzer.ProjectAggr
 	r.BusFactor = combined.BusFactor
 	r.PackageRelations = combined.PackageRelations
 
+	for name, metric := range combined.Metrics() {
+		if metric.Counter == 0 {
+			continue
+		}
+		d := distribution{
+			Count:  metric.Counter,
+			Avg:    metric.Avg,
+			Min:    metric.Min,
+			Max:    metric.Max,
+			Median: metric.Median,
+			P75:    metric.P75,
+			P90:    metric.P90,
+			P95:    metric.P95,
+			P99:    metric.P99,
+			StdDev: metric.StdDev,
+		}
+		for _, bucket := range metric.Histogram {
+			d.Histogram = append(d.Histogram, histogramBucket{UpperBound: bucket.UpperBound, Count: bucket.Count})
+		}
+		if r.Distributions == nil {
+			r.Distributions = make(map[string]distribution)
+		}
+		r.Distributions[name] = d
+	}
+
+	if dc := combined.DocCoverage; dc != nil && dc.PublicSymbols > 0 {
+		r.Documentation = &docCoverage{PublicSymbols: dc.PublicSymbols, Documented: dc.Documented, Coverage: dc.CoveragePct}
+		for _, f := range dc.Files {
+			r.Documentation.Files = append(r.Documentation.Files, pathDocCoverage{Path: f.FilePath, PublicSymbols: f.PublicSymbols, Documented: f.Documented, Coverage: f.CoveragePct})
+		}
+		for _, d := range dc.Directories {
+			r.Documentation.Directories = append(r.Documentation.Directories, pathDocCoverage{Path: d.Path, PublicSymbols: d.PublicSymbols, Documented: d.Documented, Coverage: d.CoveragePct})
+		}
+		for _, s := range dc.Undocumented {
+			r.Documentation.Undocumented = append(r.Documentation.Undocumented, undocumentedSymbol{Kind: s.Kind, Name: s.Name, Path: s.FilePath, Line: s.Line})
+		}
+	}
+
+	for _, f := range projectAggregated.FilesWithParseErrors {
+		entry := parseErrorFile{Path: f.Path, Analyzed: len(f.Errors) == 0, Errors: f.Errors}
+		for _, e := range f.ParseErrors {
+			entry.Diagnostics = append(entry.Diagnostics, parseDiagnostic{Line: e.Line, Column: e.Column, Missing: e.Missing, Message: e.Message})
+		}
+		r.ParseErrors = append(r.ParseErrors, entry)
+	}
+
 	return r
 }
@@ -130,3 +130,68 @@ func TestBuildReportMapsPerMethodMaintainability(t *testing.T) {
 		t.Errorf("expected AverageMIcwPerMethod 20, got %v", r.AverageMIcwPerMethod)
 	}
 }
+
+func TestBuildReportListsParseErrors(t *testing.T) {
+	generator := &JsonReportGenerator{}
+	aggregated := analyzer.ProjectAggregated{
+		FilesWithParseErrors: []*pb.File{
+			{Path: "partial.py", ParseErrors: []*pb.ParseError{{Line: 7, Column: 3, Missing: true, Message: "missing )"}}},
+			{Path: "broken.go", Errors: []string{"cannot read file"}},
+		},
+	}
+
+	r := generator.buildReport(aggregated)
+
+	assert.Equal(t, 2, len(r.ParseErrors))
+	assert.True(t, r.ParseErrors[0].Analyzed)
+	assert.Equal(t, []parseDiagnostic{{Line: 7, Column: 3, Missing: true, Message: "missing )"}}, r.ParseErrors[0].Diagnostics)
+	assert.False(t, r.ParseErrors[1].Analyzed)
+	assert.Equal(t, []string{"cannot read file"}, r.ParseErrors[1].Errors)
+}
+
+func TestBuildReportExportsDistributions(t *testing.T) {
+	generator := &JsonReportGenerator{}
+	aggregated := analyzer.ProjectAggregated{
+		Combined: analyzer.Aggregated{
+			CyclomaticComplexityPerMethod: analyzer.AggregateResult{
+				Counter: 10, Avg: 4, Max: 40, P90: 7.5, P99: 36,
+				Histogram: []analyzer.HistogramBucket{{UpperBound: 5, Count: 9}, {UpperBound: 50, Count: 1}},
+			},
+		},
+	}
+
+	r := generator.buildReport(aggregated)
+
+	assert.Len(t, r.Distributions, 1)
+	d := r.Distributions["cyclomatic_per_method"]
+	assert.Equal(t, 10, d.Count)
+	assert.Equal(t, 7.5, d.P90)
+	assert.Equal(t, 36.0, d.P99)
+	assert.Equal(t, []histogramBucket{{UpperBound: 5, Count: 9}, {UpperBound: 50, Count: 1}}, d.Histogram)
+}
+
+func TestBuildReportExportsDocumentationCoverage(t *testing.T) {
+	generator := &JsonReportGenerator{}
+	aggregated := analyzer.ProjectAggregated{
+		Combined: analyzer.Aggregated{
+			DocCoverage: &analyzer.DocCoverageMetrics{
+				PublicSymbols: 4, Documented: 3, CoveragePct: 75,
+				Files:        []analyzer.FileDocCoverage{{FilePath: "src/cart.go", PublicSymbols: 4, Documented: 3, CoveragePct: 75}},
+				Directories:  []analyzer.DirectoryDocCoverage{{Path: "src", PublicSymbols: 4, Documented: 3, CoveragePct: 75}},
+				Undocumented: []analyzer.UndocumentedSymbol{{Kind: "function", Name: "Cart.Add", FilePath: "src/cart.go", Line: 12}},
+			},
+		},
+	}
+
+	r := generator.buildReport(aggregated)
+
+	if assert.NotNil(t, r.Documentation) {
+		assert.Equal(t, 75.0, r.Documentation.Coverage)
+		assert.Equal(t, []pathDocCoverage{{Path: "src/cart.go", PublicSymbols: 4, Documented: 3, Coverage: 75}}, r.Documentation.Files)
+		assert.Equal(t, []undocumentedSymbol{{Kind: "function", Name: "Cart.Add", Path: "src/cart.go", Line: 12}}, r.Documentation.Undocumented)
+	}
+
+	// nothing public, nothing to report
+	r = generator.buildReport(analyzer.ProjectAggregated{Combined: analyzer.Aggregated{DocCoverage: &analyzer.DocCoverageMetrics{}}})
+	assert.Nil(t, r.Documentation)
+}
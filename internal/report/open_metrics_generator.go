@@ -3,7 +3,9 @@ package report
 import (
 	"bytes"
 	"io"
+	"math"
 	"os"
+	"sort"
 	"time"
 
 	"github.com/bsm/openmetrics"
@@ -39,6 +41,16 @@ func (v *OpenMetricsReportGenerator) Generate(files []*pb.File, projectAggregate
 		Help:   "Cyclomatic complexity of the code",
 		Labels: []string{"path"},
 	})
+	cognitive := reg.Gauge(openmetrics.Desc{
+		Name:   "cognitive_complexity",
+		Help:   "Cognitive complexity of the code",
+		Labels: []string{"path"},
+	})
+	abcSize := reg.Gauge(openmetrics.Desc{
+		Name:   "abc_size",
+		Help:   "ABC size (assignments, branches, conditions) of the code",
+		Labels: []string{"path"},
+	})
 	loc := reg.Gauge(openmetrics.Desc{
 		Name:   "lines_of_code",
 		Help:   "Lines of code",
@@ -93,6 +105,12 @@ func (v *OpenMetricsReportGenerator) Generate(files []*pb.File, projectAggregate
 
 		if file.Stmts.Analyze.Complexity != nil {
 			ccn.With(file.Path).Set(float64(*file.Stmts.Analyze.Complexity.Cyclomatic))
+			if file.Stmts.Analyze.Complexity.Cognitive != nil {
+				cognitive.With(file.Path).Set(float64(*file.Stmts.Analyze.Complexity.Cognitive))
+			}
+		}
+		if file.Stmts.Analyze.AbcSize != nil && file.Stmts.Analyze.AbcSize.Magnitude != nil {
+			abcSize.With(file.Path).Set(*file.Stmts.Analyze.AbcSize.Magnitude)
 		}
 
 		if file.Stmts.Analyze.Volume != nil {
@@ -117,6 +135,54 @@ func (v *OpenMetricsReportGenerator) Generate(files []*pb.File, projectAggregate
 		}
 	}
 
+	// Statistics of the aggregated metrics of the project: an average hides
+	// the few very complex methods, the percentiles and histograms show them
+	combined := projectAggregated.Combined
+	metrics := combined.Metrics()
+	names := make([]string, 0, len(metrics))
+	highest := 0.0
+	for name, metric := range metrics {
+		if metric.Counter == 0 {
+			continue
+		}
+		names = append(names, name)
+		highest = math.Max(highest, metric.Max)
+	}
+	sort.Strings(names)
+
+	statistics := reg.Gauge(openmetrics.Desc{
+		Name:   "project_metric",
+		Help:   "Statistics of the metrics of the project (avg, min, max, median, p75, p90, p95, p99, stddev)",
+		Labels: []string{"metric", "statistic"},
+	})
+	distribution := reg.Histogram(openmetrics.Desc{
+		Name:   "project_metric_distribution",
+		Help:   "Distribution of the values of the metrics of the project",
+		Labels: []string{"metric"},
+	}, analyzer.HistogramBounds(highest))
+
+	for _, name := range names {
+		metric := metrics[name]
+		for _, statistic := range []struct {
+			name  string
+			value float64
+		}{
+			{"avg", metric.Avg}, {"min", metric.Min}, {"max", metric.Max},
+			{"median", metric.Median}, {"p75", metric.P75}, {"p90", metric.P90},
+			{"p95", metric.P95}, {"p99", metric.P99}, {"stddev", metric.StdDev},
+		} {
+			statistics.With(name, statistic.name).Set(statistic.value)
+		}
+
+		histogram := distribution.With(name)
+		for _, value := range metric.Values() {
+			// histograms only count positive values
+			if value >= 0 && !math.IsNaN(value) && !math.IsInf(value, 0) {
+				histogram.Observe(value)
+			}
+		}
+	}
+
 	// Write the report
 	var buf bytes.Buffer
 	if _, err := reg.WriteTo(&buf); err != nil {
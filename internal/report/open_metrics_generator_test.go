@@ -1,6 +1,7 @@
 package report
 
 import (
+	"os"
 	"testing"
 
 	pb "github.com/ast-metrics/ast-metrics/pb"
@@ -39,7 +40,8 @@ func TestGenerateOpenMetricsReports(t *testing.T) {
 				Path: "file1",
 				Stmts: &pb.Stmts{
 					Analyze: &pb.Analyze{
-						Complexity: &pb.Complexity{Cyclomatic: proto.Int32(10)},
+						Complexity: &pb.Complexity{Cyclomatic: proto.Int32(10), Cognitive: proto.Int32(7)},
+						AbcSize:    &pb.AbcSize{Magnitude: proto.Float64(12.5)},
 						Volume: &pb.Volume{
 							Loc:  proto.Int32(100),
 							Lloc: proto.Int32(80),
@@ -63,6 +65,11 @@ func TestGenerateOpenMetricsReports(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Len(t, reports, 1)
 		assert.Equal(t, "test_report", reports[0].Path)
+
+		content, err := os.ReadFile("test_report")
+		assert.Nil(t, err)
+		assert.Contains(t, string(content), `cognitive_complexity{path="file1"} 7`)
+		assert.Contains(t, string(content), `abc_size{path="file1"} 12.5`)
 	})
 
 	t.Run("Should not generate report when path is incorrect", func(t *testing.T) {
@@ -73,4 +80,27 @@ func TestGenerateOpenMetricsReports(t *testing.T) {
 		_, err := v.Generate(files, projectAggregated)
 		assert.NotNil(t, err)
 	})
+
+	t.Run("Should export the distribution of the aggregated metrics", func(t *testing.T) {
+		v := &OpenMetricsReportGenerator{ReportPath: "test_report"}
+		ccn := analyzer.AggregateResult{}
+		for _, value := range []float64{1, 2, 3, 40} {
+			ccn.Add(value)
+		}
+		ccn.Max = 40
+		ccn.P90 = 28.9
+		projectAggregated := analyzer.ProjectAggregated{
+			Combined: analyzer.Aggregated{CyclomaticComplexityPerMethod: ccn},
+		}
+
+		_, err := v.Generate([]*pb.File{}, projectAggregated)
+		assert.Nil(t, err)
+
+		content, err := os.ReadFile("test_report")
+		assert.Nil(t, err)
+		assert.Contains(t, string(content), `project_metric{metric="cyclomatic_per_method",statistic="p90"} 28.9`)
+		assert.Contains(t, string(content), `project_metric_distribution_bucket{metric="cyclomatic_per_method",le="5"} 3`)
+		assert.Contains(t, string(content), `project_metric_distribution_bucket{metric="cyclomatic_per_method",le="50"} 4`)
+		assert.NotContains(t, string(content), `metric="loc"`)
+	})
 }
@@ -11,6 +11,7 @@ import (
 
 	"github.com/ast-metrics/ast-metrics/internal/analyzer"
 	requirement "github.com/ast-metrics/ast-metrics/internal/analyzer/requirement"
+	"github.com/ast-metrics/ast-metrics/internal/engine"
 	pb "github.com/ast-metrics/ast-metrics/pb"
 )
 
@@ -57,6 +58,7 @@ type sarifResult struct {
 	Level               string            `json:"level,omitempty"`
 	Message             sarifMessage      `json:"message"`
 	Locations           []sarifLocation   `json:"locations,omitempty"`
+	RelatedLocations    []sarifLocation   `json:"relatedLocations,omitempty"`
 	PartialFingerprints map[string]string `json:"partialFingerprints,omitempty"`
 	Properties          map[string]string `json:"properties,omitempty"`
 }
@@ -66,6 +68,7 @@ type sarifMessage struct {
 }
 
 type sarifLocation struct {
+	ID               *int                  `json:"id,omitempty"`
 	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
 }
 
@@ -80,6 +83,7 @@ type sarifArtifactLocation struct {
 
 type sarifRegion struct {
 	StartLine int `json:"startLine,omitempty"`
+	EndLine   int `json:"endLine,omitempty"`
 }
 
 // SarifReportGenerator implements Reporter and uses requirement outcomes when present
@@ -103,8 +107,10 @@ func (g *SarifReportGenerator) Generate(files []*pb.File, projectAggregated anal
 	// Collect outcomes from evaluation if available
 	var outcomes []requirement.RuleOutcome
 	if projectAggregated.Evaluation != nil {
-		outcomes = projectAggregated.Evaluation.Errors
+		outcomes = append(outcomes, projectAggregated.Evaluation.Errors...)
 	}
+	outcomes = append(outcomes, CloneOutcomes(projectAggregated.Combined.Duplication, outcomes)...)
+	outcomes = append(outcomes, parseErrorOutcomes(projectAggregated.FilesWithParseErrors)...)
 
 	if err := writeSarifFile(g.ReportPath, outcomes, g.MaxLevel); err != nil {
 		return nil, err
@@ -121,6 +127,84 @@ func (g *SarifReportGenerator) Generate(files []*pb.File, projectAggregated anal
 	return reports, nil
 }
 
+// parseErrorRule is the rule of the SARIF results reporting syntax errors.
+const parseErrorRule = "parse_error"
+
+// parseErrorOutcomes turns the problems met while parsing files into SARIF
+// results: one per syntax error, or one per error of a file that could not
+// be parsed at all.
+func parseErrorOutcomes(files []*pb.File) []requirement.RuleOutcome {
+	var outcomes []requirement.RuleOutcome
+	for _, f := range files {
+		if len(f.ParseErrors) == 0 {
+			for _, err := range f.Errors {
+				outcomes = append(outcomes, requirement.RuleOutcome{Severity: requirement.SeverityHigh, Rule: parseErrorRule, Message: err, File: f.Path})
+			}
+			continue
+		}
+		severity := requirement.SeverityMedium
+		if len(f.Errors) > 0 {
+			// the file is left out of the metrics
+			severity = requirement.SeverityHigh
+		}
+		for _, e := range f.ParseErrors {
+			outcome := requirement.RuleOutcome{
+				Severity: severity,
+				Rule:     parseErrorRule,
+				Message:  fmt.Sprintf("Syntax error: %s (column %d)", e.Message, e.Column),
+				File:     f.Path,
+				Line:     int(e.Line),
+			}
+			if len(f.Cells) > 0 {
+				outcome.Cell, outcome.Line = engine.NotebookPosition(f, outcome.Line)
+			}
+			outcomes = append(outcomes, outcome)
+		}
+	}
+	return outcomes
+}
+
+// duplicatedBlockRule is the rule of the SARIF results locating the
+// duplicated blocks of code.
+const duplicatedBlockRule = "duplicated_block"
+
+// CloneOutcomes turns the duplicated blocks into SARIF results, whether or not
+// the max_duplication requirement fails: one per copy, spanning its lines, with
+// the other copy as related location. The copies already reported by a
+// violation of max_duplication are left out.
+func CloneOutcomes(duplication *analyzer.DuplicationMetrics, reported []requirement.RuleOutcome) []requirement.RuleOutcome {
+	if duplication == nil {
+		return nil
+	}
+	seen := map[requirement.Location]bool{}
+	for _, out := range reported {
+		if out.Related != nil {
+			seen[requirement.Location{File: out.File, StartLine: out.Line, EndLine: out.EndLine}] = true
+		}
+	}
+
+	var outcomes []requirement.RuleOutcome
+	for _, clone := range duplication.Clones {
+		for _, copies := range [][2]analyzer.CloneLocation{{clone.First, clone.Second}, {clone.Second, clone.First}} {
+			here, other := copies[0], copies[1]
+			if seen[requirement.Location{File: here.FilePath, StartLine: here.StartLine, EndLine: here.EndLine}] {
+				continue
+			}
+			outcomes = append(outcomes, requirement.RuleOutcome{
+				Severity: requirement.SeverityLow,
+				Rule:     duplicatedBlockRule,
+				Message: fmt.Sprintf("Duplicated block of %d lines (%d tokens), also found in %s (lines %d-%d)",
+					here.Lines(), clone.Tokens, other.FilePath, other.StartLine, other.EndLine),
+				File:    here.FilePath,
+				Line:    here.StartLine,
+				EndLine: here.EndLine,
+				Related: &requirement.Location{File: other.FilePath, StartLine: other.StartLine, EndLine: other.EndLine},
+			})
+		}
+	}
+	return outcomes
+}
+
 // Export function to build SARIF directly from outcomes (to be used by lint command)
 func GenerateSarifFromOutcomes(reportPath string, outcomes []requirement.RuleOutcome, maxLevel string) (GeneratedReport, error) {
 	if reportPath == "" {
@@ -194,14 +278,37 @@ func writeSarifFile(reportPath string, outcomes []requirement.RuleOutcome, maxLe
 			// GitHub places annotations using a physical region; startLine must
 			// be >= 1. File-level findings (no specific line) anchor to line 1.
 			startLine := out.Line
+			if out.Cell > 0 {
+				// the line is a line of a notebook cell, not of the .ipynb
+				// document: the cell is named in the message instead
+				res.Message.Text += fmt.Sprintf(" (cell %d, line %d)", out.Cell, out.Line)
+				res.Properties["cell"] = strconv.Itoa(out.Cell)
+				startLine = 1
+			}
 			if startLine < 1 {
 				startLine = 1
 			}
+			region := &sarifRegion{StartLine: startLine}
+			if out.Cell == 0 && out.EndLine > startLine {
+				region.EndLine = out.EndLine
+			}
 			res.Locations = []sarifLocation{
 				{
 					PhysicalLocation: sarifPhysicalLocation{
 						ArtifactLocation: sarifArtifactLocation{URI: out.File},
-						Region:           &sarifRegion{StartLine: startLine},
+						Region:           region,
+					},
+				},
+			}
+		}
+		if out.Related != nil && out.Related.File != "" {
+			id := 1
+			res.RelatedLocations = []sarifLocation{
+				{
+					ID: &id,
+					PhysicalLocation: sarifPhysicalLocation{
+						ArtifactLocation: sarifArtifactLocation{URI: out.Related.File},
+						Region:           &sarifRegion{StartLine: max(out.Related.StartLine, 1), EndLine: out.Related.EndLine},
 					},
 				},
 			}
@@ -209,8 +316,12 @@ func writeSarifFile(reportPath string, outcomes []requirement.RuleOutcome, maxLe
 		// Stable fingerprint so GitHub can track an alert across commits and
 		// avoid duplicates. Built from rule + file + line only (not the volatile
 		// metric values in the message), so the alert persists as code evolves.
+		subject := out.File
+		if out.Cell > 0 {
+			subject += "#cell" + strconv.Itoa(out.Cell)
+		}
 		res.PartialFingerprints = map[string]string{
-			"astMetrics/v1": fingerprint(out.Rule, out.File, out.Line),
+			"astMetrics/v1": fingerprint(out.Rule, subject, out.Line),
 		}
 		log.Runs[0].Results = append(log.Runs[0].Results, res)
 	}
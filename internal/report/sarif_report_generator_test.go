@@ -1,6 +1,7 @@
 package report
 
 import (
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"testing"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/ast-metrics/ast-metrics/internal/analyzer"
 	requirement "github.com/ast-metrics/ast-metrics/internal/analyzer/requirement"
+	pb "github.com/ast-metrics/ast-metrics/pb"
 )
 
 func TestSarifGenerator_EmptyPath(t *testing.T) {
@@ -80,6 +82,102 @@ func TestSarifGenerator_FileLevelFindingDefaultsToLineOne(t *testing.T) {
 	assert.Contains(t, string(b), "\"startLine\": 1")
 }
 
+func TestSarifGenerator_NotebookFindingNamesTheCell(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "report.sarif.json")
+	out := []requirement.RuleOutcome{
+		{Rule: "max_loc_by_method", Severity: requirement.SeverityMedium, Message: "LOC too high in method train()", File: "analysis.ipynb", Cell: 3, Line: 2},
+	}
+	_, err := GenerateSarifFromOutcomes(path, out, "")
+	assert.NoError(t, err)
+	b, readErr := os.ReadFile(path)
+	assert.NoError(t, readErr)
+	content := string(b)
+	assert.Contains(t, content, "LOC too high in method train() (cell 3, line 2)")
+	assert.Contains(t, content, "\"cell\": \"3\"")
+	// a line of a cell is not a line of the .ipynb document
+	assert.Contains(t, content, "\"startLine\": 1")
+}
+
+func TestSarifGenerator_ReportsParseErrors(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "report.sarif.json")
+	gen := &SarifReportGenerator{ReportPath: path}
+
+	pa := analyzer.ProjectAggregated{
+		FilesWithParseErrors: []*pb.File{
+			{Path: "partial.py", ParseErrors: []*pb.ParseError{{Line: 7, Column: 3, Message: "missing )", Missing: true}}},
+			{Path: "unreadable.go", Errors: []string{"open unreadable.go: permission denied"}},
+		},
+	}
+
+	_, err := gen.Generate(nil, pa)
+	assert.NoError(t, err)
+	b, readErr := os.ReadFile(path)
+	assert.NoError(t, readErr)
+	content := string(b)
+	assert.Contains(t, content, "\"ruleId\": \"parse_error\"")
+	assert.Contains(t, content, "Syntax error: missing ) (column 3)")
+	assert.Contains(t, content, "\"startLine\": 7")
+	// a file analyzed despite its syntax errors is a warning, a file left out is an error
+	assert.Contains(t, content, "\"level\": \"warning\"")
+	assert.Contains(t, content, "open unreadable.go: permission denied")
+	assert.Contains(t, content, "\"level\": \"error\"")
+}
+
+func TestSarifGenerator_ReportsClonesWithTheOtherCopy(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "report.sarif.json")
+	gen := &SarifReportGenerator{ReportPath: path}
+
+	pa := analyzer.ProjectAggregated{}
+	pa.Combined.Duplication = &analyzer.DuplicationMetrics{Clones: []analyzer.CodeClone{{
+		Tokens: 60,
+		First:  analyzer.CloneLocation{FilePath: "src/a.php", StartLine: 4, EndLine: 12},
+		Second: analyzer.CloneLocation{FilePath: "src/b.php", StartLine: 40, EndLine: 48},
+	}}}
+
+	// no requirement: the clones are reported all the same
+	_, err := gen.Generate(nil, pa)
+	assert.NoError(t, err)
+	log := readSarif(t, path)
+	results := log.Runs[0].Results
+	if assert.Equal(t, 2, len(results)) {
+		assert.Equal(t, "duplicated_block", results[0].RuleID)
+		assert.Equal(t, "note", results[0].Level)
+		assert.Equal(t, &sarifRegion{StartLine: 4, EndLine: 12}, results[0].Locations[0].PhysicalLocation.Region)
+		assert.Equal(t, "src/b.php", results[0].RelatedLocations[0].PhysicalLocation.ArtifactLocation.URI)
+		assert.Equal(t, &sarifRegion{StartLine: 40, EndLine: 48}, results[0].RelatedLocations[0].PhysicalLocation.Region)
+		assert.Equal(t, "src/b.php", results[1].Locations[0].PhysicalLocation.ArtifactLocation.URI)
+		assert.Equal(t, "src/a.php", results[1].RelatedLocations[0].PhysicalLocation.ArtifactLocation.URI)
+	}
+
+	// a copy reported by a violation of max_duplication is not repeated
+	pa.Evaluation = &requirement.EvaluationResult{Errors: []requirement.RuleOutcome{{
+		Rule: "max_duplication", Severity: requirement.SeverityLow, Message: "Duplicated block", File: "src/a.php", Line: 4, EndLine: 12,
+		Related: &requirement.Location{File: "src/b.php", StartLine: 40, EndLine: 48},
+	}}}
+	_, err = gen.Generate(nil, pa)
+	assert.NoError(t, err)
+	results = readSarif(t, path).Runs[0].Results
+	if assert.Equal(t, 2, len(results)) {
+		assert.Equal(t, "max_duplication", results[0].RuleID)
+		assert.Equal(t, &sarifRegion{StartLine: 4, EndLine: 12}, results[0].Locations[0].PhysicalLocation.Region)
+		assert.Equal(t, "src/b.php", results[0].RelatedLocations[0].PhysicalLocation.ArtifactLocation.URI)
+		assert.Equal(t, "duplicated_block", results[1].RuleID)
+		assert.Equal(t, "src/b.php", results[1].Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	}
+}
+
+func readSarif(t *testing.T, path string) sarifLog {
+	t.Helper()
+	b, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	var log sarifLog
+	assert.NoError(t, json.Unmarshal(b, &log))
+	return log
+}
+
 func TestGenerateSarifFromOutcomes_Helper(t *testing.T) {
 	dir := t.TempDir()
 	path := filepath.Join(dir, "lint.sarif.json")
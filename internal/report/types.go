@@ -24,6 +24,16 @@ type report struct {
 	AverageCyclomaticComplexityPerClass  float64                   `json:"averageCyclomaticComplexityPerClass,omitempty"`
 	MinCyclomaticComplexity              int                       `json:"minCyclomaticComplexity,omitempty"`
 	MaxCyclomaticComplexity              int                       `json:"maxCyclomaticComplexity,omitempty"`
+	AverageCognitiveComplexityPerMethod  float64                   `json:"averageCognitiveComplexityPerMethod,omitempty"`
+	AverageCognitiveComplexityPerClass   float64                   `json:"averageCognitiveComplexityPerClass,omitempty"`
+	MaxCognitiveComplexity               int                       `json:"maxCognitiveComplexity,omitempty"`
+	AverageNPathPerMethod                float64                   `json:"averageNPathPerMethod,omitempty"`
+	MaxNPath                             float64                   `json:"maxNPath,omitempty"`
+	AverageNestingPerMethod              float64                   `json:"averageNestingPerMethod,omitempty"`
+	MaxNesting                           int                       `json:"maxNesting,omitempty"`
+	AverageAbcSizePerMethod              float64                   `json:"averageAbcSizePerMethod,omitempty"`
+	AverageAbcSizePerClass               float64                   `json:"averageAbcSizePerClass,omitempty"`
+	MaxAbcSize                           float64                   `json:"maxAbcSize,omitempty"`
 	AverageHalsteadDifficulty            float64                   `json:"averageHalsteadDifficulty,omitempty"`
 	AverageHalsteadEffort                float64                   `json:"averageHalsteadEffort,omitempty"`
 	AverageHalsteadVolume                float64                   `json:"averageHalsteadVolume,omitempty"`
@@ -44,6 +54,14 @@ type report struct {
 	AverageEfferentCoupling              float64                   `json:"averageEfferentCoupling,omitempty"`
 	AverageInstability                   float64                   `json:"averageInstability,omitempty"`
 	AverageLcom4                         float64                   `json:"averageLcom4,omitempty"`
+	AverageWmc                           float64                   `json:"averageWmc,omitempty"`
+	AverageDit                           float64                   `json:"averageDit,omitempty"`
+	MaxDit                               int                       `json:"maxDit,omitempty"`
+	AverageNoc                           float64                   `json:"averageNoc,omitempty"`
+	AverageCbo                           float64                   `json:"averageCbo,omitempty"`
+	AverageRfc                           float64                   `json:"averageRfc,omitempty"`
+	AveragePublicMethodsPerClass         float64                   `json:"averagePublicMethodsPerClass,omitempty"`
+	NbAbstractClasses                    int                       `json:"numberAbstractClasses,omitempty"`
 	AverageCcn                           float64                   `json:"averageCcn,omitempty"`
 	MaxLoc                               float64                   `json:"maxLoc,omitempty"`
 	CommitCountForPeriod                 int                       `json:"commitCountForPeriod,omitempty"`
@@ -52,6 +70,68 @@ type report struct {
 	TopCommitters                        []contributor             `json:"topCommitters,omitempty"`
 	GitAnalysis                          []gitAnalysis             `json:"gitAnalysis,omitempty"`
 	PackageRelations                     map[string]map[string]int `json:"packageRelations,omitempty"` // counter of dependencies. Ex: A -> B -> 2
+	ParseErrors                          []parseErrorFile          `json:"parseErrors,omitempty"`
+	Distributions                        map[string]distribution   `json:"distributions,omitempty"` // by metric name. Ex: cyclomatic_per_method
+	Documentation                        *docCoverage              `json:"documentation,omitempty"`
+}
+
+// docCoverage describes how much of the public API is documented
+type docCoverage struct {
+	PublicSymbols int                  `json:"publicSymbols"`
+	Documented    int                  `json:"documented"`
+	Coverage      float64              `json:"coverage"` // percentage of the public symbols documented
+	Files         []pathDocCoverage    `json:"files,omitempty"`
+	Directories   []pathDocCoverage    `json:"directories,omitempty"`
+	Undocumented  []undocumentedSymbol `json:"undocumented,omitempty"`
+}
+
+type pathDocCoverage struct {
+	Path          string  `json:"path"`
+	PublicSymbols int     `json:"publicSymbols"`
+	Documented    int     `json:"documented"`
+	Coverage      float64 `json:"coverage"`
+}
+
+type undocumentedSymbol struct {
+	Kind string `json:"kind"` // class or function
+	Name string `json:"name"`
+	Path string `json:"path"`
+	Line int    `json:"line,omitempty"`
+}
+
+// distribution describes how the values of an aggregated metric spread
+type distribution struct {
+	Count     int               `json:"count"`
+	Avg       float64           `json:"avg"`
+	Min       float64           `json:"min"`
+	Max       float64           `json:"max"`
+	Median    float64           `json:"median"`
+	P75       float64           `json:"p75"`
+	P90       float64           `json:"p90"`
+	P95       float64           `json:"p95"`
+	P99       float64           `json:"p99"`
+	StdDev    float64           `json:"stdDev"`
+	Histogram []histogramBucket `json:"histogram,omitempty"`
+}
+
+type histogramBucket struct {
+	UpperBound float64 `json:"le"` // values lower than or equal to the bound, and greater than the previous one
+	Count      int     `json:"count"`
+}
+
+// parseErrorFile lists the problems met while parsing a file
+type parseErrorFile struct {
+	Path        string            `json:"path"`
+	Analyzed    bool              `json:"analyzed"` // false when the file is left out of the metrics
+	Errors      []string          `json:"errors,omitempty"`
+	Diagnostics []parseDiagnostic `json:"diagnostics,omitempty"`
+}
+
+type parseDiagnostic struct {
+	Line    int32  `json:"line"`
+	Column  int32  `json:"column"`
+	Missing bool   `json:"missing,omitempty"` // true when the parser assumed a missing token
+	Message string `json:"message"`
 }
 
 type contributor struct {
@@ -79,6 +159,7 @@ type file struct {
 
 type complexity struct {
 	Cyclomatic int32 `json:"cyclomatic,omitempty"`
+	Cognitive  int32 `json:"cognitive,omitempty"`
 }
 
 type risk struct {
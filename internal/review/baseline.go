@@ -0,0 +1,120 @@
+package review
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	requirement "github.com/ast-metrics/ast-metrics/internal/analyzer/requirement"
+)
+
+// BaselineVersion is the version of the format of the baseline files.
+const BaselineVersion = 1
+
+// Baseline lists the requirement violations accepted as existing debt, for
+// the code bases with no history to review against (an exported snapshot):
+// lint then reports only the violations that are not in it. Violations are
+// matched as by DiffLint, numeric values aside, so that a metric drifting on
+// a rule already broken is still existing debt.
+type Baseline struct {
+	Version int             `json:"version"`
+	Entries []BaselineEntry `json:"entries"`
+}
+
+// BaselineEntry is a violation of the baseline. Line is informative only: a
+// violation moved by an edit above it is still the same.
+type BaselineEntry struct {
+	Rule    string `json:"rule"`
+	File    string `json:"file,omitempty"`
+	Line    int    `json:"line,omitempty"`
+	Message string `json:"message"`
+}
+
+// NewBaseline builds the baseline of the violations. Paths are written
+// relative to root, so that the file can be used from another checkout.
+func NewBaseline(outcomes []requirement.RuleOutcome, root string) *Baseline {
+	baseline := &Baseline{Version: BaselineVersion, Entries: []BaselineEntry{}}
+	for _, out := range outcomes {
+		baseline.Entries = append(baseline.Entries, BaselineEntry{
+			Rule:    out.Rule,
+			File:    relativize(out.File, root),
+			Line:    out.Line,
+			Message: strings.ReplaceAll(out.Message, relativizeToken(root), ""),
+		})
+	}
+	sort.SliceStable(baseline.Entries, func(i, j int) bool {
+		a, b := baseline.Entries[i], baseline.Entries[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.Rule != b.Rule {
+			return a.Rule < b.Rule
+		}
+		return a.Line < b.Line
+	})
+	return baseline
+}
+
+// LoadBaseline reads a baseline file.
+func LoadBaseline(path string) (*Baseline, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read baseline: %w", err)
+	}
+	var baseline Baseline
+	if err := json.Unmarshal(data, &baseline); err != nil {
+		return nil, fmt.Errorf("invalid baseline %s: %w", path, err)
+	}
+	if baseline.Version > BaselineVersion {
+		return nil, fmt.Errorf("baseline %s was written by a newer version (format %d, expected at most %d)", path, baseline.Version, BaselineVersion)
+	}
+	return &baseline, nil
+}
+
+// Save writes the baseline as JSON.
+func (b *Baseline) Save(path string) error {
+	data, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// Filter returns the violations that are not in the baseline, and the
+// entries of the baseline that no violation matches anymore: they were fixed,
+// and can be removed from the file. An entry accepts one violation: the
+// third copy of a violation baselined twice is new.
+func (b *Baseline) Filter(outcomes []requirement.RuleOutcome, root string) ([]requirement.RuleOutcome, []BaselineEntry) {
+	remaining := map[string][]int{}
+	for i, entry := range b.Entries {
+		key := entry.key()
+		remaining[key] = append(remaining[key], i)
+	}
+
+	fresh := []requirement.RuleOutcome{}
+	for _, out := range outcomes {
+		key := lintKey(out, root)
+		if entries := remaining[key]; len(entries) > 0 {
+			remaining[key] = entries[1:]
+			continue
+		}
+		fresh = append(fresh, out)
+	}
+
+	fixed := []BaselineEntry{}
+	for i, entry := range b.Entries {
+		for _, j := range remaining[entry.key()] {
+			if i == j {
+				fixed = append(fixed, entry)
+				break
+			}
+		}
+	}
+	return fresh, fixed
+}
+
+func (e BaselineEntry) key() string {
+	return lintKey(requirement.RuleOutcome{Rule: e.Rule, File: e.File, Message: e.Message}, "")
+}
@@ -0,0 +1,57 @@
+package review
+
+import (
+	"path/filepath"
+	"testing"
+
+	requirement "github.com/ast-metrics/ast-metrics/internal/analyzer/requirement"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBaselineHidesKnownViolationsAndReportsFixedOnes(t *testing.T) {
+	baseline := NewBaseline([]requirement.RuleOutcome{
+		{Rule: "max_loc", File: "/snapshot/b.php", Message: "Lines of code too high in file /snapshot/b.php: got 120 (max: 100)"},
+		{Rule: "max_cognitive", File: "/snapshot/a.php", Line: 12, Message: "Cognitive complexity too high in method run(): got 18 (max: 15)"},
+		{Rule: "max_cognitive", File: "/snapshot/a.php", Line: 40, Message: "Cognitive complexity too high in method run(): got 16 (max: 15)"},
+		{Rule: "no_circular_dependencies", Message: "Circular dependency: A -> B -> A"},
+	}, "/snapshot")
+
+	assert.Equal(t, BaselineVersion, baseline.Version)
+	assert.Equal(t, BaselineEntry{Rule: "max_loc", File: "b.php", Message: "Lines of code too high in file b.php: got 120 (max: 100)"}, baseline.Entries[3])
+	assert.Equal(t, "a.php", baseline.Entries[1].File)
+
+	// the baseline is used from another checkout
+	path := filepath.Join(t.TempDir(), "baseline.json")
+	assert.NoError(t, baseline.Save(path))
+	loaded, err := LoadBaseline(path)
+	assert.NoError(t, err)
+	assert.Equal(t, baseline, loaded)
+
+	fresh, fixed := loaded.Filter([]requirement.RuleOutcome{
+		// the metric drifted and the method moved: still known
+		{Rule: "max_cognitive", File: "/checkout/a.php", Line: 20, Message: "Cognitive complexity too high in method run(): got 19 (max: 15)"},
+		{Rule: "max_loc", File: "/checkout/b.php", Message: "Lines of code too high in file /checkout/b.php: got 125 (max: 100)"},
+		{Rule: "no_circular_dependencies", Message: "Circular dependency: A -> B -> A"},
+		// new
+		{Rule: "max_loc", File: "/checkout/c.php", Message: "Lines of code too high in file /checkout/c.php: got 101 (max: 100)"},
+	}, "/checkout")
+
+	if assert.Len(t, fresh, 1) {
+		assert.Equal(t, "/checkout/c.php", fresh[0].File)
+	}
+	// one of the two violations of run() was fixed
+	if assert.Len(t, fixed, 1) {
+		assert.Equal(t, 40, fixed[0].Line)
+	}
+}
+
+func TestLoadBaselineRejectsUnknownFormats(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "baseline.json")
+	assert.NoError(t, (&Baseline{Version: BaselineVersion + 1}).Save(path))
+
+	_, err := LoadBaseline(path)
+	assert.ErrorContains(t, err, "newer version")
+
+	_, err = LoadBaseline(filepath.Join(t.TempDir(), "missing.json"))
+	assert.ErrorContains(t, err, "cannot read baseline")
+}
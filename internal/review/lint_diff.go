@@ -30,6 +30,7 @@ func DiffLint(headOutcomes []requirement.RuleOutcome, baseOutcomes []requirement
 			Rule:     "new-violation:" + out.Rule,
 			File:     relativize(out.File, headRoot),
 			Line:     out.Line,
+			Cell:     out.Cell,
 			Message:  strings.ReplaceAll(out.Message, relativizeToken(headRoot), ""),
 		})
 	}
@@ -55,6 +55,9 @@ func (f *Finding) title() string {
 }
 
 func (f *Finding) location() string {
+	if f.Cell > 0 {
+		return fmt.Sprintf("%s (cell %d, line %d)", f.File, f.Cell, f.Line)
+	}
 	if f.Line > 0 {
 		return fmt.Sprintf("%s:%d", f.File, f.Line)
 	}
@@ -169,6 +172,7 @@ func (r *Result) ToRuleOutcomes() []requirement.RuleOutcome {
 			Message:  message,
 			File:     f.File,
 			Line:     f.Line,
+			Cell:     f.Cell,
 		})
 	}
 	return outcomes
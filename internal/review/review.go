@@ -9,13 +9,14 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/ast-metrics/ast-metrics/internal/engine"
 	pb "github.com/ast-metrics/ast-metrics/pb"
 )
 
 // MethodologyVersion identifies the formulas and thresholds used to compute
 // findings. It must be bumped whenever a threshold or a formula changes, so
 // that users can distinguish a code evolution from a calculation evolution.
-const MethodologyVersion = "1.0"
+const MethodologyVersion = "1.1"
 
 type Severity string
 
@@ -40,6 +41,7 @@ type Finding struct {
 	Rule       string   `json:"rule"`
 	File       string   `json:"file"`
 	Line       int      `json:"line,omitempty"`
+	Cell       int      `json:"cell,omitempty"` // notebook cell; Line is then the line in the cell
 	Subject    string   `json:"subject,omitempty"`
 	Message    string   `json:"message"`
 	Suggestion string   `json:"suggestion,omitempty"`
@@ -77,6 +79,10 @@ type Options struct {
 	ComplexityHigh int
 	// A complexity increase of at least this much is a high issue
 	ComplexityJumpHigh int
+	// A function at or above this cognitive complexity is worth reporting
+	CognitiveMedium int
+	// A function at or above this cognitive complexity is a high issue
+	CognitiveHigh int
 	// Minimum drop of the maintainability index to report a file
 	MaintainabilityDrop float64
 	// Below this maintainability index a file is considered hard to maintain
@@ -96,6 +102,8 @@ func DefaultOptions() Options {
 		ComplexityMedium:               10,
 		ComplexityHigh:                 25,
 		ComplexityJumpHigh:             10,
+		CognitiveMedium:                15,
+		CognitiveHigh:                  30,
 		MaintainabilityDrop:            5,
 		MaintainabilityLow:             85,
 		MaintainabilityCritical:        65,
@@ -128,7 +136,7 @@ func Compare(headFiles []*pb.File, baseFiles []*pb.File, headRoot string, baseRo
 
 		if !exists {
 			result.Summary.FilesAdded++
-			result.Regressions = append(result.Regressions, findingsForNewFile(head, path, opts)...)
+			result.Regressions = append(result.Regressions, inNotebook(head, findingsForNewFile(head, path, opts))...)
 			continue
 		}
 
@@ -138,8 +146,8 @@ func Compare(headFiles []*pb.File, baseFiles []*pb.File, headRoot string, baseRo
 		result.Summary.FilesChanged++
 
 		regressions, improvements := findingsForModifiedFile(head, base, path, opts)
-		result.Regressions = append(result.Regressions, regressions...)
-		result.Improvements = append(result.Improvements, improvements...)
+		result.Regressions = append(result.Regressions, inNotebook(head, regressions)...)
+		result.Improvements = append(result.Improvements, inNotebook(head, improvements)...)
 	}
 
 	for _, base := range baseFiles {
@@ -203,7 +211,7 @@ func findingsForNewFile(head *pb.File, path string, opts Options) []Finding {
 			Kind:       KindRegression,
 			Severity:   severity,
 			Rule:       "new-complex-function",
-			File:       path,
+			File:       fileOf(fn, path),
 			Line:       lineOf(fn),
 			Subject:    key,
 			Message:    fmt.Sprintf("New function with cyclomatic complexity %d (threshold: %d)", ccn, opts.ComplexityMedium),
@@ -236,7 +244,7 @@ func findingsForModifiedFile(head *pb.File, base *pb.File, path string, opts Opt
 					Kind:       KindRegression,
 					Severity:   severity,
 					Rule:       "new-complex-function",
-					File:       path,
+					File:       fileOf(headFn, path),
 					Line:       lineOf(headFn),
 					Subject:    key,
 					Message:    fmt.Sprintf("New function with cyclomatic complexity %d (threshold: %d)", headCcn, opts.ComplexityMedium),
@@ -258,7 +266,7 @@ func findingsForModifiedFile(head *pb.File, base *pb.File, path string, opts Opt
 				Kind:       KindRegression,
 				Severity:   severity,
 				Rule:       "complexity-regression",
-				File:       path,
+				File:       fileOf(headFn, path),
 				Line:       lineOf(headFn),
 				Subject:    key,
 				Message:    fmt.Sprintf("Cyclomatic complexity: %d -> %d (threshold: %d)", baseCcn, headCcn, opts.ComplexityMedium),
@@ -267,12 +275,32 @@ func findingsForModifiedFile(head *pb.File, base *pb.File, path string, opts Opt
 				After:      float64(headCcn),
 			})
 		}
+		headCognitive := cognitiveOf(headFn)
+		baseCognitive := cognitiveOf(baseFn)
+		if headCognitive > baseCognitive && headCognitive >= int32(opts.CognitiveMedium) {
+			severity := SeverityMedium
+			if headCognitive >= int32(opts.CognitiveHigh) {
+				severity = SeverityHigh
+			}
+			regressions = append(regressions, Finding{
+				Kind:       KindRegression,
+				Severity:   severity,
+				Rule:       "cognitive-regression",
+				File:       fileOf(headFn, path),
+				Line:       lineOf(headFn),
+				Subject:    key,
+				Message:    fmt.Sprintf("Cognitive complexity: %d -> %d (threshold: %d)", baseCognitive, headCognitive, opts.CognitiveMedium),
+				Suggestion: "Flatten nested conditions with early returns, or extract the inner blocks",
+				Before:     float64(baseCognitive),
+				After:      float64(headCognitive),
+			})
+		}
 		if int(baseCcn-headCcn) >= opts.ImprovementComplexityDrop {
 			improvements = append(improvements, Finding{
 				Kind:     KindImprovement,
 				Severity: SeverityLow,
 				Rule:     "complexity-improvement",
-				File:     path,
+				File:     fileOf(headFn, path),
 				Line:     lineOf(headFn),
 				Subject:  key,
 				Message:  fmt.Sprintf("Cyclomatic complexity: %d -> %d", baseCcn, headCcn),
@@ -339,7 +367,7 @@ func findingsForModifiedFile(head *pb.File, base *pb.File, path string, opts Opt
 // stable key (Class::method or function name). Some engines expose class
 // methods both inside the class and at file or namespace level; methods are
 // therefore collected first, and top-level duplicates are skipped by identity
-// (name and start line).
+// (name and start line, in the header for the functions it declares).
 func collectFunctions(file *pb.File) map[string]*pb.StmtFunction {
 	functions := map[string]*pb.StmtFunction{}
 	if file == nil || file.Stmts == nil {
@@ -348,6 +376,9 @@ func collectFunctions(file *pb.File) map[string]*pb.StmtFunction {
 	seen := map[string]bool{}
 
 	identity := func(fn *pb.StmtFunction) string {
+		if header := fn.GetLocation().GetFile(); header != "" {
+			return fmt.Sprintf("%s@%s:%d", nameOf(fn.Name), header, lineOf(fn))
+		}
 		return fmt.Sprintf("%s@%d", nameOf(fn.Name), lineOf(fn))
 	}
 	add := func(fn *pb.StmtFunction, key string) {
@@ -429,6 +460,22 @@ func cyclomaticOf(fn *pb.StmtFunction) int32 {
 	return *fn.Stmts.Analyze.Complexity.Cyclomatic
 }
 
+func cognitiveOf(fn *pb.StmtFunction) int32 {
+	if fn == nil || fn.Stmts == nil || fn.Stmts.Analyze == nil || fn.Stmts.Analyze.Complexity == nil {
+		return 0
+	}
+	return fn.Stmts.Analyze.Complexity.GetCognitive()
+}
+
+// fileOf returns the path of the file holding a function: the header of a
+// C++ source declares some of them.
+func fileOf(fn *pb.StmtFunction, path string) string {
+	if file := fn.GetLocation().GetFile(); file != "" {
+		return file
+	}
+	return path
+}
+
 func lineOf(fn *pb.StmtFunction) int {
 	if fn == nil || fn.Location == nil {
 		return 0
@@ -436,6 +483,19 @@ func lineOf(fn *pb.StmtFunction) int {
 	return int(fn.Location.StartLine)
 }
 
+// inNotebook locates the findings of a notebook in its cells.
+func inNotebook(head *pb.File, findings []Finding) []Finding {
+	if len(head.Cells) == 0 {
+		return findings
+	}
+	for i := range findings {
+		if findings[i].Line > 0 {
+			findings[i].Cell, findings[i].Line = engine.NotebookPosition(head, findings[i].Line)
+		}
+	}
+	return findings
+}
+
 func maintainabilityOf(file *pb.File) (float64, bool) {
 	if file == nil || file.Stmts == nil || file.Stmts.Analyze == nil || file.Stmts.Analyze.Maintainability == nil || file.Stmts.Analyze.Maintainability.MaintainabilityIndex == nil {
 		return 0, false
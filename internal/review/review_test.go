@@ -72,6 +72,24 @@ func TestCompareComplexityJumpIsHigh(t *testing.T) {
 	assert.Equal(t, SeverityHigh, result.Regressions[0].Severity)
 }
 
+func TestCompareDetectsCognitiveRegression(t *testing.T) {
+	withCognitive := func(fn *pb.StmtFunction, cognitive int32) *pb.StmtFunction {
+		fn.Stmts.Analyze.Complexity.Cognitive = &cognitive
+		return fn
+	}
+	base := []*pb.File{newFile("/base/svc.go", "aaa", withCognitive(newFunction("Pay", 6, 3), 9))}
+	head := []*pb.File{newFile("/head/svc.go", "bbb", withCognitive(newFunction("Pay", 6, 3), 18))}
+
+	result := Compare(head, base, "/head", "/base", DefaultOptions())
+
+	assert.Len(t, result.Regressions, 1)
+	finding := result.Regressions[0]
+	assert.Equal(t, "cognitive-regression", finding.Rule)
+	assert.Equal(t, SeverityMedium, finding.Severity)
+	assert.Contains(t, finding.Message, "9 -> 18")
+	assert.Equal(t, 3, finding.Line)
+}
+
 func TestCompareIgnoresUnchangedDebt(t *testing.T) {
 	// the function is complex in both versions, with the same value: not reported
 	base := []*pb.File{newFile("/base/svc.go", "aaa", newFunction("Pay", 30, 1))}
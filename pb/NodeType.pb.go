@@ -20,6 +20,63 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Visibility of a class or a function, as declared or as implied by the
+// conventions of the language (exported names in Go, underscores in Python).
+type Visibility int32
+
+const (
+	Visibility_VISIBILITY_UNKNOWN   Visibility = 0 // the language has no way to tell
+	Visibility_VISIBILITY_PUBLIC    Visibility = 1
+	Visibility_VISIBILITY_PROTECTED Visibility = 2
+	Visibility_VISIBILITY_PRIVATE   Visibility = 3
+	Visibility_VISIBILITY_PACKAGE   Visibility = 4 // visible in its package or module only (Java default, internal, unexported)
+)
+
+// Enum value maps for Visibility.
+var (
+	Visibility_name = map[int32]string{
+		0: "VISIBILITY_UNKNOWN",
+		1: "VISIBILITY_PUBLIC",
+		2: "VISIBILITY_PROTECTED",
+		3: "VISIBILITY_PRIVATE",
+		4: "VISIBILITY_PACKAGE",
+	}
+	Visibility_value = map[string]int32{
+		"VISIBILITY_UNKNOWN":   0,
+		"VISIBILITY_PUBLIC":    1,
+		"VISIBILITY_PROTECTED": 2,
+		"VISIBILITY_PRIVATE":   3,
+		"VISIBILITY_PACKAGE":   4,
+	}
+)
+
+func (x Visibility) Enum() *Visibility {
+	p := new(Visibility)
+	*p = x
+	return p
+}
+
+func (x Visibility) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Visibility) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_NodeType_proto_enumTypes[0].Descriptor()
+}
+
+func (Visibility) Type() protoreflect.EnumType {
+	return &file_proto_NodeType_proto_enumTypes[0]
+}
+
+func (x Visibility) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Visibility.Descriptor instead.
+func (Visibility) EnumDescriptor() ([]byte, []int) {
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{0}
+}
+
 // ------------------------------------
 // -- Node Types and statements
 // ------------------------------------
@@ -254,15 +311,20 @@ type File struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path                string       `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Stmts               *Stmts       `protobuf:"bytes,2,opt,name=stmts,proto3" json:"stmts,omitempty"`
-	LinesOfCode         *LinesOfCode `protobuf:"bytes,3,opt,name=linesOfCode,proto3" json:"linesOfCode,omitempty"`
-	ProgrammingLanguage string       `protobuf:"bytes,4,opt,name=programmingLanguage,proto3" json:"programmingLanguage,omitempty"`
-	Commits             *Commits     `protobuf:"bytes,5,opt,name=commits,proto3" json:"commits,omitempty"`
-	Errors              []string     `protobuf:"bytes,6,rep,name=errors,proto3" json:"errors,omitempty"`
-	Checksum            string       `protobuf:"bytes,7,opt,name=checksum,proto3" json:"checksum,omitempty"`
-	ShortPath           string       `protobuf:"bytes,8,opt,name=shortPath,proto3" json:"shortPath,omitempty"`
-	IsTest              bool         `protobuf:"varint,9,opt,name=is_test,json=isTest,proto3" json:"is_test,omitempty"` // indicates if the file is a test file (unit, functional)
+	Path                string          `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Stmts               *Stmts          `protobuf:"bytes,2,opt,name=stmts,proto3" json:"stmts,omitempty"`
+	LinesOfCode         *LinesOfCode    `protobuf:"bytes,3,opt,name=linesOfCode,proto3" json:"linesOfCode,omitempty"`
+	ProgrammingLanguage string          `protobuf:"bytes,4,opt,name=programmingLanguage,proto3" json:"programmingLanguage,omitempty"`
+	Commits             *Commits        `protobuf:"bytes,5,opt,name=commits,proto3" json:"commits,omitempty"`
+	Errors              []string        `protobuf:"bytes,6,rep,name=errors,proto3" json:"errors,omitempty"`
+	Checksum            string          `protobuf:"bytes,7,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	ShortPath           string          `protobuf:"bytes,8,opt,name=shortPath,proto3" json:"shortPath,omitempty"`
+	IsTest              bool            `protobuf:"varint,9,opt,name=is_test,json=isTest,proto3" json:"is_test,omitempty"` // indicates if the file is a test file (unit, functional)
+	Cells               []*NotebookCell `protobuf:"bytes,10,rep,name=cells,proto3" json:"cells,omitempty"`                 // code cells, when the file is a notebook
+	ParseErrors         []*ParseError   `protobuf:"bytes,11,rep,name=parseErrors,proto3" json:"parseErrors,omitempty"`     // syntax problems found by the parser
+	Tokens              *TokenStream    `protobuf:"bytes,12,opt,name=tokens,proto3" json:"tokens,omitempty"`               // normalized tokens, to detect duplicated code
+	QueryMatches        []*QueryMatch   `protobuf:"bytes,13,rep,name=queryMatches,proto3" json:"queryMatches,omitempty"`   // code matching the tree-sitter queries of the configuration
+	Directives          []*StmtComment  `protobuf:"bytes,14,rep,name=directives,proto3" json:"directives,omitempty"`       // comments addressed to ast-metrics ("ast-metrics-ignore-next-line")
 }
 
 func (x *File) Reset() {
@@ -360,23 +422,336 @@ func (x *File) GetIsTest() bool {
 	return false
 }
 
+func (x *File) GetCells() []*NotebookCell {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+func (x *File) GetParseErrors() []*ParseError {
+	if x != nil {
+		return x.ParseErrors
+	}
+	return nil
+}
+
+func (x *File) GetTokens() *TokenStream {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+func (x *File) GetQueryMatches() []*QueryMatch {
+	if x != nil {
+		return x.QueryMatches
+	}
+	return nil
+}
+
+func (x *File) GetDirectives() []*StmtComment {
+	if x != nil {
+		return x.Directives
+	}
+	return nil
+}
+
+// Describe the tokens of a file, once comments are left out and identifiers
+// and literals are abstracted: two pieces of code differing only by their
+// names or values give the same tokens. Each token is a hash of its kind.
+type TokenStream struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kinds []uint32 `protobuf:"varint,1,rep,packed,name=kinds,proto3" json:"kinds,omitempty"`
+	Lines []int32  `protobuf:"varint,2,rep,packed,name=lines,proto3" json:"lines,omitempty"` // 1-based line of each token
+}
+
+func (x *TokenStream) Reset() {
+	*x = TokenStream{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_NodeType_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenStream) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenStream) ProtoMessage() {}
+
+func (x *TokenStream) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_NodeType_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenStream.ProtoReflect.Descriptor instead.
+func (*TokenStream) Descriptor() ([]byte, []int) {
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TokenStream) GetKinds() []uint32 {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+func (x *TokenStream) GetLines() []int32 {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+// Describe a syntax problem found while parsing a file: an unexpected piece
+// of code, or a token the parser had to assume.
+type ParseError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Line    int32  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`       // 1-based line of the problem
+	Column  int32  `protobuf:"varint,2,opt,name=column,proto3" json:"column,omitempty"`   // 1-based column of the problem
+	Missing bool   `protobuf:"varint,3,opt,name=missing,proto3" json:"missing,omitempty"` // true when the parser assumed a missing token
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ParseError) Reset() {
+	*x = ParseError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_NodeType_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParseError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseError) ProtoMessage() {}
+
+func (x *ParseError) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_NodeType_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseError.ProtoReflect.Descriptor instead.
+func (*ParseError) Descriptor() ([]byte, []int) {
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ParseError) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ParseError) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+func (x *ParseError) GetMissing() bool {
+	if x != nil {
+		return x.Missing
+	}
+	return false
+}
+
+func (x *ParseError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Describe code matching a tree-sitter query of the configuration
+// (requirements.rules.queries), found while parsing the file.
+type QueryMatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rule    string `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`      // name of the query rule
+	Line    int32  `protobuf:"varint,2,opt,name=line,proto3" json:"line,omitempty"`     // 1-based line of the reported node
+	Column  int32  `protobuf:"varint,3,opt,name=column,proto3" json:"column,omitempty"` // 1-based column of the reported node
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	File    string `protobuf:"bytes,5,opt,name=file,proto3" json:"file,omitempty"` // path of the file holding the node, when it is not the analyzed file (C++ header)
+}
+
+func (x *QueryMatch) Reset() {
+	*x = QueryMatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_NodeType_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryMatch) ProtoMessage() {}
+
+func (x *QueryMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_NodeType_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryMatch.ProtoReflect.Descriptor instead.
+func (*QueryMatch) Descriptor() ([]byte, []int) {
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryMatch) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *QueryMatch) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *QueryMatch) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+func (x *QueryMatch) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *QueryMatch) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+// Describe a code cell of a notebook. The code cells are analyzed as one
+// source, where each cell covers a range of lines.
+type NotebookCell struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index     int32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`         // 1-based position of the cell in the notebook
+	StartLine int32 `protobuf:"varint,2,opt,name=startLine,proto3" json:"startLine,omitempty"` // first line of the cell in the analyzed source
+	EndLine   int32 `protobuf:"varint,3,opt,name=endLine,proto3" json:"endLine,omitempty"`     // last line of the cell in the analyzed source
+}
+
+func (x *NotebookCell) Reset() {
+	*x = NotebookCell{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_NodeType_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotebookCell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotebookCell) ProtoMessage() {}
+
+func (x *NotebookCell) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_NodeType_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotebookCell.ProtoReflect.Descriptor instead.
+func (*NotebookCell) Descriptor() ([]byte, []int) {
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *NotebookCell) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *NotebookCell) GetStartLine() int32 {
+	if x != nil {
+		return x.StartLine
+	}
+	return 0
+}
+
+func (x *NotebookCell) GetEndLine() int32 {
+	if x != nil {
+		return x.EndLine
+	}
+	return 0
+}
+
 // Describe the location of statement in file.
 type StmtLocationInFile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StartLine    int32 `protobuf:"varint,1,opt,name=startLine,proto3" json:"startLine,omitempty"`
-	StartFilePos int32 `protobuf:"varint,2,opt,name=startFilePos,proto3" json:"startFilePos,omitempty"`
-	EndLine      int32 `protobuf:"varint,3,opt,name=endLine,proto3" json:"endLine,omitempty"`
-	EndFilePos   int32 `protobuf:"varint,4,opt,name=endFilePos,proto3" json:"endFilePos,omitempty"`
-	BlankLines   int32 `protobuf:"varint,5,opt,name=blankLines,proto3" json:"blankLines,omitempty"`
+	StartLine    int32  `protobuf:"varint,1,opt,name=startLine,proto3" json:"startLine,omitempty"`
+	StartFilePos int32  `protobuf:"varint,2,opt,name=startFilePos,proto3" json:"startFilePos,omitempty"`
+	EndLine      int32  `protobuf:"varint,3,opt,name=endLine,proto3" json:"endLine,omitempty"`
+	EndFilePos   int32  `protobuf:"varint,4,opt,name=endFilePos,proto3" json:"endFilePos,omitempty"`
+	BlankLines   int32  `protobuf:"varint,5,opt,name=blankLines,proto3" json:"blankLines,omitempty"`
+	File         string `protobuf:"bytes,6,opt,name=file,proto3" json:"file,omitempty"` // path of the file holding the statement, when it is not the analyzed file (C++ header)
 }
 
 func (x *StmtLocationInFile) Reset() {
 	*x = StmtLocationInFile{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_NodeType_proto_msgTypes[3]
+		mi := &file_proto_NodeType_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -389,7 +764,7 @@ func (x *StmtLocationInFile) String() string {
 func (*StmtLocationInFile) ProtoMessage() {}
 
 func (x *StmtLocationInFile) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_NodeType_proto_msgTypes[3]
+	mi := &file_proto_NodeType_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -402,7 +777,7 @@ func (x *StmtLocationInFile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StmtLocationInFile.ProtoReflect.Descriptor instead.
 func (*StmtLocationInFile) Descriptor() ([]byte, []int) {
-	return file_proto_NodeType_proto_rawDescGZIP(), []int{3}
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *StmtLocationInFile) GetStartLine() int32 {
@@ -440,6 +815,13 @@ func (x *StmtLocationInFile) GetBlankLines() int32 {
 	return 0
 }
 
+func (x *StmtLocationInFile) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
 // Represents a namespace node.
 type StmtNamespace struct {
 	state         protoimpl.MessageState
@@ -455,7 +837,7 @@ type StmtNamespace struct {
 func (x *StmtNamespace) Reset() {
 	*x = StmtNamespace{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_NodeType_proto_msgTypes[4]
+		mi := &file_proto_NodeType_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -468,7 +850,7 @@ func (x *StmtNamespace) String() string {
 func (*StmtNamespace) ProtoMessage() {}
 
 func (x *StmtNamespace) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_NodeType_proto_msgTypes[4]
+	mi := &file_proto_NodeType_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -481,7 +863,7 @@ func (x *StmtNamespace) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StmtNamespace.ProtoReflect.Descriptor instead.
 func (*StmtNamespace) Descriptor() ([]byte, []int) {
-	return file_proto_NodeType_proto_rawDescGZIP(), []int{4}
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *StmtNamespace) GetName() *Name {
@@ -526,7 +908,7 @@ type StmtUse struct {
 func (x *StmtUse) Reset() {
 	*x = StmtUse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_NodeType_proto_msgTypes[5]
+		mi := &file_proto_NodeType_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -539,7 +921,7 @@ func (x *StmtUse) String() string {
 func (*StmtUse) ProtoMessage() {}
 
 func (x *StmtUse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_NodeType_proto_msgTypes[5]
+	mi := &file_proto_NodeType_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -552,7 +934,7 @@ func (x *StmtUse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StmtUse.ProtoReflect.Descriptor instead.
 func (*StmtUse) Descriptor() ([]byte, []int) {
-	return file_proto_NodeType_proto_rawDescGZIP(), []int{5}
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *StmtUse) GetName() *Name {
@@ -592,12 +974,16 @@ type StmtClass struct {
 	Implements  []*Name             `protobuf:"bytes,8,rep,name=implements,proto3" json:"implements,omitempty"`
 	Uses        []*Name             `protobuf:"bytes,9,rep,name=uses,proto3" json:"uses,omitempty"`
 	LinesOfCode *LinesOfCode        `protobuf:"bytes,10,opt,name=linesOfCode,proto3" json:"linesOfCode,omitempty"`
+	Modifiers   *Modifiers          `protobuf:"bytes,11,opt,name=modifiers,proto3" json:"modifiers,omitempty"`
+	Annotations []*Annotation       `protobuf:"bytes,12,rep,name=annotations,proto3" json:"annotations,omitempty"`
+	DocComment  *StmtComment        `protobuf:"bytes,13,opt,name=docComment,proto3" json:"docComment,omitempty"` // documentation written right before the class
+	References  []string            `protobuf:"bytes,14,rep,name=references,proto3" json:"references,omitempty"` // names of the types mentioned in the class (fields, parameters, instantiations)
 }
 
 func (x *StmtClass) Reset() {
 	*x = StmtClass{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_NodeType_proto_msgTypes[6]
+		mi := &file_proto_NodeType_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -610,7 +996,7 @@ func (x *StmtClass) String() string {
 func (*StmtClass) ProtoMessage() {}
 
 func (x *StmtClass) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_NodeType_proto_msgTypes[6]
+	mi := &file_proto_NodeType_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -623,7 +1009,7 @@ func (x *StmtClass) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StmtClass.ProtoReflect.Descriptor instead.
 func (*StmtClass) Descriptor() ([]byte, []int) {
-	return file_proto_NodeType_proto_rawDescGZIP(), []int{6}
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *StmtClass) GetName() *Name {
@@ -696,28 +1082,60 @@ func (x *StmtClass) GetLinesOfCode() *LinesOfCode {
 	return nil
 }
 
+func (x *StmtClass) GetModifiers() *Modifiers {
+	if x != nil {
+		return x.Modifiers
+	}
+	return nil
+}
+
+func (x *StmtClass) GetAnnotations() []*Annotation {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *StmtClass) GetDocComment() *StmtComment {
+	if x != nil {
+		return x.DocComment
+	}
+	return nil
+}
+
+func (x *StmtClass) GetReferences() []string {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
 // Represents a Function node.
 type StmtFunction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name        *Name               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Stmts       *Stmts              `protobuf:"bytes,2,opt,name=stmts,proto3" json:"stmts,omitempty"`
-	Location    *StmtLocationInFile `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
-	Comments    []*StmtComment      `protobuf:"bytes,4,rep,name=comments,proto3" json:"comments,omitempty"`
-	Operators   []*StmtOperator     `protobuf:"bytes,5,rep,name=operators,proto3" json:"operators,omitempty"`
-	Operands    []*StmtOperand      `protobuf:"bytes,6,rep,name=operands,proto3" json:"operands,omitempty"`
-	MethodCalls []*StmtMethodCall   `protobuf:"bytes,7,rep,name=methodCalls,proto3" json:"methodCalls,omitempty"`
-	Parameters  []*StmtParameter    `protobuf:"bytes,8,rep,name=parameters,proto3" json:"parameters,omitempty"`
-	Externals   []*Name             `protobuf:"bytes,9,rep,name=externals,proto3" json:"externals,omitempty"` // dependencies
-	LinesOfCode *LinesOfCode        `protobuf:"bytes,10,opt,name=linesOfCode,proto3" json:"linesOfCode,omitempty"`
+	Name          *Name               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Stmts         *Stmts              `protobuf:"bytes,2,opt,name=stmts,proto3" json:"stmts,omitempty"`
+	Location      *StmtLocationInFile `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	Comments      []*StmtComment      `protobuf:"bytes,4,rep,name=comments,proto3" json:"comments,omitempty"`
+	Operators     []*StmtOperator     `protobuf:"bytes,5,rep,name=operators,proto3" json:"operators,omitempty"`
+	Operands      []*StmtOperand      `protobuf:"bytes,6,rep,name=operands,proto3" json:"operands,omitempty"`
+	MethodCalls   []*StmtMethodCall   `protobuf:"bytes,7,rep,name=methodCalls,proto3" json:"methodCalls,omitempty"`
+	Parameters    []*StmtParameter    `protobuf:"bytes,8,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	Externals     []*Name             `protobuf:"bytes,9,rep,name=externals,proto3" json:"externals,omitempty"` // dependencies
+	LinesOfCode   *LinesOfCode        `protobuf:"bytes,10,opt,name=linesOfCode,proto3" json:"linesOfCode,omitempty"`
+	Modifiers     *Modifiers          `protobuf:"bytes,11,opt,name=modifiers,proto3" json:"modifiers,omitempty"`
+	Annotations   []*Annotation       `protobuf:"bytes,12,rep,name=annotations,proto3" json:"annotations,omitempty"`
+	DocComment    *StmtComment        `protobuf:"bytes,13,opt,name=docComment,proto3" json:"docComment,omitempty"`       // documentation written right before the function (docstring in Python)
+	CalledMethods []string            `protobuf:"bytes,14,rep,name=calledMethods,proto3" json:"calledMethods,omitempty"` // names of the methods and functions called, on any receiver
 }
 
 func (x *StmtFunction) Reset() {
 	*x = StmtFunction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_NodeType_proto_msgTypes[7]
+		mi := &file_proto_NodeType_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -730,7 +1148,7 @@ func (x *StmtFunction) String() string {
 func (*StmtFunction) ProtoMessage() {}
 
 func (x *StmtFunction) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_NodeType_proto_msgTypes[7]
+	mi := &file_proto_NodeType_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -743,7 +1161,7 @@ func (x *StmtFunction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StmtFunction.ProtoReflect.Descriptor instead.
 func (*StmtFunction) Descriptor() ([]byte, []int) {
-	return file_proto_NodeType_proto_rawDescGZIP(), []int{7}
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *StmtFunction) GetName() *Name {
@@ -809,9 +1227,166 @@ func (x *StmtFunction) GetExternals() []*Name {
 	return nil
 }
 
-func (x *StmtFunction) GetLinesOfCode() *LinesOfCode {
+func (x *StmtFunction) GetLinesOfCode() *LinesOfCode {
+	if x != nil {
+		return x.LinesOfCode
+	}
+	return nil
+}
+
+func (x *StmtFunction) GetModifiers() *Modifiers {
+	if x != nil {
+		return x.Modifiers
+	}
+	return nil
+}
+
+func (x *StmtFunction) GetAnnotations() []*Annotation {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *StmtFunction) GetDocComment() *StmtComment {
+	if x != nil {
+		return x.DocComment
+	}
+	return nil
+}
+
+func (x *StmtFunction) GetCalledMethods() []string {
+	if x != nil {
+		return x.CalledMethods
+	}
+	return nil
+}
+
+// Describe the modifiers of a class or a function.
+type Modifiers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Visibility Visibility `protobuf:"varint,1,opt,name=visibility,proto3,enum=NodeType.Visibility" json:"visibility,omitempty"`
+	IsAbstract bool       `protobuf:"varint,2,opt,name=isAbstract,proto3" json:"isAbstract,omitempty"`
+	IsStatic   bool       `protobuf:"varint,3,opt,name=isStatic,proto3" json:"isStatic,omitempty"` // static (class-level) member
+	IsFinal    bool       `protobuf:"varint,4,opt,name=isFinal,proto3" json:"isFinal,omitempty"`   // cannot be extended or overridden
+}
+
+func (x *Modifiers) Reset() {
+	*x = Modifiers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_NodeType_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Modifiers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Modifiers) ProtoMessage() {}
+
+func (x *Modifiers) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_NodeType_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Modifiers.ProtoReflect.Descriptor instead.
+func (*Modifiers) Descriptor() ([]byte, []int) {
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Modifiers) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNKNOWN
+}
+
+func (x *Modifiers) GetIsAbstract() bool {
+	if x != nil {
+		return x.IsAbstract
+	}
+	return false
+}
+
+func (x *Modifiers) GetIsStatic() bool {
+	if x != nil {
+		return x.IsStatic
+	}
+	return false
+}
+
+func (x *Modifiers) GetIsFinal() bool {
+	if x != nil {
+		return x.IsFinal
+	}
+	return false
+}
+
+// Represents an annotation (Java), an attribute (PHP, C#, Rust) or a
+// decorator (Python, TypeScript) written on a class or a function.
+type Annotation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      *Name    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`           // "Entity" / "javax.persistence.Entity", "get" / "app.get"
+	Arguments []string `protobuf:"bytes,2,rep,name=arguments,proto3" json:"arguments,omitempty"` // source of each argument: "\"/users\"", "name = \"users\""
+}
+
+func (x *Annotation) Reset() {
+	*x = Annotation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_NodeType_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Annotation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Annotation) ProtoMessage() {}
+
+func (x *Annotation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_NodeType_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Annotation.ProtoReflect.Descriptor instead.
+func (*Annotation) Descriptor() ([]byte, []int) {
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Annotation) GetName() *Name {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *Annotation) GetArguments() []string {
 	if x != nil {
-		return x.LinesOfCode
+		return x.Arguments
 	}
 	return nil
 }
@@ -829,7 +1404,7 @@ type StmtParameter struct {
 func (x *StmtParameter) Reset() {
 	*x = StmtParameter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_NodeType_proto_msgTypes[8]
+		mi := &file_proto_NodeType_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -842,7 +1417,7 @@ func (x *StmtParameter) String() string {
 func (*StmtParameter) ProtoMessage() {}
 
 func (x *StmtParameter) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_NodeType_proto_msgTypes[8]
+	mi := &file_proto_NodeType_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -855,7 +1430,7 @@ func (x *StmtParameter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StmtParameter.ProtoReflect.Descriptor instead.
 func (*StmtParameter) Descriptor() ([]byte, []int) {
-	return file_proto_NodeType_proto_rawDescGZIP(), []int{8}
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *StmtParameter) GetName() string {
@@ -887,7 +1462,7 @@ type StmtExternalDependency struct {
 func (x *StmtExternalDependency) Reset() {
 	*x = StmtExternalDependency{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_NodeType_proto_msgTypes[9]
+		mi := &file_proto_NodeType_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -900,7 +1475,7 @@ func (x *StmtExternalDependency) String() string {
 func (*StmtExternalDependency) ProtoMessage() {}
 
 func (x *StmtExternalDependency) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_NodeType_proto_msgTypes[9]
+	mi := &file_proto_NodeType_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -913,7 +1488,7 @@ func (x *StmtExternalDependency) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StmtExternalDependency.ProtoReflect.Descriptor instead.
 func (*StmtExternalDependency) Descriptor() ([]byte, []int) {
-	return file_proto_NodeType_proto_rawDescGZIP(), []int{9}
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *StmtExternalDependency) GetClassName() string {
@@ -950,16 +1525,18 @@ type StmtInterface struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name     *Name               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Stmts    *Stmts              `protobuf:"bytes,2,opt,name=stmts,proto3" json:"stmts,omitempty"`
-	Location *StmtLocationInFile `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
-	Extends  []*Name             `protobuf:"bytes,4,rep,name=extends,proto3" json:"extends,omitempty"`
+	Name       *Name               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Stmts      *Stmts              `protobuf:"bytes,2,opt,name=stmts,proto3" json:"stmts,omitempty"`
+	Location   *StmtLocationInFile `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	Extends    []*Name             `protobuf:"bytes,4,rep,name=extends,proto3" json:"extends,omitempty"`
+	Modifiers  *Modifiers          `protobuf:"bytes,5,opt,name=modifiers,proto3" json:"modifiers,omitempty"`
+	DocComment *StmtComment        `protobuf:"bytes,6,opt,name=docComment,proto3" json:"docComment,omitempty"` // documentation written right before the interface
 }
 
 func (x *StmtInterface) Reset() {
 	*x = StmtInterface{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_NodeType_proto_msgTypes[10]
+		mi := &file_proto_NodeType_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -972,7 +1549,7 @@ func (x *StmtInterface) String() string {
 func (*StmtInterface) ProtoMessage() {}
 
 func (x *StmtInterface) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_NodeType_proto_msgTypes[10]
+	mi := &file_proto_NodeType_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -985,7 +1562,7 @@ func (x *StmtInterface) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StmtInterface.ProtoReflect.Descriptor instead.
 func (*StmtInterface) Descriptor() ([]byte, []int) {
-	return file_proto_NodeType_proto_rawDescGZIP(), []int{10}
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *StmtInterface) GetName() *Name {
@@ -1016,6 +1593,20 @@ func (x *StmtInterface) GetExtends() []*Name {
 	return nil
 }
 
+func (x *StmtInterface) GetModifiers() *Modifiers {
+	if x != nil {
+		return x.Modifiers
+	}
+	return nil
+}
+
+func (x *StmtInterface) GetDocComment() *StmtComment {
+	if x != nil {
+		return x.DocComment
+	}
+	return nil
+}
+
 // Represents a Trait node.
 type StmtTrait struct {
 	state         protoimpl.MessageState
@@ -1030,7 +1621,7 @@ type StmtTrait struct {
 func (x *StmtTrait) Reset() {
 	*x = StmtTrait{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_NodeType_proto_msgTypes[11]
+		mi := &file_proto_NodeType_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1043,7 +1634,7 @@ func (x *StmtTrait) String() string {
 func (*StmtTrait) ProtoMessage() {}
 
 func (x *StmtTrait) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_NodeType_proto_msgTypes[11]
+	mi := &file_proto_NodeType_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1056,7 +1647,7 @@ func (x *StmtTrait) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StmtTrait.ProtoReflect.Descriptor instead.
 func (*StmtTrait) Descriptor() ([]byte, []int) {
-	return file_proto_NodeType_proto_rawDescGZIP(), []int{11}
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *StmtTrait) GetName() *Name {
@@ -1093,7 +1684,7 @@ type StmtDecisionIf struct {
 func (x *StmtDecisionIf) Reset() {
 	*x = StmtDecisionIf{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_NodeType_proto_msgTypes[12]
+		mi := &file_proto_NodeType_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1106,7 +1697,7 @@ func (x *StmtDecisionIf) String() string {
 func (*StmtDecisionIf) ProtoMessage() {}
 
 func (x *StmtDecisionIf) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_NodeType_proto_msgTypes[12]
+	mi := &file_proto_NodeType_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1119,7 +1710,7 @@ func (x *StmtDecisionIf) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StmtDecisionIf.ProtoReflect.Descriptor instead.
 func (*StmtDecisionIf) Descriptor() ([]byte, []int) {
-	return file_proto_NodeType_proto_rawDescGZIP(), []int{12}
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *StmtDecisionIf) GetStmts() *Stmts {
@@ -1149,7 +1740,7 @@ type StmtDecisionElseIf struct {
 func (x *StmtDecisionElseIf) Reset() {
 	*x = StmtDecisionElseIf{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_NodeType_proto_msgTypes[13]
+		mi := &file_proto_NodeType_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1162,7 +1753,7 @@ func (x *StmtDecisionElseIf) String() string {
 func (*StmtDecisionElseIf) ProtoMessage() {}
 
 func (x *StmtDecisionElseIf) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_NodeType_proto_msgTypes[13]
+	mi := &file_proto_NodeType_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1175,7 +1766,7 @@ func (x *StmtDecisionElseIf) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StmtDecisionElseIf.ProtoReflect.Descriptor instead.
 func (*StmtDecisionElseIf) Descriptor() ([]byte, []int) {
-	return file_proto_NodeType_proto_rawDescGZIP(), []int{13}
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *StmtDecisionElseIf) GetStmts() *Stmts {
@@ -1205,7 +1796,7 @@ type StmtDecisionElse struct {
 func (x *StmtDecisionElse) Reset() {
 	*x = StmtDecisionElse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_NodeType_proto_msgTypes[14]
+		mi := &file_proto_NodeType_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1218,7 +1809,7 @@ func (x *StmtDecisionElse) String() string {
 func (*StmtDecisionElse) ProtoMessage() {}
 
 func (x *StmtDecisionElse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_NodeType_proto_msgTypes[14]
+	mi := &file_proto_NodeType_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1231,7 +1822,7 @@ func (x *StmtDecisionElse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StmtDecisionElse.ProtoReflect.Descriptor instead.
 func (*StmtDecisionElse) Descriptor() ([]byte, []int) {
-	return file_proto_NodeType_proto_rawDescGZIP(), []int{14}
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *StmtDecisionElse) GetStmts() *Stmts {
@@ -1261,7 +1852,7 @@ type StmtDecisionCase struct {
 func (x *StmtDecisionCase) Reset() {
 	*x = StmtDecisionCase{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_NodeType_proto_msgTypes[15]
+		mi := &file_proto_NodeType_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1274,7 +1865,7 @@ func (x *StmtDecisionCase) String() string {
 func (*StmtDecisionCase) ProtoMessage() {}
 
 func (x *StmtDecisionCase) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_NodeType_proto_msgTypes[15]
+	mi := &file_proto_NodeType_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1287,7 +1878,7 @@ func (x *StmtDecisionCase) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StmtDecisionCase.ProtoReflect.Descriptor instead.
 func (*StmtDecisionCase) Descriptor() ([]byte, []int) {
-	return file_proto_NodeType_proto_rawDescGZIP(), []int{15}
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *StmtDecisionCase) GetStmts() *Stmts {
@@ -1317,7 +1908,7 @@ type StmtDecisionSwitch struct {
 func (x *StmtDecisionSwitch) Reset() {
 	*x = StmtDecisionSwitch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_NodeType_proto_msgTypes[16]
+		mi := &file_proto_NodeType_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1330,7 +1921,7 @@ func (x *StmtDecisionSwitch) String() string {
 func (*StmtDecisionSwitch) ProtoMessage() {}
 
 func (x *StmtDecisionSwitch) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_NodeType_proto_msgTypes[16]
+	mi := &file_proto_NodeType_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1343,7 +1934,7 @@ func (x *StmtDecisionSwitch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StmtDecisionSwitch.ProtoReflect.Descriptor instead.
 func (*StmtDecisionSwitch) Descriptor() ([]byte, []int) {
-	return file_proto_NodeType_proto_rawDescGZIP(), []int{16}
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *StmtDecisionSwitch) GetStmts() *Stmts {
@@ -1373,7 +1964,7 @@ type StmtLoop struct {
 func (x *StmtLoop) Reset() {
 	*x = StmtLoop{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_NodeType_proto_msgTypes[17]
+		mi := &file_proto_NodeType_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1386,7 +1977,7 @@ func (x *StmtLoop) String() string {
 func (*StmtLoop) ProtoMessage() {}
 
 func (x *StmtLoop) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_NodeType_proto_msgTypes[17]
+	mi := &file_proto_NodeType_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1399,7 +1990,7 @@ func (x *StmtLoop) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StmtLoop.ProtoReflect.Descriptor instead.
 func (*StmtLoop) Descriptor() ([]byte, []int) {
-	return file_proto_NodeType_proto_rawDescGZIP(), []int{17}
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *StmtLoop) GetStmts() *Stmts {
@@ -1422,14 +2013,16 @@ type StmtComment struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Text     string              `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
-	Location *StmtLocationInFile `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	Text       string              `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Location   *StmtLocationInFile `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	File       string              `protobuf:"bytes,3,opt,name=file,proto3" json:"file,omitempty"`              // path of the file holding the comment, when it is not the analyzed file (C++ header)
+	TargetLine int32               `protobuf:"varint,4,opt,name=targetLine,proto3" json:"targetLine,omitempty"` // line of the code the comment stands for, past the decorators, attributes and comments in between; 0 when nothing follows it
 }
 
 func (x *StmtComment) Reset() {
 	*x = StmtComment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_NodeType_proto_msgTypes[18]
+		mi := &file_proto_NodeType_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1442,7 +2035,7 @@ func (x *StmtComment) String() string {
 func (*StmtComment) ProtoMessage() {}
 
 func (x *StmtComment) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_NodeType_proto_msgTypes[18]
+	mi := &file_proto_NodeType_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1455,7 +2048,7 @@ func (x *StmtComment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StmtComment.ProtoReflect.Descriptor instead.
 func (*StmtComment) Descriptor() ([]byte, []int) {
-	return file_proto_NodeType_proto_rawDescGZIP(), []int{18}
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *StmtComment) GetText() string {
@@ -1472,6 +2065,20 @@ func (x *StmtComment) GetLocation() *StmtLocationInFile {
 	return nil
 }
 
+func (x *StmtComment) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *StmtComment) GetTargetLine() int32 {
+	if x != nil {
+		return x.TargetLine
+	}
+	return 0
+}
+
 // ------------------------------------
 // -- Volume: Operators and Operands
 // ------------------------------------
@@ -1486,7 +2093,7 @@ type StmtOperator struct {
 func (x *StmtOperator) Reset() {
 	*x = StmtOperator{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_NodeType_proto_msgTypes[19]
+		mi := &file_proto_NodeType_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1499,7 +2106,7 @@ func (x *StmtOperator) String() string {
 func (*StmtOperator) ProtoMessage() {}
 
 func (x *StmtOperator) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_NodeType_proto_msgTypes[19]
+	mi := &file_proto_NodeType_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1512,7 +2119,7 @@ func (x *StmtOperator) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StmtOperator.ProtoReflect.Descriptor instead.
 func (*StmtOperator) Descriptor() ([]byte, []int) {
-	return file_proto_NodeType_proto_rawDescGZIP(), []int{19}
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *StmtOperator) GetName() string {
@@ -1533,7 +2140,7 @@ type StmtOperand struct {
 func (x *StmtOperand) Reset() {
 	*x = StmtOperand{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_NodeType_proto_msgTypes[20]
+		mi := &file_proto_NodeType_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1546,7 +2153,7 @@ func (x *StmtOperand) String() string {
 func (*StmtOperand) ProtoMessage() {}
 
 func (x *StmtOperand) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_NodeType_proto_msgTypes[20]
+	mi := &file_proto_NodeType_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1559,7 +2166,7 @@ func (x *StmtOperand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StmtOperand.ProtoReflect.Descriptor instead.
 func (*StmtOperand) Descriptor() ([]byte, []int) {
-	return file_proto_NodeType_proto_rawDescGZIP(), []int{20}
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *StmtOperand) GetName() string {
@@ -1580,7 +2187,7 @@ type StmtMethodCall struct {
 func (x *StmtMethodCall) Reset() {
 	*x = StmtMethodCall{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_NodeType_proto_msgTypes[21]
+		mi := &file_proto_NodeType_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1593,7 +2200,7 @@ func (x *StmtMethodCall) String() string {
 func (*StmtMethodCall) ProtoMessage() {}
 
 func (x *StmtMethodCall) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_NodeType_proto_msgTypes[21]
+	mi := &file_proto_NodeType_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1606,7 +2213,7 @@ func (x *StmtMethodCall) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StmtMethodCall.ProtoReflect.Descriptor instead.
 func (*StmtMethodCall) Descriptor() ([]byte, []int) {
-	return file_proto_NodeType_proto_rawDescGZIP(), []int{21}
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *StmtMethodCall) GetName() string {
@@ -1633,7 +2240,7 @@ type LinesOfCode struct {
 func (x *LinesOfCode) Reset() {
 	*x = LinesOfCode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_NodeType_proto_msgTypes[22]
+		mi := &file_proto_NodeType_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1646,7 +2253,7 @@ func (x *LinesOfCode) String() string {
 func (*LinesOfCode) ProtoMessage() {}
 
 func (x *LinesOfCode) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_NodeType_proto_msgTypes[22]
+	mi := &file_proto_NodeType_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1659,7 +2266,7 @@ func (x *LinesOfCode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LinesOfCode.ProtoReflect.Descriptor instead.
 func (*LinesOfCode) Descriptor() ([]byte, []int) {
-	return file_proto_NodeType_proto_rawDescGZIP(), []int{22}
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *LinesOfCode) GetLinesOfCode() int32 {
@@ -1705,12 +2312,14 @@ type Analyze struct {
 	Risk            *Risk            `protobuf:"bytes,4,opt,name=risk,proto3" json:"risk,omitempty"`
 	Coupling        *Coupling        `protobuf:"bytes,5,opt,name=coupling,proto3" json:"coupling,omitempty"`
 	ClassCohesion   *ClassCohesion   `protobuf:"bytes,6,opt,name=classCohesion,proto3" json:"classCohesion,omitempty"`
+	ObjectOriented  *ObjectOriented  `protobuf:"bytes,7,opt,name=objectOriented,proto3" json:"objectOriented,omitempty"`
+	AbcSize         *AbcSize         `protobuf:"bytes,8,opt,name=abcSize,proto3" json:"abcSize,omitempty"`
 }
 
 func (x *Analyze) Reset() {
 	*x = Analyze{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_NodeType_proto_msgTypes[23]
+		mi := &file_proto_NodeType_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1723,7 +2332,7 @@ func (x *Analyze) String() string {
 func (*Analyze) ProtoMessage() {}
 
 func (x *Analyze) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_NodeType_proto_msgTypes[23]
+	mi := &file_proto_NodeType_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1736,7 +2345,7 @@ func (x *Analyze) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Analyze.ProtoReflect.Descriptor instead.
 func (*Analyze) Descriptor() ([]byte, []int) {
-	return file_proto_NodeType_proto_rawDescGZIP(), []int{23}
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *Analyze) GetComplexity() *Complexity {
@@ -1781,18 +2390,35 @@ func (x *Analyze) GetClassCohesion() *ClassCohesion {
 	return nil
 }
 
+func (x *Analyze) GetObjectOriented() *ObjectOriented {
+	if x != nil {
+		return x.ObjectOriented
+	}
+	return nil
+}
+
+func (x *Analyze) GetAbcSize() *AbcSize {
+	if x != nil {
+		return x.AbcSize
+	}
+	return nil
+}
+
 type Complexity struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Cyclomatic *int32 `protobuf:"varint,1,opt,name=cyclomatic,proto3,oneof" json:"cyclomatic,omitempty"`
+	Cognitive  *int32 `protobuf:"varint,2,opt,name=cognitive,proto3,oneof" json:"cognitive,omitempty"`   // cognitive complexity (nesting-aware)
+	Npath      *int64 `protobuf:"varint,3,opt,name=npath,proto3,oneof" json:"npath,omitempty"`           // number of acyclic execution paths (saturates)
+	MaxNesting *int32 `protobuf:"varint,4,opt,name=maxNesting,proto3,oneof" json:"maxNesting,omitempty"` // deepest nesting of control structures
 }
 
 func (x *Complexity) Reset() {
 	*x = Complexity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_NodeType_proto_msgTypes[24]
+		mi := &file_proto_NodeType_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1805,7 +2431,7 @@ func (x *Complexity) String() string {
 func (*Complexity) ProtoMessage() {}
 
 func (x *Complexity) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_NodeType_proto_msgTypes[24]
+	mi := &file_proto_NodeType_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1818,7 +2444,7 @@ func (x *Complexity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Complexity.ProtoReflect.Descriptor instead.
 func (*Complexity) Descriptor() ([]byte, []int) {
-	return file_proto_NodeType_proto_rawDescGZIP(), []int{24}
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *Complexity) GetCyclomatic() int32 {
@@ -1828,6 +2454,100 @@ func (x *Complexity) GetCyclomatic() int32 {
 	return 0
 }
 
+func (x *Complexity) GetCognitive() int32 {
+	if x != nil && x.Cognitive != nil {
+		return *x.Cognitive
+	}
+	return 0
+}
+
+func (x *Complexity) GetNpath() int64 {
+	if x != nil && x.Npath != nil {
+		return *x.Npath
+	}
+	return 0
+}
+
+func (x *Complexity) GetMaxNesting() int32 {
+	if x != nil && x.MaxNesting != nil {
+		return *x.MaxNesting
+	}
+	return 0
+}
+
+// Assignments, branches (calls) and conditions of the code, and the size of
+// the vector they make
+type AbcSize struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Assignments *int32   `protobuf:"varint,1,opt,name=assignments,proto3,oneof" json:"assignments,omitempty"`
+	Branches    *int32   `protobuf:"varint,2,opt,name=branches,proto3,oneof" json:"branches,omitempty"`
+	Conditions  *int32   `protobuf:"varint,3,opt,name=conditions,proto3,oneof" json:"conditions,omitempty"`
+	Magnitude   *float64 `protobuf:"fixed64,4,opt,name=magnitude,proto3,oneof" json:"magnitude,omitempty"` // sqrt(A² + B² + C²)
+}
+
+func (x *AbcSize) Reset() {
+	*x = AbcSize{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_NodeType_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AbcSize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbcSize) ProtoMessage() {}
+
+func (x *AbcSize) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_NodeType_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbcSize.ProtoReflect.Descriptor instead.
+func (*AbcSize) Descriptor() ([]byte, []int) {
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AbcSize) GetAssignments() int32 {
+	if x != nil && x.Assignments != nil {
+		return *x.Assignments
+	}
+	return 0
+}
+
+func (x *AbcSize) GetBranches() int32 {
+	if x != nil && x.Branches != nil {
+		return *x.Branches
+	}
+	return 0
+}
+
+func (x *AbcSize) GetConditions() int32 {
+	if x != nil && x.Conditions != nil {
+		return *x.Conditions
+	}
+	return 0
+}
+
+func (x *AbcSize) GetMagnitude() float64 {
+	if x != nil && x.Magnitude != nil {
+		return *x.Magnitude
+	}
+	return 0
+}
+
 type Volume struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1849,7 +2569,7 @@ type Volume struct {
 func (x *Volume) Reset() {
 	*x = Volume{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_NodeType_proto_msgTypes[25]
+		mi := &file_proto_NodeType_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1862,7 +2582,7 @@ func (x *Volume) String() string {
 func (*Volume) ProtoMessage() {}
 
 func (x *Volume) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_NodeType_proto_msgTypes[25]
+	mi := &file_proto_NodeType_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1875,7 +2595,7 @@ func (x *Volume) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Volume.ProtoReflect.Descriptor instead.
 func (*Volume) Descriptor() ([]byte, []int) {
-	return file_proto_NodeType_proto_rawDescGZIP(), []int{25}
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *Volume) GetLoc() int32 {
@@ -1968,7 +2688,7 @@ type Maintainability struct {
 func (x *Maintainability) Reset() {
 	*x = Maintainability{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_NodeType_proto_msgTypes[26]
+		mi := &file_proto_NodeType_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1981,7 +2701,7 @@ func (x *Maintainability) String() string {
 func (*Maintainability) ProtoMessage() {}
 
 func (x *Maintainability) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_NodeType_proto_msgTypes[26]
+	mi := &file_proto_NodeType_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1994,7 +2714,7 @@ func (x *Maintainability) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Maintainability.ProtoReflect.Descriptor instead.
 func (*Maintainability) Descriptor() ([]byte, []int) {
-	return file_proto_NodeType_proto_rawDescGZIP(), []int{26}
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *Maintainability) GetMaintainabilityIndex() float64 {
@@ -2018,32 +2738,91 @@ func (x *Maintainability) GetCommentWeight() float64 {
 	return 0
 }
 
-type ClassCohesion struct {
+type ClassCohesion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lcom1 *float64 `protobuf:"fixed64,1,opt,name=lcom1,proto3,oneof" json:"lcom1,omitempty"` // Henderson-Sellers algorithm
+	Lcom4 *int32   `protobuf:"varint,2,opt,name=lcom4,proto3,oneof" json:"lcom4,omitempty"`  // Hitz & Montazer (we don't use Basili algorithm => not pertinent)
+}
+
+func (x *ClassCohesion) Reset() {
+	*x = ClassCohesion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_NodeType_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClassCohesion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassCohesion) ProtoMessage() {}
+
+func (x *ClassCohesion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_NodeType_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassCohesion.ProtoReflect.Descriptor instead.
+func (*ClassCohesion) Descriptor() ([]byte, []int) {
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ClassCohesion) GetLcom1() float64 {
+	if x != nil && x.Lcom1 != nil {
+		return *x.Lcom1
+	}
+	return 0
+}
+
+func (x *ClassCohesion) GetLcom4() int32 {
+	if x != nil && x.Lcom4 != nil {
+		return *x.Lcom4
+	}
+	return 0
+}
+
+// Chidamber & Kemerer suite (LCOM is in ClassCohesion)
+type ObjectOriented struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Lcom1 *float64 `protobuf:"fixed64,1,opt,name=lcom1,proto3,oneof" json:"lcom1,omitempty"` // Henderson-Sellers algorithm
-	Lcom4 *int32   `protobuf:"varint,2,opt,name=lcom4,proto3,oneof" json:"lcom4,omitempty"`  // Hitz & Montazer (we don't use Basili algorithm => not pertinent)
+	Wmc *int32 `protobuf:"varint,1,opt,name=wmc,proto3,oneof" json:"wmc,omitempty"` // weighted methods per class: sum of the cyclomatic complexity of the methods
+	Dit *int32 `protobuf:"varint,2,opt,name=dit,proto3,oneof" json:"dit,omitempty"` // depth of inheritance tree
+	Noc *int32 `protobuf:"varint,3,opt,name=noc,proto3,oneof" json:"noc,omitempty"` // number of children: classes directly extending this class
+	Cbo *int32 `protobuf:"varint,4,opt,name=cbo,proto3,oneof" json:"cbo,omitempty"` // coupling between objects: classes using or used by this class
+	Rfc *int32 `protobuf:"varint,5,opt,name=rfc,proto3,oneof" json:"rfc,omitempty"` // response for a class: methods of the class plus distinct methods they call
 }
 
-func (x *ClassCohesion) Reset() {
-	*x = ClassCohesion{}
+func (x *ObjectOriented) Reset() {
+	*x = ObjectOriented{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_NodeType_proto_msgTypes[27]
+		mi := &file_proto_NodeType_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClassCohesion) String() string {
+func (x *ObjectOriented) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClassCohesion) ProtoMessage() {}
+func (*ObjectOriented) ProtoMessage() {}
 
-func (x *ClassCohesion) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_NodeType_proto_msgTypes[27]
+func (x *ObjectOriented) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_NodeType_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2054,21 +2833,42 @@ func (x *ClassCohesion) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClassCohesion.ProtoReflect.Descriptor instead.
-func (*ClassCohesion) Descriptor() ([]byte, []int) {
-	return file_proto_NodeType_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use ObjectOriented.ProtoReflect.Descriptor instead.
+func (*ObjectOriented) Descriptor() ([]byte, []int) {
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *ClassCohesion) GetLcom1() float64 {
-	if x != nil && x.Lcom1 != nil {
-		return *x.Lcom1
+func (x *ObjectOriented) GetWmc() int32 {
+	if x != nil && x.Wmc != nil {
+		return *x.Wmc
 	}
 	return 0
 }
 
-func (x *ClassCohesion) GetLcom4() int32 {
-	if x != nil && x.Lcom4 != nil {
-		return *x.Lcom4
+func (x *ObjectOriented) GetDit() int32 {
+	if x != nil && x.Dit != nil {
+		return *x.Dit
+	}
+	return 0
+}
+
+func (x *ObjectOriented) GetNoc() int32 {
+	if x != nil && x.Noc != nil {
+		return *x.Noc
+	}
+	return 0
+}
+
+func (x *ObjectOriented) GetCbo() int32 {
+	if x != nil && x.Cbo != nil {
+		return *x.Cbo
+	}
+	return 0
+}
+
+func (x *ObjectOriented) GetRfc() int32 {
+	if x != nil && x.Rfc != nil {
+		return *x.Rfc
 	}
 	return 0
 }
@@ -2089,7 +2889,7 @@ type Commits struct {
 func (x *Commits) Reset() {
 	*x = Commits{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_NodeType_proto_msgTypes[28]
+		mi := &file_proto_NodeType_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2102,7 +2902,7 @@ func (x *Commits) String() string {
 func (*Commits) ProtoMessage() {}
 
 func (x *Commits) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_NodeType_proto_msgTypes[28]
+	mi := &file_proto_NodeType_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2115,7 +2915,7 @@ func (x *Commits) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Commits.ProtoReflect.Descriptor instead.
 func (*Commits) Descriptor() ([]byte, []int) {
-	return file_proto_NodeType_proto_rawDescGZIP(), []int{28}
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *Commits) GetCount() int32 {
@@ -2152,7 +2952,7 @@ type Commit struct {
 func (x *Commit) Reset() {
 	*x = Commit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_NodeType_proto_msgTypes[29]
+		mi := &file_proto_NodeType_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2165,7 +2965,7 @@ func (x *Commit) String() string {
 func (*Commit) ProtoMessage() {}
 
 func (x *Commit) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_NodeType_proto_msgTypes[29]
+	mi := &file_proto_NodeType_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2178,7 +2978,7 @@ func (x *Commit) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Commit.ProtoReflect.Descriptor instead.
 func (*Commit) Descriptor() ([]byte, []int) {
-	return file_proto_NodeType_proto_rawDescGZIP(), []int{29}
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *Commit) GetHash() string {
@@ -2216,7 +3016,7 @@ type Risk struct {
 func (x *Risk) Reset() {
 	*x = Risk{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_NodeType_proto_msgTypes[30]
+		mi := &file_proto_NodeType_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2229,7 +3029,7 @@ func (x *Risk) String() string {
 func (*Risk) ProtoMessage() {}
 
 func (x *Risk) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_NodeType_proto_msgTypes[30]
+	mi := &file_proto_NodeType_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2242,7 +3042,7 @@ func (x *Risk) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Risk.ProtoReflect.Descriptor instead.
 func (*Risk) Descriptor() ([]byte, []int) {
-	return file_proto_NodeType_proto_rawDescGZIP(), []int{30}
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *Risk) GetScore() float64 {
@@ -2268,7 +3068,7 @@ type Coupling struct {
 func (x *Coupling) Reset() {
 	*x = Coupling{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_NodeType_proto_msgTypes[31]
+		mi := &file_proto_NodeType_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2281,7 +3081,7 @@ func (x *Coupling) String() string {
 func (*Coupling) ProtoMessage() {}
 
 func (x *Coupling) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_NodeType_proto_msgTypes[31]
+	mi := &file_proto_NodeType_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2294,7 +3094,7 @@ func (x *Coupling) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Coupling.ProtoReflect.Descriptor instead.
 func (*Coupling) Descriptor() ([]byte, []int) {
-	return file_proto_NodeType_proto_rawDescGZIP(), []int{31}
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *Coupling) GetAfferent() int32 {
@@ -2334,7 +3134,7 @@ type Graph struct {
 func (x *Graph) Reset() {
 	*x = Graph{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_NodeType_proto_msgTypes[32]
+		mi := &file_proto_NodeType_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2347,7 +3147,7 @@ func (x *Graph) String() string {
 func (*Graph) ProtoMessage() {}
 
 func (x *Graph) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_NodeType_proto_msgTypes[32]
+	mi := &file_proto_NodeType_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2360,7 +3160,7 @@ func (x *Graph) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Graph.ProtoReflect.Descriptor instead.
 func (*Graph) Descriptor() ([]byte, []int) {
-	return file_proto_NodeType_proto_rawDescGZIP(), []int{32}
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *Graph) GetNodes() map[string]*Node {
@@ -2383,7 +3183,7 @@ type Node struct {
 func (x *Node) Reset() {
 	*x = Node{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_NodeType_proto_msgTypes[33]
+		mi := &file_proto_NodeType_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2396,7 +3196,7 @@ func (x *Node) String() string {
 func (*Node) ProtoMessage() {}
 
 func (x *Node) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_NodeType_proto_msgTypes[33]
+	mi := &file_proto_NodeType_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2409,7 +3209,7 @@ func (x *Node) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Node.ProtoReflect.Descriptor instead.
 func (*Node) Descriptor() ([]byte, []int) {
-	return file_proto_NodeType_proto_rawDescGZIP(), []int{33}
+	return file_proto_NodeType_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *Node) GetId() string {
@@ -2501,7 +3301,7 @@ var file_proto_NodeType_proto_rawDesc = []byte{
 	0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
 	0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x18, 0x73, 0x74, 0x6d, 0x74,
 	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
-	0x63, 0x69, 0x65, 0x73, 0x22, 0xc4, 0x02, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a,
+	0x63, 0x69, 0x65, 0x73, 0x22, 0xca, 0x04, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a,
 	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
 	0x68, 0x12, 0x25, 0x0a, 0x05, 0x73, 0x74, 0x6d, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x0f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74,
@@ -2521,117 +3321,61 @@ var file_proto_NodeType_proto_rawDesc = []byte{
 	0x6b, 0x73, 0x75, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x74,
 	0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x50, 0x61,
 	0x74, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x54, 0x65, 0x73, 0x74, 0x22, 0xb0, 0x01, 0x0a, 0x12,
-	0x53, 0x74, 0x6d, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x46, 0x69,
-	0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x69, 0x6e, 0x65,
-	0x12, 0x22, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x6f, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x46, 0x69, 0x6c,
-	0x65, 0x50, 0x6f, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x1e,
-	0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0a, 0x65, 0x6e, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x6f, 0x73, 0x12, 0x1e,
-	0x0a, 0x0a, 0x62, 0x6c, 0x61, 0x6e, 0x6b, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0a, 0x62, 0x6c, 0x61, 0x6e, 0x6b, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x22, 0xcd,
-	0x01, 0x0a, 0x0d, 0x53, 0x74, 0x6d, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x12, 0x22, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
-	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x73, 0x74, 0x6d, 0x74, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53,
-	0x74, 0x6d, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x6d, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x08, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x4c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x0b, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x4f, 0x66,
-	0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x4e, 0x6f, 0x64,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x4f, 0x66, 0x43, 0x6f, 0x64,
-	0x65, 0x52, 0x0b, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x4f, 0x66, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x8e,
-	0x01, 0x0a, 0x07, 0x53, 0x74, 0x6d, 0x74, 0x55, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25,
-	0x0a, 0x05, 0x73, 0x74, 0x6d, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x73, 0x52, 0x05,
-	0x73, 0x74, 0x6d, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x6e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0xe3, 0x03, 0x0a, 0x09, 0x53, 0x74, 0x6d, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x22, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x4e, 0x6f,
-	0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x25, 0x0a, 0x05, 0x73, 0x74, 0x6d, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74,
-	0x73, 0x52, 0x05, 0x73, 0x74, 0x6d, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x4e, 0x6f, 0x64,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e,
-	0x53, 0x74, 0x6d, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
-	0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x6f,
-	0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x6e, 0x64, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x28,
-	0x0a, 0x07, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0e, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x52,
-	0x07, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x0a, 0x69, 0x6d, 0x70, 0x6c,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x4e,
-	0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x0a, 0x69, 0x6d,
-	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x73,
-	0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x75, 0x73, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x0b,
-	0x6c, 0x69, 0x6e, 0x65, 0x73, 0x4f, 0x66, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x4c, 0x69, 0x6e,
-	0x65, 0x73, 0x4f, 0x66, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0b, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x4f,
-	0x66, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x8b, 0x04, 0x0a, 0x0c, 0x53, 0x74, 0x6d, 0x74, 0x46, 0x75,
-	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e,
-	0x4e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x73, 0x74,
-	0x6d, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x6d, 0x74,
-	0x73, 0x12, 0x38, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53,
-	0x74, 0x6d, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x46, 0x69, 0x6c,
-	0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x08, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x34,
-	0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d,
-	0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x73,
-	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x08, 0x6f,
-	0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x3a, 0x0a, 0x0b, 0x6d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x4e,
-	0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x4d, 0x65, 0x74, 0x68,
-	0x6f, 0x64, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x0b, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x43, 0x61,
-	0x6c, 0x6c, 0x73, 0x12, 0x37, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
-	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
-	0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2c, 0x0a, 0x09,
-	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0e, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x52,
-	0x09, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x73, 0x12, 0x37, 0x0a, 0x0b, 0x6c, 0x69,
-	0x6e, 0x65, 0x73, 0x4f, 0x66, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x4c, 0x69, 0x6e, 0x65, 0x73,
-	0x4f, 0x66, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0b, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x4f, 0x66, 0x43,
-	0x6f, 0x64, 0x65, 0x22, 0x37, 0x0a, 0x0d, 0x53, 0x74, 0x6d, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x65, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x8c, 0x01, 0x0a,
-	0x16, 0x53, 0x74, 0x6d, 0x74, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x70,
-	0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6c, 0x61, 0x73, 0x73,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x73,
-	0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x75, 0x6e,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d,
-	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61,
-	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x22, 0xbe, 0x01, 0x0a, 0x0d,
-	0x53, 0x74, 0x6d, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x22, 0x0a,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x54, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x05, 0x63,
+	0x65, 0x6c, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x43, 0x65,
+	0x6c, 0x6c, 0x52, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x12, 0x36, 0x0a, 0x0b, 0x70, 0x61, 0x72,
+	0x73, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x73, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x12, 0x2d, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x12, 0x38, 0x0a, 0x0c, 0x71, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
+	0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0c, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x0a, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x73, 0x22, 0x39, 0x0a, 0x0b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x12, 0x14, 0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52,
+	0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x22, 0x6c, 0x0a, 0x0a,
+	0x50, 0x61, 0x72, 0x73, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7a, 0x0a, 0x0a, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x5c, 0x0a, 0x0c, 0x4e, 0x6f, 0x74, 0x65, 0x62, 0x6f,
+	0x6f, 0x6b, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e,
+	0x64, 0x4c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x65, 0x6e, 0x64,
+	0x4c, 0x69, 0x6e, 0x65, 0x22, 0xc4, 0x01, 0x0a, 0x12, 0x53, 0x74, 0x6d, 0x74, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x6f, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x46, 0x69,
+	0x6c, 0x65, 0x50, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x65, 0x6e, 0x64,
+	0x46, 0x69, 0x6c, 0x65, 0x50, 0x6f, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x61, 0x6e, 0x6b,
+	0x4c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x62, 0x6c, 0x61,
+	0x6e, 0x6b, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x22, 0xcd, 0x01, 0x0a, 0x0d,
+	0x53, 0x74, 0x6d, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x22, 0x0a,
 	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x4e, 0x6f,
 	0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d,
 	0x65, 0x12, 0x25, 0x0a, 0x05, 0x73, 0x74, 0x6d, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
@@ -2640,10 +3384,20 @@ var file_proto_NodeType_proto_rawDesc = []byte{
 	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x4e, 0x6f, 0x64,
 	0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x49, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x07, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x4e,
-	0x61, 0x6d, 0x65, 0x52, 0x07, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x73, 0x22, 0x90, 0x01, 0x0a,
-	0x09, 0x53, 0x74, 0x6d, 0x74, 0x54, 0x72, 0x61, 0x69, 0x74, 0x12, 0x22, 0x0a, 0x04, 0x6e, 0x61,
+	0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x0b, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x4f, 0x66, 0x43, 0x6f, 0x64,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x2e, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x4f, 0x66, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0b,
+	0x6c, 0x69, 0x6e, 0x65, 0x73, 0x4f, 0x66, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x07,
+	0x53, 0x74, 0x6d, 0x74, 0x55, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x73,
+	0x74, 0x6d, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x6d,
+	0x74, 0x73, 0x12, 0x38, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e,
+	0x53, 0x74, 0x6d, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa5, 0x05, 0x0a,
+	0x09, 0x53, 0x74, 0x6d, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x04, 0x6e, 0x61,
 	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54,
 	0x79, 0x70, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25,
 	0x0a, 0x05, 0x73, 0x74, 0x6d, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
@@ -2651,57 +3405,194 @@ var file_proto_NodeType_proto_rawDesc = []byte{
 	0x73, 0x74, 0x6d, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79,
 	0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x6e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x71, 0x0a, 0x0e, 0x53, 0x74, 0x6d, 0x74, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x49,
-	0x66, 0x12, 0x25, 0x0a, 0x05, 0x73, 0x74, 0x6d, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74,
-	0x73, 0x52, 0x05, 0x73, 0x74, 0x6d, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x4e, 0x6f, 0x64,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x75, 0x0a, 0x12, 0x53, 0x74, 0x6d, 0x74, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69,
-	0x6f, 0x6e, 0x45, 0x6c, 0x73, 0x65, 0x49, 0x66, 0x12, 0x25, 0x0a, 0x05, 0x73, 0x74, 0x6d, 0x74,
+	0x6e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x31, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x34, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x2e, 0x53, 0x74, 0x6d, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x6e,
+	0x64, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x07, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x07, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x0a, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x0a, 0x69, 0x6d, 0x70, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x4e,
+	0x61, 0x6d, 0x65, 0x52, 0x04, 0x75, 0x73, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x0b, 0x6c, 0x69, 0x6e,
+	0x65, 0x73, 0x4f, 0x66, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x4f,
+	0x66, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0b, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x4f, 0x66, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x36, 0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x35, 0x0a,
+	0x0a, 0x64, 0x6f, 0x63, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x64, 0x6f, 0x63, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x22, 0xd3, 0x05, 0x0a, 0x0c, 0x53, 0x74, 0x6d, 0x74, 0x46, 0x75, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x4e,
+	0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x73, 0x74, 0x6d,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x6d, 0x74, 0x73,
+	0x12, 0x38, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74,
+	0x6d, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x08, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x34, 0x0a,
+	0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x2e, 0x53, 0x74, 0x6d, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x08, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x3a, 0x0a, 0x0b, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x43, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x0b, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x43, 0x61, 0x6c,
+	0x6c, 0x73, 0x12, 0x37, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x52,
+	0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2c, 0x0a, 0x09, 0x65,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x09,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x73, 0x12, 0x37, 0x0a, 0x0b, 0x6c, 0x69, 0x6e,
+	0x65, 0x73, 0x4f, 0x66, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x4f,
+	0x66, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0b, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x4f, 0x66, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x36, 0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x35, 0x0a,
+	0x0a, 0x64, 0x6f, 0x63, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x64, 0x6f, 0x63, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x61, 0x6c,
+	0x6c, 0x65, 0x64, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x09, 0x4d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1e,
+	0x0a, 0x0a, 0x69, 0x73, 0x41, 0x62, 0x73, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x41, 0x62, 0x73, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73,
+	0x46, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x46,
+	0x69, 0x6e, 0x61, 0x6c, 0x22, 0x4e, 0x0a, 0x0a, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x72, 0x67, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0x37, 0x0a, 0x0d, 0x53, 0x74, 0x6d, 0x74, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x8c, 0x01,
+	0x0a, 0x16, 0x53, 0x74, 0x6d, 0x74, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x65,
+	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x75,
+	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x22, 0xa8, 0x02, 0x0a,
+	0x0d, 0x53, 0x74, 0x6d, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x22,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x73, 0x74, 0x6d, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d,
+	0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x6d, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x08, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x07, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e,
+	0x4e, 0x61, 0x6d, 0x65, 0x52, 0x07, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x31, 0x0a,
+	0x09, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x73, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73,
+	0x12, 0x35, 0x0a, 0x0a, 0x64, 0x6f, 0x63, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e,
+	0x53, 0x74, 0x6d, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x64, 0x6f, 0x63,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x90, 0x01, 0x0a, 0x09, 0x53, 0x74, 0x6d, 0x74,
+	0x54, 0x72, 0x61, 0x69, 0x74, 0x12, 0x22, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x4e,
+	0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x73, 0x74, 0x6d,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x6d, 0x74, 0x73,
+	0x12, 0x38, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74,
+	0x6d, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x71, 0x0a, 0x0e, 0x53, 0x74,
+	0x6d, 0x74, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x66, 0x12, 0x25, 0x0a, 0x05,
+	0x73, 0x74, 0x6d, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74,
+	0x6d, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x2e, 0x53, 0x74, 0x6d, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x46,
+	0x69, 0x6c, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x75, 0x0a,
+	0x12, 0x53, 0x74, 0x6d, 0x74, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6c, 0x73,
+	0x65, 0x49, 0x66, 0x12, 0x25, 0x0a, 0x05, 0x73, 0x74, 0x6d, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74,
+	0x6d, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x6d, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x73, 0x0a, 0x10, 0x53, 0x74, 0x6d, 0x74, 0x44, 0x65, 0x63, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6c, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x73, 0x74, 0x6d, 0x74,
 	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79,
 	0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x6d, 0x74, 0x73, 0x12,
 	0x38, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1c, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d,
 	0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x52,
 	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x73, 0x0a, 0x10, 0x53, 0x74, 0x6d,
-	0x74, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6c, 0x73, 0x65, 0x12, 0x25, 0x0a,
+	0x74, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x73, 0x65, 0x12, 0x25, 0x0a,
 	0x05, 0x73, 0x74, 0x6d, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x4e,
 	0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x73, 0x52, 0x05, 0x73,
 	0x74, 0x6d, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70,
 	0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
-	0x46, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x73,
-	0x0a, 0x10, 0x53, 0x74, 0x6d, 0x74, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x61,
-	0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x73, 0x74, 0x6d, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d,
-	0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x6d, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x08, 0x6c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x4e, 0x6f,
-	0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x75, 0x0a, 0x12, 0x53, 0x74, 0x6d, 0x74, 0x44, 0x65, 0x63, 0x69, 0x73,
-	0x69, 0x6f, 0x6e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x25, 0x0a, 0x05, 0x73, 0x74, 0x6d,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x6d, 0x74, 0x73,
-	0x12, 0x38, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74,
-	0x6d, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x46, 0x69, 0x6c, 0x65,
-	0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6b, 0x0a, 0x08, 0x53, 0x74,
-	0x6d, 0x74, 0x4c, 0x6f, 0x6f, 0x70, 0x12, 0x25, 0x0a, 0x05, 0x73, 0x74, 0x6d, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x2e, 0x53, 0x74, 0x6d, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x6d, 0x74, 0x73, 0x12, 0x38, 0x0a,
-	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x4c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5b, 0x0a, 0x0b, 0x53, 0x74, 0x6d, 0x74, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x38, 0x0a, 0x08, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x4e,
-	0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x4c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x22, 0x0a, 0x0c, 0x53, 0x74, 0x6d, 0x74, 0x4f, 0x70, 0x65, 0x72,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x75,
+	0x0a, 0x12, 0x53, 0x74, 0x6d, 0x74, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x77,
+	0x69, 0x74, 0x63, 0x68, 0x12, 0x25, 0x0a, 0x05, 0x73, 0x74, 0x6d, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53,
+	0x74, 0x6d, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x6d, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x08, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6b, 0x0a, 0x08, 0x53, 0x74, 0x6d, 0x74, 0x4c, 0x6f, 0x6f,
+	0x70, 0x12, 0x25, 0x0a, 0x05, 0x73, 0x74, 0x6d, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74,
+	0x73, 0x52, 0x05, 0x73, 0x74, 0x6d, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x8f, 0x01, 0x0a, 0x0b, 0x53, 0x74, 0x6d, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x38, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x6d, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x66, 0x69, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x69,
+	0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x4c, 0x69, 0x6e, 0x65, 0x22, 0x22, 0x0a, 0x0c, 0x53, 0x74, 0x6d, 0x74, 0x4f, 0x70, 0x65, 0x72,
 	0x61, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x21, 0x0a, 0x0b, 0x53, 0x74, 0x6d, 0x74,
 	0x4f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
@@ -2720,7 +3611,7 @@ var file_proto_NodeType_proto_rawDesc = []byte{
 	0x6f, 0x64, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x6e, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
 	0x74, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x4f, 0x66, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01,
 	0x28, 0x05, 0x52, 0x15, 0x6e, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69,
-	0x6e, 0x65, 0x73, 0x4f, 0x66, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xc1, 0x02, 0x0a, 0x07, 0x41, 0x6e,
+	0x6e, 0x65, 0x73, 0x4f, 0x66, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xb0, 0x03, 0x0a, 0x07, 0x41, 0x6e,
 	0x61, 0x6c, 0x79, 0x7a, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x78,
 	0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
 	0x54, 0x79, 0x70, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x78, 0x69, 0x74, 0x79, 0x52,
@@ -2740,11 +3631,40 @@ var file_proto_NodeType_proto_rawDesc = []byte{
 	0x0a, 0x0d, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x43, 0x6f, 0x68, 0x65, 0x73, 0x69, 0x6f, 0x6e, 0x18,
 	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65,
 	0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x43, 0x6f, 0x68, 0x65, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0d,
-	0x63, 0x6c, 0x61, 0x73, 0x73, 0x43, 0x6f, 0x68, 0x65, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x40, 0x0a,
+	0x63, 0x6c, 0x61, 0x73, 0x73, 0x43, 0x6f, 0x68, 0x65, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a,
+	0x0e, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x52,
+	0x0e, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x12,
+	0x2b, 0x0a, 0x07, 0x61, 0x62, 0x63, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x41, 0x62, 0x63, 0x53,
+	0x69, 0x7a, 0x65, 0x52, 0x07, 0x61, 0x62, 0x63, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xca, 0x01, 0x0a,
 	0x0a, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x78, 0x69, 0x74, 0x79, 0x12, 0x23, 0x0a, 0x0a, 0x63,
 	0x79, 0x63, 0x6c, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48,
 	0x00, 0x52, 0x0a, 0x63, 0x79, 0x63, 0x6c, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x88, 0x01, 0x01,
-	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x79, 0x63, 0x6c, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x22,
+	0x12, 0x21, 0x0a, 0x09, 0x63, 0x6f, 0x67, 0x6e, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x09, 0x63, 0x6f, 0x67, 0x6e, 0x69, 0x74, 0x69, 0x76, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x6e, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x02, 0x52, 0x05, 0x6e, 0x70, 0x61, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x23,
+	0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x4e, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x03, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x4e, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x79, 0x63, 0x6c, 0x6f, 0x6d, 0x61, 0x74,
+	0x69, 0x63, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6f, 0x67, 0x6e, 0x69, 0x74, 0x69, 0x76, 0x65,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6e, 0x70, 0x61, 0x74, 0x68, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d,
+	0x61, 0x78, 0x4e, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x22, 0xd3, 0x01, 0x0a, 0x07, 0x41, 0x62,
+	0x63, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x25, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0b, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08,
+	0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01,
+	0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x02, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x6d, 0x61, 0x67, 0x6e, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52, 0x09, 0x6d, 0x61, 0x67, 0x6e, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x65, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x61, 0x67, 0x6e, 0x69, 0x74, 0x75, 0x64, 0x65, 0x22,
 	0x92, 0x05, 0x0a, 0x06, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x03, 0x6c, 0x6f,
 	0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x03, 0x6c, 0x6f, 0x63, 0x88, 0x01,
 	0x01, 0x12, 0x17, 0x0a, 0x04, 0x6c, 0x6c, 0x6f, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48,
@@ -2810,43 +3730,63 @@ var file_proto_NodeType_proto_rawDesc = []byte{
 	0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x63, 0x6f, 0x6d, 0x34, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x05, 0x48, 0x01, 0x52, 0x05, 0x6c, 0x63, 0x6f, 0x6d, 0x34, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a,
 	0x06, 0x5f, 0x6c, 0x63, 0x6f, 0x6d, 0x31, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x63, 0x6f, 0x6d,
-	0x34, 0x22, 0x73, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x63, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x4e, 0x6f,
-	0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x07, 0x63,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x22, 0x48, 0x0a, 0x06, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x68, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65,
-	0x22, 0x1c, 0x0a, 0x04, 0x52, 0x69, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x64,
-	0x0a, 0x08, 0x43, 0x6f, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x66,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x66,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x66, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x66, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
-	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x62, 0x69,
-	0x6c, 0x69, 0x74, 0x79, 0x22, 0x83, 0x01, 0x0a, 0x05, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x30,
-	0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x4e,
-	0x6f, 0x64, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73,
-	0x1a, 0x48, 0x0a, 0x0a, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x24, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0e, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x50, 0x0a, 0x04, 0x4e, 0x6f,
-	0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x25, 0x5a, 0x23,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x6c, 0x6c, 0x65,
-	0x63, 0x6b, 0x34, 0x35, 0x2f, 0x61, 0x73, 0x74, 0x2d, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
-	0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x34, 0x22, 0xab, 0x01, 0x0a, 0x0e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4f, 0x72, 0x69, 0x65,
+	0x6e, 0x74, 0x65, 0x64, 0x12, 0x15, 0x0a, 0x03, 0x77, 0x6d, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x00, 0x52, 0x03, 0x77, 0x6d, 0x63, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x64,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x03, 0x64, 0x69, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x6e, 0x6f, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48,
+	0x02, 0x52, 0x03, 0x6e, 0x6f, 0x63, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x63, 0x62, 0x6f,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x03, 0x52, 0x03, 0x63, 0x62, 0x6f, 0x88, 0x01, 0x01,
+	0x12, 0x15, 0x0a, 0x03, 0x72, 0x66, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x04, 0x52,
+	0x03, 0x72, 0x66, 0x63, 0x88, 0x01, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x77, 0x6d, 0x63, 0x42,
+	0x06, 0x0a, 0x04, 0x5f, 0x64, 0x69, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6e, 0x6f, 0x63, 0x42,
+	0x06, 0x0a, 0x04, 0x5f, 0x63, 0x62, 0x6f, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x72, 0x66, 0x63, 0x22,
+	0x73, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x73, 0x22, 0x48, 0x0a, 0x06, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x1c,
+	0x0a, 0x04, 0x52, 0x69, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x64, 0x0a, 0x08,
+	0x43, 0x6f, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x66, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x66, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x22, 0x83, 0x01, 0x0a, 0x05, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x30, 0x0a, 0x05,
+	0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x1a, 0x48,
+	0x0a, 0x0a, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x24,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x50, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e,
+	0x4e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x2a, 0x85, 0x01, 0x0a, 0x0a, 0x56,
+	0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x12, 0x56, 0x49, 0x53,
+	0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10,
+	0x00, 0x12, 0x15, 0x0a, 0x11, 0x56, 0x49, 0x53, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f,
+	0x50, 0x55, 0x42, 0x4c, 0x49, 0x43, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x56, 0x49, 0x53, 0x49,
+	0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x45, 0x43, 0x54, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x56, 0x49, 0x53, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59,
+	0x5f, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x56, 0x49,
+	0x53, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45,
+	0x10, 0x04, 0x42, 0x27, 0x5a, 0x25, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x61, 0x73, 0x74, 0x2d, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2f, 0x61, 0x73, 0x74,
+	0x2d, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2861,124 +3801,151 @@ func file_proto_NodeType_proto_rawDescGZIP() []byte {
 	return file_proto_NodeType_proto_rawDescData
 }
 
-var file_proto_NodeType_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
+var file_proto_NodeType_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_NodeType_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
 var file_proto_NodeType_proto_goTypes = []interface{}{
-	(*Name)(nil),                   // 0: NodeType.Name
-	(*Stmts)(nil),                  // 1: NodeType.Stmts
-	(*File)(nil),                   // 2: NodeType.File
-	(*StmtLocationInFile)(nil),     // 3: NodeType.StmtLocationInFile
-	(*StmtNamespace)(nil),          // 4: NodeType.StmtNamespace
-	(*StmtUse)(nil),                // 5: NodeType.StmtUse
-	(*StmtClass)(nil),              // 6: NodeType.StmtClass
-	(*StmtFunction)(nil),           // 7: NodeType.StmtFunction
-	(*StmtParameter)(nil),          // 8: NodeType.StmtParameter
-	(*StmtExternalDependency)(nil), // 9: NodeType.StmtExternalDependency
-	(*StmtInterface)(nil),          // 10: NodeType.StmtInterface
-	(*StmtTrait)(nil),              // 11: NodeType.StmtTrait
-	(*StmtDecisionIf)(nil),         // 12: NodeType.StmtDecisionIf
-	(*StmtDecisionElseIf)(nil),     // 13: NodeType.StmtDecisionElseIf
-	(*StmtDecisionElse)(nil),       // 14: NodeType.StmtDecisionElse
-	(*StmtDecisionCase)(nil),       // 15: NodeType.StmtDecisionCase
-	(*StmtDecisionSwitch)(nil),     // 16: NodeType.StmtDecisionSwitch
-	(*StmtLoop)(nil),               // 17: NodeType.StmtLoop
-	(*StmtComment)(nil),            // 18: NodeType.StmtComment
-	(*StmtOperator)(nil),           // 19: NodeType.StmtOperator
-	(*StmtOperand)(nil),            // 20: NodeType.StmtOperand
-	(*StmtMethodCall)(nil),         // 21: NodeType.StmtMethodCall
-	(*LinesOfCode)(nil),            // 22: NodeType.LinesOfCode
-	(*Analyze)(nil),                // 23: NodeType.Analyze
-	(*Complexity)(nil),             // 24: NodeType.Complexity
-	(*Volume)(nil),                 // 25: NodeType.Volume
-	(*Maintainability)(nil),        // 26: NodeType.Maintainability
-	(*ClassCohesion)(nil),          // 27: NodeType.ClassCohesion
-	(*Commits)(nil),                // 28: NodeType.Commits
-	(*Commit)(nil),                 // 29: NodeType.Commit
-	(*Risk)(nil),                   // 30: NodeType.Risk
-	(*Coupling)(nil),               // 31: NodeType.Coupling
-	(*Graph)(nil),                  // 32: NodeType.Graph
-	(*Node)(nil),                   // 33: NodeType.Node
-	nil,                            // 34: NodeType.Graph.NodesEntry
+	(Visibility)(0),                // 0: NodeType.Visibility
+	(*Name)(nil),                   // 1: NodeType.Name
+	(*Stmts)(nil),                  // 2: NodeType.Stmts
+	(*File)(nil),                   // 3: NodeType.File
+	(*TokenStream)(nil),            // 4: NodeType.TokenStream
+	(*ParseError)(nil),             // 5: NodeType.ParseError
+	(*QueryMatch)(nil),             // 6: NodeType.QueryMatch
+	(*NotebookCell)(nil),           // 7: NodeType.NotebookCell
+	(*StmtLocationInFile)(nil),     // 8: NodeType.StmtLocationInFile
+	(*StmtNamespace)(nil),          // 9: NodeType.StmtNamespace
+	(*StmtUse)(nil),                // 10: NodeType.StmtUse
+	(*StmtClass)(nil),              // 11: NodeType.StmtClass
+	(*StmtFunction)(nil),           // 12: NodeType.StmtFunction
+	(*Modifiers)(nil),              // 13: NodeType.Modifiers
+	(*Annotation)(nil),             // 14: NodeType.Annotation
+	(*StmtParameter)(nil),          // 15: NodeType.StmtParameter
+	(*StmtExternalDependency)(nil), // 16: NodeType.StmtExternalDependency
+	(*StmtInterface)(nil),          // 17: NodeType.StmtInterface
+	(*StmtTrait)(nil),              // 18: NodeType.StmtTrait
+	(*StmtDecisionIf)(nil),         // 19: NodeType.StmtDecisionIf
+	(*StmtDecisionElseIf)(nil),     // 20: NodeType.StmtDecisionElseIf
+	(*StmtDecisionElse)(nil),       // 21: NodeType.StmtDecisionElse
+	(*StmtDecisionCase)(nil),       // 22: NodeType.StmtDecisionCase
+	(*StmtDecisionSwitch)(nil),     // 23: NodeType.StmtDecisionSwitch
+	(*StmtLoop)(nil),               // 24: NodeType.StmtLoop
+	(*StmtComment)(nil),            // 25: NodeType.StmtComment
+	(*StmtOperator)(nil),           // 26: NodeType.StmtOperator
+	(*StmtOperand)(nil),            // 27: NodeType.StmtOperand
+	(*StmtMethodCall)(nil),         // 28: NodeType.StmtMethodCall
+	(*LinesOfCode)(nil),            // 29: NodeType.LinesOfCode
+	(*Analyze)(nil),                // 30: NodeType.Analyze
+	(*Complexity)(nil),             // 31: NodeType.Complexity
+	(*AbcSize)(nil),                // 32: NodeType.AbcSize
+	(*Volume)(nil),                 // 33: NodeType.Volume
+	(*Maintainability)(nil),        // 34: NodeType.Maintainability
+	(*ClassCohesion)(nil),          // 35: NodeType.ClassCohesion
+	(*ObjectOriented)(nil),         // 36: NodeType.ObjectOriented
+	(*Commits)(nil),                // 37: NodeType.Commits
+	(*Commit)(nil),                 // 38: NodeType.Commit
+	(*Risk)(nil),                   // 39: NodeType.Risk
+	(*Coupling)(nil),               // 40: NodeType.Coupling
+	(*Graph)(nil),                  // 41: NodeType.Graph
+	(*Node)(nil),                   // 42: NodeType.Node
+	nil,                            // 43: NodeType.Graph.NodesEntry
 }
 var file_proto_NodeType_proto_depIdxs = []int32{
-	23, // 0: NodeType.Stmts.analyze:type_name -> NodeType.Analyze
-	6,  // 1: NodeType.Stmts.stmtClass:type_name -> NodeType.StmtClass
-	7,  // 2: NodeType.Stmts.stmtFunction:type_name -> NodeType.StmtFunction
-	10, // 3: NodeType.Stmts.stmtInterface:type_name -> NodeType.StmtInterface
-	11, // 4: NodeType.Stmts.stmtTrait:type_name -> NodeType.StmtTrait
-	5,  // 5: NodeType.Stmts.stmtUse:type_name -> NodeType.StmtUse
-	4,  // 6: NodeType.Stmts.stmtNamespace:type_name -> NodeType.StmtNamespace
-	12, // 7: NodeType.Stmts.stmtDecisionIf:type_name -> NodeType.StmtDecisionIf
-	13, // 8: NodeType.Stmts.stmtDecisionElseIf:type_name -> NodeType.StmtDecisionElseIf
-	14, // 9: NodeType.Stmts.stmtDecisionElse:type_name -> NodeType.StmtDecisionElse
-	15, // 10: NodeType.Stmts.stmtDecisionCase:type_name -> NodeType.StmtDecisionCase
-	17, // 11: NodeType.Stmts.stmtLoop:type_name -> NodeType.StmtLoop
-	16, // 12: NodeType.Stmts.stmtDecisionSwitch:type_name -> NodeType.StmtDecisionSwitch
-	9,  // 13: NodeType.Stmts.stmtExternalDependencies:type_name -> NodeType.StmtExternalDependency
-	1,  // 14: NodeType.File.stmts:type_name -> NodeType.Stmts
-	22, // 15: NodeType.File.linesOfCode:type_name -> NodeType.LinesOfCode
-	28, // 16: NodeType.File.commits:type_name -> NodeType.Commits
-	0,  // 17: NodeType.StmtNamespace.name:type_name -> NodeType.Name
-	1,  // 18: NodeType.StmtNamespace.stmts:type_name -> NodeType.Stmts
-	3,  // 19: NodeType.StmtNamespace.location:type_name -> NodeType.StmtLocationInFile
-	22, // 20: NodeType.StmtNamespace.linesOfCode:type_name -> NodeType.LinesOfCode
-	0,  // 21: NodeType.StmtUse.name:type_name -> NodeType.Name
-	1,  // 22: NodeType.StmtUse.stmts:type_name -> NodeType.Stmts
-	3,  // 23: NodeType.StmtUse.location:type_name -> NodeType.StmtLocationInFile
-	0,  // 24: NodeType.StmtClass.name:type_name -> NodeType.Name
-	1,  // 25: NodeType.StmtClass.stmts:type_name -> NodeType.Stmts
-	3,  // 26: NodeType.StmtClass.location:type_name -> NodeType.StmtLocationInFile
-	18, // 27: NodeType.StmtClass.comments:type_name -> NodeType.StmtComment
-	19, // 28: NodeType.StmtClass.operators:type_name -> NodeType.StmtOperator
-	20, // 29: NodeType.StmtClass.operands:type_name -> NodeType.StmtOperand
-	0,  // 30: NodeType.StmtClass.extends:type_name -> NodeType.Name
-	0,  // 31: NodeType.StmtClass.implements:type_name -> NodeType.Name
-	0,  // 32: NodeType.StmtClass.uses:type_name -> NodeType.Name
-	22, // 33: NodeType.StmtClass.linesOfCode:type_name -> NodeType.LinesOfCode
-	0,  // 34: NodeType.StmtFunction.name:type_name -> NodeType.Name
-	1,  // 35: NodeType.StmtFunction.stmts:type_name -> NodeType.Stmts
-	3,  // 36: NodeType.StmtFunction.location:type_name -> NodeType.StmtLocationInFile
-	18, // 37: NodeType.StmtFunction.comments:type_name -> NodeType.StmtComment
-	19, // 38: NodeType.StmtFunction.operators:type_name -> NodeType.StmtOperator
-	20, // 39: NodeType.StmtFunction.operands:type_name -> NodeType.StmtOperand
-	21, // 40: NodeType.StmtFunction.methodCalls:type_name -> NodeType.StmtMethodCall
-	8,  // 41: NodeType.StmtFunction.parameters:type_name -> NodeType.StmtParameter
-	0,  // 42: NodeType.StmtFunction.externals:type_name -> NodeType.Name
-	22, // 43: NodeType.StmtFunction.linesOfCode:type_name -> NodeType.LinesOfCode
-	0,  // 44: NodeType.StmtInterface.name:type_name -> NodeType.Name
-	1,  // 45: NodeType.StmtInterface.stmts:type_name -> NodeType.Stmts
-	3,  // 46: NodeType.StmtInterface.location:type_name -> NodeType.StmtLocationInFile
-	0,  // 47: NodeType.StmtInterface.extends:type_name -> NodeType.Name
-	0,  // 48: NodeType.StmtTrait.name:type_name -> NodeType.Name
-	1,  // 49: NodeType.StmtTrait.stmts:type_name -> NodeType.Stmts
-	3,  // 50: NodeType.StmtTrait.location:type_name -> NodeType.StmtLocationInFile
-	1,  // 51: NodeType.StmtDecisionIf.stmts:type_name -> NodeType.Stmts
-	3,  // 52: NodeType.StmtDecisionIf.location:type_name -> NodeType.StmtLocationInFile
-	1,  // 53: NodeType.StmtDecisionElseIf.stmts:type_name -> NodeType.Stmts
-	3,  // 54: NodeType.StmtDecisionElseIf.location:type_name -> NodeType.StmtLocationInFile
-	1,  // 55: NodeType.StmtDecisionElse.stmts:type_name -> NodeType.Stmts
-	3,  // 56: NodeType.StmtDecisionElse.location:type_name -> NodeType.StmtLocationInFile
-	1,  // 57: NodeType.StmtDecisionCase.stmts:type_name -> NodeType.Stmts
-	3,  // 58: NodeType.StmtDecisionCase.location:type_name -> NodeType.StmtLocationInFile
-	1,  // 59: NodeType.StmtDecisionSwitch.stmts:type_name -> NodeType.Stmts
-	3,  // 60: NodeType.StmtDecisionSwitch.location:type_name -> NodeType.StmtLocationInFile
-	1,  // 61: NodeType.StmtLoop.stmts:type_name -> NodeType.Stmts
-	3,  // 62: NodeType.StmtLoop.location:type_name -> NodeType.StmtLocationInFile
-	3,  // 63: NodeType.StmtComment.location:type_name -> NodeType.StmtLocationInFile
-	24, // 64: NodeType.Analyze.complexity:type_name -> NodeType.Complexity
-	25, // 65: NodeType.Analyze.volume:type_name -> NodeType.Volume
-	26, // 66: NodeType.Analyze.maintainability:type_name -> NodeType.Maintainability
-	30, // 67: NodeType.Analyze.risk:type_name -> NodeType.Risk
-	31, // 68: NodeType.Analyze.coupling:type_name -> NodeType.Coupling
-	27, // 69: NodeType.Analyze.classCohesion:type_name -> NodeType.ClassCohesion
-	29, // 70: NodeType.Commits.commits:type_name -> NodeType.Commit
-	34, // 71: NodeType.Graph.nodes:type_name -> NodeType.Graph.NodesEntry
-	0,  // 72: NodeType.Node.name:type_name -> NodeType.Name
-	33, // 73: NodeType.Graph.NodesEntry.value:type_name -> NodeType.Node
-	74, // [74:74] is the sub-list for method output_type
-	74, // [74:74] is the sub-list for method input_type
-	74, // [74:74] is the sub-list for extension type_name
-	74, // [74:74] is the sub-list for extension extendee
-	0,  // [0:74] is the sub-list for field type_name
+	30, // 0: NodeType.Stmts.analyze:type_name -> NodeType.Analyze
+	11, // 1: NodeType.Stmts.stmtClass:type_name -> NodeType.StmtClass
+	12, // 2: NodeType.Stmts.stmtFunction:type_name -> NodeType.StmtFunction
+	17, // 3: NodeType.Stmts.stmtInterface:type_name -> NodeType.StmtInterface
+	18, // 4: NodeType.Stmts.stmtTrait:type_name -> NodeType.StmtTrait
+	10, // 5: NodeType.Stmts.stmtUse:type_name -> NodeType.StmtUse
+	9,  // 6: NodeType.Stmts.stmtNamespace:type_name -> NodeType.StmtNamespace
+	19, // 7: NodeType.Stmts.stmtDecisionIf:type_name -> NodeType.StmtDecisionIf
+	20, // 8: NodeType.Stmts.stmtDecisionElseIf:type_name -> NodeType.StmtDecisionElseIf
+	21, // 9: NodeType.Stmts.stmtDecisionElse:type_name -> NodeType.StmtDecisionElse
+	22, // 10: NodeType.Stmts.stmtDecisionCase:type_name -> NodeType.StmtDecisionCase
+	24, // 11: NodeType.Stmts.stmtLoop:type_name -> NodeType.StmtLoop
+	23, // 12: NodeType.Stmts.stmtDecisionSwitch:type_name -> NodeType.StmtDecisionSwitch
+	16, // 13: NodeType.Stmts.stmtExternalDependencies:type_name -> NodeType.StmtExternalDependency
+	2,  // 14: NodeType.File.stmts:type_name -> NodeType.Stmts
+	29, // 15: NodeType.File.linesOfCode:type_name -> NodeType.LinesOfCode
+	37, // 16: NodeType.File.commits:type_name -> NodeType.Commits
+	7,  // 17: NodeType.File.cells:type_name -> NodeType.NotebookCell
+	5,  // 18: NodeType.File.parseErrors:type_name -> NodeType.ParseError
+	4,  // 19: NodeType.File.tokens:type_name -> NodeType.TokenStream
+	6,  // 20: NodeType.File.queryMatches:type_name -> NodeType.QueryMatch
+	25, // 21: NodeType.File.directives:type_name -> NodeType.StmtComment
+	1,  // 22: NodeType.StmtNamespace.name:type_name -> NodeType.Name
+	2,  // 23: NodeType.StmtNamespace.stmts:type_name -> NodeType.Stmts
+	8,  // 24: NodeType.StmtNamespace.location:type_name -> NodeType.StmtLocationInFile
+	29, // 25: NodeType.StmtNamespace.linesOfCode:type_name -> NodeType.LinesOfCode
+	1,  // 26: NodeType.StmtUse.name:type_name -> NodeType.Name
+	2,  // 27: NodeType.StmtUse.stmts:type_name -> NodeType.Stmts
+	8,  // 28: NodeType.StmtUse.location:type_name -> NodeType.StmtLocationInFile
+	1,  // 29: NodeType.StmtClass.name:type_name -> NodeType.Name
+	2,  // 30: NodeType.StmtClass.stmts:type_name -> NodeType.Stmts
+	8,  // 31: NodeType.StmtClass.location:type_name -> NodeType.StmtLocationInFile
+	25, // 32: NodeType.StmtClass.comments:type_name -> NodeType.StmtComment
+	26, // 33: NodeType.StmtClass.operators:type_name -> NodeType.StmtOperator
+	27, // 34: NodeType.StmtClass.operands:type_name -> NodeType.StmtOperand
+	1,  // 35: NodeType.StmtClass.extends:type_name -> NodeType.Name
+	1,  // 36: NodeType.StmtClass.implements:type_name -> NodeType.Name
+	1,  // 37: NodeType.StmtClass.uses:type_name -> NodeType.Name
+	29, // 38: NodeType.StmtClass.linesOfCode:type_name -> NodeType.LinesOfCode
+	13, // 39: NodeType.StmtClass.modifiers:type_name -> NodeType.Modifiers
+	14, // 40: NodeType.StmtClass.annotations:type_name -> NodeType.Annotation
+	25, // 41: NodeType.StmtClass.docComment:type_name -> NodeType.StmtComment
+	1,  // 42: NodeType.StmtFunction.name:type_name -> NodeType.Name
+	2,  // 43: NodeType.StmtFunction.stmts:type_name -> NodeType.Stmts
+	8,  // 44: NodeType.StmtFunction.location:type_name -> NodeType.StmtLocationInFile
+	25, // 45: NodeType.StmtFunction.comments:type_name -> NodeType.StmtComment
+	26, // 46: NodeType.StmtFunction.operators:type_name -> NodeType.StmtOperator
+	27, // 47: NodeType.StmtFunction.operands:type_name -> NodeType.StmtOperand
+	28, // 48: NodeType.StmtFunction.methodCalls:type_name -> NodeType.StmtMethodCall
+	15, // 49: NodeType.StmtFunction.parameters:type_name -> NodeType.StmtParameter
+	1,  // 50: NodeType.StmtFunction.externals:type_name -> NodeType.Name
+	29, // 51: NodeType.StmtFunction.linesOfCode:type_name -> NodeType.LinesOfCode
+	13, // 52: NodeType.StmtFunction.modifiers:type_name -> NodeType.Modifiers
+	14, // 53: NodeType.StmtFunction.annotations:type_name -> NodeType.Annotation
+	25, // 54: NodeType.StmtFunction.docComment:type_name -> NodeType.StmtComment
+	0,  // 55: NodeType.Modifiers.visibility:type_name -> NodeType.Visibility
+	1,  // 56: NodeType.Annotation.name:type_name -> NodeType.Name
+	1,  // 57: NodeType.StmtInterface.name:type_name -> NodeType.Name
+	2,  // 58: NodeType.StmtInterface.stmts:type_name -> NodeType.Stmts
+	8,  // 59: NodeType.StmtInterface.location:type_name -> NodeType.StmtLocationInFile
+	1,  // 60: NodeType.StmtInterface.extends:type_name -> NodeType.Name
+	13, // 61: NodeType.StmtInterface.modifiers:type_name -> NodeType.Modifiers
+	25, // 62: NodeType.StmtInterface.docComment:type_name -> NodeType.StmtComment
+	1,  // 63: NodeType.StmtTrait.name:type_name -> NodeType.Name
+	2,  // 64: NodeType.StmtTrait.stmts:type_name -> NodeType.Stmts
+	8,  // 65: NodeType.StmtTrait.location:type_name -> NodeType.StmtLocationInFile
+	2,  // 66: NodeType.StmtDecisionIf.stmts:type_name -> NodeType.Stmts
+	8,  // 67: NodeType.StmtDecisionIf.location:type_name -> NodeType.StmtLocationInFile
+	2,  // 68: NodeType.StmtDecisionElseIf.stmts:type_name -> NodeType.Stmts
+	8,  // 69: NodeType.StmtDecisionElseIf.location:type_name -> NodeType.StmtLocationInFile
+	2,  // 70: NodeType.StmtDecisionElse.stmts:type_name -> NodeType.Stmts
+	8,  // 71: NodeType.StmtDecisionElse.location:type_name -> NodeType.StmtLocationInFile
+	2,  // 72: NodeType.StmtDecisionCase.stmts:type_name -> NodeType.Stmts
+	8,  // 73: NodeType.StmtDecisionCase.location:type_name -> NodeType.StmtLocationInFile
+	2,  // 74: NodeType.StmtDecisionSwitch.stmts:type_name -> NodeType.Stmts
+	8,  // 75: NodeType.StmtDecisionSwitch.location:type_name -> NodeType.StmtLocationInFile
+	2,  // 76: NodeType.StmtLoop.stmts:type_name -> NodeType.Stmts
+	8,  // 77: NodeType.StmtLoop.location:type_name -> NodeType.StmtLocationInFile
+	8,  // 78: NodeType.StmtComment.location:type_name -> NodeType.StmtLocationInFile
+	31, // 79: NodeType.Analyze.complexity:type_name -> NodeType.Complexity
+	33, // 80: NodeType.Analyze.volume:type_name -> NodeType.Volume
+	34, // 81: NodeType.Analyze.maintainability:type_name -> NodeType.Maintainability
+	39, // 82: NodeType.Analyze.risk:type_name -> NodeType.Risk
+	40, // 83: NodeType.Analyze.coupling:type_name -> NodeType.Coupling
+	35, // 84: NodeType.Analyze.classCohesion:type_name -> NodeType.ClassCohesion
+	36, // 85: NodeType.Analyze.objectOriented:type_name -> NodeType.ObjectOriented
+	32, // 86: NodeType.Analyze.abcSize:type_name -> NodeType.AbcSize
+	38, // 87: NodeType.Commits.commits:type_name -> NodeType.Commit
+	43, // 88: NodeType.Graph.nodes:type_name -> NodeType.Graph.NodesEntry
+	1,  // 89: NodeType.Node.name:type_name -> NodeType.Name
+	42, // 90: NodeType.Graph.NodesEntry.value:type_name -> NodeType.Node
+	91, // [91:91] is the sub-list for method output_type
+	91, // [91:91] is the sub-list for method input_type
+	91, // [91:91] is the sub-list for extension type_name
+	91, // [91:91] is the sub-list for extension extendee
+	0,  // [0:91] is the sub-list for field type_name
 }
 
 func init() { file_proto_NodeType_proto_init() }
@@ -3024,7 +3991,7 @@ func file_proto_NodeType_proto_init() {
 			}
 		}
 		file_proto_NodeType_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StmtLocationInFile); i {
+			switch v := v.(*TokenStream); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3036,7 +4003,7 @@ func file_proto_NodeType_proto_init() {
 			}
 		}
 		file_proto_NodeType_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StmtNamespace); i {
+			switch v := v.(*ParseError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3048,7 +4015,7 @@ func file_proto_NodeType_proto_init() {
 			}
 		}
 		file_proto_NodeType_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StmtUse); i {
+			switch v := v.(*QueryMatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3060,7 +4027,7 @@ func file_proto_NodeType_proto_init() {
 			}
 		}
 		file_proto_NodeType_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StmtClass); i {
+			switch v := v.(*NotebookCell); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3072,7 +4039,7 @@ func file_proto_NodeType_proto_init() {
 			}
 		}
 		file_proto_NodeType_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StmtFunction); i {
+			switch v := v.(*StmtLocationInFile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3084,7 +4051,7 @@ func file_proto_NodeType_proto_init() {
 			}
 		}
 		file_proto_NodeType_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StmtParameter); i {
+			switch v := v.(*StmtNamespace); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3096,7 +4063,7 @@ func file_proto_NodeType_proto_init() {
 			}
 		}
 		file_proto_NodeType_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StmtExternalDependency); i {
+			switch v := v.(*StmtUse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3108,7 +4075,7 @@ func file_proto_NodeType_proto_init() {
 			}
 		}
 		file_proto_NodeType_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StmtInterface); i {
+			switch v := v.(*StmtClass); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3120,7 +4087,7 @@ func file_proto_NodeType_proto_init() {
 			}
 		}
 		file_proto_NodeType_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StmtTrait); i {
+			switch v := v.(*StmtFunction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3132,7 +4099,7 @@ func file_proto_NodeType_proto_init() {
 			}
 		}
 		file_proto_NodeType_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StmtDecisionIf); i {
+			switch v := v.(*Modifiers); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3144,7 +4111,7 @@ func file_proto_NodeType_proto_init() {
 			}
 		}
 		file_proto_NodeType_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StmtDecisionElseIf); i {
+			switch v := v.(*Annotation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3156,7 +4123,7 @@ func file_proto_NodeType_proto_init() {
 			}
 		}
 		file_proto_NodeType_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StmtDecisionElse); i {
+			switch v := v.(*StmtParameter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3168,7 +4135,7 @@ func file_proto_NodeType_proto_init() {
 			}
 		}
 		file_proto_NodeType_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StmtDecisionCase); i {
+			switch v := v.(*StmtExternalDependency); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3180,7 +4147,7 @@ func file_proto_NodeType_proto_init() {
 			}
 		}
 		file_proto_NodeType_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StmtDecisionSwitch); i {
+			switch v := v.(*StmtInterface); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3192,7 +4159,7 @@ func file_proto_NodeType_proto_init() {
 			}
 		}
 		file_proto_NodeType_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StmtLoop); i {
+			switch v := v.(*StmtTrait); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3204,7 +4171,7 @@ func file_proto_NodeType_proto_init() {
 			}
 		}
 		file_proto_NodeType_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StmtComment); i {
+			switch v := v.(*StmtDecisionIf); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3216,7 +4183,7 @@ func file_proto_NodeType_proto_init() {
 			}
 		}
 		file_proto_NodeType_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StmtOperator); i {
+			switch v := v.(*StmtDecisionElseIf); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3228,7 +4195,7 @@ func file_proto_NodeType_proto_init() {
 			}
 		}
 		file_proto_NodeType_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StmtOperand); i {
+			switch v := v.(*StmtDecisionElse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3240,7 +4207,7 @@ func file_proto_NodeType_proto_init() {
 			}
 		}
 		file_proto_NodeType_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StmtMethodCall); i {
+			switch v := v.(*StmtDecisionCase); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3252,7 +4219,7 @@ func file_proto_NodeType_proto_init() {
 			}
 		}
 		file_proto_NodeType_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LinesOfCode); i {
+			switch v := v.(*StmtDecisionSwitch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3264,7 +4231,7 @@ func file_proto_NodeType_proto_init() {
 			}
 		}
 		file_proto_NodeType_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Analyze); i {
+			switch v := v.(*StmtLoop); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3276,7 +4243,7 @@ func file_proto_NodeType_proto_init() {
 			}
 		}
 		file_proto_NodeType_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Complexity); i {
+			switch v := v.(*StmtComment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3288,7 +4255,7 @@ func file_proto_NodeType_proto_init() {
 			}
 		}
 		file_proto_NodeType_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Volume); i {
+			switch v := v.(*StmtOperator); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3300,7 +4267,7 @@ func file_proto_NodeType_proto_init() {
 			}
 		}
 		file_proto_NodeType_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Maintainability); i {
+			switch v := v.(*StmtOperand); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3312,7 +4279,7 @@ func file_proto_NodeType_proto_init() {
 			}
 		}
 		file_proto_NodeType_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClassCohesion); i {
+			switch v := v.(*StmtMethodCall); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3324,7 +4291,7 @@ func file_proto_NodeType_proto_init() {
 			}
 		}
 		file_proto_NodeType_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Commits); i {
+			switch v := v.(*LinesOfCode); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3336,7 +4303,7 @@ func file_proto_NodeType_proto_init() {
 			}
 		}
 		file_proto_NodeType_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Commit); i {
+			switch v := v.(*Analyze); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3348,7 +4315,7 @@ func file_proto_NodeType_proto_init() {
 			}
 		}
 		file_proto_NodeType_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Risk); i {
+			switch v := v.(*Complexity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3360,7 +4327,7 @@ func file_proto_NodeType_proto_init() {
 			}
 		}
 		file_proto_NodeType_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Coupling); i {
+			switch v := v.(*AbcSize); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3372,7 +4339,7 @@ func file_proto_NodeType_proto_init() {
 			}
 		}
 		file_proto_NodeType_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Graph); i {
+			switch v := v.(*Volume); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3384,6 +4351,102 @@ func file_proto_NodeType_proto_init() {
 			}
 		}
 		file_proto_NodeType_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Maintainability); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_NodeType_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClassCohesion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_NodeType_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ObjectOriented); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_NodeType_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Commits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_NodeType_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Commit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_NodeType_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Risk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_NodeType_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Coupling); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_NodeType_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Graph); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_NodeType_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Node); i {
 			case 0:
 				return &v.state
@@ -3396,22 +4459,25 @@ func file_proto_NodeType_proto_init() {
 			}
 		}
 	}
-	file_proto_NodeType_proto_msgTypes[24].OneofWrappers = []interface{}{}
-	file_proto_NodeType_proto_msgTypes[25].OneofWrappers = []interface{}{}
-	file_proto_NodeType_proto_msgTypes[26].OneofWrappers = []interface{}{}
-	file_proto_NodeType_proto_msgTypes[27].OneofWrappers = []interface{}{}
+	file_proto_NodeType_proto_msgTypes[30].OneofWrappers = []interface{}{}
+	file_proto_NodeType_proto_msgTypes[31].OneofWrappers = []interface{}{}
+	file_proto_NodeType_proto_msgTypes[32].OneofWrappers = []interface{}{}
+	file_proto_NodeType_proto_msgTypes[33].OneofWrappers = []interface{}{}
+	file_proto_NodeType_proto_msgTypes[34].OneofWrappers = []interface{}{}
+	file_proto_NodeType_proto_msgTypes[35].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_NodeType_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   35,
+			NumEnums:      1,
+			NumMessages:   43,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_proto_NodeType_proto_goTypes,
 		DependencyIndexes: file_proto_NodeType_proto_depIdxs,
+		EnumInfos:         file_proto_NodeType_proto_enumTypes,
 		MessageInfos:      file_proto_NodeType_proto_msgTypes,
 	}.Build()
 	File_proto_NodeType_proto = out.File